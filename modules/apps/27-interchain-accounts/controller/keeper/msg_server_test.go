@@ -99,9 +99,10 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 					suite.Require().Equal(expectedChannelID, res.ChannelId)
 
 					events := ctx.EventManager().Events()
-					suite.Require().Len(events, 2)
+					suite.Require().Len(events, 3)
 					suite.Require().Equal(events[0].Type, channeltypes.EventTypeChannelOpenInit)
 					suite.Require().Equal(events[1].Type, sdk.EventTypeMessage)
+					suite.Require().Equal(events[2].Type, proto.MessageName(&channeltypes.EventChannelOpenInit{}))
 
 					path.EndpointA.ChannelConfig.PortID = res.PortId
 					path.EndpointA.ChannelID = res.ChannelId
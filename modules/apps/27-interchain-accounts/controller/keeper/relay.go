@@ -7,6 +7,7 @@ import (
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/internal/telemetry"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
@@ -55,11 +56,17 @@ func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketDa
 		return 0, err
 	}
 
+	telemetry.ReportSendTx(connectionID)
+
 	return sequence, nil
 }
 
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
 // due to the semantics of ORDERED channels
-func (Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if connectionID, err := k.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel()); err == nil {
+		telemetry.ReportTimeoutPacket(connectionID)
+	}
+
 	return nil
 }
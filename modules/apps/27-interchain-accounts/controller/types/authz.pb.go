@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/controller/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InterchainAccountAuthorization allows the grantee to submit MsgSendTx on behalf of the granter
+// over the allowed connections, restricted to the allowed messages executed by the interchain account
+type InterchainAccountAuthorization struct {
+	// the connection identifiers over which transactions can be sent, an empty list permits any connection
+	ConnectionIds []string `protobuf:"bytes,1,rep,name=connection_ids,json=connectionIds,proto3" json:"connection_ids,omitempty"`
+	// the type URLs of the messages that the interchain account is allowed to execute
+	AllowedMsgTypeUrls []string `protobuf:"bytes,2,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// the encoding of the interchain account packet data, which must match the encoding negotiated
+	// in the version metadata of the interchain account channels
+	Encoding string `protobuf:"bytes,3,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// the maximum number of transactions that can be sent, 0 permits an unlimited number
+	MaxUses uint64 `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// the number of transactions sent
+	Uses uint64 `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	// spend limit of the bank send messages executed by the interchain account, an empty spend
+	// limit does not limit bank sends
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *InterchainAccountAuthorization) Reset()         { *m = InterchainAccountAuthorization{} }
+func (m *InterchainAccountAuthorization) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountAuthorization) ProtoMessage()    {}
+func (*InterchainAccountAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f921fc62dd679fac, []int{0}
+}
+func (m *InterchainAccountAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountAuthorization.Merge(m, src)
+}
+func (m *InterchainAccountAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountAuthorization proto.InternalMessageInfo
+
+func (m *InterchainAccountAuthorization) GetConnectionIds() []string {
+	if m != nil {
+		return m.ConnectionIds
+	}
+	return nil
+}
+
+func (m *InterchainAccountAuthorization) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *InterchainAccountAuthorization) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+func (m *InterchainAccountAuthorization) GetMaxUses() uint64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *InterchainAccountAuthorization) GetUses() uint64 {
+	if m != nil {
+		return m.Uses
+	}
+	return 0
+}
+
+func (m *InterchainAccountAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InterchainAccountAuthorization)(nil), "ibc.applications.interchain_accounts.controller.v1.InterchainAccountAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/controller/v1/authz.proto", fileDescriptor_f921fc62dd679fac)
+}
+
+var fileDescriptor_f921fc62dd679fac = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xdf, 0x74, 0xd7, 0xda, 0xa6, 0xe8, 0x61, 0x50, 0xc8, 0xee, 0x21, 0x5d, 0x0a, 0x4a, 0x2e,
+	0x99, 0x31, 0xeb, 0x41, 0xf4, 0x20, 0xb4, 0x9e, 0x0a, 0x0a, 0xb2, 0xd8, 0x8b, 0x97, 0x30, 0x99,
+	0x0c, 0xd9, 0xd1, 0xc9, 0xbc, 0x90, 0x37, 0x59, 0xdb, 0x7e, 0x0a, 0x3f, 0x87, 0x67, 0x3f, 0x44,
+	0xf1, 0xd4, 0xa3, 0x20, 0xa8, 0xec, 0x7e, 0x11, 0xc9, 0x24, 0x76, 0x95, 0xee, 0x69, 0xe6, 0xfd,
+	0xde, 0xfb, 0xfd, 0xde, 0x5f, 0xff, 0xa5, 0xca, 0x04, 0xe3, 0x55, 0xa5, 0x95, 0xe0, 0x56, 0x81,
+	0x41, 0xa6, 0x8c, 0x95, 0xb5, 0x58, 0x70, 0x65, 0x52, 0x2e, 0x04, 0x34, 0xc6, 0x22, 0x13, 0x60,
+	0x6c, 0x0d, 0x5a, 0xcb, 0x9a, 0x2d, 0x13, 0xc6, 0x1b, 0xbb, 0xb8, 0xa4, 0x55, 0x0d, 0x16, 0xc8,
+	0x4c, 0x65, 0x82, 0xfe, 0xcb, 0xa7, 0x5b, 0xf8, 0x74, 0xc3, 0xa7, 0xcb, 0x64, 0x32, 0x16, 0x80,
+	0x25, 0x60, 0xea, 0x14, 0x58, 0x67, 0x74, 0x72, 0x93, 0x07, 0x05, 0x14, 0xd0, 0xe1, 0xed, 0xaf,
+	0x47, 0xc3, 0x2e, 0x86, 0x65, 0x1c, 0x25, 0x5b, 0x26, 0x99, 0xb4, 0x3c, 0x61, 0x02, 0x94, 0xe9,
+	0xfc, 0x47, 0x3f, 0x76, 0xfc, 0xf0, 0xf4, 0x26, 0xed, 0x71, 0x97, 0xf5, 0xb8, 0xb1, 0x0b, 0xa8,
+	0xd5, 0xa5, 0x2b, 0x8b, 0x3c, 0xf2, 0xef, 0x0b, 0x30, 0x46, 0x8a, 0xd6, 0x4a, 0x55, 0x8e, 0x81,
+	0x37, 0x1d, 0x46, 0xfb, 0xf3, 0x7b, 0x1b, 0xf4, 0x34, 0x47, 0x92, 0xf8, 0x0f, 0xb9, 0xd6, 0xf0,
+	0x49, 0xe6, 0x69, 0x89, 0x45, 0x6a, 0x2f, 0x2a, 0x99, 0x36, 0xb5, 0xc6, 0x60, 0xc7, 0x45, 0x93,
+	0xde, 0xf9, 0x06, 0x8b, 0x77, 0x17, 0x95, 0x3c, 0xab, 0x35, 0x92, 0x89, 0xbf, 0x27, 0x8d, 0x80,
+	0x5c, 0x99, 0x22, 0x18, 0x4e, 0xbd, 0x68, 0x7f, 0x7e, 0x63, 0x93, 0xb1, 0xbf, 0x57, 0xf2, 0xf3,
+	0xb4, 0x41, 0x89, 0xc1, 0x68, 0xea, 0x45, 0xa3, 0xf9, 0xdd, 0x92, 0x9f, 0x9f, 0xa1, 0x44, 0x42,
+	0xfc, 0x91, 0x83, 0xef, 0x38, 0xd8, 0xfd, 0x89, 0xf6, 0x0f, 0xb0, 0x92, 0x26, 0x4f, 0xb5, 0x2a,
+	0x95, 0x0d, 0x76, 0xa7, 0xc3, 0xe8, 0x60, 0x36, 0xa6, 0xfd, 0x84, 0xda, 0xee, 0x69, 0xdf, 0x3d,
+	0x7d, 0x05, 0xca, 0x9c, 0x3c, 0xb9, 0xfa, 0x79, 0x38, 0xf8, 0xf2, 0xeb, 0x30, 0x2a, 0x94, 0x5d,
+	0x34, 0x19, 0x15, 0x50, 0xf6, 0xe3, 0xec, 0x9f, 0x18, 0xf3, 0x8f, 0xac, 0x6d, 0x02, 0x1d, 0x01,
+	0xe7, 0xbe, 0xd3, 0x7f, 0xdd, 0xca, 0xbf, 0x78, 0xfc, 0xed, 0x6b, 0x7c, 0xd4, 0x6b, 0x77, 0x2b,
+	0xfd, 0x2b, 0xfe, 0xdf, 0xe8, 0x4e, 0x3e, 0x5c, 0xad, 0x42, 0xef, 0x7a, 0x15, 0x7a, 0xbf, 0x57,
+	0xa1, 0xf7, 0x79, 0x1d, 0x0e, 0xae, 0xd7, 0xe1, 0xe0, 0xfb, 0x3a, 0x1c, 0xbc, 0x7f, 0x7b, 0x3b,
+	0xaf, 0xca, 0x44, 0x5c, 0x00, 0x5b, 0x3e, 0x67, 0x25, 0xe4, 0x8d, 0x96, 0xd8, 0x1e, 0x17, 0xb2,
+	0xd9, 0xb3, 0x78, 0x73, 0x17, 0xf1, 0xb6, 0xbb, 0x72, 0x55, 0x66, 0xbb, 0x6e, 0xa1, 0x4f, 0xff,
+	0x0c, 0x00, 0x76, 0x84, 0x6d, 0xea, 0x97, 0x02, 0x00, 0x00,
+}
+
+func (m *InterchainAccountAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Uses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Uses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionIds) > 0 {
+		for iNdEx := len(m.ConnectionIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionIds[iNdEx])
+			copy(dAtA[i:], m.ConnectionIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.ConnectionIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainAccountAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConnectionIds) > 0 {
+		for _, s := range m.ConnectionIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for _, s := range m.AllowedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxUses))
+	}
+	if m.Uses != 0 {
+		n += 1 + sovAuthz(uint64(m.Uses))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccountAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionIds = append(m.ConnectionIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypeUrls = append(m.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			}
+			m.Uses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
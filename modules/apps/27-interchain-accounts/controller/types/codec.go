@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterInterfaces registers the interchain accounts controller message types using the provided InterfaceRegistry
@@ -14,5 +15,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSendTx{},
 		&MsgUpdateParams{},
 	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&InterchainAccountAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -7,4 +7,5 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrInvalidAuthorization        = errorsmod.Register(SubModuleName, 3, "invalid interchain account authorization")
 )
@@ -0,0 +1,276 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var _ authz.Authorization = (*InterchainAccountAuthorization)(nil)
+
+// bankCdc is used to decode the bank send messages of the interchain account packet data in order
+// to deduct their amounts from the spend limit.
+var bankCdc = func() *codec.ProtoCodec {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}()
+
+var (
+	msgSendTypeURL      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+// NewInterchainAccountAuthorization creates a new InterchainAccountAuthorization object.
+func NewInterchainAccountAuthorization(connectionIDs, allowedMsgTypeURLs []string, encoding string, maxUses uint64, spendLimit sdk.Coins) *InterchainAccountAuthorization {
+	return &InterchainAccountAuthorization{
+		ConnectionIds:      connectionIDs,
+		AllowedMsgTypeUrls: allowedMsgTypeURLs,
+		Encoding:           encoding,
+		MaxUses:            maxUses,
+		SpendLimit:         spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (InterchainAccountAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSendTx{})
+}
+
+// Accept implements Authorization.Accept.
+func (a InterchainAccountAuthorization) Accept(goCtx context.Context, msg proto.Message) (authz.AcceptResponse, error) {
+	msgSendTx, ok := msg.(*MsgSendTx)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidType, "type mismatch")
+	}
+
+	if len(a.ConnectionIds) != 0 && !slices.Contains(a.ConnectionIds, msgSendTx.ConnectionId) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "connection %s is not allowed", msgSendTx.ConnectionId)
+	}
+
+	if msgSendTx.PacketData.Type != icatypes.EXECUTE_TX {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(icatypes.ErrInvalidOutgoingData, "packet data type %s is not allowed", msgSendTx.PacketData.Type)
+	}
+
+	// the authorization has no access to the encoding negotiated on the channel, so packet data which can be
+	// decoded with an encoding other than the encoding of the authorization is rejected, as the host chain
+	// could otherwise execute messages other than those checked by the authorization
+	msgs, err := decodeCosmosTxMsgs(msgSendTx.PacketData.Data, a.Encoding)
+	if err != nil {
+		if encoding, found := packetDataEncoding(msgSendTx.PacketData.Data, a.Encoding); found {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "packet data is encoded with %s but the authorization only accepts %s, which must match the encoding negotiated in the version metadata of the interchain account channel", encoding, a.Encoding)
+		}
+
+		return authz.AcceptResponse{}, err
+	}
+
+	if encoding, found := packetDataEncoding(msgSendTx.PacketData.Data, a.Encoding); found {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "packet data can be decoded with both %s and %s", a.Encoding, encoding)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	gasCostPerIteration := ctx.KVGasConfig().IterNextCostFlat
+
+	spent := sdk.NewCoins()
+	for _, msgAny := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "interchain account authorization")
+		if !slices.Contains(a.AllowedMsgTypeUrls, msgAny.TypeUrl) {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type %s is not allowed", msgAny.TypeUrl)
+		}
+
+		coins, err := bankSendCoins(msgAny)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		spent = spent.Add(coins...)
+	}
+
+	if !a.SpendLimit.Empty() {
+		limitLeft, isNegative := a.SpendLimit.SafeSub(spent...)
+		if isNegative {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount %s is more than spend limit %s", spent, a.SpendLimit)
+		}
+
+		a.SpendLimit = limitLeft
+	}
+
+	a.Uses++
+	if a.MaxUses != 0 && a.Uses >= a.MaxUses {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a InterchainAccountAuthorization) ValidateBasic() error {
+	found := make(map[string]bool, 0)
+	for _, connectionID := range a.ConnectionIds {
+		if !connectiontypes.IsValidConnectionID(connectionID) {
+			return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionIdentifier, "invalid connection ID: %s", connectionID)
+		}
+		if found[connectionID] {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate connection ID: %s", connectionID)
+		}
+		found[connectionID] = true
+	}
+
+	if len(a.AllowedMsgTypeUrls) == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "allowed message type URLs cannot be empty")
+	}
+
+	found = make(map[string]bool, 0)
+	for _, typeURL := range a.AllowedMsgTypeUrls {
+		if typeURL == "" {
+			return errorsmod.Wrap(ErrInvalidAuthorization, "allowed message type URL cannot be empty")
+		}
+		if found[typeURL] {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate allowed message type URL: %s", typeURL)
+		}
+		found[typeURL] = true
+	}
+
+	if !slices.Contains([]string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}, a.Encoding) {
+		return errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", a.Encoding)
+	}
+
+	if a.MaxUses != 0 && a.Uses >= a.MaxUses {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "uses %d must be less than max uses %d", a.Uses, a.MaxUses)
+	}
+
+	if err := a.SpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// decodeCosmosTxMsgs decodes the messages of the CosmosTx in the interchain account packet data with the
+// provided encoding. The messages are returned as Anys since their concrete types are only known to the
+// host chain.
+func decodeCosmosTxMsgs(data []byte, encoding string) ([]*codectypes.Any, error) {
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		var cosmosTx icatypes.CosmosTx
+		if err := proto.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal CosmosTx with protobuf: %v", err)
+		}
+
+		return cosmosTx.Messages, nil
+	case icatypes.EncodingProto3JSON:
+		// the object keys are matched exactly and unknown keys are rejected as by the host chain, since
+		// encoding/json would otherwise match keys of struct fields case-insensitively
+		var cosmosTx map[string]json.RawMessage
+		if err := json.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal CosmosTx with proto3 json: %v", err)
+		}
+
+		var msgsJSON []json.RawMessage
+		for key, value := range cosmosTx {
+			if key != "messages" {
+				return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal CosmosTx with proto3 json: unknown field %s", key)
+			}
+
+			if err := json.Unmarshal(value, &msgsJSON); err != nil {
+				return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal CosmosTx with proto3 json: %v", err)
+			}
+		}
+
+		msgs := make([]*codectypes.Any, len(msgsJSON))
+		for i, msgJSON := range msgsJSON {
+			var msgFields map[string]json.RawMessage
+			if err := json.Unmarshal(msgJSON, &msgFields); err != nil {
+				return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal message with proto3 json: %v", err)
+			}
+
+			typeURLJSON, found := msgFields["@type"]
+			if !found {
+				return nil, errorsmod.Wrap(icatypes.ErrUnknownDataType, "cannot unmarshal message with proto3 json: missing @type")
+			}
+
+			var typeURL string
+			if err := json.Unmarshal(typeURLJSON, &typeURL); err != nil {
+				return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal message type URL with proto3 json: %v", err)
+			}
+
+			msgs[i] = &codectypes.Any{TypeUrl: typeURL}
+
+			// the bank send messages are converted to protobuf so that their amounts can be decoded
+			if typeURL == msgSendTypeURL || typeURL == msgMultiSendTypeURL {
+				var bankMsg sdk.Msg
+				if err := bankCdc.UnmarshalInterfaceJSON(msgJSON, &bankMsg); err != nil {
+					return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal %s with proto3 json: %v", typeURL, err)
+				}
+
+				bz, err := proto.Marshal(bankMsg)
+				if err != nil {
+					return nil, err
+				}
+
+				msgs[i].Value = bz
+			}
+		}
+
+		return msgs, nil
+	default:
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
+
+// packetDataEncoding returns the supported encoding, other than the provided encoding, with which the
+// CosmosTx of the packet data can be decoded, if any.
+func packetDataEncoding(data []byte, excludedEncoding string) (string, bool) {
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		if encoding == excludedEncoding {
+			continue
+		}
+
+		if _, err := decodeCosmosTxMsgs(data, encoding); err == nil {
+			return encoding, true
+		}
+	}
+
+	return "", false
+}
+
+// bankSendCoins returns the coins sent by the message if it is a bank MsgSend or MsgMultiSend.
+func bankSendCoins(msgAny *codectypes.Any) (sdk.Coins, error) {
+	switch msgAny.TypeUrl {
+	case msgSendTypeURL:
+		var msgSend banktypes.MsgSend
+		if err := proto.Unmarshal(msgAny.Value, &msgSend); err != nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal %s: %v", msgAny.TypeUrl, err)
+		}
+
+		return msgSend.Amount, nil
+	case msgMultiSendTypeURL:
+		var msgMultiSend banktypes.MsgMultiSend
+		if err := proto.Unmarshal(msgAny.Value, &msgMultiSend); err != nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal %s: %v", msgAny.TypeUrl, err)
+		}
+
+		coins := sdk.NewCoins()
+		for _, input := range msgMultiSend.Inputs {
+			coins = coins.Add(input.Coins...)
+		}
+
+		return coins, nil
+	default:
+		return nil, nil
+	}
+}
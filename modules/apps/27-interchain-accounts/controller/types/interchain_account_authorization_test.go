@@ -0,0 +1,341 @@
+package types_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestInterchainAccountAuthorizationAccept(t *testing.T) {
+	var (
+		msgs       []proto.Message
+		encoding   string
+		packetData []byte
+		icaAuthz   *types.InterchainAccountAuthorization
+		msg        *types.MsgSendTx
+	)
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	msgBankSend := &banktypes.MsgSend{
+		FromAddress: ibctesting.TestAccAddress,
+		ToAddress:   ibctesting.TestAccAddress,
+		Amount:      sdk.NewCoins(ibctesting.TestCoin),
+	}
+
+	msgDelegate := &stakingtypes.MsgDelegate{
+		DelegatorAddress: ibctesting.TestAccAddress,
+		ValidatorAddress: ibctesting.TestAccAddress,
+		Amount:           ibctesting.TestCoin,
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		assertResult func(res authz.AcceptResponse, err error)
+	}{
+		{
+			"success",
+			func() {},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+				require.False(t, res.Delete)
+
+				updated, ok := res.Updated.(*types.InterchainAccountAuthorization)
+				require.True(t, ok)
+				require.Equal(t, uint64(1), updated.Uses)
+				require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), updated.SpendLimit)
+			},
+		},
+		{
+			"success: proto3 json encoding",
+			func() {
+				encoding = icatypes.EncodingProto3JSON
+				icaAuthz.Encoding = icatypes.EncodingProto3JSON
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+
+				updated, ok := res.Updated.(*types.InterchainAccountAuthorization)
+				require.True(t, ok)
+				require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), updated.SpendLimit)
+			},
+		},
+		{
+			"success: empty spend limit does not limit bank sends",
+			func() {
+				icaAuthz.SpendLimit = nil
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+			},
+		},
+		{
+			"success: any connection allowed",
+			func() {
+				icaAuthz.ConnectionIds = nil
+				msg.ConnectionId = "connection-9"
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+			},
+		},
+		{
+			"success: max uses reached deletes the authorization",
+			func() {
+				icaAuthz.MaxUses = 1
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+				require.True(t, res.Delete)
+			},
+		},
+		{
+			"failure: connection not allowed",
+			func() {
+				msg.ConnectionId = "connection-9"
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, ibcerrors.ErrUnauthorized)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: message type not allowed",
+			func() {
+				msgs = append(msgs, msgDelegate)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, ibcerrors.ErrUnauthorized)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: spend limit exceeded",
+			func() {
+				msgs = append(msgs, msgBankSend)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, ibcerrors.ErrInsufficientFunds)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: packet data encoding does not match",
+			func() {
+				encoding = icatypes.EncodingProto3JSON
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, icatypes.ErrUnknownDataType)
+				require.ErrorContains(t, err, "packet data is encoded with proto3json but the authorization only accepts proto3")
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: proto3 json authorization rejects protobuf packet data",
+			func() {
+				icaAuthz.Encoding = icatypes.EncodingProto3JSON
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, icatypes.ErrUnknownDataType)
+				require.ErrorContains(t, err, "packet data is encoded with proto3 but the authorization only accepts proto3json")
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: packet data can be decoded with both encodings",
+			func() {
+				// the json is decoded with protobuf as a skipped group and length-delimited unknown fields, i.e. as a
+				// CosmosTx without any message, while it contains a message when decoded with proto3 json
+				typeURL := strings.Repeat("a", 89) + ",* " + strings.Repeat("a", 28)
+				packetData = []byte(fmt.Sprintf(`{"messages":[{"@type":"%s"}]}`, typeURL))
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, icatypes.ErrUnknownDataType)
+				require.ErrorContains(t, err, "packet data can be decoded with both proto3 and proto3json")
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: proto3 json keys do not match exactly",
+			func() {
+				icaAuthz.Encoding = icatypes.EncodingProto3JSON
+				packetData = []byte(`{"Messages":[{"@type":"/cosmos.staking.v1beta1.MsgDelegate"}]}`)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, icatypes.ErrUnknownDataType)
+				require.ErrorContains(t, err, "unknown field Messages")
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: packet data type is not execute tx",
+			func() {
+				msgs = []proto.Message{msgDelegate}
+				icaAuthz.AllowedMsgTypeUrls = []string{sdk.MsgTypeURL(msgDelegate)}
+				msg.PacketData.Type = icatypes.UNSPECIFIED
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, icatypes.ErrInvalidOutgoingData)
+				require.False(t, res.Accept)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msgs = []proto.Message{msgBankSend}
+			encoding = icatypes.EncodingProtobuf
+			packetData = nil
+			icaAuthz = types.NewInterchainAccountAuthorization(
+				[]string{ibctesting.FirstConnectionID},
+				[]string{sdk.MsgTypeURL(msgBankSend)},
+				icatypes.EncodingProtobuf,
+				0,
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(150))),
+			)
+			msg = types.NewMsgSendTx(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, 100000, icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX})
+
+			tc.malleate()
+
+			if packetData == nil {
+				var err error
+				packetData, err = icatypes.SerializeCosmosTx(cdc, msgs, encoding)
+				require.NoError(t, err)
+			}
+			msg.PacketData.Data = packetData
+
+			ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+			res, err := icaAuthz.Accept(ctx, msg)
+			tc.assertResult(res, err)
+		})
+	}
+}
+
+func TestInterchainAccountAuthorizationValidateBasic(t *testing.T) {
+	var icaAuthz *types.InterchainAccountAuthorization
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty connection IDs and spend limit",
+			func() {
+				icaAuthz.ConnectionIds = nil
+				icaAuthz.SpendLimit = nil
+			},
+			nil,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				icaAuthz.ConnectionIds = []string{"invalid"}
+			},
+			connectiontypes.ErrInvalidConnectionIdentifier,
+		},
+		{
+			"duplicate connection ID",
+			func() {
+				icaAuthz.ConnectionIds = []string{ibctesting.FirstConnectionID, ibctesting.FirstConnectionID}
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"empty allowed message type URLs",
+			func() {
+				icaAuthz.AllowedMsgTypeUrls = nil
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"duplicate allowed message type URL",
+			func() {
+				icaAuthz.AllowedMsgTypeUrls = append(icaAuthz.AllowedMsgTypeUrls, icaAuthz.AllowedMsgTypeUrls[0])
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"unsupported encoding",
+			func() {
+				icaAuthz.Encoding = "invalid"
+			},
+			icatypes.ErrInvalidCodec,
+		},
+		{
+			"uses not less than max uses",
+			func() {
+				icaAuthz.MaxUses = 1
+				icaAuthz.Uses = 1
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"invalid spend limit",
+			func() {
+				icaAuthz.SpendLimit = sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(1)}}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			icaAuthz = types.NewInterchainAccountAuthorization(
+				[]string{ibctesting.FirstConnectionID},
+				[]string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				icatypes.EncodingProtobuf,
+				0,
+				sdk.NewCoins(ibctesting.TestCoin),
+			)
+
+			tc.malleate()
+
+			err := icaAuthz.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package telemetry
+
+import (
+	"strconv"
+
+	metrics "github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	coremetrics "github.com/cosmos/ibc-go/v9/modules/core/metrics"
+)
+
+// ReportSendTx reports an interchain account transaction sent by the controller over the given connection.
+func ReportSendTx(connectionID string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", icatypes.ModuleName, "controller", "send_tx"},
+		1,
+		[]metrics.Label{telemetry.NewLabel(coremetrics.LabelConnectionID, connectionID)},
+	)
+}
+
+// ReportTimeoutPacket reports an interchain account transaction of the controller which timed out.
+func ReportTimeoutPacket(connectionID string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", icatypes.ModuleName, "controller", "timeout"},
+		1,
+		[]metrics.Label{telemetry.NewLabel(coremetrics.LabelConnectionID, connectionID)},
+	)
+}
+
+// ReportExecuteTx reports the execution of an interchain account transaction received by the host on
+// the given channel, along with the number of executed messages by message type.
+func ReportExecuteTx(portID, channelID string, msgs []sdk.Msg, success bool) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(coremetrics.LabelPortID, portID),
+		telemetry.NewLabel(coremetrics.LabelChannelID, channelID),
+		telemetry.NewLabel(coremetrics.LabelSuccess, strconv.FormatBool(success)),
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", icatypes.ModuleName, "host", "execute_tx"},
+		1,
+		labels,
+	)
+
+	for _, msg := range msgs {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", icatypes.ModuleName, "host", "msgs"},
+			1,
+			append([]metrics.Label{telemetry.NewLabel(coremetrics.LabelMsgType, sdk.MsgTypeURL(msg))}, labels...),
+		)
+	}
+}
@@ -26,6 +26,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdIncentivizedPacketsForPayer(),
+		GetCmdRelayerStats(),
+		GetCmdRelayerStatsForChannel(),
+		GetCmdAverageFees(),
+		GetCmdParams(),
+		GetCmdClientIncentivePool(),
+		GetCmdClientIncentivePools(),
 	)
 
 	return queryCmd
@@ -45,6 +52,8 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterPayeeCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
+		NewCancelPacketFeeTxCmd(),
+		NewFundClientIncentivePoolTxCmd(),
 	)
 
 	return txCmd
@@ -437,3 +437,279 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdRelayerStats returns the command handler for the Query/RelayerStats rpc.
+func GetCmdRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayer-stats [port-id] [channel-id] [payee]",
+		Short:   "Query the statistics of a relayer payee address on a given channel",
+		Long:    "Query the number of packets relayed and the fees earned by a relayer payee address on a given channel",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee relayer-stats transfer channel-5 cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[2]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRelayerStatsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Payee:     args[2],
+			}
+
+			res, err := queryClient.RelayerStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRelayerStatsForChannel returns the command handler for the Query/RelayerStatsForChannel rpc.
+func GetCmdRelayerStatsForChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayer-stats-for-channel [port-id] [channel-id]",
+		Short:   "Query the statistics of all relayer payee addresses on a given channel",
+		Long:    "Query the number of packets relayed and the fees earned by all relayer payee addresses on a given channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee relayer-stats-for-channel transfer channel-5", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRelayerStatsForChannelRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelayerStatsForChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayer-stats-for-channel")
+
+	return cmd
+}
+
+// GetCmdAverageFees returns the command handler for the Query/AverageFees rpc.
+func GetCmdAverageFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "average-fees [port-id] [channel-id] [packets]",
+		Short: "Query the average fees of the most recent packets settled on a given channel",
+		Long: fmt.Sprintf(`Query the average fees escrowed for the most recent packets settled on a given channel.
+The optional number of packets limits the average to the most recent packets, up to %d packets are recorded per channel.`, types.MaxFeeHistory),
+		Args:    cobra.RangeArgs(2, 3),
+		Example: fmt.Sprintf("%s query ibc-fee average-fees transfer channel-5 10", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var packets uint64
+			if len(args) == 3 {
+				packets, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			req := &types.QueryAverageFeesRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Packets:   packets,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AverageFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdIncentivizedPacketsForPayer returns the command handler for the Query/IncentivizedPacketsForPayer rpc.
+func GetCmdIncentivizedPacketsForPayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packets-for-payer [payer]",
+		Short:   "Query for the outstanding packet fees paid by a given address",
+		Long:    "Query for the incentivized packets across all channels with the outstanding fees paid by a given refund address",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee packets-for-payer cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketsForPayerRequest{
+				Payer:      args[0],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentivizedPacketsForPayer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets-for-payer")
+
+	return cmd
+}
+
+// GetCmdParams returns the command handler for the Query/Params rpc.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc-fee parameters",
+		Long:    "Query the current ibc-fee parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdClientIncentivePool returns the command handler for the Query/ClientIncentivePool rpc.
+func GetCmdClientIncentivePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-incentive-pool [client-id]",
+		Short:   "Query the incentive pool of a client",
+		Long:    "Query the rewards and remaining balance of the incentive pool of a client",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee client-incentive-pool 07-tendermint-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClientIncentivePoolRequest{
+				ClientId: args[0],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientIncentivePool(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdClientIncentivePools returns the command handler for the Query/ClientIncentivePools rpc.
+func GetCmdClientIncentivePools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-incentive-pools",
+		Short:   "Query all the client incentive pools",
+		Long:    "Query the rewards and remaining balances of all the client incentive pools",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee client-incentive-pools", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClientIncentivePoolsRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientIncentivePools(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "client-incentive-pools")
+
+	return cmd
+}
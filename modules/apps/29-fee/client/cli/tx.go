@@ -21,6 +21,10 @@ const (
 	flagRecvFee    = "recv-fee"
 	flagAckFee     = "ack-fee"
 	flagTimeoutFee = "timeout-fee"
+	flagExpiry     = "expiry-timestamp"
+
+	flagUpdateReward    = "update-reward"
+	flagHandshakeReward = "handshake-reward"
 )
 
 // NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
@@ -134,7 +138,13 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 				TimeoutFee: timeoutFee,
 			}
 
+			expiryTimestamp, err := cmd.Flags().GetUint64(flagExpiry)
+			if err != nil {
+				return err
+			}
+
 			packetFee := types.NewPacketFee(fee, sender, relayers)
+			packetFee.ExpiryTimestamp = expiryTimestamp
 			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -144,6 +154,92 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
 	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
 	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	cmd.Flags().Uint64(flagExpiry, 0, "Block time in unix nanoseconds after which the fee may be cancelled before the packet is acknowledged or timed out.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelPacketFeeTxCmd returns the command to create a MsgCancelPacketFee
+func NewCancelPacketFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-packet-fee [src-port] [src-channel] [sequence]",
+		Short: "Cancel the fees paid to incentivize an IBC packet",
+		Long: strings.TrimSpace(`Cancel the fees paid by the sender to incentivize an IBC packet and refund them.
+The fees may be cancelled once the packet has been acknowledged or timed out, or once their expiry timestamp has passed.`),
+		Example: fmt.Sprintf("%s tx ibc-fee cancel-packet-fee transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packetID := channeltypes.NewPacketID(args[0], args[1], seq)
+			msg := types.NewMsgCancelPacketFee(packetID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFundClientIncentivePoolTxCmd returns the command to create a MsgFundClientIncentivePool
+func NewFundClientIncentivePoolTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-client-incentive-pool [client-id] [amount]",
+		Short: "Fund the incentive pool of a client",
+		Long: strings.TrimSpace(`Fund the incentive pool from which relayers are rewarded for the client updates and channel handshakes of a client.
+The update and handshake rewards may only be set when the pool is created or once its balance has been depleted.`),
+		Example: fmt.Sprintf("%s tx ibc-fee fund-client-incentive-pool 07-tendermint-0 100000stake --update-reward 100stake --handshake-reward 1000stake", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			updateRewardStr, err := cmd.Flags().GetString(flagUpdateReward)
+			if err != nil {
+				return err
+			}
+
+			updateReward, err := sdk.ParseCoinsNormalized(updateRewardStr)
+			if err != nil {
+				return err
+			}
+
+			handshakeRewardStr, err := cmd.Flags().GetString(flagHandshakeReward)
+			if err != nil {
+				return err
+			}
+
+			handshakeReward, err := sdk.ParseCoinsNormalized(handshakeRewardStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundClientIncentivePool(args[0], amount, updateReward, handshakeReward, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagUpdateReward, "", "Reward paid to a relayer for each rewarded client update.")
+	cmd.Flags().String(flagHandshakeReward, "", "Reward paid to a relayer for each channel handshake completed over the client.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
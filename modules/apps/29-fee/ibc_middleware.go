@@ -273,6 +273,12 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
 	}
 
+	if relayer.Empty() {
+		// packets delivered synchronously on localhost channels are not submitted by a relayer, thus no fees are
+		// distributed. The escrowed fees may be cancelled by their refund addresses as the packet is acknowledged.
+		return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
+	}
+
 	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
 	if !found {
 		payee = relayer.String()
@@ -701,6 +701,22 @@ func (suite *FeeTestSuite) TestOnAcknowledgementPacket() {
 				suite.Require().Equal(initialRefundAccBal, sdk.NewCoins(refundAccBalance))
 			},
 		},
+		{
+			"success: no fee distribution without a relayer",
+			func() {
+				// packets delivered synchronously on localhost channels are acknowledged without a relayer
+				relayerAddr = nil
+			},
+			true,
+			func() {
+				// assert that the packet fees remain in escrow
+				found := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+
+				refundAccBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(initialRefundAccBal, sdk.NewCoins(refundAccBalance))
+			},
+		},
 		{
 			"success: no op without a packet fee",
 			func() {
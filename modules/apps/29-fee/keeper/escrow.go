@@ -45,6 +45,64 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 	return nil
 }
 
+// cancelPacketFees refunds the fees escrowed for a packet by the refund address which may be cancelled. All the fees
+// may be cancelled once the packet commitment no longer exists, as the packet has been acknowledged or timed out and
+// the fees can no longer be distributed. Otherwise only the expired fees may be cancelled. The fees refunded are returned.
+func (k Keeper) cancelPacketFees(ctx sdk.Context, packetID channeltypes.PacketId, refundAddr sdk.AccAddress) (sdk.Coins, error) {
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeNotFound, "no fees escrowed for packet with port ID %s, channel ID %s and sequence %d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+	}
+
+	settled := len(k.GetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence)) == 0
+
+	var (
+		paid          bool
+		refundedFees  sdk.Coins
+		remainingFees []types.PacketFee
+	)
+
+	for _, packetFee := range feesInEscrow.PacketFees {
+		if packetFee.RefundAddress != refundAddr.String() {
+			remainingFees = append(remainingFees, packetFee)
+			continue
+		}
+
+		paid = true
+
+		if !settled && !packetFee.IsExpired(ctx.BlockTime()) {
+			remainingFees = append(remainingFees, packetFee)
+			continue
+		}
+
+		refundedFees = refundedFees.Add(packetFee.Fee.Total()...)
+	}
+
+	if !paid {
+		return nil, errorsmod.Wrapf(types.ErrFeeNotFound, "no fees escrowed by %s for packet with sequence %d", refundAddr, packetID.Sequence)
+	}
+
+	if refundedFees.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrPacketFeeNotCancellable, "packet with sequence %d", packetID.Sequence)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAddr, refundedFees); err != nil {
+		return nil, err
+	}
+
+	packetFees := types.NewPacketFees(remainingFees)
+	if len(remainingFees) > 0 {
+		k.SetFeesInEscrow(ctx, packetID, packetFees)
+	} else {
+		k.DeleteFeesInEscrow(ctx, packetID)
+	}
+
+	emitCancelPacketFeeEvent(ctx, packetID, refundAddr.String(), refundedFees)
+	emitIncentivizedPacketEvent(ctx, packetID, packetFees)
+
+	return refundedFees, nil
+}
+
 // DistributePacketFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packetID while refunding the timeout fees to the refund account.
 func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
@@ -54,6 +112,7 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 	// forward relayer address will be empty if conversion fails
 	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
 
+	var totalFee types.Fee
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -72,9 +131,17 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		k.distributePacketFeeOnAcknowledgement(cacheCtx, packetID, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		totalFee = totalFee.Add(packetFee.Fee)
 	}
 
+	// update the packet counters of the relayers and the fee history of the channel
+	if !forwardAddr.Empty() {
+		k.updateRelayerStats(cacheCtx, packetID, forwardAddr, func(stats *types.RelayerStats) { stats.RecvPackets++ })
+	}
+	k.updateRelayerStats(cacheCtx, packetID, reverseRelayer, func(stats *types.RelayerStats) { stats.AckPackets++ })
+	k.recordPacketFee(cacheCtx, packetID.PortId, packetID.ChannelId, totalFee)
+
 	// write the cache
 	writeFn()
 
@@ -84,18 +151,23 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
 // If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
-func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
+// The fees paid to the relayers are added to their statistics for the channel of the packet.
+func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, packetID channeltypes.PacketId, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
 	// distribute fee to valid forward relayer address otherwise refund the fee
 	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
 		// distribute fee for forward relaying
-		k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
+		if k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee) {
+			k.recordFeesEarned(ctx, packetID, forwardRelayer, packetFee.Fee.RecvFee)
+		}
 	} else {
 		// refund onRecv fee as forward relayer is not valid address
 		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
 	}
 
 	// distribute fee for reverse relaying
-	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	if k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee) {
+		k.recordFeesEarned(ctx, packetID, reverseRelayer, packetFee.Fee.AckFee)
+	}
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
@@ -108,6 +180,7 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
+	var totalFee types.Fee
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -126,9 +199,14 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		k.distributePacketFeeOnTimeout(cacheCtx, packetID, refundAddr, timeoutRelayer, packetFee)
+		totalFee = totalFee.Add(packetFee.Fee)
 	}
 
+	// update the packet counter of the relayer and the fee history of the channel
+	k.updateRelayerStats(cacheCtx, packetID, timeoutRelayer, func(stats *types.RelayerStats) { stats.TimeoutPackets++ })
+	k.recordPacketFee(cacheCtx, packetID.PortId, packetID.ChannelId, totalFee)
+
 	// write the cache
 	writeFn()
 
@@ -137,9 +215,12 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 }
 
 // distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
-func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
+// The fee paid to the timeout relayer is added to its statistics for the channel of the packet.
+func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, packetID channeltypes.PacketId, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
 	// distribute fee for timeout relaying
-	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	if k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee) {
+		k.recordFeesEarned(ctx, packetID, timeoutRelayer, packetFee.Fee.TimeoutFee)
+	}
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)
@@ -148,8 +229,8 @@ func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeou
 
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
 // If the distribution fails for any reason (such as the receiving address being blocked),
-// the state changes will be discarded.
-func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+// the state changes will be discarded. It returns true if the fee was distributed to the receiver address.
+func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) bool {
 	// cache context before trying to distribute fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
@@ -157,7 +238,7 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 	if err != nil {
 		if bytes.Equal(receiver, refundAccAddress) {
 			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
-			return // if sending to the refund address already failed, then return (no-op)
+			return false // if sending to the refund address already failed, then return (no-op)
 		}
 
 		// if an error is returned from x/bank and the receiver is not the refundAccAddress
@@ -165,7 +246,7 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee)
 		if err != nil {
 			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
-			return // if sending to the refund address fails, no-op
+			return false // if sending to the refund address fails, no-op
 		}
 
 		emitDistributeFeeEvent(ctx, refundAccAddress.String(), fee)
@@ -175,6 +256,9 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 
 	// write the cache
 	writeFn()
+
+	// the fee was refunded if sending to the receiver address failed
+	return err == nil
 }
 
 // RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
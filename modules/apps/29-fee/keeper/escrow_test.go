@@ -64,6 +64,21 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				// check the module acc wallet is now empty
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
 				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+
+				// check the relayer stats are updated
+				forwardStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId, forwardRelayer)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), forwardStats.RecvPackets)
+				suite.Require().Equal(defaultRecvFee.Add(defaultRecvFee...), forwardStats.FeesEarned)
+
+				reverseStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId, reverseRelayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), reverseStats.AckPackets)
+				suite.Require().Equal(defaultAckFee.Add(defaultAckFee...), reverseStats.FeesEarned)
+
+				// check the fee history of the channel is updated
+				feeHistory := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeHistory(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId, 0)
+				suite.Require().Equal([]types.Fee{fee.Add(fee)}, feeHistory)
 			},
 		},
 		{
@@ -141,6 +156,9 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
 				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
 
+				// check the relayer stats are not updated
+				suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStatsForChannel(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId))
+
 				// check if the module acc contains all the fees
 				expectedModuleAccBal := packetFee.Fee.Total().Add(packetFee.Fee.Total()...)
 				balance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
@@ -190,6 +208,13 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				expectedRefundAccBal := refundAccBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
 				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 				suite.Require().Equal(expectedRefundAccBal, balance)
+
+				// check the ack is counted but no fees are earned by the reverse relayer
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				reverseStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId, reverseRelayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), reverseStats.AckPackets)
+				suite.Require().True(reverseStats.FeesEarned.IsZero())
 			},
 		},
 		{
@@ -273,6 +298,16 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
 				suite.Require().Equal(expectedTimeoutAccBal, balance)
 
+				// check the relayer stats and the fee history of the channel are updated
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				timeoutStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId, timeoutRelayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), timeoutStats.TimeoutPackets)
+				suite.Require().Equal(defaultTimeoutFee.Add(defaultTimeoutFee...), timeoutStats.FeesEarned)
+
+				feeHistory := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeHistory(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId, 0)
+				suite.Require().Equal([]types.Fee{fee.Add(fee)}, feeHistory)
+
 				// check if the refund amount is zero
 				expectedRefundAccBal := refundAccBal
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
@@ -319,6 +354,9 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
 				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
 
+				// check the relayer stats are not updated
+				suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStatsForChannel(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId))
+
 				// check if the module acc contains all the fees
 				expectedModuleAccBal := packetFee.Fee.Total().Add(packetFee.Fee.Total()...)
 				balance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
@@ -90,3 +90,54 @@ func emitDistributeFeeEvent(ctx sdk.Context, receiver string, fee sdk.Coins) {
 		),
 	})
 }
+
+// emitCancelPacketFeeEvent emits an event containing the fees of a packet cancelled and refunded to the refund address
+func emitCancelPacketFeeEvent(ctx sdk.Context, packetID channeltypes.PacketId, refundAddr string, fee sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelPacketFee,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddr),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitFundClientIncentivePoolEvent emits an event containing the funds escrowed by a depositor in the incentive pool of a client
+func emitFundClientIncentivePoolEvent(ctx sdk.Context, clientID, depositor string, amount sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundClientIncentivePool,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitClientIncentiveRewardEvent emits an event containing the reward paid from the incentive pool of a client to a relayer
+func emitClientIncentiveRewardEvent(ctx sdk.Context, clientID, rewardType, relayer string, reward sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClientIncentiveReward,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyRewardType, rewardType),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
@@ -10,3 +10,8 @@ import (
 func LegacyTotal(f types.Fee) sdk.Coins {
 	return legacyTotal(f)
 }
+
+// RecordPacketFee is a wrapper for the recordPacketFee function for testing.
+func (k Keeper) RecordPacketFee(ctx sdk.Context, portID, channelID string, fee types.Fee) {
+	k.recordPacketFee(ctx, portID, channelID, fee)
+}
@@ -27,6 +27,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, stats := range state.RelayerStats {
+		k.SetRelayerStats(ctx, stats)
+	}
+
+	for _, feeHistory := range state.FeeHistories {
+		for _, fee := range feeHistory.Fees {
+			k.recordPacketFee(ctx, feeHistory.PortId, feeHistory.ChannelId, fee)
+		}
+	}
+
+	k.SetParams(ctx, state.Params)
+
+	for _, pool := range state.ClientIncentivePools {
+		k.SetClientIncentivePool(ctx, pool)
+	}
+
+	for _, rateLimit := range state.RelayerRateLimits {
+		k.SetRelayerRateLimit(ctx, rateLimit)
+	}
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
@@ -37,5 +57,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		RelayerStats:                 k.GetAllRelayerStats(ctx),
+		FeeHistories:                 k.GetAllFeeHistories(ctx),
+		Params:                       k.GetParams(ctx),
+		ClientIncentivePools:         k.GetAllClientIncentivePools(ctx),
+		RelayerRateLimits:            k.GetAllRelayerRateLimits(ctx),
 	}
 }
@@ -42,6 +42,21 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		RelayerStats: []types.RelayerStats{
+			{
+				PortId:      ibctesting.MockFeePort,
+				ChannelId:   ibctesting.FirstChannelID,
+				Payee:       suite.chainB.SenderAccount.GetAddress().String(),
+				RecvPackets: 1,
+				FeesEarned:  defaultRecvFee,
+			},
+		},
+		FeeHistories: []types.ChannelFeeHistory{
+			types.NewChannelFeeHistory(ibctesting.MockFeePort, ibctesting.FirstChannelID, []types.Fee{
+				types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+				types.NewFee(defaultRecvFee, defaultAckFee, defaultRecvFee),
+			}),
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -64,6 +79,15 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check relayer stats
+	stats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RelayerStats[0], stats)
+
+	// check fee history
+	feeHistory := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeHistory(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, 0)
+	suite.Require().Equal(genesisState.FeeHistories[0].Fees, feeHistory)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -97,6 +121,15 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
 
+	// set relayer stats
+	stats := types.NewRelayerStats(ibctesting.MockFeePort, ibctesting.FirstChannelID, suite.chainB.SenderAccount.GetAddress().String())
+	stats.AckPackets = 1
+	stats.FeesEarned = defaultAckFee
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), stats)
+
+	// record packet fee
+	suite.chainA.GetSimApp().IBCFeeKeeper.RecordPacketFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, fee)
+
 	// export genesis
 	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
 
@@ -123,4 +156,10 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check relayer stats
+	suite.Require().Equal([]types.RelayerStats{stats}, genesisState.RelayerStats)
+
+	// check fee histories
+	suite.Require().Equal([]types.ChannelFeeHistory{types.NewChannelFeeHistory(ibctesting.MockFeePort, ibctesting.FirstChannelID, []types.Fee{fee})}, genesisState.FeeHistories)
 }
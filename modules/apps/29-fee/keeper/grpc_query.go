@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/internal/validate"
 	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -289,3 +290,195 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// RelayerStats implements the Query/RelayerStats gRPC method and returns the statistics of a payee address for a specific channel
+func (k Keeper) RelayerStats(goCtx context.Context, req *types.QueryRelayerStatsRequest) (*types.QueryRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stats, found := k.GetRelayerStats(ctx, req.PortId, req.ChannelId, req.Payee)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "relayer stats not found for payee: %s on port: %s channel: %s", req.Payee, req.PortId, req.ChannelId)
+	}
+
+	return &types.QueryRelayerStatsResponse{
+		RelayerStats: stats,
+	}, nil
+}
+
+// RelayerStatsForChannel implements the Query/RelayerStatsForChannel gRPC method and returns the statistics of all
+// payee addresses for a specific channel
+func (k Keeper) RelayerStatsForChannel(goCtx context.Context, req *types.QueryRelayerStatsForChannelRequest) (*types.QueryRelayerStatsForChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var relayerStats []types.RelayerStats
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRelayerStatsChannelPrefix(req.PortId, req.ChannelId))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var stats types.RelayerStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+
+		relayerStats = append(relayerStats, stats)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelayerStatsForChannelResponse{
+		RelayerStats: relayerStats,
+		Pagination:   pagination,
+	}, nil
+}
+
+// AverageFees implements the Query/AverageFees gRPC method and returns the average fees escrowed for the most recent
+// packets settled on a specific channel
+func (k Keeper) AverageFees(goCtx context.Context, req *types.QueryAverageFeesRequest) (*types.QueryAverageFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fees := k.GetFeeHistory(ctx, req.PortId, req.ChannelId, req.Packets)
+
+	return &types.QueryAverageFeesResponse{
+		AverageFee: types.AverageFee(fees),
+		Packets:    uint64(len(fees)),
+	}, nil
+}
+
+// IncentivizedPacketsForPayer implements the Query/IncentivizedPacketsForPayer gRPC method and returns the outstanding
+// packet fees paid by a refund address across all channels
+func (k Keeper) IncentivizedPacketsForPayer(goCtx context.Context, req *types.QueryIncentivizedPacketsForPayerRequest) (*types.QueryIncentivizedPacketsForPayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Payer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var identifiedPackets []types.IdentifiedPacketFees
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeesInEscrowPrefix))
+	pagination, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		packetFees := k.MustUnmarshalFees(value)
+
+		var payerFees []types.PacketFee
+		for _, packetFee := range packetFees.PacketFees {
+			if packetFee.RefundAddress == req.Payer {
+				payerFees = append(payerFees, packetFee)
+			}
+		}
+
+		if len(payerFees) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			packetID, err := types.ParseKeyFeesInEscrow(types.FeesInEscrowPrefix + string(key))
+			if err != nil {
+				return false, err
+			}
+
+			identifiedPackets = append(identifiedPackets, types.NewIdentifiedPacketFees(packetID, payerFees))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryIncentivizedPacketsForPayerResponse{
+		IncentivizedPackets: identifiedPackets,
+		Pagination:          pagination,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method and returns the 29-fee module parameters
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// ClientIncentivePool implements the Query/ClientIncentivePool gRPC method and returns the incentive pool of a client
+func (k Keeper) ClientIncentivePool(goCtx context.Context, req *types.QueryClientIncentivePoolRequest) (*types.QueryClientIncentivePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetClientIncentivePool(ctx, req.ClientId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "incentive pool not found for client ID: %s", req.ClientId)
+	}
+
+	return &types.QueryClientIncentivePoolResponse{
+		Pool: pool,
+	}, nil
+}
+
+// ClientIncentivePools implements the Query/ClientIncentivePools gRPC method and returns all the client incentive pools
+func (k Keeper) ClientIncentivePools(goCtx context.Context, req *types.QueryClientIncentivePoolsRequest) (*types.QueryClientIncentivePoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pools []types.ClientIncentivePool
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClientIncentivePoolPrefix+"/"))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pool types.ClientIncentivePool
+		if err := k.cdc.Unmarshal(value, &pool); err != nil {
+			return err
+		}
+
+		pools = append(pools, pool)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryClientIncentivePoolsResponse{
+		Pools:      pools,
+		Pagination: pagination,
+	}, nil
+}
@@ -305,6 +305,92 @@ func (suite *KeeperTestSuite) TestQueryIncentivizedPacketsForChannel() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacketsForPayer() {
+	var (
+		req                     *types.QueryIncentivizedPacketsForPayerRequest
+		expIdentifiedPacketFees []types.IdentifiedPacketFees
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no packet fees paid by payer",
+			func() {
+				req.Payer = suite.chainB.SenderAccount.GetAddress().String()
+				expIdentifiedPacketFees = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid payer address",
+			func() {
+				req.Payer = "invalid-address"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payer := suite.chainA.SenderAccount.GetAddress().String()
+			payerFee := types.NewPacketFee(fee, payer, nil)
+			otherPayerFee := types.NewPacketFee(fee, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), nil)
+
+			expIdentifiedPacketFees = nil
+			for i, channelID := range []string{ibctesting.FirstChannelID, "channel-1", "channel-2"} {
+				packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, channelID, uint64(i+1))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{payerFee, otherPayerFee}))
+				expIdentifiedPacketFees = append(expIdentifiedPacketFees, types.NewIdentifiedPacketFees(packetID, []types.PacketFee{payerFee}))
+			}
+
+			// packets incentivized only by other payers must not be returned
+			packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 10)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{otherPayerFee}))
+
+			req = &types.QueryIncentivizedPacketsForPayerRequest{
+				Payer: payer,
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.IncentivizedPacketsForPayer(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expIdentifiedPacketFees, res.IncentivizedPackets)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryTotalRecvFees() {
 	var req *types.QueryTotalRecvFeesRequest
 
@@ -857,3 +943,391 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryRelayerStats() {
+	var (
+		req      *types.QueryRelayerStatsRequest
+		expStats types.RelayerStats
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"relayer stats not found: unknown payee",
+			func() {
+				req.Payee = "invalid-addr"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+			expStats = types.NewRelayerStats(ibctesting.MockFeePort, ibctesting.FirstChannelID, payee)
+			expStats.RecvPackets = 1
+			expStats.FeesEarned = defaultRecvFee
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), expStats)
+
+			req = &types.QueryRelayerStatsRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+				Payee:     payee,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RelayerStats(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expStats, res.RelayerStats)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRelayerStatsForChannel() {
+	var (
+		req      *types.QueryRelayerStatsForChannelRequest
+		expStats []types.RelayerStats
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no relayer stats on channel",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+				expStats = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expStats = nil
+			for i := 0; i < 3; i++ {
+				payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+				stats := types.NewRelayerStats(ibctesting.MockFeePort, ibctesting.FirstChannelID, payee)
+				stats.AckPackets = uint64(i)
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), stats)
+				expStats = append(expStats, stats)
+			}
+
+			// stats on another channel must not be returned
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), types.NewRelayerStats(ibctesting.MockFeePort, "channel-10", suite.chainA.SenderAccount.GetAddress().String()))
+
+			req = &types.QueryRelayerStatsForChannelRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RelayerStatsForChannel(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expStats, res.RelayerStats)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAverageFees() {
+	var (
+		req        *types.QueryAverageFeesRequest
+		expFee     types.Fee
+		expPackets uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: all recorded packets",
+			func() {},
+			true,
+		},
+		{
+			"success: most recent packet",
+			func() {
+				req.Packets = 1
+				expFee = types.NewFee(defaultRecvFee.MulInt(sdkmath.NewInt(2)), defaultAckFee.MulInt(sdkmath.NewInt(2)), defaultTimeoutFee.MulInt(sdkmath.NewInt(2)))
+				expPackets = 1
+			},
+			true,
+		},
+		{
+			"success: no recorded packets",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+				expFee = types.NewFee(sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins())
+				expPackets = 0
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			suite.chainA.GetSimApp().IBCFeeKeeper.RecordPacketFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, fee)
+			suite.chainA.GetSimApp().IBCFeeKeeper.RecordPacketFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, fee.Add(fee))
+
+			// the average of 1x and 2x the default fees, truncated
+			expFee = types.NewFee(
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(150))),
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(300))),
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(450))),
+			)
+			expPackets = 2
+
+			req = &types.QueryAverageFeesRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.AverageFees(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFee, res.AverageFee)
+				suite.Require().Equal(expPackets, res.Packets)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.chainA.GetContext()
+	expParams := types.DefaultParams()
+	res, _ := suite.chainA.GetSimApp().IBCFeeKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(&expParams, &res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryClientIncentivePool() {
+	var req *types.QueryClientIncentivePoolRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid client ID",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+		},
+		{
+			"incentive pool not found",
+			func() {
+				req.ClientId = "07-tendermint-10"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.fundClientIncentivePool(ibctesting.FirstClientID, defaultUpdateReward, defaultHandshakeReward)
+			expPool := types.NewClientIncentivePool(ibctesting.FirstClientID, defaultUpdateReward, defaultHandshakeReward)
+			expPool.Balance = defaultIncentivePoolAmount
+
+			req = &types.QueryClientIncentivePoolRequest{
+				ClientId: ibctesting.FirstClientID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ClientIncentivePool(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPool, res.Pool)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientIncentivePools() {
+	var (
+		req      *types.QueryClientIncentivePoolsRequest
+		expPools []types.ClientIncentivePool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no incentive pools",
+			func() {
+				suite.SetupTest() // reset
+				expPools = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expPools = nil
+			for _, clientID := range []string{"07-tendermint-0", "07-tendermint-1", "07-tendermint-2"} {
+				suite.fundClientIncentivePool(clientID, defaultUpdateReward, defaultHandshakeReward)
+
+				pool, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+				expPools = append(expPools, pool)
+			}
+
+			req = &types.QueryClientIncentivePoolsRequest{
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ClientIncentivePools(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPools, res.Pools)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// GetClientIncentivePool returns the incentive pool of the given client
+func (k Keeper) GetClientIncentivePool(ctx sdk.Context, clientID string) (types.ClientIncentivePool, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyClientIncentivePool(clientID))
+	if len(bz) == 0 {
+		return types.ClientIncentivePool{}, false
+	}
+
+	var pool types.ClientIncentivePool
+	k.cdc.MustUnmarshal(bz, &pool)
+
+	return pool, true
+}
+
+// SetClientIncentivePool stores the incentive pool of a client
+func (k Keeper) SetClientIncentivePool(ctx sdk.Context, pool types.ClientIncentivePool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pool)
+	store.Set(types.KeyClientIncentivePool(pool.ClientId), bz)
+}
+
+// GetAllClientIncentivePools returns all the client incentive pools stored in state
+func (k Keeper) GetAllClientIncentivePools(ctx sdk.Context) []types.ClientIncentivePool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ClientIncentivePoolPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var pools []types.ClientIncentivePool
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.ClientIncentivePool
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
+// GetRelayerRateLimit returns the rate limit of the given relayer
+func (k Keeper) GetRelayerRateLimit(ctx sdk.Context, relayer string) (types.RelayerRateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRelayerRateLimit(relayer))
+	if len(bz) == 0 {
+		return types.RelayerRateLimit{}, false
+	}
+
+	var rateLimit types.RelayerRateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRelayerRateLimit stores the rate limit of a relayer
+func (k Keeper) SetRelayerRateLimit(ctx sdk.Context, rateLimit types.RelayerRateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.KeyRelayerRateLimit(rateLimit.Relayer), bz)
+}
+
+// GetAllRelayerRateLimits returns all the relayer rate limits stored in state
+func (k Keeper) GetAllRelayerRateLimits(ctx sdk.Context) []types.RelayerRateLimit {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RelayerRateLimitPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var rateLimits []types.RelayerRateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RelayerRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// fundClientIncentivePool escrows the amount provided by the depositor in the incentive pool of the client. The rewards
+// of the pool may only be set when the pool is created or once its balance has been depleted, so that a depositor
+// cannot alter the terms under which the funds of other depositors are paid out.
+func (k Keeper) fundClientIncentivePool(ctx sdk.Context, clientID string, amount, updateReward, handshakeReward sdk.Coins, depositor sdk.AccAddress) error {
+	pool, found := k.GetClientIncentivePool(ctx, clientID)
+	switch {
+	case !found || pool.Balance.IsZero():
+		if updateReward.IsZero() && handshakeReward.IsZero() {
+			return errorsmod.Wrapf(types.ErrInvalidIncentiveReward, "rewards must be set when funding an empty incentive pool for client %s", clientID)
+		}
+
+		pool = types.NewClientIncentivePool(clientID, updateReward, handshakeReward)
+	case !updateReward.IsZero() || !handshakeReward.IsZero():
+		return errorsmod.Wrapf(types.ErrInvalidIncentiveReward, "rewards cannot be changed while the incentive pool for client %s is funded", clientID)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	pool.Balance = pool.Balance.Add(amount...)
+	k.SetClientIncentivePool(ctx, pool)
+
+	emitFundClientIncentivePoolEvent(ctx, clientID, depositor.String(), amount)
+
+	return nil
+}
+
+// AfterClientUpdated implements the core IBC RelayerHooks interface. The relayer of the client update is rewarded from
+// the incentive pool of the client if the update advances the latest height of the client by at least the client
+// update height interval, or if the latest consensus state of the client is older than the client update expiry period.
+func (k Keeper) AfterClientUpdated(ctx sdk.Context, clientID string, prevHeight ibcexported.Height, prevTimestamp uint64, latestHeight ibcexported.Height, relayer sdk.AccAddress) {
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	pool, found := k.GetClientIncentivePool(ctx, clientID)
+	if !found || pool.UpdateReward.IsZero() {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if !isRewardedClientUpdate(ctx, params, prevHeight, prevTimestamp, latestHeight) {
+		return
+	}
+
+	if !k.payIncentiveReward(ctx, pool, types.RewardTypeClientUpdate, pool.LastUpdateRewardTime, pool.UpdateReward, relayer) {
+		return
+	}
+
+	k.Logger(ctx).Debug("client update rewarded from the client incentive pool", "client-id", clientID, "relayer", relayer)
+}
+
+// AfterChannelOpened implements the core IBC RelayerHooks interface. The relayer completing the channel handshake is
+// rewarded from the incentive pool of the client underlying the channel.
+func (k Keeper) AfterChannelOpened(ctx sdk.Context, clientID, portID, channelID string, relayer sdk.AccAddress) {
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	pool, found := k.GetClientIncentivePool(ctx, clientID)
+	if !found || pool.HandshakeReward.IsZero() {
+		return
+	}
+
+	if !k.payIncentiveReward(ctx, pool, types.RewardTypeChannelHandshake, pool.LastHandshakeRewardTime, pool.HandshakeReward, relayer) {
+		return
+	}
+
+	k.Logger(ctx).Debug("channel handshake rewarded from the client incentive pool", "client-id", clientID, "port-id", portID, "channel-id", channelID, "relayer", relayer)
+}
+
+// isRewardedClientUpdate returns true if the client update advanced the latest height of the client by at least the
+// client update height interval, or if it prevented the client from expiring.
+func isRewardedClientUpdate(ctx sdk.Context, params types.Params, prevHeight ibcexported.Height, prevTimestamp uint64, latestHeight ibcexported.Height) bool {
+	if latestHeight.GetRevisionNumber() > prevHeight.GetRevisionNumber() {
+		return true
+	}
+
+	if latestHeight.GetRevisionHeight() >= prevHeight.GetRevisionHeight()+params.ClientUpdateHeightInterval {
+		return true
+	}
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	return params.ClientUpdateExpiryPeriod != 0 && prevTimestamp != 0 && blockTime >= prevTimestamp+params.ClientUpdateExpiryPeriod
+}
+
+// payIncentiveReward pays the reward from the client incentive pool to the relayer if the minimum reward interval has
+// elapsed since the last reward of the same type and the relayer has not exceeded its rate limit. The reward is capped
+// at the remaining balance of the pool. It returns true if the reward has been paid.
+func (k Keeper) payIncentiveReward(ctx sdk.Context, pool types.ClientIncentivePool, rewardType string, lastRewardTime uint64, reward sdk.Coins, relayer sdk.AccAddress) bool {
+	params := k.GetParams(ctx)
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+
+	if lastRewardTime != 0 && blockTime < lastRewardTime+params.MinRewardInterval {
+		return false
+	}
+
+	rateLimit, found := k.GetRelayerRateLimit(ctx, relayer.String())
+	if !found || blockTime >= rateLimit.WindowStart+params.RelayerRateLimitWindow {
+		rateLimit = types.NewRelayerRateLimit(relayer.String(), blockTime)
+	}
+
+	if params.RelayerRateLimit != 0 && rateLimit.Rewards >= params.RelayerRateLimit {
+		return false
+	}
+
+	paid := pool.Reward(reward)
+	if paid.IsZero() {
+		return false
+	}
+
+	// the reward is paid within a cached context so that the incentive pool is left untouched upon failure
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, relayer, paid); err != nil {
+		k.Logger(ctx).Error("failed to pay client incentive reward", "client-id", pool.ClientId, "relayer", relayer, "error", err)
+		return false
+	}
+
+	writeFn()
+
+	pool.Balance = pool.Balance.Sub(paid...)
+	switch rewardType {
+	case types.RewardTypeClientUpdate:
+		pool.LastUpdateRewardTime = blockTime
+	case types.RewardTypeChannelHandshake:
+		pool.LastHandshakeRewardTime = blockTime
+	default:
+		panic(fmt.Errorf("invalid client incentive reward type: %s", rewardType))
+	}
+
+	k.SetClientIncentivePool(ctx, pool)
+
+	rateLimit.Rewards++
+	k.SetRelayerRateLimit(ctx, rateLimit)
+
+	emitClientIncentiveRewardEvent(ctx, pool.ClientId, rewardType, relayer.String(), paid)
+
+	return true
+}
@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var (
+	defaultIncentivePoolAmount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	defaultUpdateReward        = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	defaultHandshakeReward     = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(300)))
+)
+
+// fundClientIncentivePool funds the incentive pool of the client on chainA with the default rewards
+func (suite *KeeperTestSuite) fundClientIncentivePool(clientID string, updateReward, handshakeReward sdk.Coins) {
+	msg := types.NewMsgFundClientIncentivePool(clientID, defaultIncentivePoolAmount, updateReward, handshakeReward, suite.chainA.SenderAccount.GetAddress().String())
+	_, err := suite.chainA.GetSimApp().IBCFeeKeeper.FundClientIncentivePool(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestAfterClientUpdated() {
+	var (
+		clientID      string
+		prevHeight    clienttypes.Height
+		prevTimestamp uint64
+		latestHeight  clienttypes.Height
+		expReward     sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: client update advances the latest height by the height interval",
+			func() {},
+		},
+		{
+			"success: client update advances the revision number",
+			func() {
+				latestHeight = clienttypes.NewHeight(prevHeight.RevisionNumber+1, 1)
+			},
+		},
+		{
+			"success: client update prevents the client from expiring",
+			func() {
+				latestHeight = prevHeight.Increment().(clienttypes.Height)
+				prevTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano()) - types.DefaultClientUpdateExpiryPeriod
+			},
+		},
+		{
+			"success: reward is capped at the pool balance",
+			func() {
+				pool, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+				pool.Balance = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientIncentivePool(suite.chainA.GetContext(), pool)
+
+				expReward = pool.Balance
+			},
+		},
+		{
+			"success: relayer rate limit window has elapsed",
+			func() {
+				windowStart := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) - types.DefaultRelayerRateLimitWindow
+				rateLimit := types.NewRelayerRateLimit(suite.chainA.SenderAccount.GetAddress().String(), windowStart)
+				rateLimit.Rewards = types.DefaultRelayerRateLimit
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerRateLimit(suite.chainA.GetContext(), rateLimit)
+			},
+		},
+		{
+			"client update does not advance the latest height by the height interval",
+			func() {
+				latestHeight = prevHeight.Increment().(clienttypes.Height)
+				expReward = nil
+			},
+		},
+		{
+			"minimum reward interval has not elapsed",
+			func() {
+				pool, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+				pool.LastUpdateRewardTime = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientIncentivePool(suite.chainA.GetContext(), pool)
+
+				expReward = nil
+			},
+		},
+		{
+			"relayer rate limit exceeded",
+			func() {
+				rateLimit := types.NewRelayerRateLimit(suite.chainA.SenderAccount.GetAddress().String(), uint64(suite.chainA.GetContext().BlockTime().UnixNano()))
+				rateLimit.Rewards = types.DefaultRelayerRateLimit
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerRateLimit(suite.chainA.GetContext(), rateLimit)
+
+				expReward = nil
+			},
+		},
+		{
+			"incentive pool not found",
+			func() {
+				clientID = "07-tendermint-10"
+				expReward = nil
+			},
+		},
+		{
+			"incentive pool has no update reward",
+			func() {
+				clientID = "07-tendermint-10"
+				suite.fundClientIncentivePool(clientID, nil, defaultHandshakeReward)
+
+				expReward = nil
+			},
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+				expReward = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientID = ibctesting.FirstClientID
+			suite.fundClientIncentivePool(clientID, defaultUpdateReward, defaultHandshakeReward)
+
+			relayer := suite.chainA.SenderAccount.GetAddress()
+			prevHeight = clienttypes.NewHeight(1, 10)
+			latestHeight = clienttypes.NewHeight(1, 10+types.DefaultClientUpdateHeightInterval)
+			prevTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+			expReward = defaultUpdateReward
+
+			tc.malleate()
+
+			poolBefore, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.AfterClientUpdated(suite.chainA.GetContext(), clientID, prevHeight, prevTimestamp, latestHeight, relayer)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+			suite.Require().Equal(balanceBefore.Amount.Add(expReward.AmountOf(sdk.DefaultBondDenom)), balance.Amount)
+
+			pool, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+			suite.Require().True(poolBefore.Balance.Sub(expReward...).Equal(pool.Balance))
+
+			if !expReward.IsZero() {
+				suite.Require().Equal(uint64(suite.chainA.GetContext().BlockTime().UnixNano()), pool.LastUpdateRewardTime)
+
+				rateLimit, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerRateLimit(suite.chainA.GetContext(), relayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), rateLimit.Rewards)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterChannelOpened() {
+	var (
+		clientID  string
+		relayer   sdk.AccAddress
+		expReward sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {},
+		},
+		{
+			"success: minimum reward interval has elapsed",
+			func() {
+				pool, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+				pool.LastHandshakeRewardTime = uint64(suite.chainA.GetContext().BlockTime().Add(-time.Hour).UnixNano())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientIncentivePool(suite.chainA.GetContext(), pool)
+			},
+		},
+		{
+			"minimum reward interval has not elapsed",
+			func() {
+				pool, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+				pool.LastHandshakeRewardTime = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientIncentivePool(suite.chainA.GetContext(), pool)
+
+				expReward = nil
+			},
+		},
+		{
+			"incentive pool not found",
+			func() {
+				clientID = "07-tendermint-10"
+				expReward = nil
+			},
+		},
+		{
+			"incentive pool has no handshake reward",
+			func() {
+				clientID = "07-tendermint-10"
+				suite.fundClientIncentivePool(clientID, defaultUpdateReward, nil)
+
+				expReward = nil
+			},
+		},
+		{
+			"relayer is a blocked address",
+			func() {
+				relayer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+				expReward = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientID = ibctesting.FirstClientID
+			suite.fundClientIncentivePool(clientID, defaultUpdateReward, defaultHandshakeReward)
+
+			relayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			expReward = defaultHandshakeReward
+
+			tc.malleate()
+
+			poolBefore, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.AfterChannelOpened(suite.chainA.GetContext(), clientID, ibctesting.MockFeePort, ibctesting.FirstChannelID, relayer)
+
+			pool, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+			suite.Require().True(poolBefore.Balance.Sub(expReward...).Equal(pool.Balance))
+
+			if !expReward.IsZero() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayer)
+				suite.Require().Equal(expReward, balance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClientUpdateAndHandshakeIncentives() {
+	suite.path.SetupConnections()
+
+	params := types.DefaultParams()
+	params.ClientUpdateHeightInterval = 1
+	params.MinRewardInterval = 0
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	clientID := suite.path.EndpointA.ClientID
+	suite.fundClientIncentivePool(clientID, defaultUpdateReward, defaultHandshakeReward)
+
+	relayer := suite.chainA.SenderAccount.GetAddress()
+
+	// the relayer submitting the client update is rewarded from the incentive pool of the client
+	balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+
+	err := suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore.Add(defaultUpdateReward[0]), balance)
+
+	// the relayer completing the channel handshake is rewarded from the incentive pool of the client
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetClientIncentivePool(suite.chainA.GetContext(), types.ClientIncentivePool{
+		ClientId:        clientID,
+		HandshakeReward: defaultHandshakeReward,
+		Balance:         defaultHandshakeReward,
+	})
+
+	suite.path.CreateChannels()
+
+	pool, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), clientID)
+	suite.Require().True(found)
+	suite.Require().True(pool.Balance.IsZero())
+	suite.Require().NotZero(pool.LastHandshakeRewardTime)
+}
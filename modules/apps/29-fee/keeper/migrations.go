@@ -50,3 +50,10 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func legacyTotal(f types.Fee) sdk.Coins {
 	return f.RecvFee.Add(f.AckFee...).Add(f.TimeoutFee...)
 }
+
+// Migrate2to3 migrates ibc-fee module from ConsensusVersion 2 to 3
+// by setting the default params incentivizing client updates and channel handshakes.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
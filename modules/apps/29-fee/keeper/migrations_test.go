@@ -199,3 +199,21 @@ func (suite *KeeperTestSuite) TestMigrate1to2() {
 		tc.assert(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+
+	// delete the params to mimic a chain running ConsensusVersion 2
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Delete([]byte(types.ParamsKey))
+
+	suite.Require().Panics(func() {
+		suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+	})
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCFeeKeeper)
+	err := migrator.Migrate2to3(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.DefaultParams(), suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
+}
@@ -110,6 +110,7 @@ func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee)
 
 	packetID := channeltypes.NewPacketID(msg.SourcePortId, msg.SourceChannelId, sequence)
 	packetFee := types.NewPacketFee(msg.Fee, msg.Signer, msg.Relayers)
+	packetFee.ExpiryTimestamp = msg.ExpiryTimestamp
 
 	if err := k.escrowPacketFee(ctx, packetID, packetFee); err != nil {
 		return nil, err
@@ -168,3 +169,66 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+// CancelPacketFee refunds the packet fees paid by the signer for a packet which has been acknowledged or timed out,
+// or whose expiry timestamp has passed. The fees of the signer which cannot be cancelled yet remain in escrow.
+func (k Keeper) CancelPacketFee(goCtx context.Context, msg *types.MsgCancelPacketFee) (*types.MsgCancelPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	refundAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	refundedFees, err := k.cancelPacketFees(ctx, msg.PacketId, refundAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPacketFeeResponse{
+		RefundedFees: refundedFees,
+	}, nil
+}
+
+// FundClientIncentivePool defines a rpc handler method for MsgFundClientIncentivePool
+// FundClientIncentivePool escrows the funds of the depositor in the incentive pool of a client, from which the relayers
+// submitting client updates and completing channel handshakes over the client are rewarded
+func (k Keeper) FundClientIncentivePool(goCtx context.Context, msg *types.MsgFundClientIncentivePool) (*types.MsgFundClientIncentivePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(depositor) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to fund client incentive pools", depositor)
+	}
+
+	if err := k.fundClientIncentivePool(ctx, msg.ClientId, msg.Amount, msg.UpdateReward, msg.HandshakeReward, depositor); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundClientIncentivePoolResponse{}, nil
+}
+
+// UpdateParams defines a rpc handler method for MsgUpdateParams
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -605,3 +606,328 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCancelPacketFee() {
+	var (
+		packetID         channeltypes.PacketId
+		packetFee        types.PacketFee
+		expRefundedFees  sdk.Coins
+		expFeesInEscrow  []types.PacketFee
+		expEscrowBalance sdk.Coins
+		msg              *types.MsgCancelPacketFee
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	escrowPacketFee := func(packetFee types.PacketFee) {
+		feesInEscrow, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+		feesInEscrow.PacketFees = append(feesInEscrow.PacketFees, packetFee)
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, feesInEscrow)
+
+		err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, packetFee.Fee.Total())
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: packet commitment does not exist",
+			func() {
+				packetID.Sequence++
+				msg.PacketId = packetID
+
+				escrowPacketFee(packetFee)
+			},
+			true,
+		},
+		{
+			"success: packet fee expired",
+			func() {
+				packetFee.ExpiryTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+
+				escrowPacketFee(packetFee)
+			},
+			true,
+		},
+		{
+			"success: only the expired packet fees of the signer are refunded",
+			func() {
+				unexpiredFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+				escrowPacketFee(unexpiredFee)
+
+				otherPayerFee := types.NewPacketFee(fee, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), nil)
+				otherPayerFee.ExpiryTimestamp = 1
+				escrowPacketFee(otherPayerFee)
+
+				packetFee.ExpiryTimestamp = 1
+				escrowPacketFee(packetFee)
+
+				expFeesInEscrow = []types.PacketFee{unexpiredFee, otherPayerFee}
+				expEscrowBalance = fee.Total().Add(fee.Total()...)
+			},
+			true,
+		},
+		{
+			"fee module is locked",
+			func() {
+				escrowPacketFee(packetFee)
+				expEscrowBalance = fee.Total()
+
+				lockFeeModule(suite.chainA)
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				escrowPacketFee(packetFee)
+				expEscrowBalance = fee.Total()
+
+				msg.Signer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"packet fees not found",
+			func() {},
+			false,
+		},
+		{
+			"packet fees not found for signer",
+			func() {
+				packetFee.RefundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				packetFee.ExpiryTimestamp = 1
+				escrowPacketFee(packetFee)
+				expEscrowBalance = fee.Total()
+			},
+			false,
+		},
+		{
+			"packet fee not cancellable before the packet is acknowledged or timed out",
+			func() {
+				escrowPacketFee(packetFee)
+				expEscrowBalance = fee.Total()
+			},
+			false,
+		},
+		{
+			"packet fee not cancellable before the expiry timestamp",
+			func() {
+				packetFee.ExpiryTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+				escrowPacketFee(packetFee)
+				expEscrowBalance = fee.Total()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup() // setup channel
+
+			timeoutHeight := clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), 100)
+
+			// send a packet to incentivize
+			sequence, err := suite.path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packetID = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			packetFee = types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+
+			expRefundedFees = fee.Total()
+			expFeesInEscrow = nil
+			expEscrowBalance = sdk.NewCoins()
+			msg = types.NewMsgCancelPacketFee(packetID, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.CancelPacketFee(suite.chainA.GetContext(), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRefundedFees, res.RefundedFees)
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), msg.PacketId)
+				suite.Require().Equal(len(expFeesInEscrow) > 0, found)
+				suite.Require().Equal(expFeesInEscrow, feesInEscrow.PacketFees)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+			suite.Require().Equal(expEscrowBalance.AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFundClientIncentivePool() {
+	var (
+		msg        *types.MsgFundClientIncentivePool
+		expRewards sdk.Coins
+		expBalance sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: incentive pool created",
+			func() {},
+			true,
+		},
+		{
+			"success: incentive pool funded without changing the rewards",
+			func() {
+				suite.fundClientIncentivePool(msg.ClientId, defaultUpdateReward, defaultHandshakeReward)
+
+				msg.UpdateReward = nil
+				msg.HandshakeReward = nil
+				expBalance = expBalance.Add(defaultIncentivePoolAmount...)
+			},
+			true,
+		},
+		{
+			"success: rewards of a depleted incentive pool are replaced",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientIncentivePool(suite.chainA.GetContext(), types.NewClientIncentivePool(msg.ClientId, defaultHandshakeReward, defaultUpdateReward))
+			},
+			true,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			false,
+		},
+		{
+			"invalid depositor address",
+			func() {
+				msg.Depositor = "invalid-address"
+			},
+			false,
+		},
+		{
+			"depositor is a blocked address",
+			func() {
+				msg.Depositor = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+			false,
+		},
+		{
+			"rewards not set when creating the incentive pool",
+			func() {
+				msg.UpdateReward = nil
+				msg.HandshakeReward = nil
+			},
+			false,
+		},
+		{
+			"rewards changed while the incentive pool is funded",
+			func() {
+				suite.fundClientIncentivePool(msg.ClientId, defaultUpdateReward, defaultHandshakeReward)
+			},
+			false,
+		},
+		{
+			"insufficient depositor balance",
+			func() {
+				msg.Amount = invalidCoins
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg = types.NewMsgFundClientIncentivePool(ibctesting.FirstClientID, defaultIncentivePoolAmount, defaultUpdateReward, defaultHandshakeReward, suite.chainA.SenderAccount.GetAddress().String())
+			expRewards = defaultUpdateReward
+			expBalance = defaultIncentivePoolAmount
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.FundClientIncentivePool(suite.chainA.GetContext(), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				pool, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientIncentivePool(suite.chainA.GetContext(), msg.ClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(expRewards, pool.UpdateReward)
+				suite.Require().Equal(expBalance, pool.Balance)
+
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(expBalance.AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	validAuthority := suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"success: valid authority and default params",
+			types.NewMsgUpdateParams(validAuthority, types.DefaultParams()),
+			true,
+		},
+		{
+			"failure: malformed authority address",
+			types.NewMsgUpdateParams(ibctesting.InvalidID, types.DefaultParams()),
+			false,
+		},
+		{
+			"failure: empty authority address",
+			types.NewMsgUpdateParams("", types.DefaultParams()),
+			false,
+		},
+		{
+			"failure: whitespace authority address",
+			types.NewMsgUpdateParams("    ", types.DefaultParams()),
+			false,
+		},
+		{
+			"failure: unauthorized authority address",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			tc.msg.Params.ClientUpdateHeightInterval = 1
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateParams(suite.chainA.GetContext(), tc.msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(types.DefaultParams(), suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
+			}
+		})
+	}
+}
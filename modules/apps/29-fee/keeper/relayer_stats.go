@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// GetRelayerStats returns the statistics of the payee address on the given channel
+func (k Keeper) GetRelayerStats(ctx sdk.Context, portID, channelID, payee string) (types.RelayerStats, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRelayerStats(portID, channelID, payee))
+	if len(bz) == 0 {
+		return types.RelayerStats{}, false
+	}
+
+	var stats types.RelayerStats
+	k.cdc.MustUnmarshal(bz, &stats)
+
+	return stats, true
+}
+
+// SetRelayerStats stores the statistics of a payee address on a channel
+func (k Keeper) SetRelayerStats(ctx sdk.Context, stats types.RelayerStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.KeyRelayerStats(stats.PortId, stats.ChannelId, stats.Payee), bz)
+}
+
+// GetRelayerStatsForChannel returns the statistics of all the payee addresses on the given channel
+func (k Keeper) GetRelayerStatsForChannel(ctx sdk.Context, portID, channelID string) []types.RelayerStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRelayerStatsChannelPrefix(portID, channelID))
+	return k.getRelayerStats(ctx, store)
+}
+
+// GetAllRelayerStats returns the statistics of all the payee addresses stored in state
+func (k Keeper) GetAllRelayerStats(ctx sdk.Context) []types.RelayerStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RelayerStatsPrefix+"/"))
+	return k.getRelayerStats(ctx, store)
+}
+
+// getRelayerStats returns all the relayer statistics stored in the provided prefix store
+func (k Keeper) getRelayerStats(ctx sdk.Context, store storetypes.KVStore) []types.RelayerStats {
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var relayerStats []types.RelayerStats
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.RelayerStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		relayerStats = append(relayerStats, stats)
+	}
+
+	return relayerStats
+}
+
+// updateRelayerStats applies the update to the statistics of the payee address on the channel of the packet
+func (k Keeper) updateRelayerStats(ctx sdk.Context, packetID channeltypes.PacketId, payee sdk.AccAddress, update func(stats *types.RelayerStats)) {
+	stats, found := k.GetRelayerStats(ctx, packetID.PortId, packetID.ChannelId, payee.String())
+	if !found {
+		stats = types.NewRelayerStats(packetID.PortId, packetID.ChannelId, payee.String())
+	}
+
+	update(&stats)
+
+	k.SetRelayerStats(ctx, stats)
+}
+
+// recordFeesEarned adds the fees paid to the payee address to its statistics on the channel of the packet
+func (k Keeper) recordFeesEarned(ctx sdk.Context, packetID channeltypes.PacketId, payee sdk.AccAddress, fees sdk.Coins) {
+	if fees.IsZero() {
+		return
+	}
+
+	k.updateRelayerStats(ctx, packetID, payee, func(stats *types.RelayerStats) {
+		stats.FeesEarned = stats.FeesEarned.Add(fees...)
+	})
+}
+
+// getFeeHistoryCount returns the total number of packets recorded in the fee history of the given channel
+func (k Keeper) getFeeHistoryCount(ctx sdk.Context, portID, channelID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyFeeHistoryCount(portID, channelID))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// recordPacketFee records the total fee escrowed for a packet settled on the given channel. Only the fees of
+// the most recent MaxFeeHistory packets of a channel are kept.
+func (k Keeper) recordPacketFee(ctx sdk.Context, portID, channelID string, fee types.Fee) {
+	count := k.getFeeHistoryCount(ctx, portID, channelID)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyFeeHistory(portID, channelID, count%types.MaxFeeHistory), k.cdc.MustMarshal(&fee))
+	store.Set(types.KeyFeeHistoryCount(portID, channelID), sdk.Uint64ToBigEndian(count+1))
+}
+
+// GetFeeHistory returns the fees escrowed for the most recent packets settled on the given channel, ordered
+// from the oldest to the most recent packet. All the recorded packets are returned if the number of packets
+// is zero or greater than the number of recorded packets.
+func (k Keeper) GetFeeHistory(ctx sdk.Context, portID, channelID string, packets uint64) []types.Fee {
+	count := k.getFeeHistoryCount(ctx, portID, channelID)
+
+	recorded := min(count, types.MaxFeeHistory)
+	if packets == 0 || packets > recorded {
+		packets = recorded
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	fees := make([]types.Fee, 0, packets)
+	for i := count - packets; i < count; i++ {
+		var fee types.Fee
+		k.cdc.MustUnmarshal(store.Get(types.KeyFeeHistory(portID, channelID, i%types.MaxFeeHistory)), &fee)
+
+		fees = append(fees, fee)
+	}
+
+	return fees
+}
+
+// GetAllFeeHistories returns the fee histories of all the channels stored in state
+func (k Keeper) GetAllFeeHistories(ctx sdk.Context) []types.ChannelFeeHistory {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.FeeHistoryCountPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var feeHistories []types.ChannelFeeHistory
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseKeyFeeHistoryCount(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		feeHistories = append(feeHistories, types.NewChannelFeeHistory(portID, channelID, k.GetFeeHistory(ctx, portID, channelID, 0)))
+	}
+
+	return feeHistories
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestGetFeeHistory() {
+	testCases := []struct {
+		name        string
+		recorded    int
+		packets     uint64
+		expFirstFee int64
+		expLen      int
+	}{
+		{"no recorded packets", 0, 0, 0, 0},
+		{"all recorded packets", 10, 0, 1, 10},
+		{"most recent packets", 10, 3, 8, 3},
+		{"packets greater than recorded", 10, 20, 1, 10},
+		{"oldest packets are dropped", types.MaxFeeHistory + 5, 0, 6, types.MaxFeeHistory},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// record fees of increasing amounts to assert the order of the history
+			for i := 1; i <= tc.recorded; i++ {
+				recvFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(int64(i))))
+				fee := types.NewFee(recvFee, defaultAckFee, defaultTimeoutFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.RecordPacketFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, fee)
+			}
+
+			feeHistory := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeHistory(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, tc.packets)
+			suite.Require().Len(feeHistory, tc.expLen)
+
+			for i, fee := range feeHistory {
+				suite.Require().Equal(tc.expFirstFee+int64(i), fee.RecvFee.AmountOf(sdk.DefaultBondDenom).Int64())
+			}
+		})
+	}
+}
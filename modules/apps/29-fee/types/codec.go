@@ -13,6 +13,9 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFee{}, "cosmos-sdk/MsgPayPacketFee")
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPacketFee{}, "cosmos-sdk/MsgCancelPacketFee")
+	legacy.RegisterAminoMsg(cdc, &MsgFundClientIncentivePool{}, "cosmos-sdk/MsgFundClientIncentivePool")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/MsgUpdateFeeParams")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee")
 }
@@ -24,6 +27,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgPayPacketFee{},
 		&MsgPayPacketFeeAsync{},
+		&MsgCancelPacketFee{},
+		&MsgFundClientIncentivePool{},
+		&MsgUpdateParams{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
 	)
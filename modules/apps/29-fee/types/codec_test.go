@@ -28,6 +28,21 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgPayPacketFeeAsync{}),
 			true,
 		},
+		{
+			"success: MsgCancelPacketFee",
+			sdk.MsgTypeURL(&types.MsgCancelPacketFee{}),
+			true,
+		},
+		{
+			"success: MsgFundClientIncentivePool",
+			sdk.MsgTypeURL(&types.MsgFundClientIncentivePool{}),
+			true,
+		},
+		{
+			"success: MsgUpdateParams",
+			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+			true,
+		},
 		{
 			"success: MsgRegisterPayee",
 			sdk.MsgTypeURL(&types.MsgRegisterPayee{}),
@@ -17,4 +17,7 @@ var (
 	ErrRelayerNotFoundForAsyncAck    = errorsmod.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = errorsmod.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
 	ErrUnsupportedAction             = errorsmod.Register(ModuleName, 12, "unsupported action")
+	ErrPacketFeeNotCancellable       = errorsmod.Register(ModuleName, 13, "packet fee cannot be cancelled before the packet is acknowledged or timed out, or the fee expires")
+	ErrInvalidIncentiveReward        = errorsmod.Register(ModuleName, 14, "invalid client incentive reward")
+	ErrInvalidParams                 = errorsmod.Register(ModuleName, 15, "invalid 29-fee params")
 )
@@ -6,6 +6,9 @@ const (
 	EventTypeRegisterPayee             = "register_payee"
 	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
 	EventTypeDistributeFee             = "distribute_fee"
+	EventTypeCancelPacketFee           = "cancel_packet_fee"
+	EventTypeFundClientIncentivePool   = "fund_client_incentive_pool"
+	EventTypeClientIncentiveReward     = "client_incentive_reward"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
@@ -16,4 +19,10 @@ const (
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
 	AttributeKeyReceiver          = "receiver"
 	AttributeKeyFee               = "fee"
+	AttributeKeyRefundAddress     = "refund_address"
+	AttributeKeyClientID          = "client_id"
+	AttributeKeyDepositor         = "depositor"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyRewardType        = "reward_type"
+	AttributeKeyReward            = "reward"
 )
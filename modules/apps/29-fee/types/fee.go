@@ -2,6 +2,7 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -35,6 +36,11 @@ func (p PacketFee) Validate() error {
 	return p.Fee.Validate()
 }
 
+// IsExpired returns true if the expiry timestamp of the PacketFee is set and has passed at the provided block time
+func (p PacketFee) IsExpired(blockTime time.Time) bool {
+	return p.ExpiryTimestamp != 0 && uint64(blockTime.UnixNano()) >= p.ExpiryTimestamp
+}
+
 // NewPacketFees creates and returns a new PacketFees struct including a list of type PacketFee
 func NewPacketFees(packetFees []PacketFee) PacketFees {
 	return PacketFees{
@@ -91,3 +97,33 @@ func (f Fee) Validate() error {
 
 	return nil
 }
+
+// Add returns the sum of the Fee and the provided Fee.
+func (f Fee) Add(fee Fee) Fee {
+	return NewFee(f.RecvFee.Add(fee.RecvFee...), f.AckFee.Add(fee.AckFee...), f.TimeoutFee.Add(fee.TimeoutFee...))
+}
+
+// AverageFee returns the denomwise average of the provided fees, truncated to an integer amount.
+func AverageFee(fees []Fee) Fee {
+	if len(fees) == 0 {
+		return NewFee(sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins())
+	}
+
+	total := NewFee(sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins())
+	for _, fee := range fees {
+		total = total.Add(fee)
+	}
+
+	n := int64(len(fees))
+	return NewFee(quoCoins(total.RecvFee, n), quoCoins(total.AckFee, n), quoCoins(total.TimeoutFee, n))
+}
+
+// quoCoins divides each amount of the coins by n, removing the amounts truncated to zero.
+func quoCoins(coins sdk.Coins, n int64) sdk.Coins {
+	quotient := sdk.NewCoins()
+	for _, coin := range coins {
+		quotient = quotient.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(n)))
+	}
+
+	return quotient
+}
@@ -100,6 +100,9 @@ type PacketFee struct {
 	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 	// optional list of relayers permitted to receive fees
 	Relayers []string `protobuf:"bytes,3,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// optional block time in unix nanoseconds after which the fee may be cancelled by the refund address before the
+	// packet is acknowledged or timed out
+	ExpiryTimestamp uint64 `protobuf:"varint,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
 }
 
 func (m *PacketFee) Reset()         { *m = PacketFee{} }
@@ -156,6 +159,13 @@ func (m *PacketFee) GetRelayers() []string {
 	return nil
 }
 
+func (m *PacketFee) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
 // PacketFees contains a list of type PacketFee
 type PacketFees struct {
 	// list of packet fees
@@ -267,40 +277,41 @@ func init() {
 func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
 
 var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x04, 0xb5, 0xcd, 0x05, 0x90, 0x30, 0x95, 0x5a, 0x2a, 0x70, 0x8b, 0x25, 0xa4,
-	0xa8, 0x52, 0xee, 0x94, 0x00, 0x43, 0x99, 0x68, 0x90, 0x22, 0x65, 0x02, 0x65, 0x41, 0x62, 0x89,
-	0xce, 0xe7, 0x17, 0xf7, 0x14, 0xdb, 0x67, 0xf9, 0xec, 0xa0, 0x0c, 0x2c, 0x7c, 0x02, 0x56, 0x58,
-	0xd9, 0x98, 0xfa, 0x31, 0x3a, 0x76, 0x64, 0x02, 0x94, 0x0c, 0xfd, 0x02, 0x7c, 0x00, 0xf4, 0xce,
-	0x87, 0x55, 0x15, 0x75, 0x61, 0xe8, 0xe2, 0xbb, 0x77, 0xef, 0xdd, 0xfb, 0xfd, 0xef, 0xdd, 0xf3,
-	0x91, 0xc7, 0x32, 0x10, 0x8c, 0x67, 0x59, 0x2c, 0x05, 0x2f, 0xa4, 0x4a, 0x35, 0x9b, 0x01, 0xb0,
-	0x45, 0x1f, 0x07, 0x9a, 0xe5, 0xaa, 0x50, 0xee, 0x8e, 0x0c, 0x04, 0xbd, 0x1c, 0x42, 0xd1, 0xb7,
-	0xe8, 0xef, 0xdd, 0xe3, 0x89, 0x4c, 0x15, 0x33, 0xdf, 0x2a, 0x76, 0xcf, 0x13, 0x4a, 0x27, 0x4a,
-	0xb3, 0x80, 0x6b, 0xcc, 0x12, 0x40, 0xc1, 0xfb, 0x4c, 0x28, 0x99, 0x5a, 0xff, 0x76, 0xa4, 0x22,
-	0x65, 0xa6, 0x0c, 0x67, 0x76, 0xd5, 0x88, 0x10, 0x2a, 0x07, 0x26, 0x4e, 0x78, 0x9a, 0x42, 0x8c,
-	0x02, 0xec, 0xd4, 0x86, 0xec, 0xd8, 0xc4, 0x89, 0x8e, 0xd0, 0x99, 0xe8, 0xa8, 0x72, 0xf8, 0xbf,
-	0x9b, 0xa4, 0x35, 0x02, 0x70, 0xdf, 0x93, 0xad, 0x1c, 0xc4, 0x62, 0x3a, 0x03, 0xd8, 0x75, 0x0e,
-	0x5a, 0xdd, 0xce, 0xe0, 0x01, 0xad, 0xf6, 0x50, 0x14, 0x43, 0xad, 0x18, 0xfa, 0x4a, 0xc9, 0x74,
-	0x78, 0x7c, 0xf6, 0x63, 0xbf, 0xf1, 0xed, 0xe7, 0x7e, 0x37, 0x92, 0xc5, 0x49, 0x19, 0x50, 0xa1,
-	0x12, 0x66, 0x01, 0xd5, 0xd0, 0xd3, 0xe1, 0x9c, 0x15, 0xcb, 0x0c, 0xb4, 0xd9, 0xa0, 0xbf, 0x5c,
-	0x9c, 0x1e, 0xde, 0x8e, 0x21, 0xe2, 0x62, 0x39, 0xc5, 0xe3, 0xe8, 0xc9, 0x26, 0xd2, 0x10, 0x5c,
-	0x92, 0x4d, 0x2e, 0xe6, 0x86, 0xdb, 0xbc, 0x01, 0xee, 0x06, 0x17, 0x73, 0xc4, 0x7e, 0x20, 0x9d,
-	0x42, 0x26, 0xa0, 0xca, 0xc2, 0xa0, 0x5b, 0x37, 0x80, 0x26, 0x16, 0x38, 0x02, 0xf0, 0x3f, 0x3b,
-	0xa4, 0xfd, 0x86, 0x8b, 0x39, 0xa0, 0xe5, 0x3e, 0x23, 0xad, 0xaa, 0xee, 0x4e, 0xb7, 0x33, 0x78,
-	0x48, 0xaf, 0x69, 0x18, 0x3a, 0x02, 0x18, 0xde, 0x42, 0x1d, 0x13, 0x0c, 0x77, 0x9f, 0x90, 0xbb,
-	0x39, 0xcc, 0xca, 0x34, 0x9c, 0xf2, 0x30, 0xcc, 0x41, 0xeb, 0xdd, 0xe6, 0x81, 0xd3, 0x6d, 0x4f,
-	0xee, 0x54, 0xab, 0xc7, 0xd5, 0xa2, 0xbb, 0x87, 0x37, 0x1b, 0xf3, 0x25, 0xe4, 0xda, 0x1c, 0xb3,
-	0x3d, 0xa9, 0xed, 0x17, 0xf7, 0x3f, 0x5e, 0x9c, 0x1e, 0x5e, 0xc9, 0xe2, 0xbf, 0x25, 0xa4, 0x96,
-	0xa6, 0xdd, 0x31, 0xe9, 0x64, 0xc6, 0xc2, 0x3a, 0x69, 0xdb, 0x1b, 0xfe, 0xb5, 0x1a, 0xeb, 0x9d,
-	0x56, 0x29, 0xc9, 0xea, 0x54, 0xfe, 0x57, 0x87, 0x6c, 0x8f, 0x43, 0x48, 0x0b, 0x39, 0x93, 0x10,
-	0x5e, 0x62, 0xbc, 0x24, 0x6d, 0xcb, 0x90, 0xa1, 0xad, 0xc2, 0x23, 0x43, 0xc0, 0xa6, 0xa6, 0x7f,
-	0x3b, 0xb9, 0xce, 0x3e, 0x0e, 0x6d, 0xf2, 0xad, 0xcc, 0xda, 0x57, 0x55, 0x36, 0xff, 0x5f, 0xe5,
-	0xf0, 0xf5, 0xd9, 0xca, 0x73, 0xce, 0x57, 0x9e, 0xf3, 0x6b, 0xe5, 0x39, 0x9f, 0xd6, 0x5e, 0xe3,
-	0x7c, 0xed, 0x35, 0xbe, 0xaf, 0xbd, 0xc6, 0xbb, 0xe7, 0xff, 0xde, 0xbd, 0x0c, 0x44, 0x2f, 0x52,
-	0x6c, 0x71, 0xc4, 0x12, 0x15, 0x96, 0x31, 0x68, 0x7c, 0x0c, 0x34, 0x1b, 0x1c, 0xf5, 0xf0, 0x1d,
-	0x30, 0xed, 0x10, 0x6c, 0x98, 0x3f, 0xed, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x44,
-	0xf0, 0xac, 0x2c, 0x04, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xbd, 0x6e, 0x13, 0x41,
+	0x10, 0xc7, 0x7d, 0xb6, 0x95, 0xc4, 0x6b, 0x3e, 0x8f, 0x48, 0x31, 0x16, 0x5c, 0x8c, 0x25, 0x24,
+	0x13, 0xc9, 0xbb, 0xb2, 0x81, 0x22, 0x54, 0xc4, 0x48, 0x96, 0x5c, 0x81, 0x2c, 0x24, 0x24, 0x1a,
+	0x6b, 0x6f, 0x6f, 0x7c, 0x59, 0xd9, 0x77, 0x7b, 0xba, 0x3d, 0x1b, 0x5c, 0xd0, 0xf0, 0x04, 0xd4,
+	0xb4, 0x74, 0x54, 0x79, 0x08, 0x8a, 0x94, 0x29, 0xa9, 0x00, 0xd9, 0x45, 0x5e, 0x80, 0x07, 0x40,
+	0xb3, 0xb7, 0xb1, 0xa2, 0xa0, 0x34, 0x29, 0xd2, 0x78, 0x77, 0x3e, 0x76, 0x7e, 0xff, 0x59, 0xcf,
+	0x2d, 0x79, 0x24, 0x7d, 0xc1, 0x78, 0x92, 0x4c, 0xa5, 0xe0, 0x99, 0x54, 0xb1, 0x66, 0x63, 0x00,
+	0x36, 0xef, 0xe0, 0x42, 0x93, 0x54, 0x65, 0xca, 0xdd, 0x91, 0xbe, 0xa0, 0xe7, 0x53, 0x28, 0xc6,
+	0xe6, 0x9d, 0xfa, 0x5d, 0x1e, 0xc9, 0x58, 0x31, 0xf3, 0x9b, 0xe7, 0xd6, 0x3d, 0xa1, 0x74, 0xa4,
+	0x34, 0xf3, 0xb9, 0xc6, 0x2a, 0x3e, 0x64, 0xbc, 0xc3, 0x84, 0x92, 0xb1, 0x8d, 0x6f, 0x87, 0x2a,
+	0x54, 0x66, 0xcb, 0x70, 0x67, 0xbd, 0x46, 0x84, 0x50, 0x29, 0x30, 0x71, 0xc8, 0xe3, 0x18, 0xa6,
+	0x28, 0xc0, 0x6e, 0x6d, 0xca, 0x8e, 0x2d, 0x1c, 0xe9, 0x10, 0x83, 0x91, 0x0e, 0xf3, 0x40, 0xf3,
+	0x6f, 0x91, 0x94, 0xfa, 0x00, 0xee, 0x07, 0xb2, 0x95, 0x82, 0x98, 0x8f, 0xc6, 0x00, 0x35, 0xa7,
+	0x51, 0x6a, 0x55, 0xbb, 0xf7, 0x69, 0x7e, 0x86, 0xa2, 0x18, 0x6a, 0xc5, 0xd0, 0x57, 0x4a, 0xc6,
+	0xbd, 0x83, 0xe3, 0x5f, 0xbb, 0x85, 0xef, 0xbf, 0x77, 0x5b, 0xa1, 0xcc, 0x0e, 0x67, 0x3e, 0x15,
+	0x2a, 0x62, 0x16, 0x90, 0x2f, 0x6d, 0x1d, 0x4c, 0x58, 0xb6, 0x48, 0x40, 0x9b, 0x03, 0xfa, 0xeb,
+	0xe9, 0xd1, 0xde, 0x8d, 0x29, 0x84, 0x5c, 0x2c, 0x46, 0xd8, 0x8e, 0x1e, 0x6e, 0x22, 0x0d, 0xc1,
+	0x33, 0xb2, 0xc9, 0xc5, 0xc4, 0x70, 0x8b, 0xd7, 0xc0, 0xdd, 0xe0, 0x62, 0x82, 0xd8, 0x4f, 0xa4,
+	0x9a, 0xc9, 0x08, 0xd4, 0x2c, 0x33, 0xe8, 0xd2, 0x35, 0xa0, 0x89, 0x05, 0xf6, 0x01, 0x9a, 0x3f,
+	0x1c, 0x52, 0x79, 0xc3, 0xc5, 0x04, 0xd0, 0x72, 0x9f, 0x91, 0x52, 0x7e, 0xef, 0x4e, 0xab, 0xda,
+	0x7d, 0x40, 0x2f, 0x19, 0x18, 0xda, 0x07, 0xe8, 0x95, 0x51, 0xc7, 0x10, 0xd3, 0xdd, 0xc7, 0xe4,
+	0x56, 0x0a, 0xe3, 0x59, 0x1c, 0x8c, 0x78, 0x10, 0xa4, 0xa0, 0x75, 0xad, 0xd8, 0x70, 0x5a, 0x95,
+	0xe1, 0xcd, 0xdc, 0x7b, 0x90, 0x3b, 0xdd, 0x3a, 0xfe, 0xb3, 0x53, 0xbe, 0x80, 0x54, 0x9b, 0x36,
+	0x2b, 0xc3, 0xb5, 0xed, 0x3e, 0x21, 0x77, 0xe0, 0x63, 0x22, 0xd3, 0xc5, 0x08, 0xb5, 0xe9, 0x8c,
+	0x47, 0x49, 0xad, 0xdc, 0x70, 0x5a, 0xe5, 0xe1, 0xed, 0xdc, 0xff, 0xf6, 0xcc, 0xfd, 0xe2, 0xde,
+	0xe7, 0xd3, 0xa3, 0xbd, 0x0b, 0xc0, 0xe6, 0x3b, 0x42, 0xd6, 0x5d, 0x68, 0x77, 0x40, 0xaa, 0x89,
+	0xb1, 0xf0, 0x4a, 0xb5, 0x1d, 0xa3, 0xe6, 0xa5, 0xed, 0xac, 0x4f, 0xda, 0xa6, 0x48, 0xb2, 0x2e,
+	0xd5, 0xfc, 0xe6, 0x90, 0xed, 0x41, 0x00, 0x71, 0x26, 0xc7, 0x12, 0x82, 0x73, 0x8c, 0x97, 0xa4,
+	0x62, 0x19, 0x32, 0xb0, 0x17, 0xf6, 0xd0, 0x10, 0x70, 0xfe, 0xe9, 0xd9, 0xd0, 0xaf, 0xab, 0x0f,
+	0x02, 0x5b, 0x7c, 0x2b, 0xb1, 0xf6, 0x45, 0x95, 0xc5, 0xab, 0xab, 0xec, 0xbd, 0x3e, 0x5e, 0x7a,
+	0xce, 0xc9, 0xd2, 0x73, 0xfe, 0x2c, 0x3d, 0xe7, 0xcb, 0xca, 0x2b, 0x9c, 0xac, 0xbc, 0xc2, 0xcf,
+	0x95, 0x57, 0x78, 0xff, 0xfc, 0xff, 0x31, 0x91, 0xbe, 0x68, 0x87, 0x8a, 0xcd, 0xf7, 0x59, 0xa4,
+	0x82, 0xd9, 0x14, 0x34, 0xbe, 0x1b, 0x9a, 0x75, 0xf7, 0xdb, 0xf8, 0x64, 0x98, 0xc9, 0xf1, 0x37,
+	0xcc, 0x47, 0xf9, 0xf4, 0xdf, 0x00, 0x2f, 0xd7, 0x53, 0xed, 0x57, 0x04, 0x00, 0x00,
 }
 
 func (m *Fee) Marshal() (dAtA []byte, err error) {
@@ -388,6 +399,11 @@ func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Relayers) > 0 {
 		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Relayers[iNdEx])
@@ -557,6 +573,9 @@ func (m *PacketFee) Size() (n int) {
 			n += 1 + l + sovFee(uint64(l))
 		}
 	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovFee(uint64(m.ExpiryTimestamp))
+	}
 	return n
 }
 
@@ -876,6 +895,25 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			}
 			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -226,3 +227,68 @@ func TestPacketFeeValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestPacketFeeIsExpired(t *testing.T) {
+	blockTime := time.Unix(1700000000, 0)
+	packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), defaultAccAddress, nil)
+
+	require.False(t, packetFee.IsExpired(blockTime), "packet fee without expiry timestamp must never expire")
+
+	packetFee.ExpiryTimestamp = uint64(blockTime.Add(time.Second).UnixNano())
+	require.False(t, packetFee.IsExpired(blockTime))
+
+	packetFee.ExpiryTimestamp = uint64(blockTime.UnixNano())
+	require.True(t, packetFee.IsExpired(blockTime))
+}
+
+func TestAverageFee(t *testing.T) {
+	testCases := []struct {
+		name   string
+		fees   []types.Fee
+		expFee types.Fee
+	}{
+		{
+			"success: no fees",
+			nil,
+			types.NewFee(sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()),
+		},
+		{
+			"success: single fee",
+			[]types.Fee{types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)},
+			types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+		},
+		{
+			"success: amounts are truncated",
+			[]types.Fee{
+				types.NewFee(defaultRecvFee, defaultAckFee, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))),
+				types.NewFee(defaultAckFee, defaultAckFee, sdk.NewCoins()),
+				types.NewFee(defaultRecvFee, defaultAckFee, sdk.NewCoins()),
+			},
+			types.NewFee(
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(133))),
+				defaultAckFee,
+				sdk.NewCoins(),
+			),
+		},
+		{
+			"success: multiple denoms",
+			[]types.Fee{
+				types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+				types.NewFee(sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100))), defaultAckFee, defaultTimeoutFee),
+			},
+			types.NewFee(
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)), sdk.NewCoin("denom", sdkmath.NewInt(50))),
+				defaultAckFee,
+				defaultTimeoutFee,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expFee, types.AverageFee(tc.fees))
+		})
+	}
+}
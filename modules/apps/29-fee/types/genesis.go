@@ -18,6 +18,11 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	relayerStats []RelayerStats,
+	feeHistories []ChannelFeeHistory,
+	params Params,
+	clientIncentivePools []ClientIncentivePool,
+	relayerRateLimits []RelayerRateLimit,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -25,6 +30,11 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		RelayerStats:                 relayerStats,
+		FeeHistories:                 feeHistories,
+		Params:                       params,
+		ClientIncentivePools:         clientIncentivePools,
+		RelayerRateLimits:            relayerRateLimits,
 	}
 }
 
@@ -36,6 +46,11 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		RelayerStats:                 []RelayerStats{},
+		FeeHistories:                 []ChannelFeeHistory{},
+		Params:                       DefaultParams(),
+		ClientIncentivePools:         []ClientIncentivePool{},
+		RelayerRateLimits:            []RelayerRateLimit{},
 	}
 }
 
@@ -110,5 +125,37 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate RelayerStats
+	for _, stats := range gs.RelayerStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate FeeHistories
+	for _, feeHistory := range gs.FeeHistories {
+		if err := feeHistory.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Validate ClientIncentivePools
+	for _, pool := range gs.ClientIncentivePools {
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate RelayerRateLimits
+	for _, rateLimit := range gs.RelayerRateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
@@ -36,6 +38,16 @@ type GenesisState struct {
 	RegisteredCounterpartyPayees []RegisteredCounterpartyPayee `protobuf:"bytes,4,rep,name=registered_counterparty_payees,json=registeredCounterpartyPayees,proto3" json:"registered_counterparty_payees"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,5,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers"`
+	// list of relayer statistics
+	RelayerStats []RelayerStats `protobuf:"bytes,6,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+	// list of channel fee histories
+	FeeHistories []ChannelFeeHistory `protobuf:"bytes,7,rep,name=fee_histories,json=feeHistories,proto3" json:"fee_histories"`
+	// the fee middleware parameters
+	Params Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	// list of client incentive pools
+	ClientIncentivePools []ClientIncentivePool `protobuf:"bytes,9,rep,name=client_incentive_pools,json=clientIncentivePools,proto3" json:"client_incentive_pools"`
+	// list of relayer rate limits
+	RelayerRateLimits []RelayerRateLimit `protobuf:"bytes,10,rep,name=relayer_rate_limits,json=relayerRateLimits,proto3" json:"relayer_rate_limits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +118,41 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeHistories() []ChannelFeeHistory {
+	if m != nil {
+		return m.FeeHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetClientIncentivePools() []ClientIncentivePool {
+	if m != nil {
+		return m.ClientIncentivePools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelayerRateLimits() []RelayerRateLimit {
+	if m != nil {
+		return m.RelayerRateLimits
+	}
+	return nil
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -345,12 +392,179 @@ func (m *ForwardRelayerAddress) GetPacketId() types.PacketId {
 	return types.PacketId{}
 }
 
+// RelayerStats contains the number of packets relayed and the fees earned by a payee address on a specific channel
+type RelayerStats struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the payee address to which the fees are paid out
+	Payee string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	// number of packets received on the counterparty chain with the payee as the forward relayer
+	RecvPackets uint64 `protobuf:"varint,4,opt,name=recv_packets,json=recvPackets,proto3" json:"recv_packets,omitempty"`
+	// number of packets acknowledged with the payee as the reverse relayer
+	AckPackets uint64 `protobuf:"varint,5,opt,name=ack_packets,json=ackPackets,proto3" json:"ack_packets,omitempty"`
+	// number of packets timed out with the payee as the timeout relayer
+	TimeoutPackets uint64 `protobuf:"varint,6,opt,name=timeout_packets,json=timeoutPackets,proto3" json:"timeout_packets,omitempty"`
+	// the total fees paid to the payee
+	FeesEarned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fees_earned,json=feesEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_earned"`
+}
+
+func (m *RelayerStats) Reset()         { *m = RelayerStats{} }
+func (m *RelayerStats) String() string { return proto.CompactTextString(m) }
+func (*RelayerStats) ProtoMessage()    {}
+func (*RelayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{5}
+}
+func (m *RelayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStats.Merge(m, src)
+}
+func (m *RelayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStats proto.InternalMessageInfo
+
+func (m *RelayerStats) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetRecvPackets() uint64 {
+	if m != nil {
+		return m.RecvPackets
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetAckPackets() uint64 {
+	if m != nil {
+		return m.AckPackets
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetTimeoutPackets() uint64 {
+	if m != nil {
+		return m.TimeoutPackets
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetFeesEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesEarned
+	}
+	return nil
+}
+
+// ChannelFeeHistory contains the fees of the most recent packets settled on a specific channel, ordered from the
+// oldest to the most recent packet
+type ChannelFeeHistory struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the total fees escrowed for each packet
+	Fees []Fee `protobuf:"bytes,3,rep,name=fees,proto3" json:"fees"`
+}
+
+func (m *ChannelFeeHistory) Reset()         { *m = ChannelFeeHistory{} }
+func (m *ChannelFeeHistory) String() string { return proto.CompactTextString(m) }
+func (*ChannelFeeHistory) ProtoMessage()    {}
+func (*ChannelFeeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{6}
+}
+func (m *ChannelFeeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFeeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFeeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFeeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFeeHistory.Merge(m, src)
+}
+func (m *ChannelFeeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFeeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFeeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFeeHistory proto.InternalMessageInfo
+
+func (m *ChannelFeeHistory) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelFeeHistory) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFeeHistory) GetFees() []Fee {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.fee.v1.GenesisState")
 	proto.RegisterType((*FeeEnabledChannel)(nil), "ibc.applications.fee.v1.FeeEnabledChannel")
 	proto.RegisterType((*RegisteredPayee)(nil), "ibc.applications.fee.v1.RegisteredPayee")
 	proto.RegisterType((*RegisteredCounterpartyPayee)(nil), "ibc.applications.fee.v1.RegisteredCounterpartyPayee")
 	proto.RegisterType((*ForwardRelayerAddress)(nil), "ibc.applications.fee.v1.ForwardRelayerAddress")
+	proto.RegisterType((*RelayerStats)(nil), "ibc.applications.fee.v1.RelayerStats")
+	proto.RegisterType((*ChannelFeeHistory)(nil), "ibc.applications.fee.v1.ChannelFeeHistory")
 }
 
 func init() {
@@ -358,42 +572,62 @@ func init() {
 }
 
 var fileDescriptor_7191992e856dff95 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xf6, 0x93, 0x7a, 0x88, 0xae, 0x56, 0xd1, 0xa2, 0xc1, 0xc2, 0xa8, 0x84, 0x54, 0x21,
-	0x35, 0x51, 0x0b, 0x1c, 0x76, 0x03, 0x26, 0x86, 0x2a, 0x0e, 0x4c, 0xe5, 0x06, 0x48, 0x21, 0xb1,
-	0x5f, 0x32, 0x8b, 0x36, 0x8e, 0x6c, 0xb7, 0xa8, 0x37, 0x2e, 0xdc, 0xe1, 0xbf, 0xda, 0x71, 0x47,
-	0x4e, 0x08, 0xb5, 0xff, 0x08, 0xb2, 0xe3, 0x8c, 0xae, 0x23, 0x08, 0x71, 0xf3, 0x7b, 0xef, 0xfb,
-	0xde, 0x67, 0x7f, 0x4f, 0xcf, 0xe8, 0x01, 0x8b, 0x49, 0x10, 0xe5, 0xf9, 0x88, 0x91, 0x48, 0x31,
-	0x9e, 0xc9, 0x20, 0x01, 0x08, 0xa6, 0xbd, 0x20, 0x85, 0x0c, 0x24, 0x93, 0x7e, 0x2e, 0xb8, 0xe2,
-	0x78, 0x8f, 0xc5, 0xc4, 0x5f, 0x86, 0xf9, 0x09, 0x80, 0x3f, 0xed, 0xed, 0xb7, 0x52, 0x9e, 0x72,
-	0x83, 0x09, 0xf4, 0xa9, 0x80, 0xef, 0xdf, 0xaf, 0xea, 0xaa, 0x59, 0x4b, 0x10, 0xc2, 0x05, 0x04,
-	0xe4, 0x2c, 0xca, 0x32, 0x18, 0xe9, 0xb2, 0x3d, 0x16, 0x90, 0xf6, 0xb7, 0x0d, 0x74, 0xf3, 0x65,
-	0x71, 0x8d, 0x37, 0x2a, 0x52, 0x80, 0xdf, 0xa3, 0x06, 0xa3, 0x90, 0x29, 0x96, 0x30, 0xa0, 0x61,
-	0x02, 0x20, 0x5d, 0xe7, 0x70, 0xbd, 0xb3, 0xd3, 0xef, 0xfa, 0x15, 0xf7, 0xf3, 0x07, 0x97, 0xf8,
-	0xd3, 0x88, 0x7c, 0x04, 0x75, 0x02, 0x20, 0x9f, 0x6f, 0x9c, 0xff, 0xb8, 0x57, 0x1b, 0xde, 0xfa,
-	0xdd, 0x4b, 0x67, 0x71, 0x8c, 0x5a, 0x09, 0x40, 0x08, 0x59, 0x14, 0x8f, 0x80, 0x86, 0xf6, 0x2e,
-	0xd2, 0x5d, 0x33, 0x12, 0x0f, 0x2b, 0x25, 0x4e, 0x00, 0x5e, 0x14, 0x9c, 0xe3, 0x82, 0x62, 0xfb,
-	0xe3, 0x64, 0xb5, 0x20, 0xf1, 0x3b, 0xd4, 0x14, 0x90, 0x32, 0xa9, 0x40, 0x00, 0x0d, 0xf3, 0x68,
-	0xa6, 0xdf, 0xb0, 0x6e, 0x04, 0x3a, 0x95, 0x02, 0xc3, 0x4b, 0xc6, 0xa9, 0x26, 0xd8, 0xf6, 0xbb,
-	0xe2, 0x6a, 0x5a, 0xe2, 0xcf, 0x0e, 0xf2, 0x96, 0xba, 0x13, 0x3e, 0xc9, 0x14, 0x88, 0x3c, 0x12,
-	0x6a, 0x56, 0x4a, 0x6d, 0x18, 0xa9, 0xc7, 0xff, 0x20, 0x75, 0xbc, 0xc4, 0x5e, 0x96, 0xbd, 0x2b,
-	0xaa, 0x21, 0x12, 0x87, 0x68, 0x37, 0xe1, 0xe2, 0x53, 0x24, 0x68, 0x28, 0x60, 0x14, 0xcd, 0x40,
-	0x48, 0x77, 0xd3, 0x68, 0xfa, 0xd5, 0xfe, 0x15, 0x84, 0x61, 0x81, 0x7f, 0x46, 0xa9, 0x00, 0x59,
-	0xce, 0xa8, 0x91, 0x5c, 0x29, 0xca, 0xf6, 0x2b, 0xd4, 0xbc, 0xe6, 0x37, 0xde, 0x43, 0xdb, 0x39,
-	0x17, 0x2a, 0x64, 0xd4, 0x75, 0x0e, 0x9d, 0x4e, 0x7d, 0xb8, 0xa5, 0xc3, 0x01, 0xc5, 0x07, 0x08,
-	0xd9, 0x31, 0xea, 0xda, 0x9a, 0xa9, 0xd5, 0x6d, 0x66, 0x40, 0xdb, 0x1f, 0x50, 0x63, 0xc5, 0xdb,
-	0x15, 0x86, 0xb3, 0xc2, 0xc0, 0x2e, 0xda, 0xb6, 0xef, 0xb2, 0xdd, 0xca, 0x10, 0xb7, 0xd0, 0xa6,
-	0xf1, 0xd8, 0x5d, 0x37, 0xf9, 0x22, 0x68, 0x7f, 0x71, 0xd0, 0x9d, 0xbf, 0x78, 0xfa, 0xff, 0x72,
-	0x5d, 0x84, 0xaf, 0xcf, 0xd7, 0x6a, 0x37, 0xc9, 0xaa, 0x4e, 0x5b, 0xa2, 0xdb, 0x7f, 0xb4, 0x59,
-	0x2b, 0x44, 0xc5, 0xd1, 0xaa, 0x97, 0x21, 0x7e, 0x8a, 0xea, 0xb9, 0x59, 0x99, 0xd2, 0xba, 0x9d,
-	0xfe, 0x81, 0x99, 0xa1, 0x5e, 0x5a, 0xbf, 0xdc, 0xd4, 0x69, 0xcf, 0x2f, 0x16, 0x6b, 0x40, 0xed,
-	0xc8, 0x6e, 0xe4, 0x65, 0xfc, 0xfa, 0x7c, 0xee, 0x39, 0x17, 0x73, 0xcf, 0xf9, 0x39, 0xf7, 0x9c,
-	0xaf, 0x0b, 0xaf, 0x76, 0xb1, 0xf0, 0x6a, 0xdf, 0x17, 0x5e, 0xed, 0xed, 0x93, 0x94, 0xa9, 0xb3,
-	0x49, 0xec, 0x13, 0x3e, 0x0e, 0x08, 0x97, 0x63, 0x2e, 0x03, 0x16, 0x93, 0x6e, 0xca, 0x83, 0xe9,
-	0x51, 0x30, 0xe6, 0x74, 0x32, 0x02, 0xa9, 0xff, 0x0f, 0x19, 0xf4, 0x8f, 0xba, 0xfa, 0xeb, 0x50,
-	0xb3, 0x1c, 0x64, 0xbc, 0x65, 0xfe, 0x85, 0x47, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x42, 0x7b,
-	0x2e, 0xd1, 0xb5, 0x04, 0x00, 0x00,
+	// 871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xe6, 0x8f, 0xd3, 0x8c, 0xd3, 0xa6, 0x1e, 0x02, 0x5d, 0x4a, 0xeb, 0xa4, 0x96, 0xaa,
+	0x1a, 0x84, 0x77, 0x49, 0xf8, 0x23, 0xf5, 0x80, 0x04, 0x89, 0x1a, 0xb0, 0x40, 0xc2, 0x32, 0xe2,
+	0x02, 0x48, 0xcb, 0xec, 0xec, 0x5b, 0x67, 0x94, 0xf5, 0xce, 0x6a, 0xde, 0xc4, 0xc8, 0x37, 0x24,
+	0xc4, 0x9d, 0xcf, 0xc1, 0x17, 0xe0, 0x0b, 0x70, 0xe8, 0xb1, 0x47, 0x4e, 0x80, 0x92, 0x2f, 0x82,
+	0x66, 0x76, 0xd6, 0x75, 0xed, 0x6e, 0x5a, 0xe5, 0x64, 0xcf, 0x9b, 0xdf, 0x9f, 0x99, 0xf7, 0xf6,
+	0xcd, 0x23, 0x0f, 0x45, 0xcc, 0x43, 0x56, 0x14, 0x99, 0xe0, 0x4c, 0x0b, 0x99, 0x63, 0x98, 0x02,
+	0x84, 0x93, 0x83, 0x70, 0x04, 0x39, 0xa0, 0xc0, 0xa0, 0x50, 0x52, 0x4b, 0x7a, 0x47, 0xc4, 0x3c,
+	0x98, 0x87, 0x05, 0x29, 0x40, 0x30, 0x39, 0xb8, 0xbb, 0x3b, 0x92, 0x23, 0x69, 0x31, 0xa1, 0xf9,
+	0x57, 0xc2, 0xef, 0xb6, 0xb9, 0xc4, 0xb1, 0xc4, 0x30, 0x66, 0x68, 0xc4, 0x62, 0xd0, 0xec, 0x20,
+	0xe4, 0x52, 0xe4, 0x6e, 0xff, 0x41, 0x9d, 0xab, 0x51, 0x2d, 0x21, 0x8f, 0xea, 0x20, 0x22, 0xe7,
+	0x90, 0x6b, 0x31, 0x81, 0x79, 0x2d, 0x2e, 0x15, 0x84, 0xfc, 0x94, 0xe5, 0x39, 0x64, 0x06, 0xe4,
+	0xfe, 0x96, 0x90, 0xce, 0x5f, 0x9b, 0x64, 0xfb, 0x8b, 0xf2, 0x3e, 0xdf, 0x6a, 0xa6, 0x81, 0xfe,
+	0x48, 0x76, 0x44, 0x62, 0x54, 0x52, 0x01, 0x49, 0x94, 0x02, 0xa0, 0xef, 0xed, 0xaf, 0x75, 0x9b,
+	0x87, 0xbd, 0xa0, 0xe6, 0xa2, 0x41, 0x7f, 0x86, 0x1f, 0x30, 0x7e, 0x06, 0xfa, 0x04, 0x00, 0x8f,
+	0xd6, 0x9f, 0xfe, 0xb3, 0xb7, 0x32, 0xbc, 0xf5, 0x5c, 0xcb, 0x44, 0x69, 0x4c, 0x76, 0x53, 0x80,
+	0x08, 0x72, 0x16, 0x67, 0x90, 0x44, 0xee, 0x2c, 0xe8, 0xaf, 0x5a, 0x8b, 0xf7, 0x6a, 0x2d, 0x4e,
+	0x00, 0x9e, 0x94, 0x9c, 0xe3, 0x92, 0xe2, 0xf4, 0x69, 0xba, 0xb8, 0x81, 0xf4, 0x07, 0xd2, 0x52,
+	0x30, 0x12, 0xa8, 0x41, 0x41, 0x12, 0x15, 0x6c, 0x6a, 0xee, 0xb0, 0x66, 0x0d, 0xba, 0xb5, 0x06,
+	0xc3, 0x19, 0x63, 0x60, 0x08, 0x4e, 0xfe, 0xb6, 0x7a, 0x31, 0x8c, 0xf4, 0x17, 0x8f, 0xb4, 0xe7,
+	0xd4, 0xb9, 0x3c, 0xcf, 0x35, 0xa8, 0x82, 0x29, 0x3d, 0xad, 0xac, 0xd6, 0xad, 0xd5, 0x47, 0xaf,
+	0x61, 0x75, 0x3c, 0xc7, 0x9e, 0xb7, 0xbd, 0xa7, 0xea, 0x21, 0x48, 0x23, 0x72, 0x3b, 0x95, 0xea,
+	0x67, 0xa6, 0x92, 0x48, 0x41, 0xc6, 0xa6, 0xa0, 0xd0, 0xdf, 0xb0, 0x9e, 0x41, 0x7d, 0xfe, 0x4a,
+	0xc2, 0xb0, 0xc4, 0x7f, 0x9e, 0x24, 0x0a, 0xb0, 0xaa, 0xd1, 0x4e, 0xfa, 0xc2, 0x26, 0xd2, 0x01,
+	0xb9, 0xe9, 0x84, 0x23, 0xd4, 0x4c, 0xa3, 0xdf, 0xb0, 0xea, 0x0f, 0xaf, 0xb8, 0x91, 0x45, 0x9b,
+	0x0f, 0xa8, 0x12, 0xdd, 0x56, 0x73, 0x31, 0xfa, 0x1d, 0xb9, 0x69, 0xca, 0x7e, 0x2a, 0x50, 0x4b,
+	0x25, 0x00, 0xfd, 0xcd, 0x57, 0xd4, 0xdb, 0x15, 0xf3, 0x04, 0xe0, 0x4b, 0xcb, 0x99, 0x56, 0xb2,
+	0x69, 0x15, 0x11, 0x80, 0xf4, 0x53, 0xd2, 0x28, 0x98, 0x62, 0x63, 0xf4, 0x6f, 0xec, 0x7b, 0xdd,
+	0xe6, 0xe1, 0x5e, 0xad, 0xde, 0xc0, 0xc2, 0x9c, 0x88, 0x23, 0xd1, 0x53, 0xf2, 0x16, 0xcf, 0x04,
+	0xe4, 0x3a, 0x9a, 0x35, 0x4e, 0x54, 0x48, 0x99, 0xa1, 0xbf, 0x65, 0x8f, 0xf7, 0x7e, 0xfd, 0xf1,
+	0x2c, 0xad, 0x5f, 0xb1, 0x06, 0x52, 0x56, 0x1f, 0xe4, 0x2e, 0x5f, 0xde, 0x32, 0x25, 0x7b, 0xa3,
+	0xca, 0xa8, 0x62, 0x1a, 0xa2, 0x4c, 0x8c, 0x85, 0x46, 0x9f, 0x58, 0x9b, 0x77, 0x5f, 0x95, 0xd7,
+	0x21, 0xd3, 0xf0, 0xb5, 0x61, 0x38, 0x8f, 0x96, 0x5a, 0x88, 0x63, 0xe7, 0x2b, 0xd2, 0x5a, 0x6a,
+	0x11, 0x7a, 0x87, 0x6c, 0x16, 0x52, 0xe9, 0x48, 0x24, 0xbe, 0xb7, 0xef, 0x75, 0xb7, 0x86, 0x0d,
+	0xb3, 0xec, 0x27, 0xf4, 0x3e, 0x21, 0xae, 0xf3, 0xcc, 0xde, 0xaa, 0xdd, 0xdb, 0x72, 0x91, 0x7e,
+	0xd2, 0xf9, 0x89, 0xec, 0x2c, 0xb4, 0xc3, 0x02, 0xc3, 0x5b, 0x60, 0x50, 0x9f, 0x6c, 0xba, 0x33,
+	0x39, 0xb5, 0x6a, 0x49, 0x77, 0xc9, 0x86, 0x6d, 0x0b, 0x7f, 0xcd, 0xc6, 0xcb, 0x45, 0xe7, 0x37,
+	0x8f, 0xbc, 0x73, 0x45, 0x1b, 0x5c, 0xdf, 0xae, 0x47, 0xe8, 0x72, 0x4b, 0x3a, 0xef, 0x16, 0x5f,
+	0xf4, 0xe9, 0x20, 0x79, 0xf3, 0xa5, 0x9d, 0x61, 0x1c, 0x58, 0xf9, 0xd7, 0xb9, 0x57, 0x4b, 0xfa,
+	0x19, 0xd9, 0x2a, 0xec, 0x2b, 0x57, 0xa5, 0xae, 0x79, 0x78, 0xdf, 0x16, 0xd0, 0xbc, 0xb3, 0x41,
+	0xf5, 0xb8, 0xda, 0x4f, 0xce, 0xa0, 0xfa, 0x89, 0x2b, 0xda, 0x8d, 0xc2, 0xad, 0x3b, 0x7f, 0xae,
+	0x92, 0xed, 0xf9, 0x8e, 0xb9, 0x6e, 0x9d, 0x5e, 0x9e, 0x5b, 0xfa, 0x80, 0x6c, 0x2b, 0xe0, 0x93,
+	0xa8, 0xf4, 0x33, 0xcf, 0x91, 0xd7, 0x5d, 0x1f, 0x36, 0x4d, 0xac, 0x3c, 0x12, 0xd2, 0x3d, 0xd2,
+	0x64, 0xfc, 0x6c, 0x86, 0xd8, 0xb0, 0x08, 0xc2, 0xf8, 0x59, 0x05, 0x78, 0x44, 0x76, 0xb4, 0x18,
+	0x83, 0x3c, 0xd7, 0x33, 0x50, 0xc3, 0x82, 0x6e, 0xb9, 0x70, 0x05, 0xcc, 0x48, 0xd3, 0x8c, 0x88,
+	0x08, 0x98, 0xca, 0x21, 0x71, 0x6d, 0xfd, 0x76, 0x50, 0xce, 0xb8, 0xc0, 0xcc, 0xb8, 0xc0, 0xcd,
+	0xb8, 0xe0, 0x58, 0x8a, 0xfc, 0xe8, 0x03, 0x93, 0x8b, 0x3f, 0xfe, 0xdd, 0xeb, 0x8e, 0x84, 0x3e,
+	0x3d, 0x8f, 0x03, 0x2e, 0xc7, 0xa1, 0x1b, 0x88, 0xe5, 0x4f, 0x0f, 0x93, 0xb3, 0x50, 0x4f, 0x0b,
+	0x40, 0x4b, 0xc0, 0x21, 0x31, 0xfa, 0x4f, 0xac, 0x7c, 0xe7, 0x57, 0x8f, 0xb4, 0x96, 0x5e, 0x86,
+	0x6b, 0xa7, 0xef, 0x13, 0xb2, 0x9e, 0x3e, 0x1f, 0x0d, 0xf7, 0xae, 0x9a, 0x3d, 0xae, 0x86, 0x16,
+	0x7f, 0xf4, 0xcd, 0xd3, 0x8b, 0xb6, 0xf7, 0xec, 0xa2, 0xed, 0xfd, 0x77, 0xd1, 0xf6, 0x7e, 0xbf,
+	0x6c, 0xaf, 0x3c, 0xbb, 0x6c, 0xaf, 0xfc, 0x7d, 0xd9, 0x5e, 0xf9, 0xfe, 0xe3, 0xe5, 0x5b, 0x89,
+	0x98, 0xf7, 0x46, 0x32, 0x9c, 0x3c, 0x0e, 0xc7, 0x32, 0x39, 0xcf, 0x00, 0xcd, 0xe0, 0xc6, 0xf0,
+	0xf0, 0x71, 0xcf, 0xcc, 0x6c, 0x7b, 0xd1, 0xb8, 0x61, 0x47, 0xf1, 0x87, 0xff, 0x0f, 0x00, 0x3b,
+	0xe9, 0x6e, 0x29, 0x71, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -416,6 +650,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RelayerRateLimits) > 0 {
+		for iNdEx := len(m.RelayerRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ClientIncentivePools) > 0 {
+		for iNdEx := len(m.ClientIncentivePools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientIncentivePools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.FeeHistories) > 0 {
+		for iNdEx := len(m.FeeHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ForwardRelayers) > 0 {
 		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -654,57 +954,207 @@ func (m *ForwardRelayerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RelayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RelayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IdentifiedFees) > 0 {
-		for _, e := range m.IdentifiedFees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeesEarned) > 0 {
+		for iNdEx := len(m.FeesEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.FeeEnabledChannels) > 0 {
-		for _, e := range m.FeeEnabledChannels {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TimeoutPackets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutPackets))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.RegisteredPayees) > 0 {
-		for _, e := range m.RegisteredPayees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.AckPackets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AckPackets))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.RegisteredCounterpartyPayees) > 0 {
-		for _, e := range m.RegisteredCounterpartyPayees {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.RecvPackets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecvPackets))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.ForwardRelayers) > 0 {
-		for _, e := range m.ForwardRelayers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FeeEnabledChannel) Size() (n int) {
+func (m *ChannelFeeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFeeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFeeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IdentifiedFees) > 0 {
+		for _, e := range m.IdentifiedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeEnabledChannels) > 0 {
+		for _, e := range m.FeeEnabledChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredPayees) > 0 {
+		for _, e := range m.RegisteredPayees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredCounterpartyPayees) > 0 {
+		for _, e := range m.RegisteredCounterpartyPayees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForwardRelayers) > 0 {
+		for _, e := range m.ForwardRelayers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeHistories) > 0 {
+		for _, e := range m.FeeHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ClientIncentivePools) > 0 {
+		for _, e := range m.ClientIncentivePools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerRateLimits) > 0 {
+		for _, e := range m.RelayerRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEnabledChannel) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -778,6 +1228,65 @@ func (m *ForwardRelayerAddress) Size() (n int) {
 	return n
 }
 
+func (m *RelayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RecvPackets != 0 {
+		n += 1 + sovGenesis(uint64(m.RecvPackets))
+	}
+	if m.AckPackets != 0 {
+		n += 1 + sovGenesis(uint64(m.AckPackets))
+	}
+	if m.TimeoutPackets != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutPackets))
+	}
+	if len(m.FeesEarned) > 0 {
+		for _, e := range m.FeesEarned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelFeeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -937,23 +1446,454 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredCounterpartyPayees = append(m.RegisteredCounterpartyPayees, RegisteredCounterpartyPayee{})
+			if err := m.RegisteredCounterpartyPayees[len(m.RegisteredCounterpartyPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayers = append(m.ForwardRelayers, ForwardRelayerAddress{})
+			if err := m.ForwardRelayers[len(m.ForwardRelayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeHistories = append(m.FeeHistories, ChannelFeeHistory{})
+			if err := m.FeeHistories[len(m.FeeHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIncentivePools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIncentivePools = append(m.ClientIncentivePools, ClientIncentivePool{})
+			if err := m.ClientIncentivePools[len(m.ClientIncentivePools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerRateLimits = append(m.RelayerRateLimits, RelayerRateLimit{})
+			if err := m.RelayerRateLimits[len(m.RelayerRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisteredCounterpartyPayees = append(m.RegisteredCounterpartyPayees, RegisteredCounterpartyPayee{})
-			if err := m.RegisteredCounterpartyPayees[len(m.RegisteredCounterpartyPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -963,25 +1903,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardRelayers = append(m.ForwardRelayers, ForwardRelayerAddress{})
-			if err := m.ForwardRelayers[len(m.ForwardRelayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1004,7 +1942,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,15 +1965,15 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredCounterpartyPayee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1063,11 +2001,11 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1095,7 +2033,39 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1118,7 +2088,7 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1141,15 +2111,15 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardRelayerAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardRelayerAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1177,13 +2147,13 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1193,55 +2163,24 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1264,7 +2203,7 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *RelayerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,15 +2226,15 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1323,11 +2262,11 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1355,11 +2294,11 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1387,7 +2326,98 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPackets", wireType)
+			}
+			m.RecvPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPackets", wireType)
+			}
+			m.AckPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPackets", wireType)
+			}
+			m.TimeoutPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesEarned = append(m.FeesEarned, types1.Coin{})
+			if err := m.FeesEarned[len(m.FeesEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1410,7 +2440,7 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
+func (m *ChannelFeeHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1433,15 +2463,15 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardRelayerAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelFeeHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardRelayerAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelFeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1469,11 +2499,43 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1500,7 +2562,8 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, Fee{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
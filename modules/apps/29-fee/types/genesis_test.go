@@ -130,6 +130,79 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid relayer stats: invalid channel ID",
+			func() {
+				genState.RelayerStats[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid relayer stats: invalid payee address",
+			func() {
+				genState.RelayerStats[0].Payee = ""
+			},
+			false,
+		},
+		{
+			"invalid relayer stats: invalid fees earned",
+			func() {
+				genState.RelayerStats[0].FeesEarned = invalidFee
+			},
+			false,
+		},
+		{
+			"invalid fee history: invalid port ID",
+			func() {
+				genState.FeeHistories[0].PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid fee history: invalid fee",
+			func() {
+				genState.FeeHistories[0].Fees[0] = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			},
+			false,
+		},
+		{
+			"invalid fee history: exceeds maximum length",
+			func() {
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				for len(genState.FeeHistories[0].Fees) <= types.MaxFeeHistory {
+					genState.FeeHistories[0].Fees = append(genState.FeeHistories[0].Fees, fee)
+				}
+			},
+			false,
+		},
+		{
+			"invalid params: relayer rate limit without window",
+			func() {
+				genState.Params.RelayerRateLimitWindow = 0
+			},
+			false,
+		},
+		{
+			"invalid client incentive pool: invalid client ID",
+			func() {
+				genState.ClientIncentivePools[0].ClientId = ""
+			},
+			false,
+		},
+		{
+			"invalid client incentive pool: invalid balance",
+			func() {
+				genState.ClientIncentivePools[0].Balance = invalidFee
+			},
+			false,
+		},
+		{
+			"invalid relayer rate limit: invalid relayer",
+			func() {
+				genState.RelayerRateLimits[0].Relayer = ""
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -168,6 +241,19 @@ func TestValidateGenesis(t *testing.T) {
 					ChannelId: ibctesting.FirstChannelID,
 				},
 			},
+			RelayerStats: []types.RelayerStats{
+				types.NewRelayerStats(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress),
+			},
+			FeeHistories: []types.ChannelFeeHistory{
+				types.NewChannelFeeHistory(ibctesting.MockFeePort, ibctesting.FirstChannelID, []types.Fee{types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)}),
+			},
+			Params: types.DefaultParams(),
+			ClientIncentivePools: []types.ClientIncentivePool{
+				types.NewClientIncentivePool(ibctesting.FirstClientID, defaultRecvFee, defaultAckFee),
+			},
+			RelayerRateLimits: []types.RelayerRateLimit{
+				types.NewRelayerRateLimit(defaultAccAddress, 1),
+			},
 		}
 
 		tc.malleate()
@@ -0,0 +1,80 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// Incentive reward types used in the client incentive reward events
+const (
+	RewardTypeClientUpdate     = "client_update"
+	RewardTypeChannelHandshake = "channel_handshake"
+)
+
+// NewClientIncentivePool creates and returns a new ClientIncentivePool for the given client with the provided rewards
+func NewClientIncentivePool(clientID string, updateReward, handshakeReward sdk.Coins) ClientIncentivePool {
+	return ClientIncentivePool{
+		ClientId:        clientID,
+		UpdateReward:    updateReward,
+		HandshakeReward: handshakeReward,
+	}
+}
+
+// Validate performs basic stateless validation of the associated ClientIncentivePool
+func (p ClientIncentivePool) Validate() error {
+	if err := host.ClientIdentifierValidator(p.ClientId); err != nil {
+		return errorsmod.Wrap(err, "invalid client ID")
+	}
+
+	if !p.UpdateReward.IsValid() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid update reward: %s", p.UpdateReward)
+	}
+
+	if !p.HandshakeReward.IsValid() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid handshake reward: %s", p.HandshakeReward)
+	}
+
+	if !p.Balance.IsValid() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid balance: %s", p.Balance)
+	}
+
+	return nil
+}
+
+// Reward returns the reward paid from the pool balance, which is capped at the remaining balance of the pool
+func (p ClientIncentivePool) Reward(reward sdk.Coins) sdk.Coins {
+	var paid sdk.Coins
+	for _, coin := range reward {
+		amount := coin.Amount
+		if balance := p.Balance.AmountOf(coin.Denom); balance.LT(amount) {
+			amount = balance
+		}
+
+		if amount.IsPositive() {
+			paid = paid.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return paid
+}
+
+// NewRelayerRateLimit creates and returns a new RelayerRateLimit for the relayer with a window starting at the given time
+func NewRelayerRateLimit(relayer string, windowStart uint64) RelayerRateLimit {
+	return RelayerRateLimit{
+		Relayer:     relayer,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs basic stateless validation of the associated RelayerRateLimit
+func (rl RelayerRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(rl.Relayer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
+	}
+
+	return nil
+}
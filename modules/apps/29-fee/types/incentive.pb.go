@@ -0,0 +1,1158 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/fee/v1/incentive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of ICS29 fee middleware parameters governing the incentives for client updates and
+// channel handshakes
+type Params struct {
+	// the minimum number of blocks by which a client update must advance the latest height of a client to be rewarded
+	ClientUpdateHeightInterval uint64 `protobuf:"varint,1,opt,name=client_update_height_interval,json=clientUpdateHeightInterval,proto3" json:"client_update_height_interval,omitempty"`
+	// the period in nanoseconds since the latest consensus state of a client after which a client update is rewarded
+	// regardless of the height advanced, as it prevents an idle client from expiring. Disabled if zero
+	ClientUpdateExpiryPeriod uint64 `protobuf:"varint,2,opt,name=client_update_expiry_period,json=clientUpdateExpiryPeriod,proto3" json:"client_update_expiry_period,omitempty"`
+	// the minimum time in nanoseconds between two rewarded client updates or channel handshakes over the same client
+	MinRewardInterval uint64 `protobuf:"varint,3,opt,name=min_reward_interval,json=minRewardInterval,proto3" json:"min_reward_interval,omitempty"`
+	// the maximum number of rewarded client updates and channel handshakes per relayer within a rate limit window.
+	// Unlimited if zero
+	RelayerRateLimit uint64 `protobuf:"varint,4,opt,name=relayer_rate_limit,json=relayerRateLimit,proto3" json:"relayer_rate_limit,omitempty"`
+	// the duration in nanoseconds of the relayer rate limit window
+	RelayerRateLimitWindow uint64 `protobuf:"varint,5,opt,name=relayer_rate_limit_window,json=relayerRateLimitWindow,proto3" json:"relayer_rate_limit_window,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce69c163c3c6d45f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetClientUpdateHeightInterval() uint64 {
+	if m != nil {
+		return m.ClientUpdateHeightInterval
+	}
+	return 0
+}
+
+func (m *Params) GetClientUpdateExpiryPeriod() uint64 {
+	if m != nil {
+		return m.ClientUpdateExpiryPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMinRewardInterval() uint64 {
+	if m != nil {
+		return m.MinRewardInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRelayerRateLimit() uint64 {
+	if m != nil {
+		return m.RelayerRateLimit
+	}
+	return 0
+}
+
+func (m *Params) GetRelayerRateLimitWindow() uint64 {
+	if m != nil {
+		return m.RelayerRateLimitWindow
+	}
+	return 0
+}
+
+// ClientIncentivePool defines the funds escrowed to incentivize the client updates and channel handshakes of a client
+type ClientIncentivePool struct {
+	// the client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the reward paid to the relayer of each rewarded client update
+	UpdateReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=update_reward,json=updateReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"update_reward"`
+	// the reward paid to the relayer completing each channel handshake over the client
+	HandshakeReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=handshake_reward,json=handshakeReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"handshake_reward"`
+	// the funds remaining in the pool
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// the block time in nanoseconds of the last rewarded client update
+	LastUpdateRewardTime uint64 `protobuf:"varint,5,opt,name=last_update_reward_time,json=lastUpdateRewardTime,proto3" json:"last_update_reward_time,omitempty"`
+	// the block time in nanoseconds of the last rewarded channel handshake
+	LastHandshakeRewardTime uint64 `protobuf:"varint,6,opt,name=last_handshake_reward_time,json=lastHandshakeRewardTime,proto3" json:"last_handshake_reward_time,omitempty"`
+}
+
+func (m *ClientIncentivePool) Reset()         { *m = ClientIncentivePool{} }
+func (m *ClientIncentivePool) String() string { return proto.CompactTextString(m) }
+func (*ClientIncentivePool) ProtoMessage()    {}
+func (*ClientIncentivePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce69c163c3c6d45f, []int{1}
+}
+func (m *ClientIncentivePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientIncentivePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientIncentivePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientIncentivePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientIncentivePool.Merge(m, src)
+}
+func (m *ClientIncentivePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientIncentivePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientIncentivePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientIncentivePool proto.InternalMessageInfo
+
+func (m *ClientIncentivePool) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientIncentivePool) GetUpdateReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UpdateReward
+	}
+	return nil
+}
+
+func (m *ClientIncentivePool) GetHandshakeReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HandshakeReward
+	}
+	return nil
+}
+
+func (m *ClientIncentivePool) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *ClientIncentivePool) GetLastUpdateRewardTime() uint64 {
+	if m != nil {
+		return m.LastUpdateRewardTime
+	}
+	return 0
+}
+
+func (m *ClientIncentivePool) GetLastHandshakeRewardTime() uint64 {
+	if m != nil {
+		return m.LastHandshakeRewardTime
+	}
+	return 0
+}
+
+// RelayerRateLimit tracks the number of rewards paid to a relayer within the current rate limit window
+type RelayerRateLimit struct {
+	// the relayer address
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// the block time in nanoseconds at which the current window started
+	WindowStart uint64 `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// the number of rewards paid to the relayer within the current window
+	Rewards uint64 `protobuf:"varint,3,opt,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (m *RelayerRateLimit) Reset()         { *m = RelayerRateLimit{} }
+func (m *RelayerRateLimit) String() string { return proto.CompactTextString(m) }
+func (*RelayerRateLimit) ProtoMessage()    {}
+func (*RelayerRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce69c163c3c6d45f, []int{2}
+}
+func (m *RelayerRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerRateLimit.Merge(m, src)
+}
+func (m *RelayerRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerRateLimit proto.InternalMessageInfo
+
+func (m *RelayerRateLimit) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerRateLimit) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *RelayerRateLimit) GetRewards() uint64 {
+	if m != nil {
+		return m.Rewards
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibc.applications.fee.v1.Params")
+	proto.RegisterType((*ClientIncentivePool)(nil), "ibc.applications.fee.v1.ClientIncentivePool")
+	proto.RegisterType((*RelayerRateLimit)(nil), "ibc.applications.fee.v1.RelayerRateLimit")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/fee/v1/incentive.proto", fileDescriptor_ce69c163c3c6d45f)
+}
+
+var fileDescriptor_ce69c163c3c6d45f = []byte{
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x4f, 0x14, 0x41,
+	0x14, 0xbe, 0x85, 0x13, 0x64, 0xc0, 0x08, 0x03, 0x91, 0xe5, 0x88, 0x0b, 0xd2, 0x48, 0x8c, 0xec,
+	0x04, 0x0c, 0x05, 0x31, 0x16, 0x40, 0x4c, 0x20, 0x31, 0x91, 0xac, 0x12, 0x13, 0x9b, 0xcd, 0xec,
+	0xee, 0xb0, 0xf7, 0xc2, 0xee, 0xcc, 0x66, 0x67, 0x58, 0xbc, 0xd2, 0xca, 0xd6, 0xda, 0xd6, 0xce,
+	0x58, 0xf8, 0x67, 0x50, 0x52, 0x5a, 0xa9, 0x81, 0xc2, 0x7f, 0xc3, 0xcc, 0x8f, 0x3b, 0xe1, 0xe8,
+	0x69, 0x76, 0x67, 0xde, 0xfb, 0xbe, 0xf7, 0xde, 0xbc, 0xef, 0xcd, 0xa0, 0xc7, 0x90, 0xa4, 0x84,
+	0x56, 0x55, 0x01, 0x29, 0x55, 0x20, 0xb8, 0x24, 0x47, 0x8c, 0x91, 0x66, 0x9d, 0x00, 0x4f, 0x19,
+	0x57, 0xd0, 0xb0, 0xb0, 0xaa, 0x85, 0x12, 0x78, 0x1e, 0x92, 0x34, 0xbc, 0x0a, 0x0c, 0x8f, 0x18,
+	0x0b, 0x9b, 0xf5, 0xce, 0x0c, 0x2d, 0x81, 0x0b, 0x62, 0xbe, 0x16, 0xdb, 0x09, 0x52, 0x21, 0x4b,
+	0x21, 0x49, 0x42, 0xa5, 0x8e, 0x95, 0x30, 0x45, 0xd7, 0x49, 0x2a, 0x80, 0x3b, 0xff, 0x5c, 0x2e,
+	0x72, 0x61, 0x96, 0x44, 0xaf, 0xac, 0x75, 0xe5, 0xeb, 0x08, 0x1a, 0x3b, 0xa0, 0x35, 0x2d, 0x25,
+	0xde, 0x46, 0x0f, 0xd3, 0x02, 0x18, 0x57, 0xf1, 0x49, 0x95, 0x51, 0xc5, 0xe2, 0x2e, 0x83, 0xbc,
+	0xab, 0x62, 0xe0, 0x8a, 0xd5, 0x0d, 0x2d, 0x7c, 0x6f, 0xd9, 0x5b, 0x6d, 0x47, 0x1d, 0x0b, 0x3a,
+	0x34, 0x98, 0x3d, 0x03, 0xd9, 0x77, 0x08, 0xfc, 0x02, 0x2d, 0x5e, 0x0f, 0xc1, 0x3e, 0x54, 0x50,
+	0xf7, 0xe2, 0x8a, 0xd5, 0x20, 0x32, 0x7f, 0xc4, 0x04, 0xf0, 0xaf, 0x06, 0x78, 0x69, 0x00, 0x07,
+	0xc6, 0x8f, 0x43, 0x34, 0x5b, 0x02, 0x8f, 0x6b, 0x76, 0x4a, 0xeb, 0xec, 0x7f, 0xde, 0x51, 0x43,
+	0x9b, 0x29, 0x81, 0x47, 0xc6, 0x33, 0x48, 0xf7, 0x14, 0xe1, 0x9a, 0x15, 0xb4, 0xc7, 0xea, 0xb8,
+	0xd6, 0xd9, 0x0a, 0x28, 0x41, 0xf9, 0x6d, 0x03, 0x9f, 0x76, 0x9e, 0x88, 0x2a, 0xf6, 0x4a, 0xdb,
+	0xf1, 0x16, 0x5a, 0xb8, 0x89, 0x8e, 0x4f, 0x81, 0x67, 0xe2, 0xd4, 0xbf, 0x63, 0x48, 0x0f, 0x86,
+	0x49, 0xef, 0x8c, 0x77, 0xe5, 0x7b, 0x1b, 0xcd, 0xee, 0x9a, 0xaa, 0xf7, 0xfb, 0x0a, 0x1d, 0x08,
+	0x51, 0xe0, 0x45, 0x34, 0xe1, 0xce, 0x0b, 0x99, 0x69, 0xcf, 0x44, 0x74, 0xd7, 0x1a, 0xf6, 0x33,
+	0xfc, 0xd1, 0x43, 0xf7, 0x5c, 0x1b, 0xec, 0x89, 0xfc, 0x91, 0xe5, 0xd1, 0xd5, 0xc9, 0x8d, 0x85,
+	0xd0, 0x2a, 0x15, 0x6a, 0xa5, 0x42, 0xa7, 0x54, 0xb8, 0x2b, 0x80, 0xef, 0x6c, 0x9f, 0xfd, 0x5a,
+	0x6a, 0x7d, 0xfb, 0xbd, 0xb4, 0x9a, 0x83, 0xea, 0x9e, 0x24, 0x61, 0x2a, 0x4a, 0xe2, 0x64, 0xb5,
+	0xbf, 0x35, 0x99, 0x1d, 0x13, 0xd5, 0xab, 0x98, 0x34, 0x04, 0xf9, 0xe5, 0xef, 0x8f, 0x27, 0x53,
+	0x05, 0xcb, 0x69, 0xda, 0x8b, 0xb5, 0xd6, 0x32, 0x9a, 0xb2, 0x29, 0x6d, 0xa7, 0xf0, 0x27, 0x0f,
+	0x4d, 0x77, 0x29, 0xcf, 0x64, 0x97, 0x1e, 0x0f, 0xca, 0x18, 0xbd, 0x85, 0x32, 0xee, 0x0f, 0xb2,
+	0xba, 0x4a, 0x1a, 0x34, 0x9e, 0xd0, 0x82, 0xf2, 0x94, 0xf9, 0xed, 0x5b, 0xc8, 0xdf, 0x4f, 0x86,
+	0x37, 0xd1, 0x7c, 0x41, 0xe5, 0x60, 0x20, 0xdd, 0x6c, 0x29, 0x28, 0x99, 0xd3, 0x7c, 0x4e, 0xbb,
+	0x0f, 0xaf, 0x34, 0xed, 0x2d, 0x94, 0x0c, 0x3f, 0x47, 0x1d, 0x43, 0x1b, 0x6e, 0x9e, 0x65, 0x8e,
+	0x19, 0xa6, 0x09, 0xbc, 0x77, 0xfd, 0x9c, 0x9a, 0xbc, 0x02, 0x68, 0x3a, 0x1a, 0x9e, 0x3e, 0x1f,
+	0x8d, 0xbb, 0xe1, 0x72, 0x83, 0xd2, 0xdf, 0xe2, 0x47, 0x68, 0xca, 0x0e, 0x61, 0x2c, 0x15, 0xad,
+	0x95, 0xbb, 0x25, 0x93, 0xd6, 0xf6, 0x46, 0x9b, 0x2c, 0x59, 0x87, 0x97, 0xee, 0x32, 0xf4, 0xb7,
+	0x3b, 0xaf, 0xcf, 0x2e, 0x02, 0xef, 0xfc, 0x22, 0xf0, 0xfe, 0x5c, 0x04, 0xde, 0xe7, 0xcb, 0xa0,
+	0x75, 0x7e, 0x19, 0xb4, 0x7e, 0x5e, 0x06, 0xad, 0xf7, 0x9b, 0x37, 0x9b, 0x07, 0x49, 0xba, 0x96,
+	0x0b, 0xd2, 0x6c, 0x91, 0x52, 0x64, 0x27, 0x05, 0x93, 0xfa, 0x11, 0x92, 0x64, 0x63, 0x6b, 0x4d,
+	0xbf, 0x3f, 0xa6, 0x9f, 0xc9, 0x98, 0x79, 0x17, 0x9e, 0xfd, 0x1b, 0x00, 0xe1, 0xca, 0x64, 0x32,
+	0xa4, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RelayerRateLimitWindow != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.RelayerRateLimitWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RelayerRateLimit != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.RelayerRateLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinRewardInterval != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.MinRewardInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ClientUpdateExpiryPeriod != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.ClientUpdateExpiryPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClientUpdateHeightInterval != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.ClientUpdateHeightInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientIncentivePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientIncentivePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientIncentivePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHandshakeRewardTime != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.LastHandshakeRewardTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastUpdateRewardTime != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.LastUpdateRewardTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.HandshakeReward) > 0 {
+		for iNdEx := len(m.HandshakeReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandshakeReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UpdateReward) > 0 {
+		for iNdEx := len(m.UpdateReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rewards != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Rewards))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientUpdateHeightInterval != 0 {
+		n += 1 + sovIncentive(uint64(m.ClientUpdateHeightInterval))
+	}
+	if m.ClientUpdateExpiryPeriod != 0 {
+		n += 1 + sovIncentive(uint64(m.ClientUpdateExpiryPeriod))
+	}
+	if m.MinRewardInterval != 0 {
+		n += 1 + sovIncentive(uint64(m.MinRewardInterval))
+	}
+	if m.RelayerRateLimit != 0 {
+		n += 1 + sovIncentive(uint64(m.RelayerRateLimit))
+	}
+	if m.RelayerRateLimitWindow != 0 {
+		n += 1 + sovIncentive(uint64(m.RelayerRateLimitWindow))
+	}
+	return n
+}
+
+func (m *ClientIncentivePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if len(m.UpdateReward) > 0 {
+		for _, e := range m.UpdateReward {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.HandshakeReward) > 0 {
+		for _, e := range m.HandshakeReward {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if m.LastUpdateRewardTime != 0 {
+		n += 1 + sovIncentive(uint64(m.LastUpdateRewardTime))
+	}
+	if m.LastHandshakeRewardTime != 0 {
+		n += 1 + sovIncentive(uint64(m.LastHandshakeRewardTime))
+	}
+	return n
+}
+
+func (m *RelayerRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovIncentive(uint64(m.WindowStart))
+	}
+	if m.Rewards != 0 {
+		n += 1 + sovIncentive(uint64(m.Rewards))
+	}
+	return n
+}
+
+func sovIncentive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentive(x uint64) (n int) {
+	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpdateHeightInterval", wireType)
+			}
+			m.ClientUpdateHeightInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientUpdateHeightInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpdateExpiryPeriod", wireType)
+			}
+			m.ClientUpdateExpiryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientUpdateExpiryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardInterval", wireType)
+			}
+			m.MinRewardInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRewardInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerRateLimit", wireType)
+			}
+			m.RelayerRateLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerRateLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerRateLimitWindow", wireType)
+			}
+			m.RelayerRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientIncentivePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientIncentivePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientIncentivePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateReward = append(m.UpdateReward, types.Coin{})
+			if err := m.UpdateReward[len(m.UpdateReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandshakeReward = append(m.HandshakeReward, types.Coin{})
+			if err := m.HandshakeReward[len(m.HandshakeReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateRewardTime", wireType)
+			}
+			m.LastUpdateRewardTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateRewardTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandshakeRewardTime", wireType)
+			}
+			m.LastHandshakeRewardTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHandshakeRewardTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			m.Rewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rewards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIncentive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIncentive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIncentive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIncentive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIncentive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIncentive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIncentive = fmt.Errorf("proto: unexpected end of group")
+)
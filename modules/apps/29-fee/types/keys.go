@@ -40,6 +40,27 @@ const (
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// RelayerStatsPrefix is the key prefix for relayer statistics stored in state
+	RelayerStatsPrefix = "relayerStats"
+
+	// FeeHistoryPrefix is the key prefix for the fees of the most recent packets settled on a channel
+	FeeHistoryPrefix = "feeHistory"
+
+	// FeeHistoryCountPrefix is the key prefix for the number of packets recorded in the fee history of a channel
+	FeeHistoryCountPrefix = "feeHistoryCount"
+
+	// MaxFeeHistory is the maximum number of packets kept in the fee history of a channel
+	MaxFeeHistory = 100
+
+	// ParamsKey is the store key for the 29-fee module parameters
+	ParamsKey = "params"
+
+	// ClientIncentivePoolPrefix is the key prefix for the client incentive pools stored in state
+	ClientIncentivePoolPrefix = "clientIncentivePool"
+
+	// RelayerRateLimitPrefix is the key prefix for the relayer rate limits stored in state
+	RelayerRateLimitPrefix = "relayerRateLimit"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -158,3 +179,50 @@ func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
 func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", FeesInEscrowPrefix, portID, channelID))
 }
+
+// KeyRelayerStats returns the key for the statistics of a payee address on the given channel
+func KeyRelayerStats(portID, channelID, payee string) []byte {
+	return []byte(fmt.Sprintf("%s%s", KeyRelayerStatsChannelPrefix(portID, channelID), payee))
+}
+
+// KeyRelayerStatsChannelPrefix returns the key prefix for the relayer statistics on the given channel
+func KeyRelayerStatsChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", RelayerStatsPrefix, portID, channelID))
+}
+
+// KeyFeeHistory returns the key for the fee stored at the given index of the fee history of a channel
+func KeyFeeHistory(portID, channelID string, index uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", FeeHistoryPrefix, portID, channelID, index))
+}
+
+// KeyFeeHistoryCount returns the key for the number of packets recorded in the fee history of a channel
+func KeyFeeHistoryCount(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", FeeHistoryCountPrefix, portID, channelID))
+}
+
+// ParseKeyFeeHistoryCount parses the key used to store the number of packets recorded in the fee history
+// of a channel and returns the port and channel identifiers
+func ParseKeyFeeHistoryCount(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != FeeHistoryCountPrefix {
+		return "", "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", FeeHistoryCountPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyClientIncentivePool returns the key for the incentive pool of a client
+func KeyClientIncentivePool(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ClientIncentivePoolPrefix, clientID))
+}
+
+// KeyRelayerRateLimit returns the key for the rate limit of a relayer
+func KeyRelayerRateLimit(relayer string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", RelayerRateLimitPrefix, relayer))
+}
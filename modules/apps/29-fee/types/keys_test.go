@@ -214,3 +214,48 @@ func TestParseKeyCounterpartyPayee(t *testing.T) {
 		}
 	}
 }
+
+func TestKeyRelayerStats(t *testing.T) {
+	key := types.KeyRelayerStats(ibctesting.MockFeePort, ibctesting.FirstChannelID, "payee-address")
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%s", types.RelayerStatsPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID, "payee-address"))
+}
+
+func TestParseKeyFeeHistoryCount(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyFeeHistoryCount(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeeHistory(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			string(types.KeyFeeEnabled(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		portID, channelID, err := types.ParseKeyFeeHistoryCount(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.MockFeePort, portID)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err)
+			require.Empty(t, portID)
+			require.Empty(t, channelID)
+		}
+	}
+}
@@ -19,11 +19,17 @@ var (
 	_ sdk.Msg = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.Msg = (*MsgCancelPacketFee)(nil)
+	_ sdk.Msg = (*MsgFundClientIncentivePool)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelPacketFee)(nil)
+	_ sdk.HasValidateBasic = (*MsgFundClientIncentivePool)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -147,3 +153,77 @@ func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
 
 	return msg.PacketFee.Validate()
 }
+
+// NewMsgCancelPacketFee creates a new instance of MsgCancelPacketFee
+func NewMsgCancelPacketFee(packetID channeltypes.PacketId, signer string) *MsgCancelPacketFee {
+	return &MsgCancelPacketFee{
+		PacketId: packetID,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgCancelPacketFee fields
+func (msg MsgCancelPacketFee) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// NewMsgFundClientIncentivePool creates a new instance of MsgFundClientIncentivePool
+func NewMsgFundClientIncentivePool(clientID string, amount, updateReward, handshakeReward sdk.Coins, depositor string) *MsgFundClientIncentivePool {
+	return &MsgFundClientIncentivePool{
+		ClientId:        clientID,
+		Amount:          amount,
+		UpdateReward:    updateReward,
+		HandshakeReward: handshakeReward,
+		Depositor:       depositor,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgFundClientIncentivePool fields
+func (msg MsgFundClientIncentivePool) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid amount: %s", msg.Amount)
+	}
+
+	if !msg.UpdateReward.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidIncentiveReward, "invalid update reward: %s", msg.UpdateReward)
+	}
+
+	if !msg.HandshakeReward.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidIncentiveReward, "invalid handshake reward: %s", msg.HandshakeReward)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Depositor into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateParams fields
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Params.Validate()
+}
@@ -400,3 +400,203 @@ func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, refundAddr.Bytes(), signers[0])
 }
+
+func TestMsgCancelPacketFeeValidation(t *testing.T) {
+	var msg *types.MsgCancelPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.PacketId.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PacketId.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				msg.PacketId.Sequence = 0
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+		msg = types.NewMsgCancelPacketFee(packetID, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestCancelPacketFeeGetSigners(t *testing.T) {
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	msg := types.NewMsgCancelPacketFee(packetID, signer.String())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, signer.Bytes(), signers[0])
+}
+
+func TestMsgFundClientIncentivePoolValidation(t *testing.T) {
+	var msg *types.MsgFundClientIncentivePool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with empty rewards",
+			func() {
+				msg.UpdateReward = nil
+				msg.HandshakeReward = nil
+			},
+			true,
+		},
+		{
+			"invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			false,
+		},
+		{
+			"empty amount",
+			func() {
+				msg.Amount = sdk.Coins{}
+			},
+			false,
+		},
+		{
+			"invalid amount",
+			func() {
+				msg.Amount = invalidFee
+			},
+			false,
+		},
+		{
+			"invalid update reward",
+			func() {
+				msg.UpdateReward = invalidFee
+			},
+			false,
+		},
+		{
+			"invalid handshake reward",
+			func() {
+				msg.HandshakeReward = invalidFee
+			},
+			false,
+		},
+		{
+			"invalid depositor address",
+			func() {
+				msg.Depositor = invalidAddress
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgFundClientIncentivePool(ibctesting.FirstClientID, defaultTimeoutFee, defaultRecvFee, defaultAckFee, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestFundClientIncentivePoolGetSigners(t *testing.T) {
+	depositor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgFundClientIncentivePool(ibctesting.FirstClientID, defaultTimeoutFee, defaultRecvFee, defaultAckFee, depositor.String())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, depositor.Bytes(), signers[0])
+}
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"success: valid signer address",
+			types.NewMsgUpdateParams(defaultAccAddress, types.DefaultParams()),
+			true,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()),
+			false,
+		},
+		{
+			"failure: relayer rate limit without window",
+			types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(1, 0, 0, 1, 0)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
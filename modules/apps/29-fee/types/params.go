@@ -0,0 +1,45 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultClientUpdateHeightInterval is the default minimum number of blocks a client update must advance a client by to be rewarded
+	DefaultClientUpdateHeightInterval = 100
+	// DefaultClientUpdateExpiryPeriod is the default period since the latest consensus state after which a client update is rewarded
+	DefaultClientUpdateExpiryPeriod = uint64(24 * time.Hour)
+	// DefaultMinRewardInterval is the default minimum time between two rewards paid from the same client incentive pool
+	DefaultMinRewardInterval = uint64(10 * time.Minute)
+	// DefaultRelayerRateLimit is the default maximum number of rewards paid to a relayer within a rate limit window
+	DefaultRelayerRateLimit = 100
+	// DefaultRelayerRateLimitWindow is the default duration of the relayer rate limit window
+	DefaultRelayerRateLimitWindow = uint64(24 * time.Hour)
+)
+
+// NewParams creates a new parameter configuration for the 29-fee module
+func NewParams(clientUpdateHeightInterval, clientUpdateExpiryPeriod, minRewardInterval, relayerRateLimit, relayerRateLimitWindow uint64) Params {
+	return Params{
+		ClientUpdateHeightInterval: clientUpdateHeightInterval,
+		ClientUpdateExpiryPeriod:   clientUpdateExpiryPeriod,
+		MinRewardInterval:          minRewardInterval,
+		RelayerRateLimit:           relayerRateLimit,
+		RelayerRateLimitWindow:     relayerRateLimitWindow,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the 29-fee module
+func DefaultParams() Params {
+	return NewParams(DefaultClientUpdateHeightInterval, DefaultClientUpdateExpiryPeriod, DefaultMinRewardInterval, DefaultRelayerRateLimit, DefaultRelayerRateLimitWindow)
+}
+
+// Validate performs basic validation of the 29-fee module parameters
+func (p Params) Validate() error {
+	if p.RelayerRateLimit != 0 && p.RelayerRateLimitWindow == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "relayer rate limit window must be non-zero if the relayer rate limit is set")
+	}
+
+	return nil
+}
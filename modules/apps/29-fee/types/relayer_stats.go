@@ -0,0 +1,73 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewRelayerStats creates and returns a new RelayerStats struct for the payee address on the given channel
+func NewRelayerStats(portID, channelID, payee string) RelayerStats {
+	return RelayerStats{
+		PortId:    portID,
+		ChannelId: channelID,
+		Payee:     payee,
+	}
+}
+
+// Validate performs basic stateless validation of the associated RelayerStats
+func (rs RelayerStats) Validate() error {
+	if err := host.PortIdentifierValidator(rs.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(rs.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(rs.Payee); err != nil {
+		return errorsmod.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+	}
+
+	if !rs.FeesEarned.IsValid() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid fees earned: %s", rs.FeesEarned)
+	}
+
+	return nil
+}
+
+// NewChannelFeeHistory creates and returns a new ChannelFeeHistory struct containing the fees of the most
+// recent packets settled on the given channel
+func NewChannelFeeHistory(portID, channelID string, fees []Fee) ChannelFeeHistory {
+	return ChannelFeeHistory{
+		PortId:    portID,
+		ChannelId: channelID,
+		Fees:      fees,
+	}
+}
+
+// Validate performs basic stateless validation of the associated ChannelFeeHistory
+func (h ChannelFeeHistory) Validate() error {
+	if err := host.PortIdentifierValidator(h.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(h.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+
+	if len(h.Fees) > MaxFeeHistory {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "fee history length %d exceeds maximum %d", len(h.Fees), MaxFeeHistory)
+	}
+
+	for _, fee := range h.Fees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
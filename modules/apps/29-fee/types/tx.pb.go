@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -209,6 +211,9 @@ type MsgPayPacketFee struct {
 	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 	// optional list of relayers permitted to the receive packet fees
 	Relayers []string `protobuf:"bytes,5,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// optional block time in unix nanoseconds after which the fee may be cancelled by the signer before the packet is
+	// acknowledged or timed out
+	ExpiryTimestamp uint64 `protobuf:"varint,6,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
 }
 
 func (m *MsgPayPacketFee) Reset()         { *m = MsgPayPacketFee{} }
@@ -360,6 +365,258 @@ func (m *MsgPayPacketFeeAsyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayPacketFeeAsyncResponse proto.InternalMessageInfo
 
+// MsgCancelPacketFee defines the request type for the CancelPacketFee rpc
+type MsgCancelPacketFee struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the refund address of the packet fees to be cancelled
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgCancelPacketFee) Reset()         { *m = MsgCancelPacketFee{} }
+func (m *MsgCancelPacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPacketFee) ProtoMessage()    {}
+func (*MsgCancelPacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{8}
+}
+func (m *MsgCancelPacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPacketFee.Merge(m, src)
+}
+func (m *MsgCancelPacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPacketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPacketFee proto.InternalMessageInfo
+
+// MsgCancelPacketFeeResponse defines the response type for the CancelPacketFee rpc
+type MsgCancelPacketFeeResponse struct {
+	// the fees refunded to the signer
+	RefundedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded_fees,json=refundedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_fees"`
+}
+
+func (m *MsgCancelPacketFeeResponse) Reset()         { *m = MsgCancelPacketFeeResponse{} }
+func (m *MsgCancelPacketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPacketFeeResponse) ProtoMessage()    {}
+func (*MsgCancelPacketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{9}
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPacketFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPacketFeeResponse.Merge(m, src)
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPacketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPacketFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPacketFeeResponse proto.InternalMessageInfo
+
+func (m *MsgCancelPacketFeeResponse) GetRefundedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedFees
+	}
+	return nil
+}
+
+// MsgFundClientIncentivePool defines the request type for the FundClientIncentivePool rpc
+type MsgFundClientIncentivePool struct {
+	// the client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the funds escrowed in the pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// the reward paid for each rewarded client update. Must be empty unless the pool is created or depleted
+	UpdateReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=update_reward,json=updateReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"update_reward"`
+	// the reward paid for each channel handshake completed over the client. Must be empty unless the pool is created
+	// or depleted
+	HandshakeReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=handshake_reward,json=handshakeReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"handshake_reward"`
+	// the depositor address
+	Depositor string `protobuf:"bytes,5,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *MsgFundClientIncentivePool) Reset()         { *m = MsgFundClientIncentivePool{} }
+func (m *MsgFundClientIncentivePool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundClientIncentivePool) ProtoMessage()    {}
+func (*MsgFundClientIncentivePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{10}
+}
+func (m *MsgFundClientIncentivePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundClientIncentivePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundClientIncentivePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundClientIncentivePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundClientIncentivePool.Merge(m, src)
+}
+func (m *MsgFundClientIncentivePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundClientIncentivePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundClientIncentivePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundClientIncentivePool proto.InternalMessageInfo
+
+// MsgFundClientIncentivePoolResponse defines the response type for the FundClientIncentivePool rpc
+type MsgFundClientIncentivePoolResponse struct {
+}
+
+func (m *MsgFundClientIncentivePoolResponse) Reset()         { *m = MsgFundClientIncentivePoolResponse{} }
+func (m *MsgFundClientIncentivePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundClientIncentivePoolResponse) ProtoMessage()    {}
+func (*MsgFundClientIncentivePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{11}
+}
+func (m *MsgFundClientIncentivePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundClientIncentivePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundClientIncentivePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundClientIncentivePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundClientIncentivePoolResponse.Merge(m, src)
+}
+func (m *MsgFundClientIncentivePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundClientIncentivePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundClientIncentivePoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundClientIncentivePoolResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines the request type for the UpdateParams rpc
+type MsgUpdateParams struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// params defines the fee middleware parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{12}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the response type for the UpdateParams rpc
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{13}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
 	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
@@ -369,56 +626,88 @@ func init() {
 	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
 	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
 	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+	proto.RegisterType((*MsgCancelPacketFee)(nil), "ibc.applications.fee.v1.MsgCancelPacketFee")
+	proto.RegisterType((*MsgCancelPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgCancelPacketFeeResponse")
+	proto.RegisterType((*MsgFundClientIncentivePool)(nil), "ibc.applications.fee.v1.MsgFundClientIncentivePool")
+	proto.RegisterType((*MsgFundClientIncentivePoolResponse)(nil), "ibc.applications.fee.v1.MsgFundClientIncentivePoolResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.fee.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.fee.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
 
 var fileDescriptor_05c93128649f1b96 = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0xc9, 0xe3, 0x23, 0x17, 0xde, 0xe3, 0xc5, 0x42, 0x2f, 0xc1, 0x0f, 0x0c, 0xb5, 0x50,
-	0x4b, 0x23, 0xc5, 0x26, 0xa9, 0x50, 0x45, 0xd4, 0x2e, 0x0a, 0x2a, 0x12, 0x52, 0x51, 0xa3, 0x2c,
-	0xbb, 0x41, 0x8e, 0x73, 0x31, 0x2e, 0xb1, 0xc7, 0xf2, 0x38, 0x51, 0xbd, 0xab, 0x58, 0x55, 0x5d,
-	0xb5, 0xff, 0xa0, 0xcb, 0x2e, 0xba, 0xe0, 0x67, 0xb0, 0x64, 0xd9, 0x4d, 0xab, 0x0a, 0x2a, 0xf1,
-	0x0f, 0xba, 0xae, 0x66, 0x3c, 0xb6, 0x4c, 0x42, 0x50, 0x5a, 0xa9, 0x1b, 0xcb, 0x73, 0xcf, 0x99,
-	0x33, 0xf7, 0x1c, 0xcd, 0xd5, 0xc0, 0xaa, 0xd3, 0xb6, 0x0c, 0xd3, 0xf7, 0xbb, 0x8e, 0x65, 0x86,
-	0x0e, 0xf1, 0xa8, 0x71, 0x88, 0x68, 0xf4, 0x6b, 0x46, 0xf8, 0x4a, 0xf7, 0x03, 0x12, 0x12, 0xb9,
-	0xe4, 0xb4, 0x2d, 0x3d, 0xcb, 0xd0, 0x0f, 0x11, 0xf5, 0x7e, 0x4d, 0x29, 0x9a, 0xae, 0xe3, 0x11,
-	0x83, 0x7f, 0x63, 0xae, 0xb2, 0x60, 0x13, 0x9b, 0xf0, 0x5f, 0x83, 0xfd, 0x89, 0xea, 0x9d, 0x51,
-	0x67, 0x30, 0xa1, 0x0c, 0xc5, 0x22, 0x01, 0x1a, 0xd6, 0x91, 0xe9, 0x79, 0xd8, 0x65, 0xb0, 0xf8,
-	0x15, 0x94, 0x92, 0x45, 0xa8, 0x4b, 0xa8, 0xe1, 0x52, 0x9b, 0x81, 0x2e, 0xb5, 0x63, 0x40, 0xfb,
-	0x24, 0xc1, 0xbf, 0xfb, 0xd4, 0x6e, 0xa1, 0xed, 0xd0, 0x10, 0x83, 0xa6, 0x19, 0x21, 0xca, 0x25,
-	0x98, 0xf6, 0x49, 0x10, 0x1e, 0x38, 0x9d, 0xb2, 0xb4, 0x2a, 0xad, 0x17, 0x5a, 0x53, 0x6c, 0xb9,
-	0xd7, 0x91, 0x97, 0x01, 0x84, 0x2e, 0xc3, 0x26, 0x38, 0x56, 0x10, 0x95, 0xbd, 0x8e, 0x5c, 0x86,
-	0xe9, 0x00, 0xbb, 0x66, 0x84, 0x41, 0x39, 0xcf, 0xb1, 0x64, 0x29, 0x2f, 0xc0, 0xa4, 0xcf, 0xa4,
-	0xcb, 0x7f, 0xf1, 0x7a, 0xbc, 0x68, 0x6c, 0xbc, 0xf9, 0xb0, 0x92, 0x3b, 0xb9, 0x3a, 0xad, 0x24,
-	0xbc, 0xb7, 0x57, 0xa7, 0x95, 0xff, 0xe3, 0x56, 0xab, 0xb4, 0x73, 0x6c, 0x0c, 0x76, 0xa6, 0x29,
-	0x50, 0x1e, 0xac, 0xb5, 0x90, 0xfa, 0xc4, 0xa3, 0xa8, 0x7d, 0x91, 0x60, 0x29, 0x03, 0xee, 0x90,
-	0x9e, 0x17, 0x62, 0xe0, 0x9b, 0x41, 0x18, 0xfd, 0x29, 0x5b, 0x55, 0x90, 0xad, 0xcc, 0x31, 0x07,
-	0x59, 0x8f, 0x45, 0x6b, 0xb0, 0x81, 0xc6, 0xa3, 0x9b, 0xfc, 0xde, 0xbb, 0xd9, 0xef, 0x50, 0xfb,
-	0xda, 0x5d, 0x58, 0xbb, 0x0d, 0x4f, 0x73, 0x38, 0x99, 0x80, 0xf9, 0x7d, 0x6a, 0x37, 0xcd, 0xa8,
-	0x69, 0x5a, 0xc7, 0x18, 0xee, 0x22, 0xca, 0x5b, 0x90, 0x3f, 0x44, 0xe4, 0xb6, 0x67, 0xeb, 0x4b,
-	0xfa, 0x88, 0x5b, 0xa9, 0xef, 0x22, 0x6e, 0x17, 0xce, 0xbe, 0xae, 0xe4, 0x3e, 0x5e, 0x9d, 0x56,
-	0xa4, 0x16, 0xdb, 0x23, 0xaf, 0xc1, 0x3f, 0x94, 0xf4, 0x02, 0x0b, 0x0f, 0x92, 0xf0, 0xe2, 0x80,
-	0xe6, 0xe2, 0x6a, 0x33, 0x8e, 0xb0, 0x02, 0x45, 0xc1, 0xca, 0x24, 0x19, 0xa7, 0x35, 0x1f, 0x03,
-	0x3b, 0x69, 0x9e, 0xff, 0xc1, 0x14, 0x75, 0x6c, 0x0f, 0x03, 0x91, 0x94, 0x58, 0xc9, 0x0a, 0xcc,
-	0x88, 0x5c, 0x68, 0x79, 0x72, 0x35, 0xbf, 0x5e, 0x68, 0xa5, 0xeb, 0x86, 0x9e, 0x44, 0x27, 0xc8,
-	0x2c, 0x39, 0xe5, 0x7a, 0x72, 0x59, 0xc3, 0xda, 0x22, 0x94, 0x06, 0x4a, 0x69, 0x3e, 0xdf, 0x25,
-	0x58, 0x18, 0xc0, 0x9e, 0xd0, 0xc8, 0xb3, 0xe4, 0xa7, 0x50, 0xf0, 0x79, 0x25, 0xb9, 0x21, 0xb3,
-	0xf5, 0x65, 0x1e, 0x15, 0x9b, 0x2d, 0x3d, 0x19, 0xa8, 0x7e, 0x4d, 0x8f, 0xf7, 0xed, 0x75, 0xb2,
-	0x59, 0xcd, 0xf8, 0xa2, 0x28, 0x3f, 0x03, 0x10, 0x32, 0x2c, 0xf2, 0x09, 0xae, 0xa3, 0x8d, 0x8c,
-	0x3c, 0xed, 0x21, 0x2b, 0x26, 0xfa, 0xd8, 0x45, 0x6c, 0x3c, 0x4c, 0x8c, 0x67, 0x44, 0x99, 0xf9,
-	0x95, 0xd1, 0xe6, 0xb9, 0x1b, 0x4d, 0xe5, 0xd3, 0x30, 0x54, 0x4f, 0x62, 0xa8, 0xff, 0xc8, 0x43,
-	0x7e, 0x9f, 0xda, 0xb2, 0x0b, 0x7f, 0x5f, 0x9f, 0xfe, 0xfb, 0x23, 0x7b, 0x1d, 0x1c, 0x3d, 0xa5,
-	0x36, 0x36, 0x35, 0x39, 0x56, 0x7e, 0x2f, 0xc1, 0xe2, 0xe8, 0x11, 0xdd, 0x1c, 0x47, 0x70, 0x68,
-	0x9b, 0xf2, 0xf8, 0xb7, 0xb6, 0xa5, 0x3d, 0xbd, 0x84, 0xb9, 0x6b, 0xd3, 0xb2, 0x7e, 0x9b, 0x5c,
-	0x96, 0xa9, 0x6c, 0x8c, 0xcb, 0x4c, 0xcf, 0x8a, 0xa0, 0x38, 0x7c, 0xf3, 0xaa, 0xe3, 0xca, 0x70,
-	0xba, 0xb2, 0xf9, 0x4b, 0xf4, 0xe4, 0x68, 0x65, 0xf2, 0x35, 0xbb, 0x5c, 0xdb, 0xcf, 0xcf, 0x2e,
-	0x54, 0xe9, 0xfc, 0x42, 0x95, 0xbe, 0x5d, 0xa8, 0xd2, 0xbb, 0x4b, 0x35, 0x77, 0x7e, 0xa9, 0xe6,
-	0x3e, 0x5f, 0xaa, 0xb9, 0x17, 0x9b, 0xb6, 0x13, 0x1e, 0xf5, 0xda, 0xba, 0x45, 0x5c, 0x43, 0x3c,
-	0x18, 0x4e, 0xdb, 0xaa, 0xda, 0xc4, 0xe8, 0x6f, 0x19, 0x2e, 0xe9, 0xf4, 0xba, 0x48, 0xd9, 0x5b,
-	0x44, 0x8d, 0xfa, 0x56, 0x95, 0x3d, 0x43, 0x61, 0xe4, 0x23, 0x6d, 0x4f, 0xf1, 0xa7, 0xe4, 0xc1,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x85, 0x8d, 0x1d, 0x0f, 0x07, 0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xfb, 0x91, 0x6d, 0x5e, 0x5b, 0xda, 0x5a, 0x15, 0x4d, 0xbd, 0x6d, 0x5a, 0xac, 0xc2,
+	0x66, 0x8b, 0x6a, 0x37, 0x5d, 0x55, 0xa8, 0x85, 0x3d, 0x6c, 0x2b, 0x22, 0x55, 0xa2, 0x22, 0x8a,
+	0xe0, 0xc2, 0x25, 0x9a, 0xd8, 0xaf, 0xae, 0x69, 0xec, 0xb1, 0x3c, 0x4e, 0x59, 0xdf, 0x80, 0x0b,
+	0x08, 0x24, 0x04, 0x57, 0x90, 0x10, 0x27, 0x84, 0x10, 0x48, 0xfd, 0x33, 0xf6, 0xb8, 0x47, 0x2e,
+	0x7c, 0xa8, 0x05, 0xf5, 0xdf, 0x40, 0x63, 0x8f, 0x5d, 0x27, 0xa9, 0xb3, 0xe9, 0xf2, 0x71, 0x89,
+	0xec, 0xf7, 0x7e, 0xf3, 0xde, 0xef, 0xfd, 0x66, 0xde, 0x9b, 0x18, 0xd6, 0xec, 0x96, 0xa1, 0x13,
+	0xcf, 0x6b, 0xdb, 0x06, 0x09, 0x6c, 0xea, 0x32, 0xfd, 0x18, 0x51, 0x3f, 0xab, 0xea, 0xc1, 0x63,
+	0xcd, 0xf3, 0x69, 0x40, 0xe5, 0x45, 0xbb, 0x65, 0x68, 0x59, 0x84, 0x76, 0x8c, 0xa8, 0x9d, 0x55,
+	0x95, 0x79, 0xe2, 0xd8, 0x2e, 0xd5, 0xa3, 0xdf, 0x18, 0xab, 0x2c, 0x58, 0xd4, 0xa2, 0xd1, 0xa3,
+	0xce, 0x9f, 0x84, 0xf5, 0xa5, 0xbc, 0x1c, 0x3c, 0x50, 0x0c, 0xb9, 0x97, 0x07, 0xb1, 0x5d, 0x03,
+	0xdd, 0xc0, 0x3e, 0xc3, 0x6c, 0x2c, 0x83, 0xfa, 0xa8, 0x1b, 0x27, 0xc4, 0x75, 0xb1, 0xcd, 0x41,
+	0xe2, 0x51, 0x40, 0x16, 0x0d, 0xca, 0x1c, 0xca, 0x74, 0x87, 0x59, 0xdc, 0xe9, 0x30, 0x4b, 0x38,
+	0xca, 0xc2, 0xd1, 0x22, 0x8c, 0xc7, 0x6e, 0x61, 0x40, 0xaa, 0xba, 0x41, 0x6d, 0x37, 0xf6, 0xab,
+	0x3f, 0x49, 0x30, 0x77, 0xc4, 0xac, 0x06, 0x5a, 0x36, 0x0b, 0xd0, 0xaf, 0x93, 0x10, 0x51, 0x5e,
+	0x84, 0x3b, 0x1e, 0xf5, 0x83, 0xa6, 0x6d, 0x96, 0xa4, 0x35, 0xa9, 0x52, 0x6c, 0x14, 0xf8, 0xeb,
+	0xa1, 0x29, 0xaf, 0x00, 0x88, 0xbc, 0xdc, 0x37, 0x1a, 0xf9, 0x8a, 0xc2, 0x72, 0x68, 0xca, 0x25,
+	0xb8, 0xe3, 0x63, 0x9b, 0x84, 0xe8, 0x97, 0xc6, 0x22, 0x5f, 0xf2, 0x2a, 0x2f, 0xc0, 0x84, 0xc7,
+	0x43, 0x97, 0xc6, 0x23, 0x7b, 0xfc, 0xb2, 0xb7, 0xf5, 0xe9, 0x77, 0xab, 0x23, 0x1f, 0x5f, 0x9d,
+	0x6f, 0x24, 0xb8, 0xcf, 0xae, 0xce, 0x37, 0xee, 0xc6, 0x8c, 0x37, 0x99, 0x79, 0xaa, 0xf7, 0x32,
+	0x53, 0x15, 0x28, 0xf5, 0xda, 0x1a, 0xc8, 0x3c, 0xea, 0x32, 0x54, 0x7f, 0x95, 0x60, 0x39, 0xe3,
+	0x3c, 0xa0, 0x1d, 0x37, 0x40, 0xdf, 0x23, 0x7e, 0x10, 0xfe, 0x57, 0x65, 0x6d, 0x82, 0x6c, 0x64,
+	0xd2, 0x34, 0xb3, 0x35, 0xce, 0x1b, 0xbd, 0x04, 0xf6, 0xde, 0xb8, 0xa9, 0xde, 0x7b, 0x37, 0xd7,
+	0xdb, 0x47, 0x5f, 0x7d, 0x05, 0xd6, 0x07, 0xf9, 0x53, 0x1d, 0x7e, 0x1e, 0x85, 0xd9, 0x23, 0x66,
+	0xd5, 0x49, 0x58, 0x27, 0xc6, 0x29, 0x06, 0x35, 0x44, 0x79, 0x17, 0xc6, 0x8e, 0x11, 0xa3, 0xb2,
+	0xa7, 0xb6, 0x97, 0xb5, 0x9c, 0xe3, 0xad, 0xd5, 0x10, 0xf7, 0x8b, 0x4f, 0x7e, 0x5b, 0x1d, 0xf9,
+	0xe1, 0xea, 0x7c, 0x43, 0x6a, 0xf0, 0x35, 0xf2, 0x3a, 0xbc, 0xc0, 0x68, 0xc7, 0x37, 0xb0, 0x99,
+	0x88, 0x17, 0x0b, 0x34, 0x1d, 0x5b, 0xeb, 0xb1, 0x84, 0x1b, 0x30, 0x2f, 0x50, 0x19, 0x25, 0x63,
+	0xb5, 0x66, 0x63, 0xc7, 0x41, 0xaa, 0xe7, 0x8b, 0x50, 0x60, 0xb6, 0xe5, 0xa2, 0x2f, 0x94, 0x12,
+	0x6f, 0xb2, 0x02, 0x93, 0x42, 0x17, 0x56, 0x9a, 0x58, 0x1b, 0xab, 0x14, 0x1b, 0xe9, 0xbb, 0x7c,
+	0x1f, 0xe6, 0xf0, 0xb1, 0x67, 0xfb, 0x61, 0x33, 0xb0, 0x1d, 0x64, 0x01, 0x71, 0xbc, 0x52, 0x61,
+	0x4d, 0xaa, 0x8c, 0x37, 0x66, 0x63, 0xfb, 0x3b, 0x89, 0x79, 0x4f, 0x4b, 0x54, 0x16, 0x71, 0xb9,
+	0xc8, 0x4a, 0xb7, 0xc8, 0x59, 0x6d, 0xd4, 0x25, 0x58, 0xec, 0x31, 0xa5, 0x52, 0xfe, 0x29, 0xc1,
+	0x42, 0x8f, 0xef, 0x11, 0x0b, 0x5d, 0x43, 0x7e, 0x13, 0x8a, 0x5e, 0x64, 0x49, 0x0e, 0xd3, 0xd4,
+	0xf6, 0x4a, 0xa4, 0x2a, 0x6f, 0x53, 0x2d, 0xe9, 0xcd, 0xb3, 0xaa, 0x16, 0xaf, 0x3b, 0x34, 0xb3,
+	0xb2, 0x4e, 0x7a, 0xc2, 0x28, 0xbf, 0x05, 0x20, 0xc2, 0xf0, 0xdd, 0x19, 0x8d, 0xe2, 0xa8, 0xb9,
+	0xbb, 0x93, 0x72, 0xc8, 0x06, 0x13, 0x3c, 0x6a, 0x88, 0x7b, 0xaf, 0x25, 0x85, 0x67, 0x82, 0xf2,
+	0xe2, 0x57, 0xf3, 0x8b, 0x8f, 0xaa, 0x51, 0xcb, 0xb0, 0x7c, 0x93, 0x3d, 0x95, 0xe1, 0x7b, 0x09,
+	0xe4, 0x23, 0x66, 0x1d, 0x10, 0xd7, 0xc0, 0xf6, 0xf5, 0xa1, 0xfa, 0x97, 0x44, 0xb8, 0x3e, 0x0e,
+	0xa3, 0xd9, 0xe3, 0xb0, 0x57, 0xbd, 0x61, 0x1f, 0x57, 0xba, 0x4b, 0xe9, 0x61, 0xa4, 0x7e, 0x21,
+	0x81, 0xd2, 0x6f, 0x4e, 0xea, 0x90, 0x3d, 0x98, 0xf1, 0xf1, 0xb8, 0xe3, 0x9a, 0x68, 0x72, 0x6d,
+	0x58, 0x49, 0x5a, 0x1b, 0xab, 0x4c, 0x6d, 0x2f, 0x69, 0x71, 0x54, 0x8d, 0x0f, 0x49, 0x4d, 0x0c,
+	0x49, 0xed, 0x80, 0xda, 0xee, 0xfe, 0x16, 0x27, 0xfc, 0xe3, 0xef, 0xab, 0x15, 0xcb, 0x0e, 0x4e,
+	0x3a, 0x2d, 0xcd, 0xa0, 0x8e, 0x2e, 0x26, 0x6a, 0x86, 0x49, 0x10, 0x7a, 0xc8, 0xa2, 0x05, 0xac,
+	0x31, 0x9d, 0x64, 0xa8, 0x21, 0x32, 0xf5, 0xdb, 0xf1, 0x88, 0x50, 0xad, 0xe3, 0x9a, 0x07, 0x6d,
+	0x1b, 0xdd, 0xe0, 0x30, 0x99, 0xed, 0x75, 0x4a, 0xdb, 0xf2, 0x5d, 0x28, 0x1a, 0x91, 0xf9, 0x7a,
+	0x26, 0x4d, 0xc6, 0x86, 0x43, 0x53, 0x0e, 0xa1, 0x40, 0x1c, 0xde, 0xe5, 0xa5, 0xd1, 0x67, 0xd1,
+	0xac, 0xdd, 0x96, 0xe6, 0xd7, 0x57, 0xe7, 0x1b, 0xd3, 0x6d, 0xb4, 0x88, 0x11, 0x36, 0xf9, 0x6d,
+	0xc0, 0xe2, 0x4d, 0x11, 0x09, 0xe5, 0x8f, 0x24, 0x98, 0xe9, 0x78, 0x26, 0x09, 0xb0, 0xe9, 0xe3,
+	0x07, 0xc4, 0xe7, 0xad, 0xfc, 0x0c, 0x0a, 0x8f, 0xfe, 0x31, 0x85, 0xc6, 0x74, 0x9c, 0xb2, 0x11,
+	0x65, 0x94, 0x3f, 0x91, 0x60, 0xee, 0x84, 0xb8, 0x26, 0x3b, 0x21, 0xa7, 0x29, 0x8d, 0xf1, 0xff,
+	0x81, 0xc6, 0x6c, 0x9a, 0x55, 0x30, 0x59, 0x86, 0xa2, 0x89, 0x1e, 0x65, 0x76, 0x40, 0xfd, 0xd2,
+	0x44, 0x7c, 0x3b, 0xa4, 0x86, 0xeb, 0xa1, 0x7e, 0x6d, 0xe3, 0x27, 0xf5, 0xe5, 0xee, 0x93, 0x9a,
+	0x73, 0x02, 0xd4, 0x75, 0x50, 0xf3, 0xbd, 0x69, 0x03, 0x7e, 0x23, 0x45, 0x23, 0xfd, 0xdd, 0x48,
+	0x9f, 0x3a, 0xf1, 0x89, 0xc3, 0x32, 0x6d, 0x23, 0x75, 0x4d, 0xd1, 0x87, 0x50, 0xf0, 0x22, 0x84,
+	0x98, 0x27, 0xab, 0x03, 0xe6, 0x09, 0x87, 0xed, 0x8f, 0x73, 0xc9, 0x1a, 0x62, 0xd1, 0x30, 0x5d,
+	0x17, 0xd3, 0xa8, 0xa1, 0x60, 0x22, 0x06, 0x68, 0x96, 0x5c, 0x42, 0x7c, 0xfb, 0xaf, 0x02, 0x8c,
+	0x1d, 0x31, 0x4b, 0x76, 0x60, 0xa6, 0xfb, 0x2f, 0xc6, 0xfd, 0x5c, 0x56, 0xbd, 0xf7, 0xbb, 0x52,
+	0x1d, 0x1a, 0x9a, 0x36, 0xfa, 0x57, 0x12, 0x2c, 0xe5, 0xff, 0x0f, 0xd8, 0x19, 0x26, 0x60, 0xdf,
+	0x32, 0xe5, 0xe1, 0x73, 0x2d, 0x4b, 0x39, 0xbd, 0x0f, 0xd3, 0x5d, 0x57, 0x72, 0x65, 0x50, 0xb8,
+	0x2c, 0x52, 0xd9, 0x1a, 0x16, 0x99, 0xe6, 0x0a, 0x61, 0xbe, 0xff, 0xce, 0xda, 0x1c, 0x36, 0x4c,
+	0x04, 0x57, 0x76, 0x6e, 0x05, 0x4f, 0x53, 0x33, 0x98, 0xed, 0xbd, 0x27, 0x5e, 0x1d, 0x14, 0xa9,
+	0x07, 0xac, 0x3c, 0xb8, 0x05, 0x38, 0x4d, 0xfa, 0xb9, 0x04, 0x8b, 0x79, 0x33, 0x76, 0x60, 0xc0,
+	0x9c, 0x45, 0xca, 0xeb, 0xcf, 0xb1, 0x28, 0xbb, 0xd3, 0x5d, 0x9d, 0x3a, 0x70, 0xa7, 0xb3, 0x48,
+	0x65, 0x6b, 0x58, 0x64, 0x92, 0x4b, 0x99, 0xf8, 0x90, 0x0f, 0xee, 0xfd, 0xb7, 0x9f, 0x5c, 0x94,
+	0xa5, 0xa7, 0x17, 0x65, 0xe9, 0x8f, 0x8b, 0xb2, 0xf4, 0xe5, 0x65, 0x79, 0xe4, 0xe9, 0x65, 0x79,
+	0xe4, 0x97, 0xcb, 0xf2, 0xc8, 0x7b, 0x3b, 0xfd, 0x83, 0xd0, 0x6e, 0x19, 0x9b, 0x16, 0xd5, 0xcf,
+	0x76, 0x75, 0x87, 0x9a, 0x9d, 0x36, 0x32, 0xfe, 0x15, 0xc2, 0xf4, 0xed, 0xdd, 0x4d, 0xfe, 0x01,
+	0x12, 0xcd, 0xc6, 0x56, 0x21, 0xfa, 0x3c, 0x78, 0xf0, 0xf7, 0x00, 0x3a, 0xd3, 0xf5, 0x79, 0x2c,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -455,6 +744,15 @@ type MsgClient interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+	// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+	// CancelPacketFee allows the refund address of packet fees to withdraw them from escrow once the packet has been
+	// acknowledged or timed out, or once the expiry timestamp of the fee has passed
+	CancelPacketFee(ctx context.Context, in *MsgCancelPacketFee, opts ...grpc.CallOption) (*MsgCancelPacketFeeResponse, error)
+	// FundClientIncentivePool defines a rpc handler method for MsgFundClientIncentivePool
+	// FundClientIncentivePool escrows funds incentivizing the client updates and channel handshakes of a client
+	FundClientIncentivePool(ctx context.Context, in *MsgFundClientIncentivePool, opts ...grpc.CallOption) (*MsgFundClientIncentivePoolResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -501,6 +799,33 @@ func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAs
 	return out, nil
 }
 
+func (c *msgClient) CancelPacketFee(ctx context.Context, in *MsgCancelPacketFee, opts ...grpc.CallOption) (*MsgCancelPacketFeeResponse, error) {
+	out := new(MsgCancelPacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/CancelPacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundClientIncentivePool(ctx context.Context, in *MsgFundClientIncentivePool, opts ...grpc.CallOption) (*MsgFundClientIncentivePoolResponse, error) {
+	out := new(MsgFundClientIncentivePoolResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/FundClientIncentivePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
@@ -525,6 +850,15 @@ type MsgServer interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+	// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+	// CancelPacketFee allows the refund address of packet fees to withdraw them from escrow once the packet has been
+	// acknowledged or timed out, or once the expiry timestamp of the fee has passed
+	CancelPacketFee(context.Context, *MsgCancelPacketFee) (*MsgCancelPacketFeeResponse, error)
+	// FundClientIncentivePool defines a rpc handler method for MsgFundClientIncentivePool
+	// FundClientIncentivePool escrows funds incentivizing the client updates and channel handshakes of a client
+	FundClientIncentivePool(context.Context, *MsgFundClientIncentivePool) (*MsgFundClientIncentivePoolResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -543,6 +877,15 @@ func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPack
 func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
 }
+func (*UnimplementedMsgServer) CancelPacketFee(ctx context.Context, req *MsgCancelPacketFee) (*MsgCancelPacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPacketFee not implemented")
+}
+func (*UnimplementedMsgServer) FundClientIncentivePool(ctx context.Context, req *MsgFundClientIncentivePool) (*MsgFundClientIncentivePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundClientIncentivePool not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -620,6 +963,60 @@ func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPacketFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/CancelPacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPacketFee(ctx, req.(*MsgCancelPacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundClientIncentivePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundClientIncentivePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundClientIncentivePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/FundClientIncentivePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundClientIncentivePool(ctx, req.(*MsgFundClientIncentivePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.fee.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -640,6 +1037,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PayPacketFeeAsync",
 			Handler:    _Msg_PayPacketFeeAsync_Handler,
 		},
+		{
+			MethodName: "CancelPacketFee",
+			Handler:    _Msg_CancelPacketFee_Handler,
+		},
+		{
+			MethodName: "FundClientIncentivePool",
+			Handler:    _Msg_FundClientIncentivePool_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/fee/v1/tx.proto",
@@ -813,6 +1222,11 @@ func (m *MsgPayPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Relayers) > 0 {
 		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Relayers[iNdEx])
@@ -945,44 +1359,286 @@ func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelPacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelPacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Payee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterPayeeResponse) Size() (n int) {
-	if m == nil {
+func (m *MsgCancelPacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedFees) > 0 {
+		for iNdEx := len(m.RefundedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundClientIncentivePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundClientIncentivePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundClientIncentivePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HandshakeReward) > 0 {
+		for iNdEx := len(m.HandshakeReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandshakeReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UpdateReward) > 0 {
+		for iNdEx := len(m.UpdateReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundClientIncentivePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundClientIncentivePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundClientIncentivePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPayeeResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1050,6 +1706,9 @@ func (m *MsgPayPacketFee) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryTimestamp))
+	}
 	return n
 }
 
@@ -1084,6 +1743,104 @@ func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RefundedFees) > 0 {
+		for _, e := range m.RefundedFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundClientIncentivePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UpdateReward) > 0 {
+		for _, e := range m.UpdateReward {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.HandshakeReward) > 0 {
+		for _, e := range m.HandshakeReward {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundClientIncentivePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1181,13 +1938,685 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1197,29 +2626,30 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1229,23 +2659,24 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1268,7 +2699,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,10 +2722,10 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1318,7 +2749,7 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPacketFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,49 +2772,17 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPacketFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1393,59 +2792,28 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1473,7 +2841,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1496,7 +2864,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,12 +2887,46 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPacketFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedFees = append(m.RefundedFees, types1.Coin{})
+			if err := m.RefundedFees[len(m.RefundedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1546,7 +2948,7 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+func (m *MsgFundClientIncentivePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1569,17 +2971,17 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundClientIncentivePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundClientIncentivePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1589,30 +2991,29 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1622,29 +3023,31 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1654,29 +3057,31 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			m.UpdateReward = append(m.UpdateReward, types1.Coin{})
+			if err := m.UpdateReward[len(m.UpdateReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeReward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1686,27 +3091,29 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.HandshakeReward = append(m.HandshakeReward, types1.Coin{})
+			if err := m.HandshakeReward[len(m.HandshakeReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1734,7 +3141,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1757,7 +3164,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundClientIncentivePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1780,10 +3187,10 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundClientIncentivePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundClientIncentivePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1807,7 +3214,7 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,17 +3237,17 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1850,28 +3257,27 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1898,7 +3304,7 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1923,7 +3329,7 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1946,10 +3352,10 @@ func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -22,6 +22,15 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryChannelEscrow(),
+		GetCmdQueryChannelEscrows(),
+		GetCmdQueryChannelDenomRules(),
+		GetCmdQueryAllChannelDenomRules(),
+		GetCmdQueryCanonicalAsset(),
+		GetCmdQueryCanonicalAssets(),
+		GetCmdQueryNativeMapping(),
+		GetCmdQueryNativeMappings(),
+		GetCmdQueryProtocolFeeConfig(),
 	)
 
 	return queryCmd
@@ -39,6 +48,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewWrapVoucherTxCmd(),
+		NewUnwrapVoucherTxCmd(),
 	)
 
 	return txCmd
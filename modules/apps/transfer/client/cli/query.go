@@ -200,3 +200,326 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryChannelEscrow defines the command to query the amount of tokens escrowed for a channel.
+func GetCmdQueryChannelEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-escrow [port] [channel-id]",
+		Short:   "Query the amount of tokens escrowed for a channel",
+		Long:    "Query the amount of tokens escrowed through the transfer module for a channel together with the balance of the channel escrow address",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-escrow transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelEscrowRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelEscrow(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryChannelEscrows defines the command to query the amount of tokens escrowed for all channels.
+func GetCmdQueryChannelEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-escrows",
+		Short:   "Query the amount of tokens escrowed for all channels",
+		Long:    "Query the amount of tokens escrowed through the transfer module for all channels with tokens in escrow",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-escrows", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelEscrowsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelEscrows(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel escrows")
+
+	return cmd
+}
+
+// GetCmdQueryChannelDenomRules defines the command to query the effective denomination rules of a channel.
+func GetCmdQueryChannelDenomRules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-denom-rules [port] [channel-id]",
+		Short:   "Query the effective denomination rules of a channel",
+		Long:    "Query the effective denomination rules restricting the tokens which may be sent and received over a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-denom-rules transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelDenomRulesRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelDenomRules(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAllChannelDenomRules defines the command to query the denomination rules of all channels.
+func GetCmdQueryAllChannelDenomRules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "all-channel-denom-rules",
+		Short:   "Query the denomination rules of all channels",
+		Long:    "Query the denomination rules of all channels which have rules set",
+		Example: fmt.Sprintf("%s query ibc-transfer all-channel-denom-rules", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllChannelDenomRulesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllChannelDenomRules(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel denomination rules")
+
+	return cmd
+}
+
+// GetCmdQueryCanonicalAsset defines the command to query a canonical asset.
+func GetCmdQueryCanonicalAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "canonical-asset [denom]",
+		Short:   "Query a canonical asset",
+		Long:    "Query a canonical asset and the amounts of its route vouchers wrapped",
+		Example: fmt.Sprintf("%s query ibc-transfer canonical-asset canonical/uusdc", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCanonicalAssetRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.CanonicalAsset(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCanonicalAssets defines the command to query all canonical assets.
+func GetCmdQueryCanonicalAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "canonical-assets",
+		Short:   "Query all canonical assets",
+		Long:    "Query all registered canonical assets",
+		Example: fmt.Sprintf("%s query ibc-transfer canonical-assets", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCanonicalAssetsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CanonicalAssets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "canonical assets")
+
+	return cmd
+}
+
+// GetCmdQueryNativeMapping defines the command to query a native mapping.
+func GetCmdQueryNativeMapping() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "native-mapping [local-denom]",
+		Short:   "Query a native mapping",
+		Long:    "Query the foreign asset mapped to a local denomination and the amount of the local denomination minted",
+		Example: fmt.Sprintf("%s query ibc-transfer native-mapping uusdc", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNativeMappingRequest{
+				LocalDenom: args[0],
+			}
+
+			res, err := queryClient.NativeMapping(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryNativeMappings defines the command to query all native mappings.
+func GetCmdQueryNativeMappings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "native-mappings",
+		Short:   "Query all native mappings",
+		Long:    "Query all foreign assets mapped to local denominations",
+		Example: fmt.Sprintf("%s query ibc-transfer native-mappings", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryNativeMappingsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.NativeMappings(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "native mappings")
+
+	return cmd
+}
+
+// GetCmdQueryProtocolFeeConfig defines the command to query the protocol fee configuration.
+func GetCmdQueryProtocolFeeConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "protocol-fee-config",
+		Short:   "Query the protocol fee configuration",
+		Long:    "Query the fee collector, the exempt addresses and the protocol fees of the channels",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-transfer protocol-fee-config", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProtocolFeeConfig(cmd.Context(), &types.QueryProtocolFeeConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -25,6 +25,7 @@ const (
 	flagMemo                   = "memo"
 	flagForwarding             = "forwarding"
 	flagUnwind                 = "unwind"
+	flagRefundAddress          = "refund-address"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -125,6 +126,11 @@ to first unwind IBC tokens to their native chain and then forward them to the fi
 				srcPort, srcChannel, coins, sender, receiver, timeoutHeight, timeoutTimestamp, memo, forwarding,
 			)
 
+			msg.RefundAddress, err = cmd.Flags().GetString(flagRefundAddress)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -135,6 +141,67 @@ to first unwind IBC tokens to their native chain and then forward them to the fi
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
 	cmd.Flags().String(flagForwarding, "", "Forwarding information in the form of a comma separated list of portID/channelID pairs.")
 	cmd.Flags().Bool(flagUnwind, false, "Flag to indicate if the coin should be unwound to its native chain before forwarding.")
+	cmd.Flags().String(flagRefundAddress, "", "Address to refund the tokens to in place of the sender if the packet times out or fails.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWrapVoucherTxCmd returns the command to create a MsgWrapVoucher transaction
+func NewWrapVoucherTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "wrap-voucher [voucher]",
+		Short:   "Wrap a voucher into its canonical denomination",
+		Long:    "Wrap a voucher of a canonical route into the canonical denomination of its asset at a 1:1 rate.",
+		Example: fmt.Sprintf("%s tx ibc-transfer wrap-voucher 100ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			voucher, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWrapVoucher(clientCtx.GetFromAddress().String(), voucher)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnwrapVoucherTxCmd returns the command to create a MsgUnwrapVoucher transaction
+func NewUnwrapVoucherTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unwrap-voucher [canonical] [route-path]",
+		Short:   "Unwrap canonical tokens into the voucher of one of its routes",
+		Long:    "Unwrap canonical tokens at a 1:1 rate into the voucher of the route with the given full denomination path.",
+		Example: fmt.Sprintf("%s tx ibc-transfer unwrap-voucher 100canonical/uusdc transfer/channel-0/uusdc", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			canonical, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnwrapVoucher(clientCtx.GetFromAddress().String(), canonical, args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
 	flags.AddTxFlagsToCmd(cmd)
 
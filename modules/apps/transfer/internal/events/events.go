@@ -133,6 +133,80 @@ func EmitDenomEvent(ctx sdk.Context, token types.Token) {
 	)
 }
 
+// EmitWrapVoucherEvent emits a wrap voucher event when a voucher is wrapped into its canonical denomination.
+func EmitWrapVoucherEvent(ctx sdk.Context, sender string, voucher, canonical sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWrapVoucher,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyVoucher, voucher.String()),
+			sdk.NewAttribute(types.AttributeKeyCanonical, canonical.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitUnwrapVoucherEvent emits an unwrap voucher event when canonical tokens are unwrapped into a route voucher.
+func EmitUnwrapVoucherEvent(ctx sdk.Context, sender string, canonical, voucher sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnwrapVoucher,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyCanonical, canonical.String()),
+			sdk.NewAttribute(types.AttributeKeyVoucher, voucher.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitProtocolFeeEvent emits a protocol fee event when a protocol fee is charged on the tokens transferred over a channel.
+func EmitProtocolFeeEvent(ctx sdk.Context, payer, feeCollector, portID, channelID string, fees sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolFee,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer),
+			sdk.NewAttribute(types.AttributeKeyFeeCollector, feeCollector),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyFees, fees.String()),
+		),
+	)
+}
+
+// EmitProtocolFeeRefundEvent emits a protocol fee refund event when the protocol fee held for a packet is refunded.
+func EmitProtocolFeeRefundEvent(ctx sdk.Context, receiver, portID, channelID string, fees sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolFeeRefund,
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyFees, fees.String()),
+		),
+	)
+}
+
+// EmitPacketRefundEvent emits a packet refund event when the tokens of a packet are refunded to the refund address
+// set in place of the sender.
+func EmitPacketRefundEvent(ctx sdk.Context, refundAddress, portID, channelID string, sequence uint64, coins sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketRefund,
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, refundAddress),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundTokens, coins.String()),
+		),
+	)
+}
+
 // mustMarshalType json marshals the given type and panics on failure.
 func mustMarshalJSON(v any) string {
 	bz, err := json.Marshal(v)
@@ -28,6 +28,15 @@ func ReportTransfer(sourcePort, sourceChannel, destinationPort, destinationChann
 				float32(amount.Int64()),
 				[]metrics.Label{telemetry.NewLabel(coremetrics.LabelDenom, token.Denom.Path())},
 			)
+
+			reportAmount(
+				[]string{"ibc", types.ModuleName, "send", "amount"},
+				float32(amount.Int64()),
+				[]metrics.Label{
+					telemetry.NewLabel(coremetrics.LabelDenom, token.Denom.Path()),
+					telemetry.NewLabel(coremetrics.LabelSourceChannel, sourceChannel),
+				},
+			)
 		}
 
 		labels = append(labels, telemetry.NewLabel(coremetrics.LabelSource, fmt.Sprintf("%t", !token.Denom.HasPrefix(sourcePort, sourceChannel))))
@@ -63,6 +72,15 @@ func ReportOnRecvPacket(packet channeltypes.Packet, tokens types.Tokens) {
 				float32(transferAmount.Int64()),
 				[]metrics.Label{telemetry.NewLabel(coremetrics.LabelDenom, token.Denom.Path())},
 			)
+
+			reportAmount(
+				[]string{"ibc", types.ModuleName, "receive", "amount"},
+				float32(transferAmount.Int64()),
+				[]metrics.Label{
+					telemetry.NewLabel(coremetrics.LabelDenom, token.Denom.Path()),
+					telemetry.NewLabel(coremetrics.LabelDestinationChannel, packet.DestinationChannel),
+				},
+			)
 		}
 
 		labels = append(labels, telemetry.NewLabel(coremetrics.LabelSource, fmt.Sprintf("%t", token.Denom.HasPrefix(packet.SourcePort, packet.SourceChannel))))
@@ -74,3 +92,33 @@ func ReportOnRecvPacket(packet channeltypes.Packet, tokens types.Tokens) {
 		labels,
 	)
 }
+
+// ReportRefund reports the amounts by denomination refunded to the sender of a packet which failed
+// with the given refund type, either an error acknowledgement or a timeout.
+func ReportRefund(packet channeltypes.Packet, tokens types.Tokens, refundType string) {
+	for _, token := range tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok || !amount.IsInt64() {
+			continue
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "refund"},
+			float32(amount.Int64()),
+			[]metrics.Label{
+				telemetry.NewLabel(coremetrics.LabelDenom, token.Denom.Path()),
+				telemetry.NewLabel(coremetrics.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coremetrics.LabelRefundType, refundType),
+			},
+		)
+	}
+}
+
+// reportAmount adds a sample of a transferred amount to the histogram with the given keys.
+func reportAmount(keys []string, amount float32, labels []metrics.Label) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels(keys, amount, labels)
+}
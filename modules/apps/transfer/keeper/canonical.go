@@ -0,0 +1,354 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// GetCanonicalAsset returns the canonical asset with the provided denomination.
+func (k Keeper) GetCanonicalAsset(ctx sdk.Context, denom string) (types.CanonicalAsset, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CanonicalAssetStoreKey(denom))
+	if bz == nil {
+		return types.CanonicalAsset{}, false
+	}
+
+	var asset types.CanonicalAsset
+	k.cdc.MustUnmarshal(bz, &asset)
+
+	return asset, true
+}
+
+// SetCanonicalAsset stores the canonical asset and indexes the canonical denomination
+// by the voucher denomination of each of its routes.
+func (k Keeper) SetCanonicalAsset(ctx sdk.Context, asset types.CanonicalAsset) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&asset)
+	store.Set(types.CanonicalAssetStoreKey(asset.Denom), bz)
+
+	for _, route := range asset.Routes {
+		store.Set(types.CanonicalRouteStoreKey(route.Denom().IBCDenom()), []byte(asset.Denom))
+	}
+}
+
+// GetAllCanonicalAssets returns all the registered canonical assets.
+func (k Keeper) GetAllCanonicalAssets(ctx sdk.Context) []types.CanonicalAsset {
+	assets := []types.CanonicalAsset{}
+	k.IterateCanonicalAssets(ctx, func(asset types.CanonicalAsset) bool {
+		assets = append(assets, asset)
+		return false
+	})
+
+	return assets
+}
+
+// IterateCanonicalAssets iterates over the canonical assets in the store and performs a callback function.
+func (k Keeper) IterateCanonicalAssets(ctx sdk.Context, cb func(asset types.CanonicalAsset) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.CanonicalAssetKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var asset types.CanonicalAsset
+		k.cdc.MustUnmarshal(iterator.Value(), &asset)
+
+		if cb(asset) {
+			break
+		}
+	}
+}
+
+// getCanonicalDenom returns the canonical denomination of the route with the provided voucher denomination.
+func (k Keeper) getCanonicalDenom(ctx sdk.Context, voucherDenom string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CanonicalRouteStoreKey(voucherDenom))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// GetCanonicalWrapped returns the amount of the route voucher with the provided denomination
+// which is currently wrapped into its canonical denomination.
+func (k Keeper) GetCanonicalWrapped(ctx sdk.Context, voucherDenom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CanonicalWrappedStoreKey(voucherDenom))
+	if len(bz) == 0 {
+		return sdk.NewCoin(voucherDenom, sdkmath.ZeroInt())
+	}
+
+	amount := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &amount)
+
+	return sdk.NewCoin(voucherDenom, amount.Int)
+}
+
+// SetCanonicalWrapped stores the amount of a route voucher which is wrapped into its canonical
+// denomination. Amount is stored in state if and only if it is not equal to zero. The function
+// will panic if the amount is negative.
+func (k Keeper) SetCanonicalWrapped(ctx sdk.Context, coin sdk.Coin) {
+	if coin.Amount.IsNegative() {
+		panic(fmt.Errorf("amount cannot be negative: %s", coin.Amount))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.CanonicalWrappedStoreKey(coin.Denom)
+
+	if coin.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	store.Set(key, bz)
+}
+
+// GetAllCanonicalWrapped returns the wrapped amounts of all the route vouchers.
+func (k Keeper) GetAllCanonicalWrapped(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.CanonicalWrappedKey)
+
+	var wrapped sdk.Coins
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		voucherDenom := string(iterator.Key()[len(types.CanonicalWrappedKey):])
+
+		amount := sdk.IntProto{}
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+
+		wrapped = wrapped.Add(sdk.NewCoin(voucherDenom, amount.Int))
+	}
+
+	return wrapped
+}
+
+// registerCanonicalAsset registers the canonical asset or replaces the routes of an already
+// registered canonical asset. A route cannot belong to multiple canonical assets and a route
+// cannot be removed while any of its vouchers are wrapped.
+func (k Keeper) registerCanonicalAsset(ctx sdk.Context, asset types.CanonicalAsset) error {
+	for _, route := range asset.Routes {
+		canonicalDenom, found := k.getCanonicalDenom(ctx, route.Denom().IBCDenom())
+		if found && canonicalDenom != asset.Denom {
+			return errorsmod.Wrapf(types.ErrInvalidCanonicalAsset, "route %s already belongs to canonical asset %s", route.Path, canonicalDenom)
+		}
+	}
+
+	if existing, found := k.GetCanonicalAsset(ctx, asset.Denom); found {
+		store := ctx.KVStore(k.storeKey)
+		for _, route := range existing.Routes {
+			voucherDenom := route.Denom().IBCDenom()
+			if _, found := asset.Route(voucherDenom); found {
+				continue
+			}
+
+			if wrapped := k.GetCanonicalWrapped(ctx, voucherDenom); wrapped.IsPositive() {
+				return errorsmod.Wrapf(types.ErrInvalidCanonicalAsset, "cannot remove route %s with %s wrapped", route.Path, wrapped)
+			}
+
+			store.Delete(types.CanonicalRouteStoreKey(voucherDenom))
+		}
+	}
+
+	k.SetCanonicalAsset(ctx, asset)
+
+	return nil
+}
+
+// wrapVoucher locks the voucher in the module account and mints the same amount of its
+// canonical denomination to the sender. An error is returned if the voucher is not the
+// voucher of a canonical route or if enforceCap is set and the cap of the route would be exceeded.
+func (k Keeper) wrapVoucher(ctx sdk.Context, sender sdk.AccAddress, voucher sdk.Coin, enforceCap bool) (sdk.Coin, error) {
+	canonicalDenom, found := k.getCanonicalDenom(ctx, voucher.Denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrCanonicalAssetNotFound, "no canonical asset for voucher %s", voucher.Denom)
+	}
+
+	asset, found := k.GetCanonicalAsset(ctx, canonicalDenom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrCanonicalAssetNotFound, canonicalDenom)
+	}
+
+	route, found := asset.Route(voucher.Denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidCanonicalAsset, "voucher %s is not a route of canonical asset %s", voucher.Denom, canonicalDenom)
+	}
+
+	wrapped := k.GetCanonicalWrapped(ctx, voucher.Denom).Add(voucher)
+	if enforceCap && route.ExceedsCap(wrapped.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrCanonicalCapExceeded, "wrapping %s would exceed cap %s of route %s", voucher, route.Cap, route.Path)
+	}
+
+	canonical := sdk.NewCoin(canonicalDenom, voucher.Amount)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(voucher)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(canonical)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "failed to mint canonical tokens")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(canonical)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(err, "failed to send canonical tokens to %s", sender)
+	}
+
+	k.SetCanonicalWrapped(ctx, wrapped)
+
+	events.EmitWrapVoucherEvent(ctx, sender.String(), voucher, canonical)
+
+	return canonical, nil
+}
+
+// unwrapVoucher burns the canonical tokens of the sender and releases the same amount of the
+// voucher of the provided route to the sender. An error is returned if not enough vouchers
+// of the route are wrapped.
+func (k Keeper) unwrapVoucher(ctx sdk.Context, sender sdk.AccAddress, canonical sdk.Coin, route types.CanonicalRoute) (sdk.Coin, error) {
+	voucher := sdk.NewCoin(route.Denom().IBCDenom(), canonical.Amount)
+
+	wrapped := k.GetCanonicalWrapped(ctx, voucher.Denom)
+	if wrapped.IsLT(voucher) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInsufficientWrapped, "cannot unwrap %s into route %s with %s wrapped", canonical, route.Path, wrapped)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(canonical)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(canonical)); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balance
+		// to burn.
+		panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(voucher)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(err, "failed to send voucher to %s", sender)
+	}
+
+	k.SetCanonicalWrapped(ctx, wrapped.Sub(voucher))
+
+	events.EmitUnwrapVoucherEvent(ctx, sender.String(), canonical, voucher)
+
+	return voucher, nil
+}
+
+// unwrapCanonicalCoins unwraps the coins of canonical denominations into the vouchers of the
+// routes received over the provided port and channel, such that they are sent back toward
+// their origin. Coins of other denominations are returned unchanged. The vouchers unwrapped
+// are returned alongside the coins to send.
+func (k Keeper) unwrapCanonicalCoins(ctx sdk.Context, portID, channelID string, sender sdk.AccAddress, coins sdk.Coins) (sdk.Coins, sdk.Coins, error) {
+	unwrappedCoins := make(sdk.Coins, 0, len(coins))
+	vouchers := sdk.NewCoins()
+	for _, coin := range coins {
+		asset, found := k.GetCanonicalAsset(ctx, coin.Denom)
+		if !found {
+			unwrappedCoins = append(unwrappedCoins, coin)
+			continue
+		}
+
+		route, found := asset.RouteForChannel(portID, channelID)
+		if !found {
+			return nil, nil, errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "canonical asset %s has no route received over port ID (%s) channel ID (%s)", asset.Denom, portID, channelID)
+		}
+
+		voucherDenom := route.Denom().IBCDenom()
+		if coins.AmountOf(voucherDenom).IsPositive() {
+			return nil, nil, errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "cannot transfer both %s and its route voucher %s", asset.Denom, voucherDenom)
+		}
+
+		voucher, err := k.unwrapVoucher(ctx, sender, coin, route)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		unwrappedCoins = append(unwrappedCoins, voucher)
+		vouchers = vouchers.Add(voucher)
+	}
+
+	return unwrappedCoins, vouchers, nil
+}
+
+// rewrapPacketVouchers wraps the route vouchers unwrapped when the packet with the provided source
+// port, channel and sequence was sent back into their canonical denomination for the refund address,
+// and returns the refunded coins with the vouchers wrapped replaced by their canonical denomination.
+// The cap of the route is not enforced as the vouchers were wrapped before the packet was sent.
+// Vouchers whose route was removed from its canonical asset while the packet was in flight are
+// refunded as is.
+func (k Keeper) rewrapPacketVouchers(ctx sdk.Context, portID, channelID string, sequence uint64, refundAddress sdk.AccAddress, refundedCoins sdk.Coins) (sdk.Coins, error) {
+	unwrap, found := k.GetPacketCanonicalUnwrap(ctx, portID, channelID, sequence)
+	if !found {
+		return refundedCoins, nil
+	}
+
+	k.deletePacketCanonicalUnwrap(ctx, portID, channelID, sequence)
+
+	for _, voucher := range unwrap.Vouchers {
+		if _, found := k.getCanonicalDenom(ctx, voucher.Denom); !found {
+			continue
+		}
+
+		canonical, err := k.wrapVoucher(ctx, refundAddress, voucher, false)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to wrap refunded voucher %s", voucher)
+		}
+
+		if amount := refundedCoins.AmountOf(voucher.Denom); amount.IsPositive() {
+			refundedCoins = refundedCoins.Sub(sdk.NewCoin(voucher.Denom, amount)).Add(sdk.NewCoin(canonical.Denom, amount))
+		}
+	}
+
+	return refundedCoins, nil
+}
+
+// GetPacketCanonicalUnwrap returns the route vouchers unwrapped for the packet with the provided source port, channel and sequence.
+func (k Keeper) GetPacketCanonicalUnwrap(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketCanonicalUnwrap, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketCanonicalUnwrapStoreKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketCanonicalUnwrap{}, false
+	}
+
+	var unwrap types.PacketCanonicalUnwrap
+	k.cdc.MustUnmarshal(bz, &unwrap)
+
+	return unwrap, true
+}
+
+// SetPacketCanonicalUnwrap sets the route vouchers unwrapped for a packet in the store.
+func (k Keeper) SetPacketCanonicalUnwrap(ctx sdk.Context, unwrap types.PacketCanonicalUnwrap) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&unwrap)
+	store.Set(types.PacketCanonicalUnwrapStoreKey(unwrap.PortId, unwrap.ChannelId, unwrap.Sequence), bz)
+}
+
+// deletePacketCanonicalUnwrap deletes the route vouchers unwrapped for a packet from the store.
+func (k Keeper) deletePacketCanonicalUnwrap(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketCanonicalUnwrapStoreKey(portID, channelID, sequence))
+}
+
+// GetAllPacketCanonicalUnwraps returns the route vouchers unwrapped for all packets in flight.
+func (k Keeper) GetAllPacketCanonicalUnwraps(ctx sdk.Context) []types.PacketCanonicalUnwrap {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PacketCanonicalUnwrapKey)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	unwraps := []types.PacketCanonicalUnwrap{}
+	for ; iterator.Valid(); iterator.Next() {
+		var unwrap types.PacketCanonicalUnwrap
+		k.cdc.MustUnmarshal(iterator.Value(), &unwrap)
+
+		unwraps = append(unwraps, unwrap)
+	}
+
+	return unwraps
+}
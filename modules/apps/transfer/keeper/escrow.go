@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// GetChannelEscrow returns the amount of tokens escrowed through the transfer module for the
+// provided port and channel. Empty coins are returned if no tokens are escrowed for the channel.
+func (k Keeper) GetChannelEscrow(ctx sdk.Context, portID, channelID string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelEscrowStoreKey(portID, channelID))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var escrow types.ChannelEscrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+
+	return escrow.Amount
+}
+
+// SetChannelEscrow stores the amount of tokens escrowed for a channel. The entry of the channel
+// is deleted if no tokens are escrowed.
+func (k Keeper) SetChannelEscrow(ctx sdk.Context, escrow types.ChannelEscrow) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ChannelEscrowStoreKey(escrow.PortId, escrow.ChannelId)
+
+	if escrow.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&escrow)
+	store.Set(key, bz)
+}
+
+// GetAllChannelEscrows returns the amount of tokens escrowed for all channels.
+func (k Keeper) GetAllChannelEscrows(ctx sdk.Context) []types.ChannelEscrow {
+	escrows := []types.ChannelEscrow{}
+	k.IterateChannelEscrows(ctx, func(escrow types.ChannelEscrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+
+	return escrows
+}
+
+// IterateChannelEscrows iterates over the amount of tokens escrowed for the channels in the store
+// and performs a callback function.
+func (k Keeper) IterateChannelEscrows(ctx sdk.Context, cb func(escrow types.ChannelEscrow) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChannelEscrowKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.ChannelEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+
+		if cb(escrow) {
+			break
+		}
+	}
+}
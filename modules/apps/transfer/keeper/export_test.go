@@ -53,3 +53,8 @@ func (k Keeper) IsBlockedAddr(addr sdk.AccAddress) bool {
 func CreatePacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, hops []types.Hop) ([]byte, error) {
 	return createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, tokens, hops)
 }
+
+// GetNativeDenom is a wrapper around getNativeDenom for testing purposes.
+func (k Keeper) GetNativeDenom(ctx sdk.Context, voucherDenom string) (string, bool) {
+	return k.getNativeDenom(ctx, voucherDenom)
+}
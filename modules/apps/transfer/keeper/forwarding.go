@@ -62,7 +62,8 @@ func (k Keeper) acknowledgeForwardedPacket(ctx sdk.Context, packet, forwardedPac
 // revertForwardedPacket reverts the logic of receive packet that occurs in the middle chains during a packet forwarding.
 // If the packet fails to be forwarded all the way to the final destination, the state changes on this chain must be reverted
 // before sending back the error acknowledgement to ensure atomic packet forwarding.
-func (k Keeper) revertForwardedPacket(ctx sdk.Context, forwardedPacket channeltypes.Packet, failedPacketData types.FungibleTokenPacketDataV2) error {
+// The tokens are reverted from the refundAddress the failed packet was refunded to.
+func (k Keeper) revertForwardedPacket(ctx sdk.Context, forwardedPacket channeltypes.Packet, failedPacketData types.FungibleTokenPacketDataV2, refundAddress sdk.AccAddress) error {
 	/*
 		Recall that RecvPacket handles an incoming packet depending on the denom of the received funds:
 			1. If the funds are native, then the amount is sent to the receiver from the escrow.
@@ -73,7 +74,6 @@ func (k Keeper) revertForwardedPacket(ctx sdk.Context, forwardedPacket channelty
 	*/
 
 	forwardingAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
-	escrow := types.GetEscrowAddress(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel)
 
 	// we can iterate over the received tokens of forwardedPacket by iterating over the sent tokens of failedPacketData
 	for _, token := range failedPacketData.Tokens {
@@ -83,16 +83,31 @@ func (k Keeper) revertForwardedPacket(ctx sdk.Context, forwardedPacket channelty
 			return err
 		}
 
+		// the local denomination of a mapped asset received over the forwarded packet channel
+		// has been minted in place of the voucher during the receive step
+		_, isNative := k.getNativeMappingForChannel(ctx, coin.Denom, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel)
+
 		// check if the token we received originated on the sender
 		// given that the packet is being reversed, we check the DestinationChannel and DestinationPort
 		// of the forwardedPacket to see if a hop was added to the trace during the receive step
-		if token.Denom.HasPrefix(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel) {
+		if isNative || token.Denom.HasPrefix(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel) {
+			if !refundAddress.Equals(forwardingAddr) {
+				if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddress, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+					return err
+				}
+			}
 			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
 				return err
 			}
+
+			if isNative {
+				if err := k.burnedNativeCoin(ctx, coin); err != nil {
+					return err
+				}
+			}
 		} else {
 			// send it back to the escrow address
-			if err := k.escrowCoin(ctx, forwardingAddr, escrow, coin); err != nil {
+			if err := k.escrowCoin(ctx, refundAddress, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, coin); err != nil {
 				return err
 			}
 		}
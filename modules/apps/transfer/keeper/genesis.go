@@ -35,14 +35,62 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, rules := range state.ChannelDenomRules {
+		k.SetChannelDenomRules(ctx, rules)
+	}
+
+	for _, asset := range state.CanonicalAssets {
+		k.SetCanonicalAsset(ctx, asset)
+	}
+
+	for _, wrapped := range state.CanonicalWrapped {
+		k.SetCanonicalWrapped(ctx, wrapped)
+	}
+
+	k.SetProtocolFeeConfig(ctx, state.ProtocolFeeConfig)
+
+	for _, packetFee := range state.PacketProtocolFees {
+		k.SetPacketProtocolFee(ctx, packetFee)
+	}
+
+	for _, refund := range state.PacketRefundAddresses {
+		k.SetPacketRefundAddress(ctx, refund)
+	}
+
+	for _, escrow := range state.ChannelEscrows {
+		k.SetChannelEscrow(ctx, escrow)
+	}
+
+	for _, mapping := range state.NativeMappings {
+		k.SetNativeMapping(ctx, mapping)
+	}
+
+	for _, minted := range state.NativeMinted {
+		k.SetNativeMinted(ctx, minted)
+	}
+
+	for _, unwrap := range state.PacketCanonicalUnwraps {
+		k.SetPacketCanonicalUnwrap(ctx, unwrap)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		Denoms:        k.GetAllDenoms(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:                 k.GetPort(ctx),
+		Denoms:                 k.GetAllDenoms(ctx),
+		Params:                 k.GetParams(ctx),
+		TotalEscrowed:          k.GetAllTotalEscrowed(ctx),
+		ChannelDenomRules:      k.GetAllChannelDenomRules(ctx),
+		CanonicalAssets:        k.GetAllCanonicalAssets(ctx),
+		CanonicalWrapped:       k.GetAllCanonicalWrapped(ctx),
+		ProtocolFeeConfig:      k.GetProtocolFeeConfig(ctx),
+		PacketProtocolFees:     k.GetAllPacketProtocolFees(ctx),
+		PacketRefundAddresses:  k.GetAllPacketRefundAddresses(ctx),
+		ChannelEscrows:         k.GetAllChannelEscrows(ctx),
+		NativeMappings:         k.GetAllNativeMappings(ctx),
+		NativeMinted:           k.GetAllNativeMinted(ctx),
+		PacketCanonicalUnwraps: k.GetAllPacketCanonicalUnwraps(ctx),
 	}
 }
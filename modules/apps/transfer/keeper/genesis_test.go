@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
@@ -42,11 +43,44 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), escrow)
 	}
 
+	rules := types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"uatom"}, 1)
+	suite.chainA.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainA.GetContext(), rules)
+
+	asset := types.NewCanonicalAsset("canonical/atom", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt()))
+	suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), asset)
+	wrapped := sdk.NewCoin(asset.Routes[0].Denom().IBCDenom(), sdkmath.NewInt(10))
+	suite.chainA.GetSimApp().TransferKeeper.SetCanonicalWrapped(suite.chainA.GetContext(), wrapped)
+
+	feeConfig := types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee("transfer", "channel-0", 100, nil))
+	suite.chainA.GetSimApp().TransferKeeper.SetProtocolFeeConfig(suite.chainA.GetContext(), feeConfig)
+	packetFee := types.NewPacketProtocolFee("transfer", "channel-0", 1, ibctesting.TestAccAddress, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1))))
+	suite.chainA.GetSimApp().TransferKeeper.SetPacketProtocolFee(suite.chainA.GetContext(), packetFee)
+	refund := types.NewPacketRefundAddress("transfer", "channel-0", 1, ibctesting.TestAccAddress)
+	suite.chainA.GetSimApp().TransferKeeper.SetPacketRefundAddress(suite.chainA.GetContext(), refund)
+	channelEscrow := types.NewChannelEscrow("transfer", "channel-0", sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(10))))
+	suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), channelEscrow)
+	nativeMapping := types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", ibctesting.TestAccAddress, sdkmath.ZeroInt())
+	suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), nativeMapping)
+	nativeMinted := sdk.NewCoin("uusdc", sdkmath.NewInt(10))
+	suite.chainA.GetSimApp().TransferKeeper.SetNativeMinted(suite.chainA.GetContext(), nativeMinted)
+	unwrap := types.NewPacketCanonicalUnwrap("transfer", "channel-0", 1, sdk.NewCoins(wrapped))
+	suite.chainA.GetSimApp().TransferKeeper.SetPacketCanonicalUnwrap(suite.chainA.GetContext(), unwrap)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(denoms.Sort(), genesis.Denoms)
 	suite.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	suite.Require().Equal([]types.ChannelDenomRules{rules}, genesis.ChannelDenomRules)
+	suite.Require().Equal([]types.CanonicalAsset{asset}, genesis.CanonicalAssets)
+	suite.Require().Equal(sdk.NewCoins(wrapped), genesis.CanonicalWrapped)
+	suite.Require().Equal(feeConfig, genesis.ProtocolFeeConfig)
+	suite.Require().Equal([]types.PacketProtocolFee{packetFee}, genesis.PacketProtocolFees)
+	suite.Require().Equal([]types.PacketRefundAddress{refund}, genesis.PacketRefundAddresses)
+	suite.Require().Equal([]types.ChannelEscrow{channelEscrow}, genesis.ChannelEscrows)
+	suite.Require().Equal([]types.NativeMapping{nativeMapping}, genesis.NativeMappings)
+	suite.Require().Equal(sdk.NewCoins(nativeMinted), genesis.NativeMinted)
+	suite.Require().Equal([]types.PacketCanonicalUnwrap{unwrap}, genesis.PacketCanonicalUnwraps)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
@@ -159,3 +159,232 @@ func (k Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscr
 		Amount: amount,
 	}, nil
 }
+
+// ChannelDenomRules implements the ChannelDenomRules gRPC method.
+func (k Keeper) ChannelDenomRules(c context.Context, req *types.QueryChannelDenomRulesRequest) (*types.QueryChannelDenomRulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rules, found := k.GetChannelDenomRules(ctx, req.PortId, req.ChannelId)
+	if !found {
+		// channels without rules place no restriction on the tokens transferred
+		rules = types.NewChannelDenomRules(req.PortId, req.ChannelId, types.DENOM_FILTER_NONE, nil, 0)
+	}
+
+	return &types.QueryChannelDenomRulesResponse{
+		Rules: rules,
+	}, nil
+}
+
+// AllChannelDenomRules implements the AllChannelDenomRules gRPC method.
+func (k Keeper) AllChannelDenomRules(c context.Context, req *types.QueryAllChannelDenomRulesRequest) (*types.QueryAllChannelDenomRulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var rules []types.ChannelDenomRules
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelDenomRulesKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var channelRules types.ChannelDenomRules
+		if err := k.cdc.Unmarshal(value, &channelRules); err != nil {
+			return err
+		}
+
+		rules = append(rules, channelRules)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllChannelDenomRulesResponse{
+		Rules:      rules,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ChannelEscrow implements the ChannelEscrow gRPC method.
+func (k Keeper) ChannelEscrow(c context.Context, req *types.QueryChannelEscrowRequest) (*types.QueryChannelEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	escrowed := k.GetChannelEscrow(ctx, req.PortId, req.ChannelId)
+	balance := k.bankKeeper.GetAllBalances(ctx, types.GetEscrowAddress(req.PortId, req.ChannelId))
+
+	return &types.QueryChannelEscrowResponse{
+		Escrowed: escrowed,
+		Balance:  balance,
+	}, nil
+}
+
+// ChannelEscrows implements the ChannelEscrows gRPC method.
+func (k Keeper) ChannelEscrows(c context.Context, req *types.QueryChannelEscrowsRequest) (*types.QueryChannelEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var escrows []types.ChannelEscrow
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelEscrowKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var escrow types.ChannelEscrow
+		if err := k.cdc.Unmarshal(value, &escrow); err != nil {
+			return err
+		}
+
+		escrows = append(escrows, escrow)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelEscrowsResponse{
+		Escrows:    escrows,
+		Pagination: pageRes,
+	}, nil
+}
+
+// CanonicalAsset implements the CanonicalAsset gRPC method.
+func (k Keeper) CanonicalAsset(c context.Context, req *types.QueryCanonicalAssetRequest) (*types.QueryCanonicalAssetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	asset, found := k.GetCanonicalAsset(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrCanonicalAssetNotFound, req.Denom).Error(),
+		)
+	}
+
+	var wrapped sdk.Coins
+	for _, route := range asset.Routes {
+		if coin := k.GetCanonicalWrapped(ctx, route.Denom().IBCDenom()); coin.IsPositive() {
+			wrapped = wrapped.Add(coin)
+		}
+	}
+
+	return &types.QueryCanonicalAssetResponse{
+		Asset:   asset,
+		Wrapped: wrapped,
+	}, nil
+}
+
+// CanonicalAssets implements the CanonicalAssets gRPC method.
+func (k Keeper) CanonicalAssets(c context.Context, req *types.QueryCanonicalAssetsRequest) (*types.QueryCanonicalAssetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var assets []types.CanonicalAsset
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CanonicalAssetKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var asset types.CanonicalAsset
+		if err := k.cdc.Unmarshal(value, &asset); err != nil {
+			return err
+		}
+
+		assets = append(assets, asset)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCanonicalAssetsResponse{
+		Assets:     assets,
+		Pagination: pageRes,
+	}, nil
+}
+
+// NativeMapping implements the NativeMapping gRPC method.
+func (k Keeper) NativeMapping(c context.Context, req *types.QueryNativeMappingRequest) (*types.QueryNativeMappingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.LocalDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	mapping, found := k.GetNativeMapping(ctx, req.LocalDenom)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrInvalidNativeMapping, req.LocalDenom).Error())
+	}
+
+	return &types.QueryNativeMappingResponse{
+		Mapping: mapping,
+		Minted:  k.GetNativeMinted(ctx, req.LocalDenom),
+	}, nil
+}
+
+// NativeMappings implements the NativeMappings gRPC method.
+func (k Keeper) NativeMappings(c context.Context, req *types.QueryNativeMappingsRequest) (*types.QueryNativeMappingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var mappings []types.NativeMapping
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NativeMappingKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var mapping types.NativeMapping
+		if err := k.cdc.Unmarshal(value, &mapping); err != nil {
+			return err
+		}
+
+		mappings = append(mappings, mapping)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNativeMappingsResponse{
+		Mappings:   mappings,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ProtocolFeeConfig implements the ProtocolFeeConfig gRPC method.
+func (k Keeper) ProtocolFeeConfig(c context.Context, req *types.QueryProtocolFeeConfigRequest) (*types.QueryProtocolFeeConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryProtocolFeeConfigResponse{
+		Config: k.GetProtocolFeeConfig(ctx),
+	}, nil
+}
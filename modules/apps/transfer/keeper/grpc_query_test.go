@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
@@ -398,3 +399,435 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestChannelDenomRules() {
+	var (
+		req      *types.QueryChannelDenomRulesRequest
+		expRules types.ChannelDenomRules
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: channel with rules",
+			func() {
+				expRules = types.NewChannelDenomRules(ibctesting.TransferPort, ibctesting.FirstChannelID, types.DENOM_FILTER_ALLOW, []string{sdk.DefaultBondDenom}, 1)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainA.GetContext(), expRules)
+			},
+			true,
+		},
+		{
+			"success: channel without rules",
+			func() {
+				expRules = types.NewChannelDenomRules(ibctesting.TransferPort, ibctesting.FirstChannelID, types.DENOM_FILTER_NONE, nil, 0)
+			},
+			true,
+		},
+		{
+			"failure - empty channelID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"failure - empty portID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryChannelDenomRulesRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ChannelDenomRules(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRules, res.Rules)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAllChannelDenomRules() {
+	var (
+		req      *types.QueryAllChannelDenomRulesRequest
+		expRules []types.ChannelDenomRules
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryAllChannelDenomRulesRequest{}
+			},
+		},
+		{
+			"success",
+			func() {
+				expRules = make([]types.ChannelDenomRules, 0, 5)
+
+				for i := 0; i < 5; i++ {
+					rules := types.NewChannelDenomRules(ibctesting.TransferPort, fmt.Sprintf("channel-%d", i), types.DENOM_FILTER_DENY, []string{sdk.DefaultBondDenom}, 0)
+					suite.chainA.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainA.GetContext(), rules)
+					expRules = append(expRules, rules)
+				}
+
+				req = &types.QueryAllChannelDenomRulesRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expRules = nil
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.AllChannelDenomRules(suite.chainA.GetContext(), req)
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(expRules, res.Rules)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelEscrow() {
+	var (
+		req         *types.QueryChannelEscrowRequest
+		expEscrowed sdk.Coins
+		expBalance  sdk.Coins
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: channel with tokens in escrow",
+			func() {
+				coin := ibctesting.TestCoin
+				escrowAddress := types.GetEscrowAddress(ibctesting.TransferPort, ibctesting.FirstChannelID)
+				suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), types.NewChannelEscrow(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.NewCoins(coin)))
+
+				expEscrowed = sdk.NewCoins(coin)
+				expBalance = sdk.NewCoins(coin)
+			},
+			true,
+		},
+		{
+			"success: channel without tokens in escrow",
+			func() {
+				expEscrowed = sdk.NewCoins()
+				expBalance = sdk.NewCoins()
+			},
+			true,
+		},
+		{
+			"failure - empty channelID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"failure - empty portID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryChannelEscrowRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ChannelEscrow(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expEscrowed, res.Escrowed)
+				suite.Require().Equal(expBalance, res.Balance)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelEscrows() {
+	var (
+		req        *types.QueryChannelEscrowsRequest
+		expEscrows []types.ChannelEscrow
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryChannelEscrowsRequest{}
+			},
+		},
+		{
+			"success",
+			func() {
+				expEscrows = make([]types.ChannelEscrow, 0, 5)
+
+				for i := 0; i < 5; i++ {
+					escrow := types.NewChannelEscrow(ibctesting.TransferPort, fmt.Sprintf("channel-%d", i), sdk.NewCoins(ibctesting.TestCoin))
+					suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), escrow)
+					expEscrows = append(expEscrows, escrow)
+				}
+
+				req = &types.QueryChannelEscrowsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expEscrows = nil
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ChannelEscrows(suite.chainA.GetContext(), req)
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(expEscrows, res.Escrows)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCanonicalAsset() {
+	var (
+		req        *types.QueryCanonicalAssetRequest
+		expWrapped sdk.Coins
+	)
+
+	route := types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt())
+	asset := types.NewCanonicalAsset("canonical/usdc", route, types.NewCanonicalRoute("transfer/channel-1/uusdc", sdkmath.NewInt(1000)))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with wrapped vouchers",
+			func() {
+				wrapped := sdk.NewCoin(route.Denom().IBCDenom(), sdkmath.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalWrapped(suite.chainA.GetContext(), wrapped)
+
+				expWrapped = sdk.NewCoins(wrapped)
+			},
+			true,
+		},
+		{
+			"failure: canonical asset not found",
+			func() {
+				req.Denom = "canonical/atom"
+			},
+			false,
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				req.Denom = "0canonical"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), asset)
+
+			req = &types.QueryCanonicalAssetRequest{Denom: asset.Denom}
+			expWrapped = nil
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.CanonicalAsset(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(asset, res.Asset)
+				suite.Require().Equal(expWrapped, res.Wrapped)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestNativeMapping() {
+	var (
+		req       *types.QueryNativeMappingRequest
+		expMinted sdk.Coin
+	)
+
+	mapping := types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", ibctesting.TestAccAddress, sdkmath.NewInt(1000))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with minted tokens",
+			func() {
+				expMinted = sdk.NewCoin(mapping.LocalDenom, sdkmath.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMinted(suite.chainA.GetContext(), expMinted)
+			},
+			true,
+		},
+		{
+			"failure: native mapping not found",
+			func() {
+				req.LocalDenom = "uatom"
+			},
+			false,
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				req.LocalDenom = "0uusdc"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), mapping)
+
+			req = &types.QueryNativeMappingRequest{LocalDenom: mapping.LocalDenom}
+			expMinted = sdk.NewCoin(mapping.LocalDenom, sdkmath.ZeroInt())
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.NativeMapping(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(mapping, res.Mapping)
+				suite.Require().Equal(expMinted, res.Minted)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestNativeMappings() {
+	suite.SetupTest()
+
+	expMappings := []types.NativeMapping{
+		types.NewNativeMapping("uatom", "transfer/channel-1/uatom", "", sdkmath.ZeroInt()),
+		types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", "", sdkmath.ZeroInt()),
+	}
+	for _, mapping := range expMappings {
+		suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), mapping)
+	}
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.NativeMappings(suite.chainA.GetContext(), &types.QueryNativeMappingsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expMappings, res.Mappings)
+}
+
+func (suite *KeeperTestSuite) TestCanonicalAssets() {
+	suite.SetupTest()
+
+	expAssets := make([]types.CanonicalAsset, 0, 3)
+	for i := 0; i < 3; i++ {
+		asset := types.NewCanonicalAsset(fmt.Sprintf("canonical/asset%d", i), types.NewCanonicalRoute(fmt.Sprintf("transfer/channel-%d/uasset", i), sdkmath.ZeroInt()))
+		suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), asset)
+		expAssets = append(expAssets, asset)
+	}
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.CanonicalAssets(suite.chainA.GetContext(), &types.QueryCanonicalAssetsRequest{
+		Pagination: &query.PageRequest{Limit: 3},
+	})
+
+	suite.Require().NoError(err)
+	suite.Require().Equal(expAssets, res.Assets)
+}
+
+func (suite *KeeperTestSuite) TestQueryProtocolFeeConfig() {
+	ctx := suite.chainA.GetContext()
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.ProtocolFeeConfig(ctx, &types.QueryProtocolFeeConfigRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultProtocolFeeConfig(), res.Config)
+
+	config := types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee(ibctesting.TransferPort, ibctesting.FirstChannelID, 100, nil))
+	suite.chainA.GetSimApp().TransferKeeper.SetProtocolFeeConfig(ctx, config)
+
+	res, err = suite.chainA.GetSimApp().TransferKeeper.ProtocolFeeConfig(ctx, &types.QueryProtocolFeeConfigRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(config, res.Config)
+}
@@ -12,12 +12,19 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom",
 		TotalEscrowPerDenomInvariants(k))
+	ir.RegisterRoute(types.ModuleName, "channel-escrow",
+		ChannelEscrowInvariants(k))
 }
 
 // AllInvariants runs all invariants of the transfer module.
 func AllInvariants(k *Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return TotalEscrowPerDenomInvariants(k)(ctx)
+		res, stop := TotalEscrowPerDenomInvariants(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ChannelEscrowInvariants(k)(ctx)
 	}
 }
 
@@ -49,3 +56,36 @@ func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
 		return "", false
 	}
 }
+
+// ChannelEscrowInvariants checks that the balance of the escrow address of each
+// channel is not smaller than the amount escrowed for the channel stored in state.
+func ChannelEscrowInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateChannelEscrows(ctx, func(escrow types.ChannelEscrow) bool {
+			escrowAddress := types.GetEscrowAddress(escrow.PortId, escrow.ChannelId)
+			escrowBalances := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
+
+			// the escrow address balance must be greater than or equal to the tracked amount for all denominations
+			if !escrowBalances.IsAllGTE(escrow.Amount) {
+				msg += fmt.Sprintf("\tchannel %s/%s: actual escrowed: %s, expected escrowed: %s\n", escrow.PortId, escrow.ChannelId, escrowBalances, escrow.Amount)
+				broken = true
+			}
+
+			return false
+		})
+
+		if broken {
+			return sdk.FormatInvariant(
+				types.ModuleName,
+				"channel escrow invariance",
+				fmt.Sprintf("found channel(s) with escrow balance lower than expected:\n%s", msg)), true
+		}
+
+		return "", false
+	}
+}
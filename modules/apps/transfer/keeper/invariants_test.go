@@ -4,6 +4,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
@@ -77,3 +78,71 @@ func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestChannelEscrowInvariant() {
+	testCases := []struct {
+		name     string
+		malleate func(path *ibctesting.Path)
+		expPass  bool
+	}{
+		{
+			"success",
+			func(path *ibctesting.Path) {},
+			true,
+		},
+		{
+			"success with tokens sent directly to the escrow address",
+			func(path *ibctesting.Path) {
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(ibctesting.TestCoin)))
+			},
+			true,
+		},
+		{
+			"fails with broken invariant",
+			func(path *ibctesting.Path) {
+				// set amount for channel higher than actual value in escrow
+				amount := ibctesting.TestCoin.Amount.Add(sdkmath.NewInt(100))
+				escrow := types.NewChannelEscrow(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)))
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), escrow)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainA.GetTimeoutHeight(), 0, "",
+				nil,
+			)
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			tc.malleate(path)
+
+			out, broken := keeper.ChannelEscrowInvariants(&suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken)
+				suite.Require().Empty(out)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().NotEmpty(out)
+			}
+		})
+	}
+}
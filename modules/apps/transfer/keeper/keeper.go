@@ -37,6 +37,9 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	scopedKeeper  exported.ScopedKeeper
 
+	refundHook    types.RefundHook
+	receiverHooks map[string]types.ReceiverHook
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -74,6 +77,7 @@ func NewKeeper(
 		authKeeper:     authKeeper,
 		bankKeeper:     bankKeeper,
 		scopedKeeper:   scopedKeeper,
+		receiverHooks:  make(map[string]types.ReceiverHook),
 		authority:      authority,
 	}
 }
@@ -222,6 +226,55 @@ func (k Keeper) setDenomMetadata(ctx sdk.Context, denom types.Denom) {
 	k.bankKeeper.SetDenomMetaData(ctx, metadata)
 }
 
+// setCounterpartyDenomMetadata sets an IBC token's denomination metadata from the metadata
+// propagated by the sending chain. The base denomination of the sending chain is replaced by
+// the IBC denomination of the token. The description, name and symbol are derived from the
+// denomination path as for tokens received without metadata, such that the sending chain
+// cannot impersonate other assets; they can be overridden through governance. If the resulting
+// metadata is invalid, the metadata is synthesized from the denomination instead.
+func (k Keeper) setCounterpartyDenomMetadata(ctx sdk.Context, denom types.Denom, counterpartyMetadata banktypes.Metadata) {
+	voucherDenom := denom.IBCDenom()
+
+	metadata := counterpartyMetadata
+	metadata.Base = voucherDenom
+	metadata.Description = fmt.Sprintf("IBC token from %s", denom.Path())
+	metadata.Name = fmt.Sprintf("%s IBC token", denom.Path())
+	metadata.Symbol = strings.ToUpper(denom.Base)
+	if metadata.Display == counterpartyMetadata.Base {
+		metadata.Display = voucherDenom
+	}
+
+	metadata.DenomUnits = make([]*banktypes.DenomUnit, len(counterpartyMetadata.DenomUnits))
+	for i, unit := range counterpartyMetadata.DenomUnits {
+		denomUnit := *unit
+		if denomUnit.Denom == counterpartyMetadata.Base {
+			denomUnit.Denom = voucherDenom
+		}
+
+		metadata.DenomUnits[i] = &denomUnit
+	}
+
+	if err := metadata.Validate(); err != nil {
+		k.Logger(ctx).Error("invalid counterparty denomination metadata", "denom", voucherDenom, "error", err)
+		k.setDenomMetadata(ctx, denom)
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+}
+
+// getSendDenomMetadata returns the bank metadata of a denomination to be propagated in the
+// packet data of a transfer. Metadata that is not valid, such as the metadata synthesized
+// for IBC tokens received without propagated metadata, is not propagated.
+func (k Keeper) getSendDenomMetadata(ctx sdk.Context, denom string) *banktypes.Metadata {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found || metadata.Validate() != nil {
+		return nil
+	}
+
+	return &metadata
+}
+
 // GetTotalEscrowForDenom gets the total amount of source chain tokens that
 // are in escrow, keyed by the denomination.
 //
@@ -337,11 +390,80 @@ func (k Keeper) deleteForwardedPacket(ctx sdk.Context, portID, channelID string,
 	store.Delete(packetKey)
 }
 
+// GetChannelDenomRules returns the denomination rules of the provided port and channel.
+func (k Keeper) GetChannelDenomRules(ctx sdk.Context, portID, channelID string) (types.ChannelDenomRules, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelDenomRulesStoreKey(portID, channelID))
+	if bz == nil {
+		return types.ChannelDenomRules{}, false
+	}
+
+	var rules types.ChannelDenomRules
+	k.cdc.MustUnmarshal(bz, &rules)
+
+	return rules, true
+}
+
+// SetChannelDenomRules sets the denomination rules of a channel in the store. The rules
+// of the channel are deleted if they do not place any restriction.
+func (k Keeper) SetChannelDenomRules(ctx sdk.Context, rules types.ChannelDenomRules) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ChannelDenomRulesStoreKey(rules.PortId, rules.ChannelId)
+
+	if rules.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&rules)
+	store.Set(key, bz)
+}
+
+// GetAllChannelDenomRules returns the denomination rules of all channels.
+func (k Keeper) GetAllChannelDenomRules(ctx sdk.Context) []types.ChannelDenomRules {
+	rules := []types.ChannelDenomRules{}
+	k.IterateChannelDenomRules(ctx, func(channelRules types.ChannelDenomRules) bool {
+		rules = append(rules, channelRules)
+		return false
+	})
+
+	return rules
+}
+
+// IterateChannelDenomRules iterates over the denomination rules of the channels in the store
+// and performs a callback function.
+func (k Keeper) IterateChannelDenomRules(ctx sdk.Context, cb func(rules types.ChannelDenomRules) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChannelDenomRulesKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var rules types.ChannelDenomRules
+		k.cdc.MustUnmarshal(iterator.Value(), &rules)
+
+		if cb(rules) {
+			break
+		}
+	}
+}
+
+// validateChannelDenom returns an error if the denomination rules of the provided port and
+// channel do not allow the denomination, as represented on this chain, to be transferred.
+func (k Keeper) validateChannelDenom(ctx sdk.Context, portID, channelID string, denom types.Denom) error {
+	rules, found := k.GetChannelDenomRules(ctx, portID, channelID)
+	if !found {
+		return nil
+	}
+
+	return rules.ValidateDenom(denom)
+}
+
 // IsBlockedAddr checks if the given address is allowed to send or receive tokens.
-// The module account is always allowed to send and receive tokens.
+// The module account and the module accounts with a registered receiver hook are
+// always allowed to send and receive tokens.
 func (k Keeper) isBlockedAddr(addr sdk.AccAddress) bool {
 	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
-	if addr.Equals(moduleAddr) {
+	if addr.Equals(moduleAddr) || k.hasReceiverHook(addr) {
 		return false
 	}
 
@@ -336,6 +336,31 @@ func (suite *KeeperTestSuite) TestUnsetParams() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestSetGetChannelDenomRules() {
+	suite.SetupTest()
+
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	_, found := transferKeeper.GetChannelDenomRules(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID)
+	suite.Require().False(found)
+
+	rules := types.NewChannelDenomRules(ibctesting.TransferPort, ibctesting.FirstChannelID, types.DENOM_FILTER_ALLOW, []string{sdk.DefaultBondDenom}, 2)
+	transferKeeper.SetChannelDenomRules(ctx, rules)
+
+	storedRules, found := transferKeeper.GetChannelDenomRules(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(rules, storedRules)
+	suite.Require().Equal([]types.ChannelDenomRules{rules}, transferKeeper.GetAllChannelDenomRules(ctx))
+
+	// rules placing no restriction remove the rules of the channel
+	transferKeeper.SetChannelDenomRules(ctx, types.NewChannelDenomRules(ibctesting.TransferPort, ibctesting.FirstChannelID, types.DENOM_FILTER_NONE, nil, 0))
+
+	_, found = transferKeeper.GetChannelDenomRules(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID)
+	suite.Require().False(found)
+	suite.Require().Empty(transferKeeper.GetAllChannelDenomRules(ctx))
+}
+
 func (suite *KeeperTestSuite) TestWithICS4Wrapper() {
 	suite.SetupTest()
 
@@ -72,6 +72,23 @@ func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateChannelEscrow backfills the amount of tokens escrowed for each transfer channel
+// from the balances of the channel escrow addresses.
+func (m Migrator) MigrateChannelEscrow(ctx sdk.Context) error {
+	portID := m.keeper.GetPort(ctx)
+
+	transferChannels := m.keeper.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
+	for _, channel := range transferChannels {
+		escrowAddress := types.GetEscrowAddress(portID, channel.ChannelId)
+		escrowBalances := m.keeper.bankKeeper.GetAllBalances(ctx, escrowAddress)
+
+		m.keeper.SetChannelEscrow(ctx, types.NewChannelEscrow(portID, channel.ChannelId, escrowBalances))
+	}
+
+	m.keeper.Logger(ctx).Info("successfully set channel escrows", "number of channels", len(transferChannels))
+	return nil
+}
+
 // MigrateDenomTraceToDenom migrates storage from using DenomTrace to Denom.
 func (m Migrator) MigrateDenomTraceToDenom(ctx sdk.Context) error {
 	var (
@@ -304,6 +304,68 @@ func (suite *KeeperTestSuite) TestMigrateTotalEscrowForDenom() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigrateChannelEscrow() {
+	var (
+		path      *ibctesting.Path
+		extraPath *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expEscrows func() []transfertypes.ChannelEscrow
+	}{
+		{
+			"success: no tokens escrowed",
+			func() {},
+			func() []transfertypes.ChannelEscrow {
+				return []transfertypes.ChannelEscrow{}
+			},
+		},
+		{
+			"success: tokens escrowed in two channels",
+			func() {
+				extraPath = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				extraPath.Setup()
+
+				voucherDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				escrowAddress1 := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowAddress2 := transfertypes.GetEscrowAddress(extraPath.EndpointA.ChannelConfig.PortID, extraPath.EndpointA.ChannelID)
+
+				// funds the escrow accounts to have balance
+				suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress1, sdk.NewCoins(ibctesting.TestCoin, sdk.NewCoin(voucherDenom.IBCDenom(), sdkmath.NewInt(100)))))
+				suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress2, sdk.NewCoins(ibctesting.SecondaryTestCoin)))
+			},
+			func() []transfertypes.ChannelEscrow {
+				voucherDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				return []transfertypes.ChannelEscrow{
+					transfertypes.NewChannelEscrow(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoins(ibctesting.TestCoin, sdk.NewCoin(voucherDenom.IBCDenom(), sdkmath.NewInt(100)))),
+					transfertypes.NewChannelEscrow(extraPath.EndpointA.ChannelConfig.PortID, extraPath.EndpointA.ChannelID, sdk.NewCoins(ibctesting.SecondaryTestCoin)),
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate() // explicitly fund escrow accounts
+
+			migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+			suite.Require().NoError(migrator.MigrateChannelEscrow(suite.chainA.GetContext()))
+
+			escrows := suite.chainA.GetSimApp().TransferKeeper.GetAllChannelEscrows(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expEscrows(), escrows)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 	var (
 		denomTraces      []internaltransfertypes.DenomTrace
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"slices"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -37,6 +38,17 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	if msg.RefundAddress != "" {
+		refundAddress, err := sdk.AccAddressFromBech32(msg.RefundAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.isBlockedAddr(refundAddress) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", refundAddress)
+		}
+	}
+
 	if msg.Forwarding.GetUnwind() {
 		msg, err = k.unwindHops(ctx, msg)
 		if err != nil {
@@ -51,6 +63,11 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, err
 	}
 
+	// the refund address is stored locally and is not sent in the packet data
+	if msg.RefundAddress != "" {
+		k.SetPacketRefundAddress(ctx, types.NewPacketRefundAddress(msg.SourcePort, msg.SourceChannel, sequence, msg.RefundAddress))
+	}
+
 	k.Logger(ctx).Info("IBC fungible token transfer", "tokens", coins, "sender", msg.Sender, "receiver", msg.Receiver)
 
 	return &types.MsgTransferResponse{Sequence: sequence}, nil
@@ -68,6 +85,126 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// UpdateChannelDenomRules defines an rpc handler method for MsgUpdateChannelDenomRules. Updates the denomination rules of a channel.
+func (k Keeper) UpdateChannelDenomRules(goCtx context.Context, msg *types.MsgUpdateChannelDenomRules) (*types.MsgUpdateChannelDenomRulesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetChannelDenomRules(ctx, msg.Rules)
+
+	return &types.MsgUpdateChannelDenomRulesResponse{}, nil
+}
+
+// RegisterCanonicalAsset defines an rpc handler method for MsgRegisterCanonicalAsset. Registers a canonical asset or replaces its routes.
+func (k Keeper) RegisterCanonicalAsset(goCtx context.Context, msg *types.MsgRegisterCanonicalAsset) (*types.MsgRegisterCanonicalAssetResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.registerCanonicalAsset(ctx, msg.Asset); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCanonicalAssetResponse{}, nil
+}
+
+// UpdateDenomMetadata defines an rpc handler method for MsgUpdateDenomMetadata. Overrides the bank metadata of a received IBC denomination.
+func (k Keeper) UpdateDenomMetadata(goCtx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hexHash, found := strings.CutPrefix(msg.Metadata.Base, types.DenomPrefix+"/")
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "base denomination %s is not an IBC denomination", msg.Metadata.Base)
+	}
+
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	if !k.HasDenom(ctx, hash) {
+		return nil, errorsmod.Wrap(types.ErrDenomNotFound, hexHash)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+// UpdateProtocolFeeConfig defines an rpc handler method for MsgUpdateProtocolFeeConfig. Replaces the protocol fee configuration.
+func (k Keeper) UpdateProtocolFeeConfig(goCtx context.Context, msg *types.MsgUpdateProtocolFeeConfig) (*types.MsgUpdateProtocolFeeConfigResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetProtocolFeeConfig(ctx, msg.Config)
+
+	return &types.MsgUpdateProtocolFeeConfigResponse{}, nil
+}
+
+// RegisterNativeMapping defines an rpc handler method for MsgRegisterNativeMapping. Registers a native mapping or updates an existing one.
+func (k Keeper) RegisterNativeMapping(goCtx context.Context, msg *types.MsgRegisterNativeMapping) (*types.MsgRegisterNativeMappingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.registerNativeMapping(ctx, msg.Signer, msg.Mapping); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterNativeMappingResponse{}, nil
+}
+
+// WrapVoucher defines an rpc handler method for MsgWrapVoucher.
+func (k Keeper) WrapVoucher(goCtx context.Context, msg *types.MsgWrapVoucher) (*types.MsgWrapVoucherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical, err := k.wrapVoucher(ctx, sender, msg.Voucher, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrapVoucherResponse{Canonical: canonical}, nil
+}
+
+// UnwrapVoucher defines an rpc handler method for MsgUnwrapVoucher.
+func (k Keeper) UnwrapVoucher(goCtx context.Context, msg *types.MsgUnwrapVoucher) (*types.MsgUnwrapVoucherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	asset, found := k.GetCanonicalAsset(ctx, msg.Canonical.Denom)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrCanonicalAssetNotFound, msg.Canonical.Denom)
+	}
+
+	route, found := asset.Route(types.ExtractDenomFromPath(msg.RoutePath).IBCDenom())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCanonicalAsset, "%s is not a route of canonical asset %s", msg.RoutePath, asset.Denom)
+	}
+
+	voucher, err := k.unwrapVoucher(ctx, sender, msg.Canonical, route)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnwrapVoucherResponse{Voucher: voucher}, nil
+}
+
 // unwindHops unwinds the hops present in the tokens denomination and returns the message modified to reflect
 // the unwound path to take. It assumes that only a single token is present (as this is verified in ValidateBasic)
 // in the tokens list and ensures that the token is not native to the chain.
@@ -3,9 +3,13 @@ package keeper_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
@@ -246,6 +250,545 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 	}
 }
 
+// TestUpdateChannelDenomRules tests UpdateChannelDenomRules rpc handler
+func (suite *KeeperTestSuite) TestUpdateChannelDenomRules() {
+	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
+	rules := types.NewChannelDenomRules(ibctesting.TransferPort, ibctesting.FirstChannelID, types.DENOM_FILTER_DENY, []string{sdk.DefaultBondDenom}, 0)
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateChannelDenomRules
+		expError error
+	}{
+		{
+			"success: valid signer and rules",
+			types.NewMsgUpdateChannelDenomRules(signer, rules),
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			types.NewMsgUpdateChannelDenomRules(ibctesting.TestAccAddress, rules),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateChannelDenomRules(ctx, tc.msg)
+
+			storedRules, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelDenomRules(ctx, rules.PortId, rules.ChannelId)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(rules, storedRules)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+// TestUpdateDenomMetadata tests UpdateDenomMetadata rpc handler
+func (suite *KeeperTestSuite) TestUpdateDenomMetadata() {
+	var msg *types.MsgUpdateDenomMetadata
+
+	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, ibctesting.FirstChannelID))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: overrides metadata of received denomination",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: denomination not received",
+			func() {
+				otherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-100"))
+				msg.Metadata = *counterpartyMetadata(otherDenom.IBCDenom())
+			},
+			types.ErrDenomNotFound,
+		},
+		{
+			"failure: base denomination is not an IBC denomination",
+			func() {
+				msg.Metadata = *counterpartyMetadata(sdk.DefaultBondDenom)
+			},
+			types.ErrInvalidDenomForTransfer,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().TransferKeeper.SetDenom(ctx, denom)
+
+			msg = types.NewMsgUpdateDenomMetadata(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), *counterpartyMetadata(denom.IBCDenom()))
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateDenomMetadata(ctx, msg)
+
+			metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(ctx, msg.Metadata.Base)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Metadata, metadata)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+// TestUpdateProtocolFeeConfig tests UpdateProtocolFeeConfig rpc handler
+func (suite *KeeperTestSuite) TestUpdateProtocolFeeConfig() {
+	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
+	config := types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee(ibctesting.TransferPort, ibctesting.FirstChannelID, 100, nil))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateProtocolFeeConfig
+		expError error
+	}{
+		{
+			"success: valid signer and config",
+			types.NewMsgUpdateProtocolFeeConfig(signer, config),
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			types.NewMsgUpdateProtocolFeeConfig(ibctesting.TestAccAddress, config),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateProtocolFeeConfig(ctx, tc.msg)
+
+			storedConfig := suite.chainA.GetSimApp().TransferKeeper.GetProtocolFeeConfig(ctx)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(config, storedConfig)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Equal(types.DefaultProtocolFeeConfig(), storedConfig)
+			}
+		})
+	}
+}
+
+// TestRegisterCanonicalAsset tests RegisterCanonicalAsset rpc handler
+func (suite *KeeperTestSuite) TestRegisterCanonicalAsset() {
+	var (
+		signer string
+		asset  types.CanonicalAsset
+	)
+
+	routeA := types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt())
+	routeB := types.NewCanonicalRoute("transfer/channel-1/uusdc", sdkmath.NewInt(1000))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: new canonical asset",
+			func() {},
+			nil,
+		},
+		{
+			"success: replace routes of canonical asset",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), types.NewCanonicalAsset("canonical/usdc", routeA, routeB))
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalWrapped(suite.chainA.GetContext(), sdk.NewCoin(routeA.Denom().IBCDenom(), sdkmath.NewInt(100)))
+
+				asset = types.NewCanonicalAsset("canonical/usdc", routeA)
+			},
+			nil,
+		},
+		{
+			"failure: route belongs to another canonical asset",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), types.NewCanonicalAsset("canonical/usdc.e", routeB))
+			},
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: removed route has wrapped vouchers",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), types.NewCanonicalAsset("canonical/usdc", routeA, routeB))
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalWrapped(suite.chainA.GetContext(), sdk.NewCoin(routeB.Denom().IBCDenom(), sdkmath.NewInt(100)))
+
+				asset = types.NewCanonicalAsset("canonical/usdc", routeA)
+			},
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			signer = suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
+			asset = types.NewCanonicalAsset("canonical/usdc", routeA, routeB)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.RegisterCanonicalAsset(ctx, types.NewMsgRegisterCanonicalAsset(signer, asset))
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				storedAsset, found := suite.chainA.GetSimApp().TransferKeeper.GetCanonicalAsset(ctx, asset.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(asset, storedAsset)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestRegisterNativeMapping tests RegisterNativeMapping rpc handler
+func (suite *KeeperTestSuite) TestRegisterNativeMapping() {
+	var (
+		signer  string
+		mapping types.NativeMapping
+	)
+
+	admin := suite.chainA.SenderAccount.GetAddress().String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: new native mapping",
+			func() {},
+			nil,
+		},
+		{
+			"success: authority replaces mapped asset",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), types.NewNativeMapping("uusdc", "transfer/channel-1/uusdc", admin, sdkmath.ZeroInt()))
+			},
+			nil,
+		},
+		{
+			"success: admin updates supply cap",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), mapping)
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMinted(suite.chainA.GetContext(), sdk.NewCoin("uusdc", sdkmath.NewInt(100)))
+
+				signer = admin
+				mapping.SupplyCap = sdkmath.NewInt(2000)
+			},
+			nil,
+		},
+		{
+			"failure: admin replaces mapped asset",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), types.NewNativeMapping("uusdc", "transfer/channel-1/uusdc", admin, sdkmath.ZeroInt()))
+
+				signer = admin
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: new mapping registered by admin",
+			func() {
+				signer = admin
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: mapping without admin updated by other signer",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), types.NewNativeMapping("uusdc", mapping.Path, "", sdkmath.ZeroInt()))
+
+				signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: local denomination has supply",
+			func() {
+				mapping.LocalDenom = sdk.DefaultBondDenom
+			},
+			types.ErrInvalidNativeMapping,
+		},
+		{
+			"failure: asset mapped to another local denomination",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), types.NewNativeMapping("usdc", mapping.Path, "", sdkmath.ZeroInt()))
+			},
+			types.ErrInvalidNativeMapping,
+		},
+		{
+			"failure: replaced asset has minted tokens",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainA.GetContext(), types.NewNativeMapping("uusdc", "transfer/channel-1/uusdc", "", sdkmath.ZeroInt()))
+				suite.chainA.GetSimApp().TransferKeeper.SetNativeMinted(suite.chainA.GetContext(), sdk.NewCoin("uusdc", sdkmath.NewInt(100)))
+			},
+			types.ErrInvalidNativeMapping,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			signer = suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
+			mapping = types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", admin, sdkmath.NewInt(1000))
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.RegisterNativeMapping(ctx, types.NewMsgRegisterNativeMapping(signer, mapping))
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				storedMapping, found := suite.chainA.GetSimApp().TransferKeeper.GetNativeMapping(ctx, mapping.LocalDenom)
+				suite.Require().True(found)
+				suite.Require().Equal(mapping, storedMapping)
+
+				localDenom, found := suite.chainA.GetSimApp().TransferKeeper.GetNativeDenom(ctx, mapping.Denom().IBCDenom())
+				suite.Require().True(found)
+				suite.Require().Equal(mapping.LocalDenom, localDenom)
+
+				// the replaced asset is no longer mapped
+				_, found = suite.chainA.GetSimApp().TransferKeeper.GetNativeDenom(ctx, types.ExtractDenomFromPath("transfer/channel-1/uusdc").IBCDenom())
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestWrapVoucher tests WrapVoucher rpc handler
+func (suite *KeeperTestSuite) TestWrapVoucher() {
+	var (
+		path    *ibctesting.Path
+		route   types.CanonicalRoute
+		voucher sdk.Coin
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: wrapped amount equal to cap",
+			func() {
+				route.Cap = voucher.Amount
+			},
+			nil,
+		},
+		{
+			"failure: cap exceeded",
+			func() {
+				route.Cap = voucher.Amount.SubRaw(1)
+			},
+			types.ErrCanonicalCapExceeded,
+		},
+		{
+			"failure: voucher is not a canonical route",
+			func() {
+				route = types.NewCanonicalRoute(fmt.Sprintf("%s/%s/uusdc", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), sdkmath.ZeroInt())
+			},
+			types.ErrCanonicalAssetNotFound,
+		},
+		{
+			"failure: insufficient voucher balance",
+			func() {
+				voucher.Amount = voucher.Amount.AddRaw(1)
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// receive the voucher on chainA
+			denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.receiveVoucher(path)
+
+			route = types.NewCanonicalRoute(denom.Path(), sdkmath.ZeroInt())
+			voucher = sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			transferKeeper.SetCanonicalAsset(ctx, types.NewCanonicalAsset("canonical/stake", route))
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			res, err := transferKeeper.WrapVoucher(ctx, types.NewMsgWrapVoucher(sender.String(), voucher))
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				expCanonical := sdk.NewCoin("canonical/stake", voucher.Amount)
+				suite.Require().Equal(expCanonical, res.Canonical)
+				suite.Require().Equal(expCanonical, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, expCanonical.Denom))
+				suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, voucher.Denom).IsZero())
+				suite.Require().Equal(voucher, transferKeeper.GetCanonicalWrapped(ctx, voucher.Denom))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().True(transferKeeper.GetCanonicalWrapped(ctx, voucher.Denom).IsZero())
+			}
+		})
+	}
+}
+
+// TestUnwrapVoucher tests UnwrapVoucher rpc handler
+func (suite *KeeperTestSuite) TestUnwrapVoucher() {
+	var (
+		path      *ibctesting.Path
+		canonical sdk.Coin
+		routePath string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: canonical asset not found",
+			func() {
+				canonical.Denom = "canonical/atom"
+			},
+			types.ErrCanonicalAssetNotFound,
+		},
+		{
+			"failure: route not part of canonical asset",
+			func() {
+				routePath = fmt.Sprintf("%s/%s/%s", path.EndpointA.ChannelConfig.PortID, "channel-100", ibctesting.TestCoin.Denom)
+			},
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: insufficient wrapped amount",
+			func() {
+				canonical.Amount = canonical.Amount.AddRaw(1)
+			},
+			types.ErrInsufficientWrapped,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// receive the voucher on chainA and wrap it
+			denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.receiveVoucher(path)
+
+			ctx := suite.chainA.GetContext()
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			sender := suite.chainA.SenderAccount.GetAddress()
+			voucher := sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount)
+
+			transferKeeper.SetCanonicalAsset(ctx, types.NewCanonicalAsset("canonical/stake", types.NewCanonicalRoute(denom.Path(), sdkmath.ZeroInt())))
+			_, err := transferKeeper.WrapVoucher(ctx, types.NewMsgWrapVoucher(sender.String(), voucher))
+			suite.Require().NoError(err)
+
+			canonical = sdk.NewCoin("canonical/stake", voucher.Amount)
+			routePath = denom.Path()
+
+			tc.malleate()
+
+			res, err := transferKeeper.UnwrapVoucher(ctx, types.NewMsgUnwrapVoucher(sender.String(), canonical, routePath))
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(voucher, res.Voucher)
+				suite.Require().Equal(voucher, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, voucher.Denom))
+				suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, canonical.Denom).IsZero())
+				suite.Require().True(transferKeeper.GetCanonicalWrapped(ctx, voucher.Denom).IsZero())
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Equal(voucher, transferKeeper.GetCanonicalWrapped(ctx, voucher.Denom))
+			}
+		})
+	}
+}
+
+// receiveVoucher transfers the test coin from chainB to chainA over the provided path.
+func (suite *KeeperTestSuite) receiveVoucher(path *ibctesting.Path) {
+	transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoins(ibctesting.TestCoin), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "", nil)
+
+	result, err := suite.chainB.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestUnwindHops() {
 	var msg *types.MsgTransfer
 	var path *ibctesting.Path
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// GetNativeMapping returns the native mapping of the provided local denomination.
+func (k Keeper) GetNativeMapping(ctx sdk.Context, localDenom string) (types.NativeMapping, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NativeMappingStoreKey(localDenom))
+	if bz == nil {
+		return types.NativeMapping{}, false
+	}
+
+	var mapping types.NativeMapping
+	k.cdc.MustUnmarshal(bz, &mapping)
+
+	return mapping, true
+}
+
+// SetNativeMapping stores the native mapping and indexes the local denomination by the
+// voucher denomination of the mapped asset.
+func (k Keeper) SetNativeMapping(ctx sdk.Context, mapping types.NativeMapping) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&mapping)
+	store.Set(types.NativeMappingStoreKey(mapping.LocalDenom), bz)
+	store.Set(types.NativeRouteStoreKey(mapping.Denom().IBCDenom()), []byte(mapping.LocalDenom))
+}
+
+// GetAllNativeMappings returns all the registered native mappings.
+func (k Keeper) GetAllNativeMappings(ctx sdk.Context) []types.NativeMapping {
+	mappings := []types.NativeMapping{}
+	k.IterateNativeMappings(ctx, func(mapping types.NativeMapping) bool {
+		mappings = append(mappings, mapping)
+		return false
+	})
+
+	return mappings
+}
+
+// IterateNativeMappings iterates over the native mappings in the store and performs a callback function.
+func (k Keeper) IterateNativeMappings(ctx sdk.Context, cb func(mapping types.NativeMapping) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.NativeMappingKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var mapping types.NativeMapping
+		k.cdc.MustUnmarshal(iterator.Value(), &mapping)
+
+		if cb(mapping) {
+			break
+		}
+	}
+}
+
+// getNativeDenom returns the local denomination mapped to the provided voucher denomination.
+func (k Keeper) getNativeDenom(ctx sdk.Context, voucherDenom string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NativeRouteStoreKey(voucherDenom))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// GetNativeMinted returns the amount of the provided local denomination which is currently minted.
+func (k Keeper) GetNativeMinted(ctx sdk.Context, localDenom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NativeMintedStoreKey(localDenom))
+	if len(bz) == 0 {
+		return sdk.NewCoin(localDenom, sdkmath.ZeroInt())
+	}
+
+	amount := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &amount)
+
+	return sdk.NewCoin(localDenom, amount.Int)
+}
+
+// SetNativeMinted stores the amount of a local denomination which is minted. Amount is stored
+// in state if and only if it is not equal to zero. The function will panic if the amount is negative.
+func (k Keeper) SetNativeMinted(ctx sdk.Context, coin sdk.Coin) {
+	if coin.Amount.IsNegative() {
+		panic(fmt.Errorf("amount cannot be negative: %s", coin.Amount))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.NativeMintedStoreKey(coin.Denom)
+
+	if coin.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	store.Set(key, bz)
+}
+
+// GetAllNativeMinted returns the minted amounts of all the local denominations.
+func (k Keeper) GetAllNativeMinted(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.NativeMintedKey)
+
+	var minted sdk.Coins
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		localDenom := string(iterator.Key()[len(types.NativeMintedKey):])
+
+		amount := sdk.IntProto{}
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+
+		minted = minted.Add(sdk.NewCoin(localDenom, amount.Int))
+	}
+
+	return minted
+}
+
+// registerNativeMapping registers the native mapping or replaces the mapping of an already
+// registered local denomination. New mappings may only be registered by the authority and only
+// for local denominations without any supply. The admin of an existing mapping may update its
+// supply cap and admin, but not the mapped asset. The mapped asset cannot be replaced while any
+// of the local denomination is minted, and an asset cannot be mapped to multiple local denominations.
+func (k Keeper) registerNativeMapping(ctx sdk.Context, signer string, mapping types.NativeMapping) error {
+	isAuthority := k.GetAuthority() == signer
+	voucherDenom := mapping.Denom().IBCDenom()
+
+	existing, found := k.GetNativeMapping(ctx, mapping.LocalDenom)
+	switch {
+	case !found:
+		if !isAuthority {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), signer)
+		}
+
+		if supply := k.bankKeeper.GetSupply(ctx, mapping.LocalDenom); supply.IsPositive() {
+			return errorsmod.Wrapf(types.ErrInvalidNativeMapping, "local denomination %s already has a supply of %s", mapping.LocalDenom, supply)
+		}
+	case !isAuthority && (existing.Admin == "" || existing.Admin != signer):
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s or the mapping admin, got %s", k.GetAuthority(), signer)
+	case !isAuthority && existing.Path != mapping.Path:
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "the mapping admin cannot replace the asset %s mapped to %s", existing.Path, mapping.LocalDenom)
+	}
+
+	if localDenom, found := k.getNativeDenom(ctx, voucherDenom); found && localDenom != mapping.LocalDenom {
+		return errorsmod.Wrapf(types.ErrInvalidNativeMapping, "path %s is already mapped to %s", mapping.Path, localDenom)
+	}
+
+	if found && existing.Path != mapping.Path {
+		if minted := k.GetNativeMinted(ctx, mapping.LocalDenom); minted.IsPositive() {
+			return errorsmod.Wrapf(types.ErrInvalidNativeMapping, "cannot replace asset %s mapped to %s with %s minted", existing.Path, mapping.LocalDenom, minted)
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.NativeRouteStoreKey(existing.Denom().IBCDenom()))
+	}
+
+	k.SetNativeMapping(ctx, mapping)
+
+	return nil
+}
+
+// getNativeMappingForChannel returns the native mapping of the provided local denomination if the
+// mapped asset is received over the provided port and channel, such that the local denomination
+// is burned when sent over the channel.
+func (k Keeper) getNativeMappingForChannel(ctx sdk.Context, localDenom, portID, channelID string) (types.NativeMapping, bool) {
+	mapping, found := k.GetNativeMapping(ctx, localDenom)
+	if !found || !mapping.IsReceivedOver(portID, channelID) {
+		return types.NativeMapping{}, false
+	}
+
+	return mapping, true
+}
+
+// mintNativeCoin mints the provided amount of the local denomination mapped to the voucher
+// denomination to the module account. An error is returned if the supply cap of the mapping
+// would be exceeded.
+func (k Keeper) mintNativeCoin(ctx sdk.Context, localDenom string, amount sdkmath.Int) (sdk.Coin, error) {
+	mapping, found := k.GetNativeMapping(ctx, localDenom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidNativeMapping, "no native mapping for %s", localDenom)
+	}
+
+	coin := sdk.NewCoin(localDenom, amount)
+	minted := k.GetNativeMinted(ctx, localDenom).Add(coin)
+	if mapping.ExceedsCap(minted.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNativeSupplyCapExceeded, "minting %s would exceed supply cap %s", coin, mapping.SupplyCap)
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "failed to mint native tokens")
+	}
+
+	k.SetNativeMinted(ctx, minted)
+
+	return coin, nil
+}
+
+// burnedNativeCoin records that the provided coin of a local denomination has been burned.
+func (k Keeper) burnedNativeCoin(ctx sdk.Context, coin sdk.Coin) error {
+	minted := k.GetNativeMinted(ctx, coin.Denom)
+	if minted.IsLT(coin) {
+		return errorsmod.Wrapf(types.ErrInvalidNativeMapping, "cannot burn %s with %s minted", coin, minted)
+	}
+
+	k.SetNativeMinted(ctx, minted.Sub(coin))
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+const nativeDenom = "unative"
+
+// TestOnRecvPacketNativeMapping tests that a foreign asset mapped to a local denomination is
+// minted as the local denomination in place of the voucher, up to the supply cap of the mapping.
+func (suite *KeeperTestSuite) TestOnRecvPacketNativeMapping() {
+	testCases := []struct {
+		name      string
+		supplyCap sdkmath.Int
+		expPass   bool
+	}{
+		{
+			"success: no supply cap",
+			sdkmath.ZeroInt(),
+			true,
+		},
+		{
+			"success: minted amount equal to supply cap",
+			ibctesting.TestCoin.Amount,
+			true,
+		},
+		{
+			"failure: supply cap exceeded",
+			ibctesting.TestCoin.Amount.SubRaw(1),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			mapping := types.NewNativeMapping(nativeDenom, fmt.Sprintf("%s/%s/%s", path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom), "", tc.supplyCap)
+			suite.chainB.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainB.GetContext(), mapping)
+
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+				nil,
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			nativeBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, nativeDenom)
+			voucherBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, mapping.Denom().IBCDenom())
+			minted := suite.chainB.GetSimApp().TransferKeeper.GetNativeMinted(suite.chainB.GetContext(), nativeDenom)
+
+			// vouchers of the mapped asset are never minted
+			suite.Require().True(voucherBalance.IsZero())
+
+			if tc.expPass {
+				suite.Require().Equal(ibctesting.TestCoin.Amount, nativeBalance.Amount)
+				suite.Require().Equal(ibctesting.TestCoin.Amount, minted.Amount)
+			} else {
+				suite.Require().True(nativeBalance.IsZero())
+				suite.Require().True(minted.IsZero())
+
+				// the sender is refunded on the error acknowledgement
+				suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
+
+// TestSendTransferNativeMapping tests that the local denomination of a mapped asset is burned when
+// sent back over the channel the asset is received over, and minted back if the packet fails.
+func (suite *KeeperTestSuite) TestSendTransferNativeMapping() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: metadata of the local denomination is not propagated",
+			func() {
+				suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), *counterpartyMetadata(nativeDenom))
+			},
+			true,
+		},
+		{
+			"failure: local denomination minted back on refund",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.ReceiveEnabled = false
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			mapping := types.NewNativeMapping(nativeDenom, fmt.Sprintf("%s/%s/%s", path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom), "", sdkmath.ZeroInt())
+			suite.chainB.GetSimApp().TransferKeeper.SetNativeMapping(suite.chainB.GetContext(), mapping)
+
+			// send the asset from chain A to chain B to mint the local denomination
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+				nil,
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			// send the local denomination back from chain B to chain A
+			nativeCoin := sdk.NewCoin(nativeDenom, ibctesting.TestCoin.Amount)
+			msg = types.NewMsgTransfer(
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				sdk.NewCoins(nativeCoin),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainA.GetTimeoutHeight(), 0, "",
+				nil,
+			)
+			res, err = suite.chainB.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			sender := suite.chainB.SenderAccount.GetAddress()
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sender, nativeDenom).IsZero())
+			suite.Require().True(suite.chainB.GetSimApp().TransferKeeper.GetNativeMinted(suite.chainB.GetContext(), nativeDenom).IsZero())
+
+			packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			// the asset is sent back as the voucher denomination
+			data, err := internal.UnmarshalPacketData(packet.GetData(), types.V2)
+			suite.Require().NoError(err)
+			suite.Require().Equal(mapping.Denom(), data.Tokens[0].Denom)
+			suite.Require().Nil(data.Tokens[0].Metadata)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			escrowed := suite.chainA.GetSimApp().TransferKeeper.GetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			nativeBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sender, nativeDenom)
+			minted := suite.chainB.GetSimApp().TransferKeeper.GetNativeMinted(suite.chainB.GetContext(), nativeDenom)
+
+			if tc.expPass {
+				suite.Require().Empty(escrowed)
+				suite.Require().True(nativeBalance.IsZero())
+				suite.Require().True(minted.IsZero())
+			} else {
+				suite.Require().Equal(sdk.NewCoins(ibctesting.TestCoin), escrowed)
+				suite.Require().Equal(nativeCoin, nativeBalance)
+				suite.Require().Equal(nativeCoin, minted)
+			}
+		})
+	}
+}
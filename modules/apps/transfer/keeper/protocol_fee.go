@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// GetProtocolFeeConfig returns the protocol fee configuration.
+func (k Keeper) GetProtocolFeeConfig(ctx sdk.Context) types.ProtocolFeeConfig {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProtocolFeeConfigKey)
+	if bz == nil {
+		return types.DefaultProtocolFeeConfig()
+	}
+
+	var config types.ProtocolFeeConfig
+	k.cdc.MustUnmarshal(bz, &config)
+
+	return config
+}
+
+// SetProtocolFeeConfig sets the protocol fee configuration in the store. An empty configuration
+// is deleted from the store rather than stored as an empty value, as empty values cannot be proven
+// with ICS23 proofs and would break (non-)membership proofs of neighbouring keys.
+func (k Keeper) SetProtocolFeeConfig(ctx sdk.Context, config types.ProtocolFeeConfig) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&config)
+	if len(bz) == 0 {
+		store.Delete(types.ProtocolFeeConfigKey)
+		return
+	}
+
+	store.Set(types.ProtocolFeeConfigKey, bz)
+}
+
+// GetPacketProtocolFee returns the protocol fee held for the packet with the provided source port, channel and sequence.
+func (k Keeper) GetPacketProtocolFee(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketProtocolFee, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketProtocolFeeStoreKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketProtocolFee{}, false
+	}
+
+	var packetFee types.PacketProtocolFee
+	k.cdc.MustUnmarshal(bz, &packetFee)
+
+	return packetFee, true
+}
+
+// SetPacketProtocolFee sets the protocol fee held for a packet in the store.
+func (k Keeper) SetPacketProtocolFee(ctx sdk.Context, packetFee types.PacketProtocolFee) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packetFee)
+	store.Set(types.PacketProtocolFeeStoreKey(packetFee.PortId, packetFee.ChannelId, packetFee.Sequence), bz)
+}
+
+// deletePacketProtocolFee deletes the protocol fee held for a packet from the store.
+func (k Keeper) deletePacketProtocolFee(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketProtocolFeeStoreKey(portID, channelID, sequence))
+}
+
+// GetAllPacketProtocolFees returns the protocol fees held for all packets in flight.
+func (k Keeper) GetAllPacketProtocolFees(ctx sdk.Context) []types.PacketProtocolFee {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PacketProtocolFeeKey)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	packetFees := []types.PacketProtocolFee{}
+	for ; iterator.Valid(); iterator.Next() {
+		var packetFee types.PacketProtocolFee
+		k.cdc.MustUnmarshal(iterator.Value(), &packetFee)
+
+		packetFees = append(packetFees, packetFee)
+	}
+
+	return packetFees
+}
+
+// protocolFees returns the coins net of the protocol fee of the provided port and channel, the fees
+// and the fee collector. No fee is charged if the channel has no protocol fee or the address is exempt.
+// An error is returned if the fee of any coin is not lower than its amount.
+func (k Keeper) protocolFees(ctx sdk.Context, portID, channelID string, address sdk.AccAddress, coins sdk.Coins) (sdk.Coins, sdk.Coins, string, error) {
+	config := k.GetProtocolFeeConfig(ctx)
+
+	channelFee, found := config.ChannelFee(portID, channelID)
+	if !found || config.IsExempt(address.String()) {
+		return coins, sdk.NewCoins(), config.FeeCollector, nil
+	}
+
+	netCoins := make(sdk.Coins, 0, len(coins))
+	fees := sdk.NewCoins()
+	for _, coin := range coins {
+		fee := channelFee.Fee(coin)
+		if fee.Amount.GTE(coin.Amount) {
+			return nil, nil, "", errorsmod.Wrapf(types.ErrProtocolFeeExceedsAmount, "protocol fee %s on port ID (%s) channel ID (%s) is not lower than %s", fee, portID, channelID, coin)
+		}
+
+		netCoins = append(netCoins, coin.Sub(fee))
+		if fee.IsPositive() {
+			fees = fees.Add(fee)
+		}
+	}
+
+	return netCoins, fees, config.FeeCollector, nil
+}
+
+// chargeSendProtocolFees deducts the protocol fee of the source channel from the coins sent. The fees
+// are held by the module account until the packet is acknowledged and the coins net of the fees and
+// the fees charged are returned. Coins forwarded on the next hop are sent by the module account and are
+// not charged, as the fees held could not be reverted along with the forwarded coins if the packet fails.
+func (k Keeper) chargeSendProtocolFees(ctx sdk.Context, portID, channelID string, sender sdk.AccAddress, coins sdk.Coins) (sdk.Coins, sdk.Coins, error) {
+	if sender.Equals(k.authKeeper.GetModuleAddress(types.ModuleName)) {
+		return coins, sdk.NewCoins(), nil
+	}
+
+	netCoins, fees, feeCollector, err := k.protocolFees(ctx, portID, channelID, sender, coins)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if fees.IsZero() {
+		return netCoins, fees, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, fees); err != nil {
+		return nil, nil, err
+	}
+
+	events.EmitProtocolFeeEvent(ctx, sender.String(), feeCollector, portID, channelID, fees)
+
+	return netCoins, fees, nil
+}
+
+// chargeRecvProtocolFees charges the protocol fee of the destination channel on the coins received,
+// sending the fees from the receiver to the fee collector.
+func (k Keeper) chargeRecvProtocolFees(ctx sdk.Context, portID, channelID string, receiver sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	_, fees, feeCollector, err := k.protocolFees(ctx, portID, channelID, receiver, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	if fees.IsZero() {
+		return fees, nil
+	}
+
+	feeCollectorAddr, err := sdk.AccAddressFromBech32(feeCollector)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, receiver, feeCollectorAddr, fees); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to charge protocol fee")
+	}
+
+	events.EmitProtocolFeeEvent(ctx, receiver.String(), feeCollector, portID, channelID, fees)
+
+	return fees, nil
+}
+
+// payPacketProtocolFee pays the protocol fee held for a successfully acknowledged packet to the fee collector.
+func (k Keeper) payPacketProtocolFee(ctx sdk.Context, portID, channelID string, sequence uint64) error {
+	packetFee, found := k.GetPacketProtocolFee(ctx, portID, channelID, sequence)
+	if !found {
+		return nil
+	}
+
+	feeCollector, err := sdk.AccAddressFromBech32(packetFee.FeeCollector)
+	if err != nil {
+		return err
+	}
+
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddr, feeCollector, packetFee.Fees); err != nil {
+		return errorsmod.Wrap(err, "failed to pay protocol fee")
+	}
+
+	k.deletePacketProtocolFee(ctx, portID, channelID, sequence)
+
+	return nil
+}
+
+// refundPacketProtocolFee refunds the protocol fee held for a timed out or failed packet to the sender.
+func (k Keeper) refundPacketProtocolFee(ctx sdk.Context, portID, channelID string, sequence uint64, sender sdk.AccAddress) error {
+	packetFee, found := k.GetPacketProtocolFee(ctx, portID, channelID, sequence)
+	if !found {
+		return nil
+	}
+
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddr, sender, packetFee.Fees); err != nil {
+		return errorsmod.Wrap(err, "failed to refund protocol fee")
+	}
+
+	k.deletePacketProtocolFee(ctx, portID, channelID, sequence)
+
+	events.EmitProtocolFeeRefundEvent(ctx, sender.String(), portID, channelID, packetFee.Fees)
+
+	return nil
+}
@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// TestSetProtocolFeeConfig tests that an empty protocol fee configuration is not stored as an empty value.
+func (suite *KeeperTestSuite) TestSetProtocolFeeConfig() {
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	config := types.ProtocolFeeConfig{
+		FeeCollector: suite.chainA.SenderAccount.GetAddress().String(),
+	}
+
+	transferKeeper.SetProtocolFeeConfig(ctx, config)
+	suite.Require().True(store.Has(types.ProtocolFeeConfigKey))
+	suite.Require().Equal(config, transferKeeper.GetProtocolFeeConfig(ctx))
+
+	transferKeeper.SetProtocolFeeConfig(ctx, types.DefaultProtocolFeeConfig())
+	suite.Require().False(store.Has(types.ProtocolFeeConfigKey))
+	suite.Require().Equal(types.DefaultProtocolFeeConfig(), transferKeeper.GetProtocolFeeConfig(ctx))
+}
+
+// TestSendTransferProtocolFee tests that the protocol fee of the source channel is deducted from the
+// tokens sent and held by the module until the packet is acknowledged.
+func (suite *KeeperTestSuite) TestSendTransferProtocolFee() {
+	var (
+		path   *ibctesting.Path
+		config types.ProtocolFeeConfig
+		expFee sdkmath.Int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: basis points fee",
+			func() {},
+			nil,
+		},
+		{
+			"success: minimum fee greater than basis points fee",
+			func() {
+				config.ChannelFees[0].MinFees = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(25)))
+				expFee = sdkmath.NewInt(25)
+			},
+			nil,
+		},
+		{
+			"success: sender is exempt",
+			func() {
+				config.ExemptAddresses = []string{suite.chainA.SenderAccount.GetAddress().String()}
+				expFee = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"success: no protocol fee on channel",
+			func() {
+				config.ChannelFees[0].ChannelId = "channel-100"
+				expFee = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"failure: protocol fee is not lower than amount",
+			func() {
+				config.ChannelFees[0].MinFees = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount))
+			},
+			types.ErrProtocolFeeExceedsAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			config = types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1000, nil))
+			expFee = sdkmath.NewInt(10)
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().TransferKeeper.SetProtocolFeeConfig(suite.chainA.GetContext(), config)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+				nil,
+			)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			moduleAddr := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+			moduleBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), moduleAddr, sdk.DefaultBondDenom)
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(expFee, moduleBalance.Amount)
+				suite.Require().Equal(defaultAmount.Sub(expFee), totalEscrow.Amount)
+
+				packetFee, found := suite.chainA.GetSimApp().TransferKeeper.GetPacketProtocolFee(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+				suite.Require().Equal(expFee.IsPositive(), found)
+				if found {
+					expPacketFee := types.NewPacketProtocolFee(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence, ibctesting.TestAccAddress, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expFee)))
+					suite.Require().Equal(expPacketFee, packetFee)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+
+				suite.Require().True(moduleBalance.IsZero())
+				suite.Require().True(totalEscrow.IsZero())
+			}
+		})
+	}
+}
+
+// TestProtocolFeeSettlement tests that the protocol fee held for a packet is paid to the fee collector
+// on a successful acknowledgement and refunded to the sender on an error acknowledgement or timeout.
+func (suite *KeeperTestSuite) TestProtocolFeeSettlement() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name        string
+		settle      func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error
+		expRefunded bool
+	}{
+		{
+			"successful acknowledgement pays the fee collector",
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+			},
+			false,
+		},
+		{
+			"error acknowledgement refunds the sender",
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewErrorAcknowledgement(errors.New("failed packet transfer"))
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+			},
+			true,
+		},
+		{
+			"timeout refunds the sender",
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			config := types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1000, nil))
+			suite.chainA.GetSimApp().TransferKeeper.SetProtocolFeeConfig(suite.chainA.GetContext(), config)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			preSendBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+				nil,
+			)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			fee := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))
+			token := types.Token{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: defaultAmount.Sub(fee.Amount).String()}
+			data := types.NewFungibleTokenPacketDataV2([]types.Token{token}, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "", ibctesting.EmptyForwardingPacketData)
+			packet := channeltypes.NewPacket(data.GetBytes(), res.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err = tc.settle(packet, data)
+			suite.Require().NoError(err)
+
+			_, found := suite.chainA.GetSimApp().TransferKeeper.GetPacketProtocolFee(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+			suite.Require().False(found)
+
+			moduleAddr := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+			moduleBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), moduleAddr, sdk.DefaultBondDenom)
+			suite.Require().True(moduleBalance.IsZero())
+
+			collectorBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sdk.MustAccAddressFromBech32(ibctesting.TestAccAddress), sdk.DefaultBondDenom)
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			if tc.expRefunded {
+				suite.Require().True(collectorBalance.IsZero())
+				suite.Require().Equal(preSendBalance, senderBalance)
+			} else {
+				suite.Require().Equal(fee, collectorBalance)
+				suite.Require().Equal(preSendBalance.Sub(ibctesting.TestCoin), senderBalance)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketProtocolFee tests that the protocol fee of the destination channel is charged to the receiver.
+func (suite *KeeperTestSuite) TestOnRecvPacketProtocolFee() {
+	var (
+		path   *ibctesting.Path
+		config types.ProtocolFeeConfig
+		data   types.FungibleTokenPacketDataV2
+		expFee sdkmath.Int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: receiver charged protocol fee",
+			func() {},
+			nil,
+		},
+		{
+			"success: receiver is exempt",
+			func() {
+				config.ExemptAddresses = []string{data.Receiver}
+				expFee = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"failure: protocol fee is not lower than amount",
+			func() {
+				denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				config.ChannelFees[0].MinFees = sdk.NewCoins(sdk.NewCoin(denom.IBCDenom(), defaultAmount))
+			},
+			types.ErrProtocolFeeExceedsAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			token := types.Token{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: defaultAmount.String()}
+			data = types.NewFungibleTokenPacketDataV2([]types.Token{token}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "", ibctesting.EmptyForwardingPacketData)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			config = types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1000, nil))
+			expFee = sdkmath.NewInt(10)
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().TransferKeeper.SetProtocolFeeConfig(suite.chainB.GetContext(), config)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				voucherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+				receiverBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+				collectorBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(ibctesting.TestAccAddress), voucherDenom)
+
+				suite.Require().Equal(defaultAmount.Sub(expFee), receiverBalance.Amount)
+				suite.Require().Equal(expFee, collectorBalance.Amount)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// RegisterReceiverHook registers the hook notified when tokens are received by the module account of
+// the provided module. Module accounts with a registered hook are allowed to receive tokens even if
+// they are blocked addresses. This function may be used after the keepers creation and must be called
+// before the keeper is passed to the transfer module and IBC application stack. It panics if the module
+// account does not exist or already has a registered hook.
+func (k *Keeper) RegisterReceiverHook(moduleName string, hook types.ReceiverHook) {
+	addr := k.authKeeper.GetModuleAddress(moduleName)
+	if addr == nil {
+		panic(fmt.Errorf("module account %s does not exist", moduleName))
+	}
+
+	if _, found := k.receiverHooks[addr.String()]; found {
+		panic(fmt.Errorf("receiver hook already registered for module account %s", moduleName))
+	}
+
+	k.receiverHooks[addr.String()] = hook
+}
+
+// hasReceiverHook returns true if a receiver hook is registered for the provided address.
+func (k Keeper) hasReceiverHook(addr sdk.AccAddress) bool {
+	_, found := k.receiverHooks[addr.String()]
+	return found
+}
+
+// callReceiverHook notifies the receiver hook registered for the receiver, if any, of the coins received
+// net of the protocol fees charged. An error is returned if the hook rejects the packet.
+func (k Keeper) callReceiverHook(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, receiver sdk.AccAddress, receivedCoins, fees sdk.Coins) error {
+	hook, found := k.receiverHooks[receiver.String()]
+	if !found {
+		return nil
+	}
+
+	// the received coins are in the order of the packet tokens and may not be sorted
+	coins := sdk.NewCoins()
+	for _, coin := range receivedCoins {
+		coins = coins.Add(coin)
+	}
+	coins = coins.Sub(fees...)
+
+	if err := hook.OnRecvTokens(ctx, packet, data, receiver, coins); err != nil {
+		return errorsmod.Wrapf(types.ErrReceiverRejected, "receiver %s: %s", receiver, err.Error())
+	}
+
+	return nil
+}
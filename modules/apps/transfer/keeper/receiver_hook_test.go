@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var _ types.ReceiverHook = (*mockReceiverHook)(nil)
+
+// mockReceiverHook records the transfers it is notified of and returns the configured error.
+type mockReceiverHook struct {
+	data          []types.FungibleTokenPacketDataV2
+	receivedCoins []sdk.Coins
+	err           error
+}
+
+func (h *mockReceiverHook) OnRecvTokens(_ sdk.Context, _ channeltypes.Packet, data types.FungibleTokenPacketDataV2, _ sdk.AccAddress, coins sdk.Coins) error {
+	h.data = append(h.data, data)
+	h.receivedCoins = append(h.receivedCoins, coins)
+	return h.err
+}
+
+// TestReceiverHook tests that the receiver hook registered for a module account is notified of the
+// tokens received by the module account and may reject the transfer.
+func (suite *KeeperTestSuite) TestReceiverHook() {
+	var hook *mockReceiverHook
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: blocked module account receives tokens",
+			func() {},
+			true,
+		},
+		{
+			"failure: receiver hook rejects the transfer",
+			func() {
+				hook.err = errors.New("rejected")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			hook = &mockReceiverHook{}
+			suite.chainB.GetSimApp().TransferKeeper.RegisterReceiverHook(distrtypes.ModuleName, hook)
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				sender.String(),
+				receiver.String(),
+				suite.chainB.GetTimeoutHeight(), 0, "memo",
+				nil,
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			voucher := sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount)
+			receiverBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, denom.IBCDenom())
+
+			// the hook is notified of the packet data and the coins received
+			suite.Require().Len(hook.data, 1)
+			suite.Require().Equal(sender.String(), hook.data[0].Sender)
+			suite.Require().Equal("memo", hook.data[0].Memo)
+			suite.Require().Equal(sdk.NewCoins(voucher), hook.receivedCoins[0])
+
+			if tc.expPass {
+				suite.Require().Equal(voucher, receiverBalance)
+			} else {
+				suite.Require().True(receiverBalance.IsZero())
+
+				// the sender is refunded on the error acknowledgement
+				suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
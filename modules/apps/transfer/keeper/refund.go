@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// SetRefundHook sets the hook notified when the tokens of a sent packet are refunded. This function
+// may be used after the keepers creation and must be called before the keeper is passed to the
+// transfer module and IBC application stack.
+func (k *Keeper) SetRefundHook(hook types.RefundHook) {
+	k.refundHook = hook
+}
+
+// GetPacketRefundAddress returns the refund address of the packet with the provided source port, channel and sequence.
+func (k Keeper) GetPacketRefundAddress(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketRefundAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketRefundAddressStoreKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketRefundAddress{}, false
+	}
+
+	var refund types.PacketRefundAddress
+	k.cdc.MustUnmarshal(bz, &refund)
+
+	return refund, true
+}
+
+// SetPacketRefundAddress sets the refund address of a packet in the store.
+func (k Keeper) SetPacketRefundAddress(ctx sdk.Context, refund types.PacketRefundAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&refund)
+	store.Set(types.PacketRefundAddressStoreKey(refund.PortId, refund.ChannelId, refund.Sequence), bz)
+}
+
+// deletePacketRefundAddress deletes the refund address of a packet from the store.
+func (k Keeper) deletePacketRefundAddress(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketRefundAddressStoreKey(portID, channelID, sequence))
+}
+
+// GetAllPacketRefundAddresses returns the refund addresses of all packets in flight.
+func (k Keeper) GetAllPacketRefundAddresses(ctx sdk.Context) []types.PacketRefundAddress {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PacketRefundAddressKey)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	refunds := []types.PacketRefundAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		var refund types.PacketRefundAddress
+		k.cdc.MustUnmarshal(iterator.Value(), &refund)
+
+		refunds = append(refunds, refund)
+	}
+
+	return refunds
+}
+
+// getRefundAddress returns the address to which the tokens of the packet must be refunded. This is the
+// refund address set when the packet was sent, or the sender of the packet data otherwise.
+func (k Keeper) getRefundAddress(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) (sdk.AccAddress, bool, error) {
+	refund, found := k.GetPacketRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if found {
+		refundAddress, err := sdk.AccAddressFromBech32(refund.RefundAddress)
+		return refundAddress, true, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	return sender, false, err
+}
+
+// callRefundHook notifies the refund hook, if any, of the coins refunded to the refund address. The state
+// changes of the hook are discarded if it returns an error so that a failing hook cannot block the refund.
+func (k Keeper) callRefundHook(ctx sdk.Context, packet channeltypes.Packet, refundAddress sdk.AccAddress, coins sdk.Coins) {
+	if k.refundHook == nil {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.refundHook.OnRefundPacket(cacheCtx, packet, refundAddress, coins); err != nil {
+		k.Logger(ctx).Error("refund hook failed", "port-id", packet.GetSourcePort(), "channel-id", packet.GetSourceChannel(), "sequence", packet.GetSequence(), "error", err)
+		return
+	}
+
+	writeFn()
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var _ types.RefundHook = (*mockRefundHook)(nil)
+
+// mockRefundHook records the refunds it is notified of and returns the configured error.
+type mockRefundHook struct {
+	refundAddresses []sdk.AccAddress
+	refundedCoins   []sdk.Coins
+	err             error
+}
+
+func (h *mockRefundHook) OnRefundPacket(_ sdk.Context, _ channeltypes.Packet, refundAddress sdk.AccAddress, coins sdk.Coins) error {
+	h.refundAddresses = append(h.refundAddresses, refundAddress)
+	h.refundedCoins = append(h.refundedCoins, coins)
+	return h.err
+}
+
+// TestRefundAddress tests that the tokens of a failed packet are refunded to the refund address set in
+// MsgTransfer and that the refund hook is notified of the refund.
+func (suite *KeeperTestSuite) TestRefundAddress() {
+	var (
+		path *ibctesting.Path
+		hook *mockRefundHook
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		settle      func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error
+		expRefunded bool
+	}{
+		{
+			"error acknowledgement refunds the refund address",
+			func() {},
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewErrorAcknowledgement(errors.New("failed packet transfer"))
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+			},
+			true,
+		},
+		{
+			"timeout refunds the refund address",
+			func() {},
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			},
+			true,
+		},
+		{
+			"refund succeeds when the refund hook fails",
+			func() {
+				hook.err = errors.New("refund hook failed")
+			},
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			},
+			true,
+		},
+		{
+			"successful acknowledgement does not refund",
+			func() {},
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			hook = &mockRefundHook{}
+			suite.chainA.GetSimApp().TransferKeeper.SetRefundHook(hook)
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			refundAddress := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			preSendBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddress, sdk.DefaultBondDenom)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+				nil,
+			)
+			msg.RefundAddress = refundAddress.String()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			refund, found := suite.chainA.GetSimApp().TransferKeeper.GetPacketRefundAddress(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(refundAddress.String(), refund.RefundAddress)
+
+			token := types.Token{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: defaultAmount.String()}
+			data := types.NewFungibleTokenPacketDataV2([]types.Token{token}, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "", ibctesting.EmptyForwardingPacketData)
+			packet := channeltypes.NewPacket(data.GetBytes(), res.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err = tc.settle(packet, data)
+			suite.Require().NoError(err)
+
+			_, found = suite.chainA.GetSimApp().TransferKeeper.GetPacketRefundAddress(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+			suite.Require().False(found)
+
+			refundBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddress, sdk.DefaultBondDenom)
+			if tc.expRefunded {
+				suite.Require().Equal(preSendBalance.Add(ibctesting.TestCoin), refundBalance)
+				suite.Require().Equal([]sdk.AccAddress{refundAddress}, hook.refundAddresses)
+				suite.Require().Equal([]sdk.Coins{sdk.NewCoins(ibctesting.TestCoin)}, hook.refundedCoins)
+			} else {
+				suite.Require().Equal(preSendBalance, refundBalance)
+				suite.Require().Empty(hook.refundAddresses)
+			}
+		})
+	}
+}
+
+// TestTransferBlockedRefundAddress tests that a MsgTransfer with a blocked refund address is rejected.
+func (suite *KeeperTestSuite) TestTransferBlockedRefundAddress() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoins(ibctesting.TestCoin),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+		nil,
+	)
+	msg.RefundAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String()
+
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+	suite.Require().ErrorIs(err, ibcerrors.ErrUnauthorized)
+}
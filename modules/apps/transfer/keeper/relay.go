@@ -95,6 +95,19 @@ func (k Keeper) sendTransfer(
 		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	// canonical tokens are sent back toward their origin as the voucher of the route received over the source channel
+	coins, unwrappedVouchers, err := k.unwrapCanonicalCoins(ctx, sourcePort, sourceChannel, sender, coins)
+	if err != nil {
+		return 0, err
+	}
+
+	// the protocol fee is deducted from the coins before they are escrowed or burned
+	coins, protocolFees, err := k.chargeSendProtocolFees(ctx, sourcePort, sourceChannel, sender, coins)
+	if err != nil {
+		return 0, err
+	}
+
+	propagateMetadata := k.GetParams(ctx).PropagatesMetadata(sourceChannel)
 	tokens := make([]types.Token, 0, len(coins))
 
 	for _, coin := range coins {
@@ -103,6 +116,25 @@ func (k Keeper) sendTransfer(
 			return 0, err
 		}
 
+		// local denominations of foreign assets are sent back over the channel the asset is received
+		// over as the asset, and the local denomination is burned in place of the voucher
+		mapping, isNative := k.getNativeMappingForChannel(ctx, coin.Denom, sourcePort, sourceChannel)
+		if isNative {
+			token.Denom = mapping.Denom()
+		}
+
+		if err := k.validateChannelDenom(ctx, sourcePort, sourceChannel, token.Denom); err != nil {
+			return 0, err
+		}
+
+		// ics20-1 packet data cannot carry denomination metadata, and the metadata of the local denomination
+		// of a mapped asset does not describe the asset sent back, so it is not propagated either. Metadata
+		// is only propagated over channels whose counterparty is known to accept it, as chains rejecting
+		// unknown fields in the packet data would otherwise reject the packet.
+		if appVersion != types.V1 && !isNative && propagateMetadata {
+			token.Metadata = k.getSendDenomMetadata(ctx, coin.Denom)
+		}
+
 		// NOTE: SendTransfer simply sends the denomination as it exists on its own
 		// chain inside the packet data. The receiving chain will perform denom
 		// prefixing as necessary.
@@ -125,10 +157,14 @@ func (k Keeper) sendTransfer(
 				// to burn.
 				panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
 			}
+
+			if isNative {
+				if err := k.burnedNativeCoin(ctx, coin); err != nil {
+					return 0, err
+				}
+			}
 		} else {
-			// obtain the escrow address for the source channel end
-			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-			if err := k.escrowCoin(ctx, sender, escrowAddress, coin); err != nil {
+			if err := k.escrowCoin(ctx, sender, sourcePort, sourceChannel, coin); err != nil {
 				return 0, err
 			}
 		}
@@ -146,6 +182,17 @@ func (k Keeper) sendTransfer(
 		return 0, err
 	}
 
+	// the protocol fee is held until the packet is acknowledged
+	if !protocolFees.IsZero() {
+		packetFee := types.NewPacketProtocolFee(sourcePort, sourceChannel, sequence, k.GetProtocolFeeConfig(ctx).FeeCollector, protocolFees)
+		k.SetPacketProtocolFee(ctx, packetFee)
+	}
+
+	// the vouchers unwrapped are wrapped back into their canonical denomination if the packet is refunded
+	if !unwrappedVouchers.IsZero() {
+		k.SetPacketCanonicalUnwrap(ctx, types.NewPacketCanonicalUnwrap(sourcePort, sourceChannel, sequence, unwrappedVouchers))
+	}
+
 	events.EmitTransferEvent(ctx, sender.String(), receiver, tokens, memo, hops)
 
 	telemetry.ReportTransfer(sourcePort, sourceChannel, destinationPort, destinationChannel, tokens)
@@ -202,10 +249,13 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			// remove prefix added by sender chain
 			token.Denom.Trace = token.Denom.Trace[1:]
 
+			if err := k.validateChannelDenom(ctx, packet.GetDestPort(), packet.GetDestChannel(), token.Denom); err != nil {
+				return err
+			}
+
 			coin := sdk.NewCoin(token.Denom.IBCDenom(), transferAmount)
 
-			escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-			if err := k.unescrowCoin(ctx, escrowAddress, receiver, coin); err != nil {
+			if err := k.unescrowCoin(ctx, packet.GetDestPort(), packet.GetDestChannel(), receiver, coin); err != nil {
 				return err
 			}
 
@@ -218,13 +268,41 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			trace := []types.Hop{types.NewHop(packet.DestinationPort, packet.DestinationChannel)}
 			token.Denom.Trace = append(trace, token.Denom.Trace...)
 
+			if err := k.validateChannelDenom(ctx, packet.GetDestPort(), packet.GetDestChannel(), token.Denom); err != nil {
+				return err
+			}
+
+			// foreign assets mapped to a local denomination are minted as the local denomination
+			if localDenom, found := k.getNativeDenom(ctx, token.Denom.IBCDenom()); found {
+				coin, err := k.mintNativeCoin(ctx, localDenom, transferAmount)
+				if err != nil {
+					return err
+				}
+
+				moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+				if err := k.bankKeeper.SendCoins(
+					ctx, moduleAddr, receiver, sdk.NewCoins(coin),
+				); err != nil {
+					return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+				}
+
+				receivedCoins = append(receivedCoins, coin)
+				continue
+			}
+
 			if !k.HasDenom(ctx, token.Denom.Hash()) {
 				k.SetDenom(ctx, token.Denom)
 			}
 
 			voucherDenom := token.Denom.IBCDenom()
+			// the denomination metadata is only set on first receipt, metadata set
+			// afterwards, e.g. through governance, is never overwritten
 			if !k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
-				k.setDenomMetadata(ctx, token.Denom)
+				if token.Metadata != nil {
+					k.setCounterpartyDenomMetadata(ctx, token.Denom, *token.Metadata)
+				} else {
+					k.setDenomMetadata(ctx, token.Denom)
+				}
 			}
 
 			events.EmitDenomEvent(ctx, token)
@@ -250,6 +328,19 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		}
 	}
 
+	// the protocol fee is charged to the final receiver, forwarded tokens are not charged on intermediate chains
+	if !data.HasForwarding() {
+		fees, err := k.chargeRecvProtocolFees(ctx, packet.GetDestPort(), packet.GetDestChannel(), receiver, receivedCoins)
+		if err != nil {
+			return err
+		}
+
+		// notify the receiver module account, the packet is rejected and refunded if the hook returns an error
+		if err := k.callReceiverHook(ctx, packet, data, receiver, receivedCoins, fees); err != nil {
+			return err
+		}
+	}
+
 	if data.HasForwarding() {
 		// we are now sending from the forward escrow address to the final receiver address.
 		if err := k.forwardPacket(ctx, data, packet, receivedCoins); err != nil {
@@ -277,6 +368,12 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
+		if err := k.payPacketProtocolFee(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence); err != nil {
+			return err
+		}
+		k.deletePacketRefundAddress(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		k.deletePacketCanonicalUnwrap(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
 		if isForwarded {
 			// Write a successful async ack for the forwardedPacket
 			forwardAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
@@ -287,15 +384,19 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		// needs to be executed and no error needs to be returned
 		return nil
 	case *channeltypes.Acknowledgement_Error:
-		// We refund the tokens from the escrow address to the sender
-		if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+		// We refund the tokens from the escrow address to the sender or refund address
+		refundAddress, err := k.refundPacketTokens(ctx, packet, data)
+		if err != nil {
 			return err
 		}
+
+		telemetry.ReportRefund(packet, data.Tokens, "acknowledgement-error")
+
 		if isForwarded {
 			// the forwarded packet has failed, thus the funds have been refunded to the intermediate address.
 			// we must revert the changes that came from successfully receiving the tokens on our chain
 			// before propagating the error acknowledgement back to original sender chain
-			if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
+			if err := k.revertForwardedPacket(ctx, forwardedPacket, data, refundAddress); err != nil {
 				return err
 			}
 
@@ -317,13 +418,16 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 // the tokens to the sender, the tokens of the forwarded packet that were received are in turn
 // either refunded or burned.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
-	if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+	refundAddress, err := k.refundPacketTokens(ctx, packet, data)
+	if err != nil {
 		return err
 	}
 
+	telemetry.ReportRefund(packet, data.Tokens, "timeout")
+
 	forwardedPacket, isForwarded := k.getForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 	if isForwarded {
-		if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
+		if err := k.revertForwardedPacket(ctx, forwardedPacket, data, refundAddress); err != nil {
 			return err
 		}
 
@@ -337,54 +441,90 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, dat
 // refundPacketTokens will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
-func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+// the sending address. The tokens are refunded to the refund address set when
+// the packet was sent in place of the sender, if any, and the address refunded
+// is returned.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) (sdk.AccAddress, error) {
 	// NOTE: packet data type already checked in handler.go
 
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	refundAddress, isRefundAddressSet, err := k.getRefundAddress(ctx, packet, data)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if k.isBlockedAddr(sender) {
-		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", sender)
+	if k.isBlockedAddr(refundAddress) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", refundAddress)
 	}
 
-	// escrow address for unescrowing tokens back to sender
-	escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-
 	moduleAccountAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	refundedCoins := sdk.NewCoins()
 	for _, token := range data.Tokens {
 		coin, err := token.ToCoin()
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// if the token we must refund is prefixed by the source port and channel
 		// then the tokens were burnt when the packet was sent and we must mint new tokens
 		if token.Denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+			// the local denomination burned in place of the voucher of a mapped asset is minted back
+			localDenom, isNative := k.getNativeDenom(ctx, coin.Denom)
+			if isNative {
+				coin = sdk.NewCoin(localDenom, coin.Amount)
+			}
+
 			// mint vouchers back to sender
 			if err := k.bankKeeper.MintCoins(
 				ctx, types.ModuleName, sdk.NewCoins(coin),
 			); err != nil {
-				return err
+				return nil, err
 			}
 
-			if err := k.bankKeeper.SendCoins(ctx, moduleAccountAddr, sender, sdk.NewCoins(coin)); err != nil {
+			if err := k.bankKeeper.SendCoins(ctx, moduleAccountAddr, refundAddress, sdk.NewCoins(coin)); err != nil {
 				panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 			}
+
+			// the supply cap is not enforced as the refund restores the amount burned on send
+			if isNative {
+				k.SetNativeMinted(ctx, k.GetNativeMinted(ctx, localDenom).Add(coin))
+			}
 		} else {
-			if err := k.unescrowCoin(ctx, escrowAddress, sender, coin); err != nil {
-				return err
+			if err := k.unescrowCoin(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), refundAddress, coin); err != nil {
+				return nil, err
 			}
 		}
+
+		refundedCoins = refundedCoins.Add(coin)
 	}
 
-	return nil
+	// refund the protocol fee charged when the packet was sent
+	if err := k.refundPacketProtocolFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), refundAddress); err != nil {
+		return nil, err
+	}
+
+	// canonical tokens unwrapped to send the packet are refunded as canonical tokens
+	refundedCoins, err = k.rewrapPacketVouchers(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), refundAddress, refundedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	if isRefundAddressSet {
+		k.deletePacketRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		events.EmitPacketRefundEvent(ctx, refundAddress.String(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), refundedCoins)
+	}
+
+	// the tokens of forwarded packets are refunded to the module account and returned along the forwarding path
+	if !refundAddress.Equals(moduleAccountAddr) {
+		k.callRefundHook(ctx, packet, refundAddress, refundedCoins)
+	}
+
+	return refundAddress, nil
 }
 
-// escrowCoin will send the given coin from the provided sender to the escrow address. It will also
-// update the total escrowed amount by adding the escrowed coin's amount to the current total escrow.
-func (k Keeper) escrowCoin(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, coin sdk.Coin) error {
+// escrowCoin will send the given coin from the provided sender to the escrow address of the provided
+// port and channel. It will also update the total escrowed amount and the amount escrowed for the channel
+// by adding the escrowed coin's amount to the current escrows.
+func (k Keeper) escrowCoin(ctx sdk.Context, sender sdk.AccAddress, portID, channelID string, coin sdk.Coin) error {
+	escrowAddress := types.GetEscrowAddress(portID, channelID)
 	if err := k.bankKeeper.SendCoins(ctx, sender, escrowAddress, sdk.NewCoins(coin)); err != nil {
 		// failure is expected for insufficient balances
 		return err
@@ -395,12 +535,17 @@ func (k Keeper) escrowCoin(ctx sdk.Context, sender, escrowAddress sdk.AccAddress
 	newTotalEscrow := currentTotalEscrow.Add(coin)
 	k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
 
+	channelEscrow := k.GetChannelEscrow(ctx, portID, channelID)
+	k.SetChannelEscrow(ctx, types.NewChannelEscrow(portID, channelID, channelEscrow.Add(coin)))
+
 	return nil
 }
 
-// unescrowCoin will send the given coin from the escrow address to the provided receiver. It will also
-// update the total escrow by deducting the unescrowed coin's amount from the current total escrow.
-func (k Keeper) unescrowCoin(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, coin sdk.Coin) error {
+// unescrowCoin will send the given coin from the escrow address of the provided port and channel to the
+// provided receiver. It will also update the total escrow and the amount escrowed for the channel by
+// deducting the unescrowed coin's amount from the current escrows.
+func (k Keeper) unescrowCoin(ctx sdk.Context, portID, channelID string, receiver sdk.AccAddress, coin sdk.Coin) error {
+	escrowAddress := types.GetEscrowAddress(portID, channelID)
 	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(coin)); err != nil {
 		// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 		// counterparty module. The bug may occur in bank or any part of the code that allows
@@ -414,6 +559,16 @@ func (k Keeper) unescrowCoin(ctx sdk.Context, escrowAddress, receiver sdk.AccAdd
 	newTotalEscrow := currentTotalEscrow.Sub(coin)
 	k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
 
+	// the escrow address may hold tokens that were not escrowed through the transfer module
+	// (e.g. sent directly to it), so the amount escrowed for the channel is floored at zero
+	channelEscrow := k.GetChannelEscrow(ctx, portID, channelID)
+	tracked := channelEscrow.AmountOf(coin.Denom)
+	if tracked.GT(coin.Amount) {
+		tracked = coin.Amount
+	}
+	channelEscrow = channelEscrow.Sub(sdk.NewCoin(coin.Denom, tracked))
+	k.SetChannelEscrow(ctx, types.NewChannelEscrow(portID, channelID, channelEscrow))
+
 	return nil
 }
 
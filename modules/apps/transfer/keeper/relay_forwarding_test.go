@@ -14,6 +14,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal"
 	internaltypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/types"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
@@ -1031,6 +1032,92 @@ func (suite *ForwardingTestSuite) TestOnTimeoutPacketForwarding() {
 	suite.assertAmountOnChain(suite.chainA, balance, originalABalance.Amount, coin.Denom)
 }
 
+// TestOnTimeoutPacketForwardingWithProtocolFee tests that tokens forwarded over a channel with a protocol fee
+// are not charged on the intermediate chain, so that the escrow and voucher supply of all chains are restored
+// after the forwarded packet times out.
+func (suite *ForwardingTestSuite) TestOnTimeoutPacketForwardingWithProtocolFee() {
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	feeConfig := types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID, 100, nil))
+	suite.chainB.GetSimApp().TransferKeeper.SetProtocolFeeConfig(suite.chainB.GetContext(), feeConfig)
+
+	amount := sdkmath.NewInt(100)
+	coin := ibctesting.TestCoin
+	sender := suite.chainA.SenderAccounts[0].SenderAccount
+	receiver := suite.chainC.SenderAccounts[0].SenderAccount
+
+	denomA := types.NewDenom(coin.Denom)
+	denomAB := types.NewDenom(coin.Denom, types.NewHop(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID))
+
+	originalABalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender.GetAddress(), coin.Denom)
+
+	forwarding := types.NewForwarding(false, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+
+	transferMsg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID,
+		pathAtoB.EndpointA.ChannelID,
+		sdk.NewCoins(coin),
+		sender.GetAddress().String(),
+		receiver.GetAddress().String(),
+		clienttypes.ZeroHeight(),
+		uint64(suite.chainA.GetContext().BlockTime().Add(time.Minute*5).UnixNano()),
+		"",
+		forwarding,
+	)
+
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packetAtoB, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	// Receive packet on B, which forwards the tokens to C.
+	result, err = pathAtoB.EndpointB.RecvPacketWithResult(packetAtoB)
+	suite.Require().NoError(err)
+
+	packetBtoC, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	// The forwarded packet carries the full amount and no protocol fee is held on B.
+	forwardedData, err := internal.UnmarshalPacketData(packetBtoC.GetData(), types.V2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount.String(), forwardedData.Tokens[0].Amount)
+
+	_, found := suite.chainB.GetSimApp().TransferKeeper.GetPacketProtocolFee(suite.chainB.GetContext(), packetBtoC.SourcePort, packetBtoC.SourceChannel, packetBtoC.Sequence)
+	suite.Require().False(found)
+
+	// Time out the forwarded packet on B.
+	module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), pathBtoC.EndpointA.ChannelConfig.PortID)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(module)
+	suite.Require().True(ok)
+
+	err = cbs.OnTimeoutPacket(suite.chainB.GetContext(), packetBtoC, nil)
+	suite.Require().NoError(err)
+
+	// Send the error acknowledgement written on B to A.
+	data, err := internal.UnmarshalPacketData(packetAtoB.GetData(), types.V2)
+	suite.Require().NoError(err)
+
+	ack := internaltypes.NewForwardTimeoutAcknowledgement(packetBtoC)
+	err = suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packetAtoB, data, ack)
+	suite.Require().NoError(err)
+
+	// The vouchers minted on B have all been burned, none remain held by the module account.
+	moduleAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), moduleAddr, denomAB.IBCDenom()).IsZero())
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomAB.IBCDenom()).IsZero())
+	suite.assertAmountOnChain(suite.chainB, escrow, sdkmath.NewInt(0), denomAB.IBCDenom())
+
+	// A has unescrowed the full amount back to the sender.
+	suite.assertAmountOnChain(suite.chainA, escrow, sdkmath.NewInt(0), denomA.IBCDenom())
+	suite.assertAmountOnChain(suite.chainA, balance, originalABalance.Amount, coin.Denom)
+}
+
 // TestForwardingWithMoreThanOneHop tests the scenario in which we
 // forward with more than one forwarding hop.
 func (suite *ForwardingTestSuite) TestForwardingWithMoreThanOneHop() {
@@ -13,6 +13,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal"
 	transferkeeper "github.com/cosmos/ibc-go/v9/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
@@ -113,6 +114,52 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			},
 			nil,
 		},
+		{
+			"successful transfer of native tokens in channel allow list",
+			func() {
+				rules := types.NewChannelDenomRules(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.DENOM_FILTER_ALLOW, []string{ibctesting.TestCoin.Denom, ibctesting.SecondaryDenom}, 0)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainA.GetContext(), rules)
+			},
+			nil,
+		},
+		{
+			"successful transfer of IBC token in channel allow list by full path",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				coins = sdk.NewCoins(sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount))
+
+				rules := types.NewChannelDenomRules(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.DENOM_FILTER_ALLOW, []string{denom.Path()}, 1)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainA.GetContext(), rules)
+
+				expEscrowAmounts = []sdkmath.Int{zeroAmount}
+			},
+			nil,
+		},
+		{
+			"successful transfer of canonical token unwrapped to the route of the channel",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				otherRoute := types.NewCanonicalRoute(fmt.Sprintf("%s/channel-100/%s", path.EndpointA.ChannelConfig.PortID, ibctesting.TestCoin.Denom), sdkmath.ZeroInt())
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), types.NewCanonicalAsset("canonical/stake", types.NewCanonicalRoute(denom.Path(), sdkmath.ZeroInt()), otherRoute))
+
+				_, err := suite.chainA.GetSimApp().TransferKeeper.WrapVoucher(suite.chainA.GetContext(), types.NewMsgWrapVoucher(sender.String(), sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount)))
+				suite.Require().NoError(err)
+
+				coins = sdk.NewCoins(sdk.NewCoin("canonical/stake", ibctesting.TestCoin.Amount))
+				expEscrowAmounts = []sdkmath.Int{zeroAmount}
+			},
+			nil,
+		},
+		{
+			"failure: canonical token without route on the channel",
+			func() {
+				route := types.NewCanonicalRoute(fmt.Sprintf("%s/channel-100/%s", path.EndpointA.ChannelConfig.PortID, ibctesting.TestCoin.Denom), sdkmath.ZeroInt())
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), types.NewCanonicalAsset("canonical/stake", route))
+
+				coins = sdk.NewCoins(sdk.NewCoin("canonical/stake", ibctesting.TestCoin.Amount))
+			},
+			types.ErrInvalidDenomForTransfer,
+		},
 		{
 			"failure: source channel not found",
 			func() {
@@ -121,6 +168,27 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			},
 			channeltypes.ErrChannelNotFound,
 		},
+		{
+			"failure: native token in channel deny list",
+			func() {
+				rules := types.NewChannelDenomRules(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.DENOM_FILTER_DENY, []string{coins[0].Denom}, 0)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainA.GetContext(), rules)
+			},
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"failure: IBC token not in channel allow list",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				coins = sdk.NewCoins(sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount))
+
+				// only the token received over another channel is allowed
+				allowedDenom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, "channel-100"))
+				rules := types.NewChannelDenomRules(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.DENOM_FILTER_ALLOW, []string{allowedDenom.Path()}, 0)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainA.GetContext(), rules)
+			},
+			types.ErrDenomNotAllowed,
+		},
 		{
 			"failure: sender account is blocked",
 			func() {
@@ -318,12 +386,178 @@ func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCT
 	suite.Require().Equal(defaultAmount, totalEscrow.Amount)
 }
 
+// TestSendTransferPropagatesDenomMetadata tests that the bank metadata of a token is propagated
+// when sending native tokens from chain A to chain B and sending the vouchers back to chain A over
+// a second channel, if the metadata channels of the sending chains contain the channels.
+func (suite *KeeperTestSuite) TestSendTransferPropagatesDenomMetadata() {
+	path1 := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path1.Setup()
+
+	path2 := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path2.Setup()
+
+	suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), *counterpartyMetadata(sdk.DefaultBondDenom))
+
+	paramsA := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+	paramsA.MetadataChannels = []string{path1.EndpointA.ChannelID}
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), paramsA)
+
+	paramsB := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+	paramsB.MetadataChannels = []string{path2.EndpointB.ChannelID}
+	suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), paramsB)
+
+	// send native tokens from chain A to chain B
+	transferMsg := types.NewMsgTransfer(
+		path1.EndpointA.ChannelConfig.PortID,
+		path1.EndpointA.ChannelID,
+		sdk.NewCoins(ibctesting.TestCoin),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+		nil,
+	)
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = path1.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	denomB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path1.EndpointB.ChannelConfig.PortID, path1.EndpointB.ChannelID))
+	metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denomB.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(voucherMetadata(denomB), metadata)
+
+	// send the vouchers from chain B to chain A over the second channel
+	transferMsg = types.NewMsgTransfer(
+		path2.EndpointB.ChannelConfig.PortID,
+		path2.EndpointB.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(denomB.IBCDenom(), defaultAmount)),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainA.GetTimeoutHeight(), 0, "",
+		nil,
+	)
+	result, err = suite.chainB.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = path2.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	denomA := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path2.EndpointA.ChannelConfig.PortID, path2.EndpointA.ChannelID), denomB.Trace[0])
+	metadata, found = suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denomA.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(voucherMetadata(denomA), metadata)
+}
+
+// TestSendTransferWithoutMetadataChannel tests that the bank metadata of a token is not propagated
+// over a channel which is not in the metadata channels of the sending chain.
+func (suite *KeeperTestSuite) TestSendTransferWithoutMetadataChannel() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), *counterpartyMetadata(sdk.DefaultBondDenom))
+
+	transferMsg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoins(ibctesting.TestCoin),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+		nil,
+	)
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	data, err := internal.UnmarshalPacketData(packet.GetData(), types.V2)
+	suite.Require().NoError(err)
+	suite.Require().Nil(data.Tokens[0].Metadata)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	denomB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denomB.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(metadataFromDenom(denomB), metadata)
+}
+
+// TestChannelEscrowTracking tests that the amount escrowed for a channel is increased when native
+// tokens are sent over the channel and decreased when they are received back.
+func (suite *KeeperTestSuite) TestChannelEscrowTracking() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// send native tokens from chain A to chain B
+	transferMsg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoins(ibctesting.TestCoin),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+		nil,
+	)
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	escrowed := suite.chainA.GetSimApp().TransferKeeper.GetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(sdk.NewCoins(ibctesting.TestCoin), escrowed)
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// send the vouchers back from chain B to chain A
+	denomB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	transferMsg = types.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(denomB.IBCDenom(), defaultAmount)),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainA.GetTimeoutHeight(), 0, "",
+		nil,
+	)
+	result, err = suite.chainB.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	// vouchers are burned and not escrowed
+	escrowed = suite.chainB.GetSimApp().TransferKeeper.GetChannelEscrow(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().Empty(escrowed)
+
+	packet, err = ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	escrowed = suite.chainA.GetSimApp().TransferKeeper.GetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Empty(escrowed)
+	suite.Require().Empty(suite.chainA.GetSimApp().TransferKeeper.GetAllChannelEscrows(suite.chainA.GetContext()))
+}
+
 // TestOnRecvPacket_ReceiverIsNotSource tests receiving on chainB a coin that
 // originates on chainA. The bulk of the testing occurs  in the test case for
 // loop since setup is intensive for all cases. The malleate function allows
 // for testing invalid cases.
 func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
-	var packetData types.FungibleTokenPacketDataV2
+	var (
+		packetData  types.FungibleTokenPacketDataV2
+		path        *ibctesting.Path
+		expMetadata map[string]banktypes.Metadata
+	)
 
 	testCases := []struct {
 		msg      string
@@ -373,6 +607,72 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			},
 			types.ErrReceiveDisabled,
 		},
+		{
+			"successful receive of tokens in channel allow list by full path",
+			func() {
+				var denoms []string
+				for _, token := range packetData.Tokens {
+					denoms = append(denoms, types.NewDenom(token.Denom.Base, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).Path())
+				}
+
+				rules := types.NewChannelDenomRules(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, types.DENOM_FILTER_ALLOW, denoms, 1)
+				suite.chainB.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainB.GetContext(), rules)
+			},
+			nil,
+		},
+		{
+			"failure: token in channel deny list",
+			func() {
+				rules := types.NewChannelDenomRules(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, types.DENOM_FILTER_DENY, []string{packetData.Tokens[0].Denom.Base}, 0)
+				suite.chainB.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainB.GetContext(), rules)
+			},
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"failure: token trace exceeds channel maximum trace length",
+			func() {
+				for i := range packetData.Tokens {
+					packetData.Tokens[i].Denom.Trace = []types.Hop{types.NewHop(ibctesting.TransferPort, "channel-100")}
+				}
+
+				rules := types.NewChannelDenomRules(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, types.DENOM_FILTER_NONE, nil, 1)
+				suite.chainB.GetSimApp().TransferKeeper.SetChannelDenomRules(suite.chainB.GetContext(), rules)
+			},
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"successful receive with counterparty denom metadata",
+			func() {
+				token := packetData.Tokens[0]
+				packetData.Tokens[0].Metadata = counterpartyMetadata(token.Denom.Base)
+
+				denom := types.NewDenom(token.Denom.Base, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				expMetadata[denom.IBCDenom()] = voucherMetadata(denom)
+			},
+			nil,
+		},
+		{
+			"successful receive with counterparty denom metadata does not overwrite existing metadata",
+			func() {
+				token := packetData.Tokens[0]
+				packetData.Tokens[0].Metadata = counterpartyMetadata(token.Denom.Base)
+
+				denom := types.NewDenom(token.Denom.Base, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				metadata := metadataFromDenom(denom)
+				metadata.Symbol = "ATOM"
+				suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), metadata)
+
+				expMetadata[denom.IBCDenom()] = metadata
+			},
+			nil,
+		},
+		{
+			"failure: counterparty denom metadata does not describe token denom",
+			func() {
+				packetData.Tokens[0].Metadata = counterpartyMetadata("uosmo")
+			},
+			types.ErrInvalidDenomMetadata,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -381,7 +681,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
 			receiver := suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
@@ -397,6 +697,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			}
 			packetData = types.NewFungibleTokenPacketDataV2(tokens, suite.chainA.SenderAccount.GetAddress().String(), receiver, "", ibctesting.EmptyForwardingPacketData)
 			packet := channeltypes.NewPacket(packetData.GetBytes(), uint64(1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+			expMetadata = make(map[string]banktypes.Metadata)
 
 			tc.malleate()
 
@@ -417,7 +718,12 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 					actualMetadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denom.IBCDenom())
 
 					suite.Require().True(found)
-					suite.Require().Equal(metadataFromDenom(denom), actualMetadata)
+
+					metadata, ok := expMetadata[denom.IBCDenom()]
+					if !ok {
+						metadata = metadataFromDenom(denom)
+					}
+					suite.Require().Equal(metadata, actualMetadata)
 				}
 			} else {
 				suite.Require().Error(err)
@@ -1085,6 +1391,142 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	suite.Require().Equal(zeroAmount, totalEscrowChainB.Amount)
 }
 
+// TestRefundRewrapsCanonicalTokens tests that the canonical tokens unwrapped into the route voucher
+// to send a packet are refunded as canonical tokens if the packet times out or is acknowledged with an error.
+func (suite *KeeperTestSuite) TestRefundRewrapsCanonicalTokens() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		settle      func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error
+		expRewrap   bool
+		expRefunded bool
+	}{
+		{
+			"successful acknowledgement keeps the vouchers sent",
+			func() {},
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+			},
+			false,
+			false,
+		},
+		{
+			"error acknowledgement refunds canonical tokens",
+			func() {},
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewErrorAcknowledgement(errors.New("failed packet transfer"))
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+			},
+			true,
+			true,
+		},
+		{
+			"timeout refunds canonical tokens",
+			func() {},
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			},
+			true,
+			true,
+		},
+		{
+			"timeout refunds vouchers of a route removed while the packet was in flight",
+			func() {
+				route := types.NewCanonicalRoute(fmt.Sprintf("%s/channel-100/%s", path.EndpointA.ChannelConfig.PortID, sdk.DefaultBondDenom), sdkmath.ZeroInt())
+				msg := types.NewMsgRegisterCanonicalAsset(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), types.NewCanonicalAsset("canonical/stake", route))
+				_, err := suite.chainA.GetSimApp().TransferKeeper.RegisterCanonicalAsset(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			},
+			func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// receive vouchers of the native token of chainB on chainA
+			transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoins(ibctesting.TestCoin), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "", nil)
+			result, err := suite.chainB.SendMsgs(transferMsg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			// wrap the vouchers into a canonical asset capped at the amount wrapped
+			sender := suite.chainA.SenderAccount.GetAddress()
+			denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			asset := types.NewCanonicalAsset("canonical/stake", types.NewCanonicalRoute(denom.Path(), defaultAmount))
+			suite.chainA.GetSimApp().TransferKeeper.SetCanonicalAsset(suite.chainA.GetContext(), asset)
+
+			_, err = suite.chainA.GetSimApp().TransferKeeper.WrapVoucher(suite.chainA.GetContext(), types.NewMsgWrapVoucher(sender.String(), sdk.NewCoin(denom.IBCDenom(), defaultAmount)))
+			suite.Require().NoError(err)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(sdk.NewCoin(asset.Denom, defaultAmount)),
+				sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+				nil,
+			)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			unwrap, found := suite.chainA.GetSimApp().TransferKeeper.GetPacketCanonicalUnwrap(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom.IBCDenom(), defaultAmount)), unwrap.Vouchers)
+
+			tc.malleate()
+
+			token := types.Token{Denom: denom, Amount: defaultAmount.String()}
+			data := types.NewFungibleTokenPacketDataV2([]types.Token{token}, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "", ibctesting.EmptyForwardingPacketData)
+			packet = channeltypes.NewPacket(data.GetBytes(), res.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err = tc.settle(packet, data)
+			suite.Require().NoError(err)
+
+			_, found = suite.chainA.GetSimApp().TransferKeeper.GetPacketCanonicalUnwrap(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+			suite.Require().False(found)
+
+			canonicalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, asset.Denom)
+			voucherBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, denom.IBCDenom())
+			wrapped := suite.chainA.GetSimApp().TransferKeeper.GetCanonicalWrapped(suite.chainA.GetContext(), denom.IBCDenom())
+			switch {
+			case tc.expRewrap:
+				suite.Require().Equal(defaultAmount, canonicalBalance.Amount)
+				suite.Require().True(voucherBalance.IsZero())
+				suite.Require().Equal(defaultAmount, wrapped.Amount)
+			case tc.expRefunded:
+				suite.Require().True(canonicalBalance.IsZero())
+				suite.Require().Equal(defaultAmount, voucherBalance.Amount)
+				suite.Require().True(wrapped.IsZero())
+			default:
+				suite.Require().True(canonicalBalance.IsZero())
+				suite.Require().True(voucherBalance.IsZero())
+				suite.Require().True(wrapped.IsZero())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestPacketForwardsCompatibility() {
 	// We are testing a scenario where a packet in the future has a new populated
 	// field called "new_field". And this packet is being sent to this module which
@@ -1308,6 +1750,39 @@ func metadataFromDenom(denom types.Denom) banktypes.Metadata {
 	}
 }
 
+// counterpartyMetadata returns valid bank metadata with a display unit of exponent 6 for the provided base denomination.
+func counterpartyMetadata(base string) *banktypes.Metadata {
+	return &banktypes.Metadata{
+		Description: "The native staking token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    base,
+				Exponent: 0,
+			},
+			{
+				Denom:    "atom",
+				Exponent: 6,
+			},
+		},
+		Base:    base,
+		Display: "atom",
+		Name:    "Atom",
+		Symbol:  "ATOM",
+	}
+}
+
+// voucherMetadata returns the bank metadata expected for the provided voucher denomination when
+// received with the counterparty metadata, whose description, name and symbol are derived from the
+// denomination path.
+func voucherMetadata(denom types.Denom) banktypes.Metadata {
+	metadata := counterpartyMetadata(denom.IBCDenom())
+	metadata.Description = fmt.Sprintf("IBC token from %s", denom.Path())
+	metadata.Name = fmt.Sprintf("%s IBC token", denom.Path())
+	metadata.Symbol = strings.ToUpper(denom.Base)
+
+	return *metadata
+}
+
 // assertEscrowEqual asserts that the amounts escrowed for each of the coins on chain matches the expectedAmounts
 func (suite *KeeperTestSuite) assertEscrowEqual(chain *ibctesting.TestChain, coins sdk.Coins, expectedAmounts []sdkmath.Int) {
 	for i, coin := range coins {
@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -41,6 +46,16 @@ type Allocation struct {
 	AllowedPacketData []string `protobuf:"bytes,5,rep,name=allowed_packet_data,json=allowedPacketData,proto3" json:"allowed_packet_data,omitempty"`
 	// Forwarding options that are allowed.
 	AllowedForwarding []AllowedForwarding `protobuf:"bytes,6,rep,name=allowed_forwarding,json=allowedForwarding,proto3" json:"allowed_forwarding"`
+	// optional allowance limiting the amount of tokens that can be spent in each period,
+	// in addition to the spend limit
+	PeriodicAllowance *PeriodicAllowance `protobuf:"bytes,7,opt,name=periodic_allowance,json=periodicAllowance,proto3" json:"periodic_allowance,omitempty"`
+	// optional time after which the allocation can no longer be used
+	Expiration *time.Time `protobuf:"bytes,8,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// allow list of receiver address prefixes, any receiver starting with one of the prefixes is permitted
+	AllowedReceiverPrefixes []string `protobuf:"bytes,9,rep,name=allowed_receiver_prefixes,json=allowedReceiverPrefixes,proto3" json:"allowed_receiver_prefixes,omitempty"`
+	// allow list of bech32 human readable parts, any valid bech32 receiver address with one of the
+	// human readable parts is permitted
+	AllowedReceiverHrps []string `protobuf:"bytes,10,rep,name=allowed_receiver_hrps,json=allowedReceiverHrps,proto3" json:"allowed_receiver_hrps,omitempty"`
 }
 
 func (m *Allocation) Reset()         { *m = Allocation{} }
@@ -118,6 +133,107 @@ func (m *Allocation) GetAllowedForwarding() []AllowedForwarding {
 	return nil
 }
 
+func (m *Allocation) GetPeriodicAllowance() *PeriodicAllowance {
+	if m != nil {
+		return m.PeriodicAllowance
+	}
+	return nil
+}
+
+func (m *Allocation) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *Allocation) GetAllowedReceiverPrefixes() []string {
+	if m != nil {
+		return m.AllowedReceiverPrefixes
+	}
+	return nil
+}
+
+func (m *Allocation) GetAllowedReceiverHrps() []string {
+	if m != nil {
+		return m.AllowedReceiverHrps
+	}
+	return nil
+}
+
+// PeriodicAllowance defines an allowance of tokens that is reset at the start of each period.
+type PeriodicAllowance struct {
+	// the duration of a period
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+	// the maximum amount of tokens that can be spent in a period
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// the amount of tokens left to spend in the current period
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// the time at which the current period ends and the allowance is reset
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicAllowance) Reset()         { *m = PeriodicAllowance{} }
+func (m *PeriodicAllowance) String() string { return proto.CompactTextString(m) }
+func (*PeriodicAllowance) ProtoMessage()    {}
+func (*PeriodicAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1a28b55d17325aa, []int{1}
+}
+func (m *PeriodicAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicAllowance.Merge(m, src)
+}
+func (m *PeriodicAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicAllowance proto.InternalMessageInfo
+
+func (m *PeriodicAllowance) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicAllowance) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicAllowance) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *PeriodicAllowance) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 // AllowedForwarding defines which options are allowed for forwarding.
 type AllowedForwarding struct {
 	// a list of allowed source port ID/channel ID pairs through which the packet is allowed to be forwarded until final
@@ -129,7 +245,7 @@ func (m *AllowedForwarding) Reset()         { *m = AllowedForwarding{} }
 func (m *AllowedForwarding) String() string { return proto.CompactTextString(m) }
 func (*AllowedForwarding) ProtoMessage()    {}
 func (*AllowedForwarding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1a28b55d17325aa, []int{1}
+	return fileDescriptor_b1a28b55d17325aa, []int{2}
 }
 func (m *AllowedForwarding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,7 +292,7 @@ func (m *TransferAuthorization) Reset()         { *m = TransferAuthorization{} }
 func (m *TransferAuthorization) String() string { return proto.CompactTextString(m) }
 func (*TransferAuthorization) ProtoMessage()    {}
 func (*TransferAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1a28b55d17325aa, []int{2}
+	return fileDescriptor_b1a28b55d17325aa, []int{3}
 }
 func (m *TransferAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,6 +330,7 @@ func (m *TransferAuthorization) GetAllocations() []Allocation {
 
 func init() {
 	proto.RegisterType((*Allocation)(nil), "ibc.applications.transfer.v1.Allocation")
+	proto.RegisterType((*PeriodicAllowance)(nil), "ibc.applications.transfer.v1.PeriodicAllowance")
 	proto.RegisterType((*AllowedForwarding)(nil), "ibc.applications.transfer.v1.AllowedForwarding")
 	proto.RegisterType((*TransferAuthorization)(nil), "ibc.applications.transfer.v1.TransferAuthorization")
 }
@@ -223,38 +340,53 @@ func init() {
 }
 
 var fileDescriptor_b1a28b55d17325aa = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x9b, 0x6d, 0x5d, 0xe8, 0x14, 0x85, 0x8d, 0x0a, 0xd9, 0x45, 0xd3, 0x5a, 0x50, 0x02,
-	0xd2, 0x19, 0xab, 0x07, 0x51, 0x4f, 0xed, 0x8a, 0x78, 0xd8, 0x43, 0x2d, 0x9e, 0xbc, 0x84, 0xc9,
-	0x64, 0xb6, 0x19, 0x76, 0x9a, 0x37, 0x64, 0x26, 0x5d, 0xdc, 0x4f, 0xa1, 0x5f, 0xc3, 0xb3, 0x1f,
-	0x62, 0xf1, 0xb4, 0x47, 0x4f, 0x2a, 0xed, 0x87, 0xf0, 0x2a, 0x99, 0x99, 0xd6, 0xca, 0x42, 0x3d,
-	0xb5, 0xf3, 0xbc, 0xbf, 0xf7, 0x5f, 0x9e, 0x17, 0x45, 0x22, 0x61, 0x84, 0x16, 0x85, 0x14, 0x8c,
-	0x6a, 0x01, 0xb9, 0x22, 0xba, 0xa4, 0xb9, 0x3a, 0xe5, 0x25, 0x59, 0x0c, 0x09, 0xad, 0x74, 0x76,
-	0x81, 0x8b, 0x12, 0x34, 0xf8, 0xf7, 0x44, 0xc2, 0xf0, 0x36, 0x89, 0xd7, 0x24, 0x5e, 0x0c, 0x8f,
-	0x0e, 0x19, 0xa8, 0x39, 0xa8, 0xd8, 0xb0, 0xc4, 0x3e, 0x6c, 0xe2, 0xd1, 0x9d, 0x19, 0xcc, 0xc0,
-	0xea, 0xf5, 0x3f, 0xa7, 0x86, 0x96, 0x21, 0x09, 0x55, 0x9c, 0x2c, 0x86, 0x09, 0xd7, 0x74, 0x48,
-	0x18, 0x88, 0xdc, 0xc5, 0x1f, 0xef, 0x1c, 0x6c, 0xd3, 0xda, 0xc0, 0xfd, 0xdf, 0x7b, 0x08, 0x8d,
-	0xa4, 0x04, 0x8b, 0xfa, 0x5d, 0xd4, 0x51, 0x50, 0x95, 0x8c, 0xc7, 0x05, 0x94, 0x3a, 0xf0, 0x7a,
-	0x5e, 0xd4, 0x9e, 0x22, 0x2b, 0x4d, 0xa0, 0xd4, 0xfe, 0x43, 0x74, 0xcb, 0x01, 0x2c, 0xa3, 0x79,
-	0xce, 0x65, 0xb0, 0x67, 0x98, 0x9b, 0x56, 0x3d, 0xb6, 0xa2, 0x2f, 0x51, 0x47, 0x15, 0x3c, 0x4f,
-	0x63, 0x29, 0xe6, 0x42, 0x07, 0xcd, 0x5e, 0x33, 0xea, 0x3c, 0x3d, 0xc4, 0x6e, 0xbb, 0x7a, 0x72,
-	0xec, 0x26, 0xc7, 0xc7, 0x20, 0xf2, 0xf1, 0x93, 0xcb, 0x1f, 0xdd, 0xc6, 0x97, 0x9f, 0xdd, 0x68,
-	0x26, 0x74, 0x56, 0x25, 0x98, 0xc1, 0xdc, 0x7d, 0x0a, 0xf7, 0x33, 0x50, 0xe9, 0x19, 0xd1, 0x1f,
-	0x0b, 0xae, 0x4c, 0x82, 0x9a, 0x22, 0x53, 0xff, 0xa4, 0x2e, 0xef, 0xdf, 0x47, 0x88, 0x4a, 0x09,
-	0xe7, 0xb1, 0x14, 0x4a, 0x07, 0xad, 0x5e, 0x33, 0x6a, 0x4f, 0xdb, 0x46, 0x39, 0x11, 0x4a, 0xfb,
-	0x18, 0xdd, 0x36, 0x0f, 0x9e, 0xc6, 0x05, 0x65, 0x67, 0x5c, 0xc7, 0x29, 0xd5, 0x34, 0xb8, 0x61,
-	0xb8, 0x03, 0x17, 0x9a, 0x98, 0xc8, 0x6b, 0xaa, 0xa9, 0x9f, 0x22, 0x7f, 0xcd, 0x9f, 0x42, 0x79,
-	0x4e, 0xcb, 0x54, 0xe4, 0xb3, 0x60, 0xdf, 0xec, 0x40, 0xf0, 0x2e, 0x33, 0xf1, 0xc8, 0xe6, 0xbd,
-	0xd9, 0xa4, 0x8d, 0x5b, 0xf5, 0x66, 0x9b, 0x2e, 0x7f, 0x03, 0xfd, 0x09, 0x3a, 0xb8, 0x46, 0xfb,
-	0xaf, 0x50, 0x2b, 0x83, 0x42, 0x05, 0x9e, 0x69, 0xf6, 0x60, 0x77, 0xb3, 0xb7, 0x50, 0xb8, 0xf2,
-	0x26, 0xa9, 0xff, 0xd9, 0x43, 0x77, 0xdf, 0xbb, 0xf8, 0xa8, 0xd2, 0x19, 0x94, 0xe2, 0xc2, 0xda,
-	0x3a, 0x41, 0x1d, 0xba, 0x31, 0x79, 0x5d, 0x3d, 0xfa, 0xff, 0x2a, 0x56, 0x77, 0x4d, 0xb6, 0x4b,
-	0xbc, 0x7c, 0xf4, 0xed, 0xeb, 0xa0, 0xef, 0xec, 0xb4, 0xb7, 0xbe, 0xf6, 0xf3, 0x9f, 0xce, 0xe3,
-	0x77, 0x97, 0xcb, 0xd0, 0xbb, 0x5a, 0x86, 0xde, 0xaf, 0x65, 0xe8, 0x7d, 0x5a, 0x85, 0x8d, 0xab,
-	0x55, 0xd8, 0xf8, 0xbe, 0x0a, 0x1b, 0x1f, 0x9e, 0x5f, 0xb7, 0x5a, 0x24, 0x6c, 0x30, 0x03, 0xb2,
-	0x78, 0x41, 0xe6, 0x90, 0x56, 0x92, 0xab, 0xfa, 0x8c, 0xb7, 0xce, 0xd7, 0xf8, 0x9f, 0xec, 0x9b,
-	0xcb, 0x7d, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x66, 0x4e, 0x45, 0x23, 0x81, 0x03, 0x00, 0x00,
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0x13, 0x3d,
+	0x14, 0xcd, 0x34, 0x69, 0xbf, 0xc6, 0xf9, 0xa8, 0x88, 0x4b, 0xc5, 0xb4, 0x82, 0x24, 0x44, 0x02,
+	0x45, 0x42, 0x9d, 0x21, 0x65, 0x81, 0x68, 0x37, 0x24, 0xad, 0xa0, 0x8b, 0x2e, 0x42, 0xe8, 0x8a,
+	0x05, 0x23, 0xcf, 0x8c, 0x93, 0x58, 0x9d, 0x8c, 0x2d, 0xdb, 0x93, 0xfe, 0xbc, 0x01, 0x62, 0x53,
+	0x76, 0x3c, 0x03, 0x6b, 0x1e, 0xa2, 0x62, 0xd5, 0x25, 0x2b, 0x8a, 0xda, 0x17, 0x41, 0x63, 0x7b,
+	0xd2, 0x90, 0x48, 0xed, 0x06, 0x56, 0x33, 0xbe, 0xf7, 0x9e, 0x7b, 0x7c, 0x8f, 0x8f, 0x0d, 0x1a,
+	0xc4, 0x0f, 0x5c, 0xc4, 0x58, 0x44, 0x02, 0x24, 0x09, 0x8d, 0x85, 0x2b, 0x39, 0x8a, 0x45, 0x0f,
+	0x73, 0x77, 0xd4, 0x74, 0x51, 0x22, 0x07, 0x27, 0x0e, 0xe3, 0x54, 0x52, 0xf8, 0x80, 0xf8, 0x81,
+	0x33, 0x59, 0xe9, 0x64, 0x95, 0xce, 0xa8, 0xb9, 0xb6, 0x1a, 0x50, 0x31, 0xa4, 0xc2, 0x53, 0xb5,
+	0xae, 0x5e, 0x68, 0xe0, 0xda, 0xbd, 0x3e, 0xed, 0x53, 0x1d, 0x4f, 0xff, 0x4c, 0xb4, 0xd2, 0xa7,
+	0xb4, 0x1f, 0x61, 0x57, 0xad, 0xfc, 0xa4, 0xe7, 0x86, 0x09, 0x57, 0x7d, 0x4d, 0xbe, 0x3a, 0x9d,
+	0x97, 0x64, 0x88, 0x85, 0x44, 0x43, 0x96, 0x35, 0xd0, 0x24, 0xae, 0x8f, 0x04, 0x76, 0x47, 0x4d,
+	0x1f, 0x4b, 0xd4, 0x74, 0x03, 0x4a, 0xb2, 0x06, 0x4f, 0x6f, 0x9c, 0x6c, 0xbc, 0x77, 0x55, 0x5c,
+	0xff, 0x38, 0x0f, 0x40, 0x2b, 0x8a, 0xa8, 0x2e, 0x85, 0x55, 0x50, 0x12, 0x34, 0xe1, 0x01, 0xf6,
+	0x18, 0xe5, 0xd2, 0xb6, 0x6a, 0x56, 0xa3, 0xd8, 0x05, 0x3a, 0xd4, 0xa1, 0x5c, 0xc2, 0xc7, 0x60,
+	0xc9, 0x14, 0x04, 0x03, 0x14, 0xc7, 0x38, 0xb2, 0xe7, 0x54, 0xcd, 0x1d, 0x1d, 0xdd, 0xd6, 0x41,
+	0x18, 0x81, 0x92, 0x60, 0x38, 0x0e, 0xbd, 0x88, 0x0c, 0x89, 0xb4, 0xf3, 0xb5, 0x7c, 0xa3, 0xb4,
+	0xb1, 0xea, 0x18, 0x79, 0xd2, 0x9d, 0x3b, 0x66, 0xe7, 0xce, 0x36, 0x25, 0x71, 0xfb, 0xd9, 0xd9,
+	0xcf, 0x6a, 0xee, 0xeb, 0x45, 0xb5, 0xd1, 0x27, 0x72, 0x90, 0xf8, 0x4e, 0x40, 0x87, 0x46, 0x4b,
+	0xf3, 0x59, 0x17, 0xe1, 0x81, 0x2b, 0x8f, 0x19, 0x16, 0x0a, 0x20, 0xba, 0x40, 0xf5, 0xdf, 0x4b,
+	0xdb, 0xc3, 0x87, 0x00, 0xa0, 0x28, 0xa2, 0x87, 0x5e, 0x44, 0x84, 0xb4, 0x0b, 0xb5, 0x7c, 0xa3,
+	0xd8, 0x2d, 0xaa, 0xc8, 0x1e, 0x11, 0x12, 0x3a, 0x60, 0x59, 0x2d, 0x70, 0xe8, 0x31, 0x14, 0x1c,
+	0x60, 0xe9, 0x85, 0x48, 0x22, 0x7b, 0x5e, 0xd5, 0x95, 0x4d, 0xaa, 0xa3, 0x32, 0x3b, 0x48, 0x22,
+	0x18, 0x02, 0x98, 0xd5, 0xf7, 0x28, 0x3f, 0x44, 0x3c, 0x24, 0x71, 0xdf, 0x5e, 0x50, 0x33, 0xb8,
+	0xce, 0x4d, 0x6e, 0x70, 0x5a, 0x1a, 0xf7, 0x7a, 0x0c, 0x6b, 0x17, 0xd2, 0xc9, 0xc6, 0x2c, 0xd7,
+	0x09, 0xf8, 0x01, 0x40, 0x86, 0x39, 0xa1, 0x21, 0x09, 0x3c, 0x95, 0x45, 0x71, 0x80, 0xed, 0xff,
+	0x6a, 0xd6, 0xed, 0x2c, 0x1d, 0x83, 0x6b, 0x65, 0xb0, 0x6e, 0x99, 0x4d, 0x87, 0xe0, 0x2b, 0x00,
+	0xf0, 0x11, 0x23, 0xda, 0x5b, 0xf6, 0xa2, 0xea, 0xbb, 0xe6, 0x68, 0x73, 0x39, 0x99, 0xb9, 0x9c,
+	0xfd, 0xcc, 0x5c, 0xed, 0xc2, 0xe9, 0x45, 0xd5, 0xea, 0x4e, 0x60, 0xe0, 0x26, 0x58, 0xcd, 0x74,
+	0xe0, 0x38, 0xc0, 0x64, 0x84, 0xb9, 0xc7, 0x38, 0xee, 0x91, 0x23, 0x2c, 0xec, 0xa2, 0x52, 0xef,
+	0xbe, 0x29, 0xe8, 0x9a, 0x7c, 0xc7, 0xa4, 0xe1, 0x06, 0x58, 0x99, 0xc1, 0x0e, 0x38, 0x13, 0x36,
+	0x50, 0xb8, 0xe5, 0x29, 0xdc, 0x2e, 0x67, 0xa2, 0xfe, 0x29, 0x0f, 0xca, 0x33, 0xa3, 0xc1, 0x2d,
+	0xb0, 0xa0, 0x87, 0x53, 0x6e, 0x4c, 0x5d, 0x34, 0x3d, 0xc3, 0x8e, 0xb9, 0x40, 0xed, 0xc5, 0x54,
+	0xeb, 0x2f, 0xe9, 0x18, 0x06, 0x02, 0x8f, 0x33, 0x91, 0xbd, 0x49, 0x3b, 0xce, 0xfd, 0x7d, 0x3b,
+	0xde, 0xd5, 0x34, 0xef, 0xae, 0x4d, 0x99, 0x00, 0x13, 0xf3, 0x02, 0x14, 0x6b, 0xfa, 0x7f, 0x71,
+	0x0f, 0x96, 0x34, 0xc9, 0x36, 0x8a, 0x15, 0x37, 0x7c, 0x03, 0xfe, 0x37, 0xb4, 0x1c, 0x0b, 0x9c,
+	0xde, 0x86, 0xdb, 0x0e, 0x5e, 0xa9, 0xa6, 0x0e, 0xbf, 0xa4, 0x91, 0xdd, 0x14, 0x58, 0xef, 0x80,
+	0xf2, 0x8c, 0x9b, 0xe1, 0x16, 0x28, 0x0c, 0x28, 0x13, 0xb6, 0xa5, 0x06, 0x79, 0x74, 0xb3, 0x4d,
+	0x77, 0x29, 0x33, 0xf6, 0x57, 0xa0, 0xfa, 0x67, 0x0b, 0xac, 0xec, 0x9b, 0x7c, 0x2b, 0x91, 0x03,
+	0xca, 0xc9, 0x89, 0x76, 0x5a, 0x07, 0x94, 0xd0, 0xf8, 0x11, 0xca, 0xba, 0x37, 0x6e, 0xbf, 0x6a,
+	0x3a, 0x6e, 0x48, 0x26, 0x5b, 0x6c, 0x3e, 0xf9, 0xfe, 0x6d, 0xbd, 0x6e, 0x64, 0xd6, 0x8f, 0x79,
+	0xa6, 0xf3, 0x1f, 0xcc, 0xed, 0xb7, 0x67, 0x97, 0x15, 0xeb, 0xfc, 0xb2, 0x62, 0xfd, 0xba, 0xac,
+	0x58, 0xa7, 0x57, 0x95, 0xdc, 0xf9, 0x55, 0x25, 0xf7, 0xe3, 0xaa, 0x92, 0x7b, 0xff, 0x62, 0xf6,
+	0x08, 0x88, 0x1f, 0xac, 0xf7, 0xa9, 0x3b, 0x7a, 0xe9, 0x0e, 0x69, 0x98, 0x44, 0x58, 0xa4, 0xcf,
+	0xec, 0xc4, 0xf3, 0xaa, 0xce, 0xc5, 0x5f, 0x50, 0x1a, 0x3f, 0xff, 0x3d, 0x00, 0xf6, 0xb6, 0x2e,
+	0xb2, 0x62, 0x06, 0x00, 0x00,
 }
 
 func (m *Allocation) Marshal() (dAtA []byte, err error) {
@@ -277,6 +409,46 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedReceiverHrps) > 0 {
+		for iNdEx := len(m.AllowedReceiverHrps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedReceiverHrps[iNdEx])
+			copy(dAtA[i:], m.AllowedReceiverHrps[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedReceiverHrps[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AllowedReceiverPrefixes) > 0 {
+		for iNdEx := len(m.AllowedReceiverPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedReceiverPrefixes[iNdEx])
+			copy(dAtA[i:], m.AllowedReceiverPrefixes[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedReceiverPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PeriodicAllowance != nil {
+		{
+			size, err := m.PeriodicAllowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.AllowedForwarding) > 0 {
 		for iNdEx := len(m.AllowedForwarding) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -340,6 +512,73 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeriodicAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthz(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AllowedForwarding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -463,6 +702,51 @@ func (m *Allocation) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if m.PeriodicAllowance != nil {
+		l = m.PeriodicAllowance.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedReceiverPrefixes) > 0 {
+		for _, s := range m.AllowedReceiverPrefixes {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedReceiverHrps) > 0 {
+		for _, s := range m.AllowedReceiverHrps {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeriodicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -727,6 +1011,326 @@ func (m *Allocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodicAllowance == nil {
+				m.PeriodicAllowance = &PeriodicAllowance{}
+			}
+			if err := m.PeriodicAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedReceiverPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedReceiverPrefixes = append(m.AllowedReceiverPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedReceiverHrps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedReceiverHrps = append(m.AllowedReceiverHrps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
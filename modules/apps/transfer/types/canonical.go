@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewCanonicalAsset creates a new CanonicalAsset instance.
+func NewCanonicalAsset(denom string, routes ...CanonicalRoute) CanonicalAsset {
+	return CanonicalAsset{
+		Denom:  denom,
+		Routes: routes,
+	}
+}
+
+// NewCanonicalRoute creates a new CanonicalRoute instance.
+func NewCanonicalRoute(path string, capAmount sdkmath.Int) CanonicalRoute {
+	return CanonicalRoute{
+		Path: path,
+		Cap:  capAmount,
+	}
+}
+
+// Validate performs a basic validation of the canonical asset. The denomination must be a valid
+// denomination prefixed with "canonical/" and the asset must have at least one route. The routes
+// must be vouchers with distinct denominations received over distinct channels, such that each
+// channel identifies the route through which the canonical tokens are sent back toward their origin.
+func (a CanonicalAsset) Validate() error {
+	prefix := fmt.Sprintf("%s/", CanonicalDenomPrefix)
+	if !strings.HasPrefix(a.Denom, prefix) || strings.TrimSpace(strings.TrimPrefix(a.Denom, prefix)) == "" {
+		return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "denomination %s must be prefixed with %s followed by a name", a.Denom, prefix)
+	}
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "invalid denomination %s: %v", a.Denom, err)
+	}
+
+	if len(a.Routes) == 0 {
+		return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "canonical asset %s must have at least one route", a.Denom)
+	}
+
+	seenDenoms := make(map[string]bool)
+	seenChannels := make(map[string]bool)
+	for _, route := range a.Routes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+
+		denom := route.Denom()
+		if seenDenoms[denom.IBCDenom()] {
+			return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "duplicate route %s", route.Path)
+		}
+		seenDenoms[denom.IBCDenom()] = true
+
+		if seenChannels[denom.Trace[0].String()] {
+			return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "multiple routes received over %s", denom.Trace[0])
+		}
+		seenChannels[denom.Trace[0].String()] = true
+	}
+
+	return nil
+}
+
+// Route returns the route of the canonical asset with the provided voucher denomination.
+func (a CanonicalAsset) Route(voucherDenom string) (CanonicalRoute, bool) {
+	for _, route := range a.Routes {
+		if route.Denom().IBCDenom() == voucherDenom {
+			return route, true
+		}
+	}
+
+	return CanonicalRoute{}, false
+}
+
+// RouteForChannel returns the route of the canonical asset whose voucher has been received over
+// the provided port and channel, that is the route through which the voucher is sent back toward
+// its origin.
+func (a CanonicalAsset) RouteForChannel(portID, channelID string) (CanonicalRoute, bool) {
+	for _, route := range a.Routes {
+		if route.Denom().HasPrefix(portID, channelID) {
+			return route, true
+		}
+	}
+
+	return CanonicalRoute{}, false
+}
+
+// Validate performs a basic validation of the canonical route. The path must be the valid full
+// denomination path of a voucher and the cap must not be negative.
+func (r CanonicalRoute) Validate() error {
+	denom := r.Denom()
+	if denom.IsNative() {
+		return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "route %s must be the full denomination path of a voucher", r.Path)
+	}
+	if err := denom.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "invalid route %s: %v", r.Path, err)
+	}
+
+	if r.Cap.IsNil() || r.Cap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "cap of route %s cannot be nil or negative", r.Path)
+	}
+
+	return nil
+}
+
+// Denom returns the denomination of the route voucher.
+func (r CanonicalRoute) Denom() Denom {
+	return ExtractDenomFromPath(r.Path)
+}
+
+// ExceedsCap returns true if the provided wrapped amount exceeds the cap of the route.
+func (r CanonicalRoute) ExceedsCap(wrapped sdkmath.Int) bool {
+	return r.Cap.IsPositive() && wrapped.GT(r.Cap)
+}
+
+// NewPacketCanonicalUnwrap creates a new PacketCanonicalUnwrap instance.
+func NewPacketCanonicalUnwrap(portID, channelID string, sequence uint64, vouchers sdk.Coins) PacketCanonicalUnwrap {
+	return PacketCanonicalUnwrap{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Vouchers:  vouchers,
+	}
+}
+
+// Validate performs a basic validation of the route vouchers unwrapped for a packet.
+func (u PacketCanonicalUnwrap) Validate() error {
+	if err := host.PortIdentifierValidator(u.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port ID (%s)", u.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(u.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID (%s)", u.ChannelId)
+	}
+
+	if u.Sequence == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+	}
+
+	if u.Vouchers.Empty() || !u.Vouchers.IsValid() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid unwrapped vouchers %s", u.Vouchers)
+	}
+
+	return nil
+}
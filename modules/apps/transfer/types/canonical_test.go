@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+func (suite *TypesTestSuite) TestCanonicalAssetValidate() {
+	testCases := []struct {
+		name     string
+		asset    types.CanonicalAsset
+		expError error
+	}{
+		{
+			"success: routes received over different channels",
+			types.NewCanonicalAsset(
+				"canonical/usdc",
+				types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt()),
+				types.NewCanonicalRoute("transfer/channel-1/transfer/channel-5/uusdc", sdkmath.NewInt(1000)),
+			),
+			nil,
+		},
+		{
+			"failure: denomination without canonical prefix",
+			types.NewCanonicalAsset("usdc", types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt())),
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: denomination with blank name",
+			types.NewCanonicalAsset("canonical/", types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt())),
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: invalid denomination",
+			types.NewCanonicalAsset("canonical/u$dc", types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt())),
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: no routes",
+			types.NewCanonicalAsset("canonical/usdc"),
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: route is a native denomination",
+			types.NewCanonicalAsset("canonical/usdc", types.NewCanonicalRoute("uusdc", sdkmath.ZeroInt())),
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: negative cap",
+			types.NewCanonicalAsset("canonical/usdc", types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.NewInt(-1))),
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: nil cap",
+			types.NewCanonicalAsset("canonical/usdc", types.CanonicalRoute{Path: "transfer/channel-0/uusdc"}),
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: duplicate route",
+			types.NewCanonicalAsset(
+				"canonical/usdc",
+				types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt()),
+				types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.NewInt(1000)),
+			),
+			types.ErrInvalidCanonicalAsset,
+		},
+		{
+			"failure: routes received over the same channel",
+			types.NewCanonicalAsset(
+				"canonical/usdc",
+				types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt()),
+				types.NewCanonicalRoute("transfer/channel-0/transfer/channel-5/uusdc", sdkmath.ZeroInt()),
+			),
+			types.ErrInvalidCanonicalAsset,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.asset.Validate()
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestCanonicalAssetRoutes() {
+	routeA := types.NewCanonicalRoute("transfer/channel-0/uusdc", sdkmath.ZeroInt())
+	routeB := types.NewCanonicalRoute("transfer/channel-1/transfer/channel-5/uusdc", sdkmath.NewInt(1000))
+	asset := types.NewCanonicalAsset("canonical/usdc", routeA, routeB)
+
+	route, found := asset.Route(routeB.Denom().IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(routeB, route)
+
+	_, found = asset.Route(types.NewDenom("uusdc", types.NewHop("transfer", "channel-5")).IBCDenom())
+	suite.Require().False(found)
+
+	route, found = asset.RouteForChannel("transfer", "channel-1")
+	suite.Require().True(found)
+	suite.Require().Equal(routeB, route)
+
+	_, found = asset.RouteForChannel("transfer", "channel-5")
+	suite.Require().False(found)
+
+	suite.Require().False(routeA.ExceedsCap(sdkmath.NewInt(1_000_000)))
+	suite.Require().False(routeB.ExceedsCap(sdkmath.NewInt(1000)))
+	suite.Require().True(routeB.ExceedsCap(sdkmath.NewInt(1001)))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewChannelEscrow creates a new ChannelEscrow instance.
+func NewChannelEscrow(portID, channelID string, amount sdk.Coins) ChannelEscrow {
+	return ChannelEscrow{
+		PortId:    portID,
+		ChannelId: channelID,
+		Amount:    amount,
+	}
+}
+
+// Validate performs a basic validation of the amount escrowed for a channel.
+func (e ChannelEscrow) Validate() error {
+	if err := host.PortIdentifierValidator(e.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port ID (%s)", e.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(e.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID (%s)", e.ChannelId)
+	}
+
+	if err := e.Amount.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid escrowed amount: %s", err.Error())
+	}
+
+	return nil
+}
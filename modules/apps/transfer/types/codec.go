@@ -18,7 +18,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{}, &MsgUpdateParams{})
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgTransfer{},
+		&MsgUpdateParams{},
+		&MsgUpdateChannelDenomRules{},
+		&MsgRegisterCanonicalAsset{},
+		&MsgWrapVoucher{},
+		&MsgUnwrapVoucher{},
+		&MsgUpdateDenomMetadata{},
+		&MsgUpdateProtocolFeeConfig{},
+		&MsgRegisterNativeMapping{},
+	)
 
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
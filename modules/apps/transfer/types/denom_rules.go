@@ -0,0 +1,100 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// NewChannelDenomRules creates a new ChannelDenomRules instance.
+func NewChannelDenomRules(portID, channelID string, filterMode DenomFilterMode, denoms []string, maxTraceLength uint64) ChannelDenomRules {
+	return ChannelDenomRules{
+		PortId:         portID,
+		ChannelId:      channelID,
+		FilterMode:     filterMode,
+		Denoms:         denoms,
+		MaxTraceLength: maxTraceLength,
+	}
+}
+
+// Validate performs a basic validation of the channel denomination rules. The identifiers
+// must be valid, the denominations must be valid base denominations or full denomination
+// paths without duplicates and they must only be provided when a filter mode is set.
+func (r ChannelDenomRules) Validate() error {
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port ID (%s)", r.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID (%s)", r.ChannelId)
+	}
+
+	switch r.FilterMode {
+	case DENOM_FILTER_NONE:
+		if len(r.Denoms) != 0 {
+			return errorsmod.Wrapf(ErrInvalidDenomRules, "denominations cannot be provided with filter mode %s", r.FilterMode)
+		}
+	case DENOM_FILTER_ALLOW, DENOM_FILTER_DENY:
+		if len(r.Denoms) == 0 {
+			return errorsmod.Wrapf(ErrInvalidDenomRules, "denominations must be provided with filter mode %s", r.FilterMode)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidDenomRules, "invalid filter mode %d", r.FilterMode)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, entry := range r.Denoms {
+		if seenDenoms[entry] {
+			return errorsmod.Wrapf(ErrInvalidDenomRules, "duplicate denomination %s", entry)
+		}
+		seenDenoms[entry] = true
+
+		denom := ExtractDenomFromPath(entry)
+		if err := denom.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDenomRules, "invalid denomination %s: %v", entry, err)
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the rules do not place any restriction on the tokens
+// transferred over the channel.
+func (r ChannelDenomRules) IsEmpty() bool {
+	return r.FilterMode == DENOM_FILTER_NONE && r.MaxTraceLength == 0
+}
+
+// ValidateDenom returns an error if the provided denomination, as represented on this chain,
+// may not be transferred over the channel according to the rules.
+func (r ChannelDenomRules) ValidateDenom(denom Denom) error {
+	if r.MaxTraceLength != 0 && uint64(len(denom.Trace)) > r.MaxTraceLength {
+		return errorsmod.Wrapf(ErrDenomNotAllowed, "trace length of %s (%d) exceeds maximum trace length %d on port ID (%s) channel ID (%s)", denom.Path(), len(denom.Trace), r.MaxTraceLength, r.PortId, r.ChannelId)
+	}
+
+	switch r.FilterMode {
+	case DENOM_FILTER_ALLOW:
+		if !r.matches(denom) {
+			return errorsmod.Wrapf(ErrDenomNotAllowed, "%s is not in the allow list of port ID (%s) channel ID (%s)", denom.Path(), r.PortId, r.ChannelId)
+		}
+	case DENOM_FILTER_DENY:
+		if r.matches(denom) {
+			return errorsmod.Wrapf(ErrDenomNotAllowed, "%s is in the deny list of port ID (%s) channel ID (%s)", denom.Path(), r.PortId, r.ChannelId)
+		}
+	}
+
+	return nil
+}
+
+// matches returns true if the provided denomination matches any of the denominations of the rules.
+// Entries without a trace match on the base denomination, all other entries match on the full path.
+func (r ChannelDenomRules) matches(denom Denom) bool {
+	path := denom.Path()
+	return slices.ContainsFunc(r.Denoms, func(entry string) bool {
+		if ExtractDenomFromPath(entry).IsNative() {
+			return entry == denom.Base
+		}
+
+		return entry == path
+	})
+}
@@ -0,0 +1,159 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+func (suite *TypesTestSuite) TestChannelDenomRulesValidate() {
+	testCases := []struct {
+		name     string
+		rules    types.ChannelDenomRules
+		expError error
+	}{
+		{
+			"success: allow list of base denomination and full path",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"uatom", "transfer/channel-1/uusdc"}, 0),
+			nil,
+		},
+		{
+			"success: deny list with maximum trace length",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_DENY, []string{"gamm/pool/1"}, 2),
+			nil,
+		},
+		{
+			"success: maximum trace length only",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_NONE, nil, 1),
+			nil,
+		},
+		{
+			"failure: invalid port ID",
+			types.NewChannelDenomRules("", "channel-0", types.DENOM_FILTER_NONE, nil, 1),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid channel ID",
+			types.NewChannelDenomRules("transfer", "", types.DENOM_FILTER_NONE, nil, 1),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: denominations without filter mode",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_NONE, []string{"uatom"}, 0),
+			types.ErrInvalidDenomRules,
+		},
+		{
+			"failure: filter mode without denominations",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, nil, 0),
+			types.ErrInvalidDenomRules,
+		},
+		{
+			"failure: invalid filter mode",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DenomFilterMode(3), []string{"uatom"}, 0),
+			types.ErrInvalidDenomRules,
+		},
+		{
+			"failure: duplicate denomination",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_DENY, []string{"uatom", "uatom"}, 0),
+			types.ErrInvalidDenomRules,
+		},
+		{
+			"failure: invalid denomination",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_DENY, []string{"transfer/channel-1/"}, 0),
+			types.ErrInvalidDenomRules,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.rules.Validate()
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestChannelDenomRulesValidateDenom() {
+	var (
+		nativeDenom  = types.NewDenom("uusdc")
+		voucherDenom = types.NewDenom("uusdc", types.NewHop("transfer", "channel-1"))
+		twoHopDenom  = types.NewDenom("uusdc", types.NewHop("transfer", "channel-1"), types.NewHop("transfer", "channel-2"))
+	)
+
+	testCases := []struct {
+		name     string
+		rules    types.ChannelDenomRules
+		denom    types.Denom
+		expError error
+	}{
+		{
+			"success: no restriction",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_NONE, nil, 0),
+			twoHopDenom,
+			nil,
+		},
+		{
+			"success: base denomination in allow list matches any trace",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"uusdc"}, 0),
+			twoHopDenom,
+			nil,
+		},
+		{
+			"success: full path in allow list",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"transfer/channel-1/uusdc"}, 0),
+			voucherDenom,
+			nil,
+		},
+		{
+			"success: full path in deny list does not match other trace",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_DENY, []string{"transfer/channel-1/uusdc"}, 0),
+			twoHopDenom,
+			nil,
+		},
+		{
+			"success: trace length equal to maximum trace length",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_NONE, nil, 1),
+			voucherDenom,
+			nil,
+		},
+		{
+			"failure: full path in allow list does not match other trace",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"transfer/channel-1/uusdc"}, 0),
+			twoHopDenom,
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"failure: full path in allow list does not match native denomination",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"transfer/channel-1/uusdc"}, 0),
+			nativeDenom,
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"failure: base denomination in deny list",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_DENY, []string{"uusdc"}, 0),
+			voucherDenom,
+			types.ErrDenomNotAllowed,
+		},
+		{
+			"failure: trace length exceeds maximum trace length",
+			types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"uusdc"}, 1),
+			twoHopDenom,
+			types.ErrDenomNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.rules.ValidateDenom(tc.denom)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
@@ -6,17 +6,29 @@ import (
 
 // IBC transfer sentinel errors
 var (
-	ErrInvalidPacketTimeout    = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
-	ErrInvalidDenomForTransfer = errorsmod.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
-	ErrInvalidVersion          = errorsmod.Register(ModuleName, 4, "invalid ICS20 version")
-	ErrInvalidAmount           = errorsmod.Register(ModuleName, 5, "invalid token amount")
-	ErrDenomNotFound           = errorsmod.Register(ModuleName, 6, "denomination not found")
-	ErrSendDisabled            = errorsmod.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
-	ErrReceiveDisabled         = errorsmod.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
-	ErrMaxTransferChannels     = errorsmod.Register(ModuleName, 9, "max transfer channels")
-	ErrInvalidAuthorization    = errorsmod.Register(ModuleName, 10, "invalid transfer authorization")
-	ErrInvalidMemo             = errorsmod.Register(ModuleName, 11, "invalid memo")
-	ErrInvalidForwarding       = errorsmod.Register(ModuleName, 12, "invalid token forwarding")
-	ErrForwardedPacketTimedOut = errorsmod.Register(ModuleName, 13, "forwarded packet timed out")
-	ErrForwardedPacketFailed   = errorsmod.Register(ModuleName, 14, "forwarded packet failed")
+	ErrInvalidPacketTimeout     = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidDenomForTransfer  = errorsmod.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
+	ErrInvalidVersion           = errorsmod.Register(ModuleName, 4, "invalid ICS20 version")
+	ErrInvalidAmount            = errorsmod.Register(ModuleName, 5, "invalid token amount")
+	ErrDenomNotFound            = errorsmod.Register(ModuleName, 6, "denomination not found")
+	ErrSendDisabled             = errorsmod.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled          = errorsmod.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels      = errorsmod.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidAuthorization     = errorsmod.Register(ModuleName, 10, "invalid transfer authorization")
+	ErrInvalidMemo              = errorsmod.Register(ModuleName, 11, "invalid memo")
+	ErrInvalidForwarding        = errorsmod.Register(ModuleName, 12, "invalid token forwarding")
+	ErrForwardedPacketTimedOut  = errorsmod.Register(ModuleName, 13, "forwarded packet timed out")
+	ErrForwardedPacketFailed    = errorsmod.Register(ModuleName, 14, "forwarded packet failed")
+	ErrInvalidDenomRules        = errorsmod.Register(ModuleName, 15, "invalid channel denomination rules")
+	ErrDenomNotAllowed          = errorsmod.Register(ModuleName, 16, "denomination not allowed on channel")
+	ErrInvalidCanonicalAsset    = errorsmod.Register(ModuleName, 17, "invalid canonical asset")
+	ErrCanonicalAssetNotFound   = errorsmod.Register(ModuleName, 18, "canonical asset not found")
+	ErrCanonicalCapExceeded     = errorsmod.Register(ModuleName, 19, "canonical route cap exceeded")
+	ErrInsufficientWrapped      = errorsmod.Register(ModuleName, 20, "insufficient wrapped amount for canonical route")
+	ErrInvalidDenomMetadata     = errorsmod.Register(ModuleName, 21, "invalid denomination metadata")
+	ErrInvalidProtocolFee       = errorsmod.Register(ModuleName, 22, "invalid protocol fee")
+	ErrProtocolFeeExceedsAmount = errorsmod.Register(ModuleName, 23, "protocol fee exceeds transfer amount")
+	ErrInvalidNativeMapping     = errorsmod.Register(ModuleName, 24, "invalid native mapping")
+	ErrNativeSupplyCapExceeded  = errorsmod.Register(ModuleName, 25, "native mapping supply cap exceeded")
+	ErrReceiverRejected         = errorsmod.Register(ModuleName, 26, "receiver rejected the transfer")
 )
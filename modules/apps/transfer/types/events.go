@@ -2,11 +2,16 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenom        = "denomination"
+	EventTypeTimeout           = "timeout"
+	EventTypePacket            = "fungible_token_packet"
+	EventTypeTransfer          = "ibc_transfer"
+	EventTypeChannelClose      = "channel_closed"
+	EventTypeDenom             = "denomination"
+	EventTypeWrapVoucher       = "wrap_voucher"
+	EventTypeUnwrapVoucher     = "unwrap_voucher"
+	EventTypeProtocolFee       = "protocol_fee"
+	EventTypeProtocolFeeRefund = "protocol_fee_refund"
+	EventTypePacketRefund      = "packet_refund"
 
 	AttributeKeySender         = "sender"
 	AttributeKeyReceiver       = "receiver"
@@ -20,4 +25,12 @@ const (
 	AttributeKeyAckError       = "error"
 	AttributeKeyMemo           = "memo"
 	AttributeKeyForwardingHops = "forwarding_hops"
+	AttributeKeyVoucher        = "voucher"
+	AttributeKeyCanonical      = "canonical"
+	AttributeKeyPayer          = "payer"
+	AttributeKeyFeeCollector   = "fee_collector"
+	AttributeKeyFees           = "fees"
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeySequence       = "sequence"
 )
@@ -29,9 +29,11 @@ type BankKeeper interface {
 	BlockedAddr(addr sdk.AccAddress) bool
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	HasDenomMetaData(ctx context.Context, denom string) bool
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
 // ChannelKeeper defines the expected IBC channel keeper
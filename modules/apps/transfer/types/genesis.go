@@ -1,9 +1,14 @@
 package types
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
@@ -35,5 +40,154 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Denoms.Validate(); err != nil {
 		return err
 	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, rules := range gs.ChannelDenomRules {
+		if err := rules.Validate(); err != nil {
+			return err
+		}
+
+		channel := fmt.Sprintf("%s/%s", rules.PortId, rules.ChannelId)
+		if seenChannels[channel] {
+			return errorsmod.Wrapf(ErrInvalidDenomRules, "duplicate denomination rules for port ID (%s) channel ID (%s)", rules.PortId, rules.ChannelId)
+		}
+		seenChannels[channel] = true
+	}
+
+	if err := gs.validateCanonicalAssets(); err != nil {
+		return err
+	}
+
+	if err := gs.ProtocolFeeConfig.Validate(); err != nil {
+		return err
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, packetFee := range gs.PacketProtocolFees {
+		if err := packetFee.Validate(); err != nil {
+			return err
+		}
+
+		packet := fmt.Sprintf("%s/%s/%d", packetFee.PortId, packetFee.ChannelId, packetFee.Sequence)
+		if seenPackets[packet] {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "duplicate protocol fee for packet %s", packet)
+		}
+		seenPackets[packet] = true
+	}
+
+	seenRefunds := make(map[string]bool)
+	for _, refund := range gs.PacketRefundAddresses {
+		if err := refund.Validate(); err != nil {
+			return err
+		}
+
+		packet := fmt.Sprintf("%s/%s/%d", refund.PortId, refund.ChannelId, refund.Sequence)
+		if seenRefunds[packet] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate refund address for packet %s", packet)
+		}
+		seenRefunds[packet] = true
+	}
+
+	seenEscrows := make(map[string]bool)
+	for _, escrow := range gs.ChannelEscrows {
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+
+		channel := fmt.Sprintf("%s/%s", escrow.PortId, escrow.ChannelId)
+		if seenEscrows[channel] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate escrow for channel %s", channel)
+		}
+		seenEscrows[channel] = true
+	}
+
+	seenLocalDenoms := make(map[string]bool)
+	seenPaths := make(map[string]bool)
+	for _, mapping := range gs.NativeMappings {
+		if err := mapping.Validate(); err != nil {
+			return err
+		}
+
+		if seenLocalDenoms[mapping.LocalDenom] {
+			return errorsmod.Wrapf(ErrInvalidNativeMapping, "duplicate native mapping for local denomination %s", mapping.LocalDenom)
+		}
+		seenLocalDenoms[mapping.LocalDenom] = true
+
+		voucherDenom := mapping.Denom().IBCDenom()
+		if seenPaths[voucherDenom] {
+			return errorsmod.Wrapf(ErrInvalidNativeMapping, "path %s is mapped to multiple local denominations", mapping.Path)
+		}
+		seenPaths[voucherDenom] = true
+	}
+
+	if err := gs.NativeMinted.Validate(); err != nil {
+		return err
+	}
+	for _, minted := range gs.NativeMinted {
+		if !seenLocalDenoms[minted.Denom] {
+			return errorsmod.Wrapf(ErrInvalidNativeMapping, "minted amount %s has no native mapping", minted)
+		}
+	}
+
 	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
 }
+
+// validateCanonicalAssets validates the canonical assets and ensures that every route voucher belongs
+// to a single canonical asset and that wrapped amounts and the vouchers unwrapped for packets in flight
+// are only recorded for route vouchers.
+func (gs GenesisState) validateCanonicalAssets() error {
+	seenAssets := make(map[string]bool)
+	routeVouchers := make(map[string]bool)
+	for _, asset := range gs.CanonicalAssets {
+		if err := asset.Validate(); err != nil {
+			return err
+		}
+
+		if seenAssets[asset.Denom] {
+			return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "duplicate canonical asset %s", asset.Denom)
+		}
+		seenAssets[asset.Denom] = true
+
+		for _, route := range asset.Routes {
+			voucherDenom := route.Denom().IBCDenom()
+			if routeVouchers[voucherDenom] {
+				return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "route %s belongs to multiple canonical assets", route.Path)
+			}
+			routeVouchers[voucherDenom] = true
+		}
+	}
+
+	if err := gs.CanonicalWrapped.Validate(); err != nil {
+		return err
+	}
+
+	for _, wrapped := range gs.CanonicalWrapped {
+		if !routeVouchers[wrapped.Denom] {
+			return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "wrapped denomination %s is not the voucher of a canonical route", wrapped.Denom)
+		}
+	}
+
+	seenUnwraps := make(map[string]bool)
+	for _, unwrap := range gs.PacketCanonicalUnwraps {
+		if err := unwrap.Validate(); err != nil {
+			return err
+		}
+
+		packet := fmt.Sprintf("%s/%s/%d", unwrap.PortId, unwrap.ChannelId, unwrap.Sequence)
+		if seenUnwraps[packet] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate unwrapped vouchers for packet %s", packet)
+		}
+		seenUnwraps[packet] = true
+
+		for _, voucher := range unwrap.Vouchers {
+			if !routeVouchers[voucher.Denom] {
+				return errorsmod.Wrapf(ErrInvalidCanonicalAsset, "unwrapped denomination %s is not the voucher of a canonical route", voucher.Denom)
+			}
+		}
+	}
+
+	return nil
+}
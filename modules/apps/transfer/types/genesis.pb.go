@@ -33,6 +33,26 @@ type GenesisState struct {
 	// total_escrowed contains the total amount of tokens escrowed
 	// by the transfer module
 	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// channel_denom_rules contains the denomination rules of the channels
+	ChannelDenomRules []ChannelDenomRules `protobuf:"bytes,5,rep,name=channel_denom_rules,json=channelDenomRules,proto3" json:"channel_denom_rules"`
+	// canonical_assets contains the registered canonical assets
+	CanonicalAssets []CanonicalAsset `protobuf:"bytes,6,rep,name=canonical_assets,json=canonicalAssets,proto3" json:"canonical_assets"`
+	// canonical_wrapped contains the amounts of the route vouchers wrapped into canonical denominations
+	CanonicalWrapped github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=canonical_wrapped,json=canonicalWrapped,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"canonical_wrapped"`
+	// protocol_fee_config contains the protocol fee configuration
+	ProtocolFeeConfig ProtocolFeeConfig `protobuf:"bytes,8,opt,name=protocol_fee_config,json=protocolFeeConfig,proto3" json:"protocol_fee_config"`
+	// packet_protocol_fees contains the protocol fees held for the packets in flight
+	PacketProtocolFees []PacketProtocolFee `protobuf:"bytes,9,rep,name=packet_protocol_fees,json=packetProtocolFees,proto3" json:"packet_protocol_fees"`
+	// packet_refund_addresses contains the refund addresses of the packets in flight
+	PacketRefundAddresses []PacketRefundAddress `protobuf:"bytes,10,rep,name=packet_refund_addresses,json=packetRefundAddresses,proto3" json:"packet_refund_addresses"`
+	// channel_escrows contains the amount of tokens escrowed for each channel
+	ChannelEscrows []ChannelEscrow `protobuf:"bytes,11,rep,name=channel_escrows,json=channelEscrows,proto3" json:"channel_escrows"`
+	// native_mappings contains the foreign assets mapped to local denominations
+	NativeMappings []NativeMapping `protobuf:"bytes,12,rep,name=native_mappings,json=nativeMappings,proto3" json:"native_mappings"`
+	// native_minted contains the amounts of the local denominations minted for foreign assets
+	NativeMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=native_minted,json=nativeMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"native_minted"`
+	// packet_canonical_unwraps contains the route vouchers unwrapped for the packets in flight
+	PacketCanonicalUnwraps []PacketCanonicalUnwrap `protobuf:"bytes,14,rep,name=packet_canonical_unwraps,json=packetCanonicalUnwraps,proto3" json:"packet_canonical_unwraps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +116,76 @@ func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetChannelDenomRules() []ChannelDenomRules {
+	if m != nil {
+		return m.ChannelDenomRules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCanonicalAssets() []CanonicalAsset {
+	if m != nil {
+		return m.CanonicalAssets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCanonicalWrapped() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CanonicalWrapped
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProtocolFeeConfig() ProtocolFeeConfig {
+	if m != nil {
+		return m.ProtocolFeeConfig
+	}
+	return ProtocolFeeConfig{}
+}
+
+func (m *GenesisState) GetPacketProtocolFees() []PacketProtocolFee {
+	if m != nil {
+		return m.PacketProtocolFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketRefundAddresses() []PacketRefundAddress {
+	if m != nil {
+		return m.PacketRefundAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelEscrows() []ChannelEscrow {
+	if m != nil {
+		return m.ChannelEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNativeMappings() []NativeMapping {
+	if m != nil {
+		return m.NativeMappings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNativeMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NativeMinted
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketCanonicalUnwraps() []PacketCanonicalUnwrap {
+	if m != nil {
+		return m.PacketCanonicalUnwraps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v2.GenesisState")
 }
@@ -105,31 +195,49 @@ func init() {
 }
 
 var fileDescriptor_62efebb47a9093ed = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xc1, 0x4e, 0xdb, 0x40,
-	0x10, 0xb5, 0x93, 0xc8, 0x55, 0x9d, 0x36, 0x07, 0xab, 0x52, 0xdd, 0xa8, 0x72, 0xa2, 0xb6, 0x07,
-	0xab, 0x55, 0x76, 0x6b, 0x73, 0x40, 0x5c, 0x0d, 0x08, 0x21, 0x2e, 0x60, 0x6e, 0x5c, 0xa2, 0xf5,
-	0x7a, 0x31, 0xab, 0xc4, 0x1e, 0xcb, 0xbb, 0x31, 0xe2, 0x2f, 0xf8, 0x0e, 0xc4, 0x87, 0xe4, 0x98,
-	0x23, 0x27, 0x40, 0xc9, 0x8f, 0x20, 0xaf, 0x1d, 0x14, 0x09, 0xc9, 0xa7, 0x9d, 0xd9, 0x7d, 0xf3,
-	0xde, 0xec, 0x7b, 0xe6, 0x5f, 0x1e, 0x51, 0x4c, 0xf2, 0x7c, 0xce, 0x29, 0x91, 0x1c, 0x32, 0x81,
-	0x65, 0x41, 0x32, 0x71, 0xcd, 0x0a, 0x5c, 0xfa, 0x38, 0x61, 0x19, 0x13, 0x5c, 0xa0, 0xbc, 0x00,
-	0x09, 0xd6, 0x4f, 0x1e, 0x51, 0xb4, 0x8b, 0x45, 0x5b, 0x2c, 0x2a, 0xfd, 0xe1, 0xbf, 0x16, 0x26,
-	0xef, 0xbd, 0xae, 0xa9, 0x86, 0x6e, 0xab, 0xac, 0x84, 0x19, 0xcb, 0x1a, 0xa4, 0x43, 0x41, 0xa4,
-	0x20, 0x70, 0x44, 0x04, 0xc3, 0xa5, 0x17, 0x31, 0x49, 0x3c, 0x4c, 0x81, 0x6f, 0xdf, 0xbf, 0x25,
-	0x90, 0x80, 0x2a, 0x71, 0x55, 0xd5, 0xb7, 0xbf, 0x1e, 0x3b, 0xe6, 0x97, 0x93, 0x7a, 0xf9, 0x4b,
-	0x49, 0x24, 0xb3, 0xbe, 0x9b, 0x9f, 0x72, 0x28, 0xe4, 0x94, 0xc7, 0xb6, 0x3e, 0xd6, 0xdd, 0xcf,
-	0xa1, 0x51, 0xb5, 0xa7, 0xb1, 0x75, 0x66, 0x1a, 0x31, 0xcb, 0x20, 0x15, 0x76, 0x67, 0xdc, 0x75,
-	0xfb, 0xfe, 0x6f, 0xd4, 0xf6, 0x4b, 0x74, 0x54, 0x61, 0x83, 0xc1, 0xf2, 0x79, 0xa4, 0x3d, 0xbc,
-	0x8c, 0x0c, 0xd5, 0x8a, 0xb0, 0xa1, 0xb0, 0x02, 0xd3, 0xc8, 0x49, 0x41, 0x52, 0x61, 0x77, 0xc7,
-	0xba, 0xdb, 0xf7, 0xff, 0xb4, 0x91, 0x79, 0xe8, 0x5c, 0x61, 0x83, 0x5e, 0xc5, 0x16, 0x36, 0x93,
-	0x56, 0x61, 0x0e, 0x24, 0x48, 0x32, 0x9f, 0x32, 0x41, 0x0b, 0xb8, 0x65, 0xb1, 0xdd, 0x53, 0x8b,
-	0xfd, 0x40, 0xb5, 0x13, 0xa8, 0x72, 0x02, 0x35, 0x4e, 0xa0, 0x43, 0xe0, 0x59, 0xf0, 0xbf, 0x59,
-	0xc7, 0x4d, 0xb8, 0xbc, 0x59, 0x44, 0x88, 0x42, 0x8a, 0x1b, 0xdb, 0xea, 0x63, 0x22, 0xe2, 0x19,
-	0x96, 0x77, 0x39, 0x13, 0x6a, 0x40, 0x84, 0x5f, 0x95, 0xc4, 0x71, 0xa3, 0x10, 0x5c, 0x2c, 0xd7,
-	0x8e, 0xbe, 0x5a, 0x3b, 0xfa, 0xeb, 0xda, 0xd1, 0xef, 0x37, 0x8e, 0xb6, 0xda, 0x38, 0xda, 0xd3,
-	0xc6, 0xd1, 0xae, 0xf6, 0x3f, 0x52, 0xf2, 0x88, 0x4e, 0x12, 0xc0, 0xe5, 0x01, 0x4e, 0x21, 0x5e,
-	0xcc, 0x99, 0xa8, 0x82, 0xdc, 0x09, 0x50, 0xe9, 0x44, 0x86, 0x0a, 0x62, 0xef, 0x2d, 0x00, 0x00,
-	0xff, 0xff, 0x87, 0x4d, 0x84, 0xc3, 0x61, 0x02, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xdf, 0x4e, 0x13, 0x41,
+	0x14, 0xc6, 0xbb, 0x82, 0x45, 0x06, 0x28, 0x32, 0xa2, 0x8c, 0xc4, 0x2c, 0x8d, 0x7a, 0xd1, 0x88,
+	0xec, 0xda, 0x72, 0x61, 0xbc, 0xa4, 0xf8, 0x27, 0xc6, 0x68, 0xb0, 0xc6, 0x98, 0x90, 0x98, 0xcd,
+	0xec, 0xec, 0x74, 0x99, 0xd0, 0xce, 0x4c, 0xf6, 0x4c, 0x8b, 0xbe, 0x85, 0xcf, 0xe1, 0x93, 0x70,
+	0xe1, 0x05, 0x97, 0x5e, 0xa9, 0x81, 0x17, 0x31, 0x3b, 0x3b, 0x0b, 0x15, 0x4c, 0xe9, 0x05, 0x57,
+	0x3b, 0x3b, 0xe7, 0x7c, 0xdf, 0x6f, 0xce, 0x99, 0xb3, 0x8b, 0x1e, 0x89, 0x98, 0x85, 0x54, 0xeb,
+	0x9e, 0x60, 0xd4, 0x08, 0x25, 0x21, 0x34, 0x19, 0x95, 0xd0, 0xe5, 0x59, 0x38, 0x6c, 0x85, 0x29,
+	0x97, 0x1c, 0x04, 0x04, 0x3a, 0x53, 0x46, 0xe1, 0x7b, 0x22, 0x66, 0xc1, 0x68, 0x6e, 0x50, 0xe6,
+	0x06, 0xc3, 0xd6, 0xea, 0xfa, 0x18, 0xa7, 0xe6, 0xe9, 0xba, 0xb0, 0x5a, 0x6d, 0x8c, 0xc5, 0x1a,
+	0xb5, 0xcf, 0xa5, 0xcb, 0xf4, 0x99, 0x82, 0xbe, 0x82, 0x30, 0xa6, 0xc0, 0xc3, 0x61, 0x33, 0xe6,
+	0x86, 0x36, 0x43, 0xa6, 0x44, 0x19, 0x5f, 0x4e, 0x55, 0xaa, 0xec, 0x32, 0xcc, 0x57, 0xc5, 0xee,
+	0xfd, 0x1f, 0x08, 0xcd, 0xbf, 0x2a, 0x0e, 0xff, 0xc1, 0x50, 0xc3, 0xf1, 0x0a, 0x9a, 0xd1, 0x2a,
+	0x33, 0x91, 0x48, 0x88, 0x57, 0xf7, 0x1a, 0xb3, 0x9d, 0x6a, 0xfe, 0xfa, 0x3a, 0xc1, 0x6f, 0x50,
+	0x35, 0xe1, 0x52, 0xf5, 0x81, 0x5c, 0xab, 0x4f, 0x35, 0xe6, 0x5a, 0x0f, 0x82, 0x71, 0x55, 0x06,
+	0xcf, 0xf3, 0xdc, 0x76, 0xed, 0xf0, 0xd7, 0x5a, 0xe5, 0xfb, 0xef, 0xb5, 0xaa, 0x7d, 0x85, 0x8e,
+	0xb3, 0xc0, 0x6d, 0x54, 0xd5, 0x34, 0xa3, 0x7d, 0x20, 0x53, 0x75, 0xaf, 0x31, 0xd7, 0x7a, 0x38,
+	0xce, 0xac, 0x19, 0xec, 0xd8, 0xdc, 0xf6, 0x74, 0xee, 0xd6, 0x71, 0x4a, 0x9c, 0xa1, 0x9a, 0x51,
+	0x86, 0xf6, 0x22, 0x0e, 0x2c, 0x53, 0x07, 0x3c, 0x21, 0xd3, 0xf6, 0x60, 0x77, 0x83, 0xa2, 0x13,
+	0x41, 0xde, 0x89, 0xc0, 0x75, 0x22, 0xd8, 0x56, 0x42, 0xb6, 0x9f, 0xb8, 0xe3, 0x34, 0x52, 0x61,
+	0xf6, 0x06, 0x71, 0xc0, 0x54, 0x3f, 0x74, 0x6d, 0x2b, 0x1e, 0x1b, 0x90, 0xec, 0x87, 0xe6, 0xab,
+	0xe6, 0x60, 0x05, 0xd0, 0x59, 0xb0, 0x88, 0x17, 0x8e, 0x80, 0x39, 0xba, 0xc5, 0xf6, 0xa8, 0x94,
+	0xbc, 0x17, 0xd9, 0x4a, 0xa2, 0x6c, 0xd0, 0xe3, 0x40, 0xae, 0x5b, 0x70, 0x38, 0xbe, 0x88, 0xed,
+	0x42, 0x68, 0x3b, 0xd1, 0xc9, 0x65, 0xae, 0x9e, 0x25, 0x76, 0x3e, 0x80, 0x3f, 0xa3, 0x9b, 0x8c,
+	0x4a, 0x25, 0x05, 0xa3, 0xbd, 0x88, 0x02, 0x70, 0x03, 0xa4, 0x6a, 0x19, 0x8f, 0x2f, 0x61, 0x94,
+	0xaa, 0xad, 0x5c, 0xe4, 0x00, 0x8b, 0xec, 0x9f, 0x5d, 0xc0, 0x5f, 0xd0, 0xd2, 0x99, 0xfd, 0x41,
+	0x46, 0xb5, 0xe6, 0x09, 0x99, 0xb9, 0xfa, 0xe6, 0x9d, 0x15, 0xf1, 0xa9, 0x80, 0xe4, 0xfd, 0xb3,
+	0x73, 0xc7, 0x54, 0x2f, 0xea, 0x72, 0x1e, 0x31, 0x25, 0xbb, 0x22, 0x25, 0x37, 0xea, 0xde, 0xe5,
+	0xfd, 0xdb, 0x71, 0xc2, 0x97, 0x9c, 0x6f, 0x5b, 0x59, 0xd9, 0x3f, 0x7d, 0x3e, 0x80, 0x53, 0xb4,
+	0xac, 0x29, 0xdb, 0xe7, 0x26, 0x1a, 0xa5, 0x01, 0x99, 0x9d, 0xe4, 0x9e, 0x76, 0xac, 0x72, 0x84,
+	0xe6, 0x38, 0x58, 0x9f, 0x0f, 0x00, 0x56, 0x68, 0xc5, 0x81, 0x32, 0xde, 0x1d, 0xc8, 0x24, 0xa2,
+	0x49, 0x92, 0x71, 0x00, 0x0e, 0x04, 0x59, 0x56, 0x73, 0x12, 0x56, 0xc7, 0x6a, 0xb7, 0x0a, 0xa9,
+	0xa3, 0xdd, 0xd6, 0x17, 0x43, 0x1c, 0xf0, 0x2e, 0x5a, 0x2c, 0x07, 0xb0, 0x18, 0x7b, 0x20, 0x73,
+	0x16, 0xb4, 0x3e, 0xd1, 0xf0, 0x15, 0x83, 0xec, 0x10, 0x35, 0x36, 0xba, 0x69, 0xbd, 0x25, 0x35,
+	0x62, 0xc8, 0xa3, 0x3e, 0xd5, 0x5a, 0xc8, 0x14, 0xc8, 0xfc, 0x24, 0xde, 0xef, 0xac, 0xe8, 0x6d,
+	0xa1, 0x29, 0xbd, 0xe5, 0xe8, 0x26, 0x60, 0x8d, 0x16, 0x4a, 0x6f, 0x21, 0x0d, 0x4f, 0xc8, 0xc2,
+	0xd5, 0x8f, 0xdb, 0xbc, 0x63, 0x5a, 0x00, 0x06, 0x44, 0xdc, 0xd5, 0x9c, 0xcd, 0xfa, 0x40, 0xe6,
+	0xd3, 0x0e, 0xa4, 0x66, 0xe1, 0x9b, 0x93, 0xdc, 0xcd, 0xe9, 0x17, 0xf5, 0xd1, 0x6a, 0x5d, 0x79,
+	0x77, 0xf4, 0xff, 0x82, 0xd0, 0x7e, 0x7f, 0x78, 0xec, 0x7b, 0x47, 0xc7, 0xbe, 0xf7, 0xe7, 0xd8,
+	0xf7, 0xbe, 0x9d, 0xf8, 0x95, 0xa3, 0x13, 0xbf, 0xf2, 0xf3, 0xc4, 0xaf, 0xec, 0x3e, 0xbd, 0x58,
+	0x86, 0x88, 0xd9, 0x46, 0xaa, 0xc2, 0xe1, 0xb3, 0xb0, 0xaf, 0x92, 0xfc, 0xbb, 0xcf, 0x7f, 0xf4,
+	0x23, 0x3f, 0x78, 0x5b, 0x5b, 0x5c, 0xb5, 0x23, 0xbc, 0xf9, 0x77, 0x00, 0x8c, 0xf7, 0x74, 0x14,
+	0x81, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +260,142 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PacketCanonicalUnwraps) > 0 {
+		for iNdEx := len(m.PacketCanonicalUnwraps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketCanonicalUnwraps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.NativeMinted) > 0 {
+		for iNdEx := len(m.NativeMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NativeMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.NativeMappings) > 0 {
+		for iNdEx := len(m.NativeMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NativeMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ChannelEscrows) > 0 {
+		for iNdEx := len(m.ChannelEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PacketRefundAddresses) > 0 {
+		for iNdEx := len(m.PacketRefundAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketRefundAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PacketProtocolFees) > 0 {
+		for iNdEx := len(m.PacketProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.ProtocolFeeConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.CanonicalWrapped) > 0 {
+		for iNdEx := len(m.CanonicalWrapped) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanonicalWrapped[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CanonicalAssets) > 0 {
+		for iNdEx := len(m.CanonicalAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanonicalAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChannelDenomRules) > 0 {
+		for iNdEx := len(m.ChannelDenomRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelDenomRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TotalEscrowed) > 0 {
 		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -235,6 +479,62 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ChannelDenomRules) > 0 {
+		for _, e := range m.ChannelDenomRules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CanonicalAssets) > 0 {
+		for _, e := range m.CanonicalAssets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CanonicalWrapped) > 0 {
+		for _, e := range m.CanonicalWrapped {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ProtocolFeeConfig.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PacketProtocolFees) > 0 {
+		for _, e := range m.PacketProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketRefundAddresses) > 0 {
+		for _, e := range m.PacketRefundAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelEscrows) > 0 {
+		for _, e := range m.ChannelEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NativeMappings) > 0 {
+		for _, e := range m.NativeMappings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NativeMinted) > 0 {
+		for _, e := range m.NativeMinted {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketCanonicalUnwraps) > 0 {
+		for _, e := range m.PacketCanonicalUnwraps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -406,6 +706,345 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelDenomRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelDenomRules = append(m.ChannelDenomRules, ChannelDenomRules{})
+			if err := m.ChannelDenomRules[len(m.ChannelDenomRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalAssets = append(m.CanonicalAssets, CanonicalAsset{})
+			if err := m.CanonicalAssets[len(m.CanonicalAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalWrapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalWrapped = append(m.CanonicalWrapped, types.Coin{})
+			if err := m.CanonicalWrapped[len(m.CanonicalWrapped)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketProtocolFees = append(m.PacketProtocolFees, PacketProtocolFee{})
+			if err := m.PacketProtocolFees[len(m.PacketProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRefundAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketRefundAddresses = append(m.PacketRefundAddresses, PacketRefundAddress{})
+			if err := m.PacketRefundAddresses[len(m.PacketRefundAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelEscrows = append(m.ChannelEscrows, ChannelEscrow{})
+			if err := m.ChannelEscrows[len(m.ChannelEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeMappings = append(m.NativeMappings, NativeMapping{})
+			if err := m.NativeMappings[len(m.NativeMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeMinted = append(m.NativeMinted, types.Coin{})
+			if err := m.NativeMinted[len(m.NativeMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCanonicalUnwraps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketCanonicalUnwraps = append(m.PacketCanonicalUnwraps, PacketCanonicalUnwrap{})
+			if err := m.PacketCanonicalUnwraps[len(m.PacketCanonicalUnwraps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,7 +5,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
 func TestValidateGenesis(t *testing.T) {
@@ -26,6 +31,215 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with channel denomination rules",
+			&types.GenesisState{
+				PortId: "portidone",
+				ChannelDenomRules: []types.ChannelDenomRules{
+					types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"uatom"}, 0),
+					types.NewChannelDenomRules("transfer", "channel-1", types.DENOM_FILTER_NONE, nil, 1),
+				},
+			},
+			true,
+		},
+		{
+			"invalid channel denomination rules",
+			&types.GenesisState{
+				PortId: "portidone",
+				ChannelDenomRules: []types.ChannelDenomRules{
+					types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_DENY, nil, 0),
+				},
+			},
+			false,
+		},
+		{
+			"duplicate channel denomination rules",
+			&types.GenesisState{
+				PortId: "portidone",
+				ChannelDenomRules: []types.ChannelDenomRules{
+					types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_ALLOW, []string{"uatom"}, 0),
+					types.NewChannelDenomRules("transfer", "channel-0", types.DENOM_FILTER_NONE, nil, 1),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with canonical assets",
+			&types.GenesisState{
+				PortId:           "portidone",
+				CanonicalAssets:  []types.CanonicalAsset{types.NewCanonicalAsset("canonical/atom", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt()))},
+				CanonicalWrapped: sdk.NewCoins(sdk.NewCoin(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")).IBCDenom(), sdkmath.NewInt(10))),
+			},
+			true,
+		},
+		{
+			"route belongs to multiple canonical assets",
+			&types.GenesisState{
+				PortId: "portidone",
+				CanonicalAssets: []types.CanonicalAsset{
+					types.NewCanonicalAsset("canonical/atom", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt())),
+					types.NewCanonicalAsset("canonical/atom2", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt())),
+				},
+			},
+			false,
+		},
+		{
+			"wrapped denomination is not a route voucher",
+			&types.GenesisState{
+				PortId:           "portidone",
+				CanonicalAssets:  []types.CanonicalAsset{types.NewCanonicalAsset("canonical/atom", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt()))},
+				CanonicalWrapped: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(10))),
+			},
+			false,
+		},
+		{
+			"valid genesis with vouchers unwrapped for a packet",
+			&types.GenesisState{
+				PortId:                 "portidone",
+				CanonicalAssets:        []types.CanonicalAsset{types.NewCanonicalAsset("canonical/atom", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt()))},
+				PacketCanonicalUnwraps: []types.PacketCanonicalUnwrap{types.NewPacketCanonicalUnwrap("transfer", "channel-0", 1, sdk.NewCoins(sdk.NewCoin(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")).IBCDenom(), sdkmath.NewInt(10))))},
+			},
+			true,
+		},
+		{
+			"vouchers unwrapped for a packet are not route vouchers",
+			&types.GenesisState{
+				PortId:                 "portidone",
+				CanonicalAssets:        []types.CanonicalAsset{types.NewCanonicalAsset("canonical/atom", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt()))},
+				PacketCanonicalUnwraps: []types.PacketCanonicalUnwrap{types.NewPacketCanonicalUnwrap("transfer", "channel-0", 1, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(10))))},
+			},
+			false,
+		},
+		{
+			"vouchers unwrapped for a packet with sequence 0",
+			&types.GenesisState{
+				PortId:                 "portidone",
+				CanonicalAssets:        []types.CanonicalAsset{types.NewCanonicalAsset("canonical/atom", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt()))},
+				PacketCanonicalUnwraps: []types.PacketCanonicalUnwrap{types.NewPacketCanonicalUnwrap("transfer", "channel-0", 0, sdk.NewCoins(sdk.NewCoin(types.NewDenom("uatom", types.NewHop("transfer", "channel-0")).IBCDenom(), sdkmath.NewInt(10))))},
+			},
+			false,
+		},
+		{
+			"valid genesis with protocol fees",
+			&types.GenesisState{
+				PortId:             "portidone",
+				ProtocolFeeConfig:  types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee("transfer", "channel-0", 100, nil)),
+				PacketProtocolFees: []types.PacketProtocolFee{types.NewPacketProtocolFee("transfer", "channel-0", 1, ibctesting.TestAccAddress, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1))))},
+			},
+			true,
+		},
+		{
+			"duplicate packet protocol fees",
+			&types.GenesisState{
+				PortId: "portidone",
+				PacketProtocolFees: []types.PacketProtocolFee{
+					types.NewPacketProtocolFee("transfer", "channel-0", 1, ibctesting.TestAccAddress, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1)))),
+					types.NewPacketProtocolFee("transfer", "channel-0", 1, ibctesting.TestAccAddress, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(2)))),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with packet refund addresses",
+			&types.GenesisState{
+				PortId:                "portidone",
+				PacketRefundAddresses: []types.PacketRefundAddress{types.NewPacketRefundAddress("transfer", "channel-0", 1, ibctesting.TestAccAddress)},
+			},
+			true,
+		},
+		{
+			"invalid packet refund address",
+			&types.GenesisState{
+				PortId:                "portidone",
+				PacketRefundAddresses: []types.PacketRefundAddress{types.NewPacketRefundAddress("transfer", "channel-0", 1, "invalid")},
+			},
+			false,
+		},
+		{
+			"duplicate packet refund addresses",
+			&types.GenesisState{
+				PortId: "portidone",
+				PacketRefundAddresses: []types.PacketRefundAddress{
+					types.NewPacketRefundAddress("transfer", "channel-0", 1, ibctesting.TestAccAddress),
+					types.NewPacketRefundAddress("transfer", "channel-0", 1, ibctesting.TestAccAddress),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with channel escrows",
+			&types.GenesisState{
+				PortId:         "portidone",
+				ChannelEscrows: []types.ChannelEscrow{types.NewChannelEscrow("transfer", "channel-0", sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1))))},
+			},
+			true,
+		},
+		{
+			"invalid channel escrow",
+			&types.GenesisState{
+				PortId:         "portidone",
+				ChannelEscrows: []types.ChannelEscrow{types.NewChannelEscrow("transfer", "", sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1))))},
+			},
+			false,
+		},
+		{
+			"duplicate channel escrows",
+			&types.GenesisState{
+				PortId: "portidone",
+				ChannelEscrows: []types.ChannelEscrow{
+					types.NewChannelEscrow("transfer", "channel-0", sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1)))),
+					types.NewChannelEscrow("transfer", "channel-0", sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(2)))),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with native mappings",
+			&types.GenesisState{
+				PortId:         "portidone",
+				NativeMappings: []types.NativeMapping{types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", "", sdkmath.ZeroInt())},
+				NativeMinted:   sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(10))),
+			},
+			true,
+		},
+		{
+			"invalid native mapping",
+			&types.GenesisState{
+				PortId:         "portidone",
+				NativeMappings: []types.NativeMapping{types.NewNativeMapping("uusdc", "uusdc", "", sdkmath.ZeroInt())},
+			},
+			false,
+		},
+		{
+			"duplicate native mappings for local denomination",
+			&types.GenesisState{
+				PortId: "portidone",
+				NativeMappings: []types.NativeMapping{
+					types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", "", sdkmath.ZeroInt()),
+					types.NewNativeMapping("uusdc", "transfer/channel-1/uusdc", "", sdkmath.ZeroInt()),
+				},
+			},
+			false,
+		},
+		{
+			"path mapped to multiple local denominations",
+			&types.GenesisState{
+				PortId: "portidone",
+				NativeMappings: []types.NativeMapping{
+					types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", "", sdkmath.ZeroInt()),
+					types.NewNativeMapping("usdc", "transfer/channel-0/uusdc", "", sdkmath.ZeroInt()),
+				},
+			},
+			false,
+		},
+		{
+			"minted amount without native mapping",
+			&types.GenesisState{
+				PortId:       "portidone",
+				NativeMinted: sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(10))),
+			},
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -33,6 +33,9 @@ const (
 
 	ParamsKey = "params"
 
+	// CanonicalDenomPrefix is the prefix of the canonical denominations minted for wrapped vouchers.
+	CanonicalDenomPrefix = "canonical"
+
 	// V1 defines first version of the IBC transfer module
 	V1 = "ics20-1"
 
@@ -55,6 +58,30 @@ var (
 	DenomKey = []byte{0x03}
 	// forwardPacketKey defines the key to store the forwarded packet in store
 	forwardPacketKey = []byte{0x04}
+	// ChannelDenomRulesKey defines the key to store the denomination rules of a channel in store
+	ChannelDenomRulesKey = []byte{0x05}
+	// CanonicalAssetKey defines the key to store the canonical assets in store
+	CanonicalAssetKey = []byte{0x06}
+	// CanonicalRouteKey defines the key to store the canonical denomination of a route voucher in store
+	CanonicalRouteKey = []byte{0x07}
+	// CanonicalWrappedKey defines the key to store the amount of a route voucher wrapped in store
+	CanonicalWrappedKey = []byte{0x08}
+	// ProtocolFeeConfigKey defines the key to store the protocol fee configuration in store
+	ProtocolFeeConfigKey = []byte{0x09}
+	// PacketProtocolFeeKey defines the key to store the protocol fees held for sent packets in store
+	PacketProtocolFeeKey = []byte{0x0a}
+	// PacketRefundAddressKey defines the key to store the refund addresses of sent packets in store
+	PacketRefundAddressKey = []byte{0x0b}
+	// ChannelEscrowKey defines the key to store the amount of tokens escrowed for a channel in store
+	ChannelEscrowKey = []byte{0x0c}
+	// NativeMappingKey defines the key to store the native mappings of foreign assets in store
+	NativeMappingKey = []byte{0x0d}
+	// NativeRouteKey defines the key to store the local denomination of a mapped voucher in store
+	NativeRouteKey = []byte{0x0e}
+	// NativeMintedKey defines the key to store the amount of a local denomination minted in store
+	NativeMintedKey = []byte{0x0f}
+	// PacketCanonicalUnwrapKey defines the key to store the route vouchers unwrapped for sent packets in store
+	PacketCanonicalUnwrapKey = []byte{0x10}
 
 	// SupportedVersions defines all versions that are supported by the module
 	SupportedVersions = []string{V2, V1}
@@ -87,3 +114,69 @@ func TotalEscrowForDenomKey(denom string) []byte {
 func PacketForwardKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%s", forwardPacketKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
 }
+
+// ChannelDenomRulesStoreKey returns the store key under which the denomination rules
+// are stored for the provided portID and channelID.
+func ChannelDenomRulesStoreKey(portID, channelID string) []byte {
+	return append(ChannelDenomRulesKey, []byte(fmt.Sprintf("%s/%s", portID, channelID))...)
+}
+
+// CanonicalAssetStoreKey returns the store key under which the canonical asset with the
+// provided denomination is stored.
+func CanonicalAssetStoreKey(denom string) []byte {
+	return append(CanonicalAssetKey, []byte(denom)...)
+}
+
+// CanonicalRouteStoreKey returns the store key under which the canonical denomination of the
+// provided route voucher denomination is stored.
+func CanonicalRouteStoreKey(voucherDenom string) []byte {
+	return append(CanonicalRouteKey, []byte(voucherDenom)...)
+}
+
+// CanonicalWrappedStoreKey returns the store key under which the wrapped amount of the
+// provided route voucher denomination is stored.
+func CanonicalWrappedStoreKey(voucherDenom string) []byte {
+	return append(CanonicalWrappedKey, []byte(voucherDenom)...)
+}
+
+// PacketProtocolFeeStoreKey returns the store key under which the protocol fee held for the
+// packet with the provided source portID, channelID and sequence is stored.
+func PacketProtocolFeeStoreKey(portID, channelID string, sequence uint64) []byte {
+	return append(PacketProtocolFeeKey, []byte(fmt.Sprintf("%s/%s/%s", portID, channelID, sdk.Uint64ToBigEndian(sequence)))...)
+}
+
+// PacketRefundAddressStoreKey returns the store key under which the refund address of the
+// packet with the provided source portID, channelID and sequence is stored.
+func PacketRefundAddressStoreKey(portID, channelID string, sequence uint64) []byte {
+	return append(PacketRefundAddressKey, []byte(fmt.Sprintf("%s/%s/%s", portID, channelID, sdk.Uint64ToBigEndian(sequence)))...)
+}
+
+// PacketCanonicalUnwrapStoreKey returns the store key under which the route vouchers unwrapped for the
+// packet with the provided source portID, channelID and sequence are stored.
+func PacketCanonicalUnwrapStoreKey(portID, channelID string, sequence uint64) []byte {
+	return append(PacketCanonicalUnwrapKey, []byte(fmt.Sprintf("%s/%s/%s", portID, channelID, sdk.Uint64ToBigEndian(sequence)))...)
+}
+
+// ChannelEscrowStoreKey returns the store key under which the amount of tokens escrowed
+// for the provided portID and channelID is stored.
+func ChannelEscrowStoreKey(portID, channelID string) []byte {
+	return append(ChannelEscrowKey, []byte(fmt.Sprintf("%s/%s", portID, channelID))...)
+}
+
+// NativeMappingStoreKey returns the store key under which the native mapping of the provided
+// local denomination is stored.
+func NativeMappingStoreKey(localDenom string) []byte {
+	return append(NativeMappingKey, []byte(localDenom)...)
+}
+
+// NativeRouteStoreKey returns the store key under which the local denomination of the provided
+// voucher denomination is stored.
+func NativeRouteStoreKey(voucherDenom string) []byte {
+	return append(NativeRouteKey, []byte(voucherDenom)...)
+}
+
+// NativeMintedStoreKey returns the store key under which the minted amount of the provided
+// local denomination is stored.
+func NativeMintedStoreKey(localDenom string) []byte {
+	return append(NativeMintedKey, []byte(localDenom)...)
+}
@@ -4,8 +4,10 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
@@ -20,8 +22,22 @@ const (
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
+	_ sdk.Msg              = (*MsgUpdateChannelDenomRules)(nil)
+	_ sdk.Msg              = (*MsgRegisterCanonicalAsset)(nil)
+	_ sdk.Msg              = (*MsgWrapVoucher)(nil)
+	_ sdk.Msg              = (*MsgUnwrapVoucher)(nil)
+	_ sdk.Msg              = (*MsgUpdateDenomMetadata)(nil)
+	_ sdk.Msg              = (*MsgUpdateProtocolFeeConfig)(nil)
+	_ sdk.Msg              = (*MsgRegisterNativeMapping)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateChannelDenomRules)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterCanonicalAsset)(nil)
+	_ sdk.HasValidateBasic = (*MsgWrapVoucher)(nil)
+	_ sdk.HasValidateBasic = (*MsgUnwrapVoucher)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateDenomMetadata)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateProtocolFeeConfig)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterNativeMapping)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
 )
 
@@ -40,9 +56,165 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
+	return msg.Params.Validate()
+}
+
+// NewMsgUpdateChannelDenomRules creates a new MsgUpdateChannelDenomRules instance
+func NewMsgUpdateChannelDenomRules(signer string, rules ChannelDenomRules) *MsgUpdateChannelDenomRules {
+	return &MsgUpdateChannelDenomRules{
+		Signer: signer,
+		Rules:  rules,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateChannelDenomRules) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Rules.Validate()
+}
+
+// NewMsgRegisterCanonicalAsset creates a new MsgRegisterCanonicalAsset instance
+func NewMsgRegisterCanonicalAsset(signer string, asset CanonicalAsset) *MsgRegisterCanonicalAsset {
+	return &MsgRegisterCanonicalAsset{
+		Signer: signer,
+		Asset:  asset,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRegisterCanonicalAsset) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Asset.Validate()
+}
+
+// NewMsgRegisterNativeMapping creates a new MsgRegisterNativeMapping instance
+func NewMsgRegisterNativeMapping(signer string, mapping NativeMapping) *MsgRegisterNativeMapping {
+	return &MsgRegisterNativeMapping{
+		Signer:  signer,
+		Mapping: mapping,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRegisterNativeMapping) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Mapping.Validate()
+}
+
+// NewMsgUpdateDenomMetadata creates a new MsgUpdateDenomMetadata instance
+func NewMsgUpdateDenomMetadata(signer string, metadata banktypes.Metadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{
+		Signer:   signer,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateDenomMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomMetadata, err.Error())
+	}
+
+	if err := validateIBCDenom(msg.Metadata.Base); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomMetadata, err.Error())
+	}
+	if !strings.HasPrefix(msg.Metadata.Base, DenomPrefix+"/") {
+		return errorsmod.Wrapf(ErrInvalidDenomMetadata, "base denomination must be an IBC denomination: %s", msg.Metadata.Base)
+	}
+
 	return nil
 }
 
+// NewMsgUpdateProtocolFeeConfig creates a new MsgUpdateProtocolFeeConfig instance
+func NewMsgUpdateProtocolFeeConfig(signer string, config ProtocolFeeConfig) *MsgUpdateProtocolFeeConfig {
+	return &MsgUpdateProtocolFeeConfig{
+		Signer: signer,
+		Config: config,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateProtocolFeeConfig) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Config.Validate()
+}
+
+// NewMsgWrapVoucher creates a new MsgWrapVoucher instance
+func NewMsgWrapVoucher(sender string, voucher sdk.Coin) *MsgWrapVoucher {
+	return &MsgWrapVoucher{
+		Sender:  sender,
+		Voucher: voucher,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgWrapVoucher fields.
+func (msg MsgWrapVoucher) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := validateIBCCoin(msg.Voucher); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s: %s", err.Error(), msg.Voucher.String())
+	}
+	if !strings.HasPrefix(msg.Voucher.Denom, DenomPrefix+"/") {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s is not a voucher", msg.Voucher.String())
+	}
+
+	return nil
+}
+
+// NewMsgUnwrapVoucher creates a new MsgUnwrapVoucher instance
+func NewMsgUnwrapVoucher(sender string, canonical sdk.Coin, routePath string) *MsgUnwrapVoucher {
+	return &MsgUnwrapVoucher{
+		Sender:    sender,
+		Canonical: canonical,
+		RoutePath: routePath,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUnwrapVoucher fields.
+func (msg MsgUnwrapVoucher) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := msg.Canonical.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s: %s", err.Error(), msg.Canonical.String())
+	}
+	if !msg.Canonical.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
+	}
+	if !strings.HasPrefix(msg.Canonical.Denom, CanonicalDenomPrefix+"/") {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s is not a canonical token", msg.Canonical.String())
+	}
+
+	// the cap is irrelevant for the validation of the route path
+	return NewCanonicalRoute(msg.RoutePath, sdkmath.ZeroInt()).Validate()
+}
+
 // NewMsgTransfer creates a new MsgTransfer instance
 func NewMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -102,6 +274,11 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if len(msg.Memo) > MaximumMemoLength {
 		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
 	}
+	if msg.RefundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "refund address could not be parsed as address: %v", err)
+		}
+	}
 
 	for _, coin := range msg.GetCoins() {
 		if err := validateIBCCoin(coin); err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
@@ -60,6 +61,7 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"valid msg with base denom", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), nil},
 		{"valid msg with unwind", types.NewMsgTransfer("", "", sdk.NewCoins(coin), sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(true)), nil},
 		{"valid msg with trace hash", types.NewMsgTransfer(validPort, validChannel, ibcCoins, sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), nil},
+		{"valid msg with refund address", withRefundAddress(types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), receiver), nil},
 		{"multidenom", types.NewMsgTransfer(validPort, validChannel, coins.Add(ibcCoins...), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), nil},
 		{"memo with forwarding path hops not empty", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "memo", types.NewForwarding(false, validHop)), nil},
 		{"memo with forwarding unwind set to true", types.NewMsgTransfer("", "", sdk.NewCoins(coin), sender, receiver, clienttypes.ZeroHeight(), 100, "memo", types.NewForwarding(true)), nil},
@@ -76,12 +78,13 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing sender address", types.NewMsgTransfer(validPort, validChannel, coins, emptyAddr, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidAddress},
 		{"missing recipient address", types.NewMsgTransfer(validPort, validChannel, coins, sender, "", clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidAddress},
 		{"too long recipient address", types.NewMsgTransfer(validPort, validChannel, coins, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidAddress},
+		{"invalid refund address", withRefundAddress(types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), "invalid"), ibcerrors.ErrInvalidAddress},
 		{"empty coins", types.NewMsgTransfer(validPort, validChannel, sdk.NewCoins(), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
 		{"multidenom: invalid denom", types.NewMsgTransfer(validPort, validChannel, coins.Add(invalidDenomCoins...), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
 		{"multidenom: invalid ibc denom", types.NewMsgTransfer(validPort, validChannel, coins.Add(invalidIBCCoins...), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
 		{"multidenom: zero coins", types.NewMsgTransfer(validPort, validChannel, zeroCoins, sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
 		{"multidenom: too many coins", types.NewMsgTransfer(validPort, validChannel, make([]sdk.Coin, types.MaximumTokensLength+1), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
-		{"multidenom: both token and tokens are set", &types.MsgTransfer{validPort, validChannel, coin, sender, receiver, clienttypes.ZeroHeight(), 100, "", coins, nil}, ibcerrors.ErrInvalidCoins},
+		{"multidenom: both token and tokens are set", &types.MsgTransfer{validPort, validChannel, coin, sender, receiver, clienttypes.ZeroHeight(), 100, "", coins, nil, ""}, ibcerrors.ErrInvalidCoins},
 		{"timeout height must be zero if forwarding path hops is not empty", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, timeoutHeight, 100, "memo", types.NewForwarding(false, validHop)), types.ErrInvalidPacketTimeout},
 		{"invalid forwarding info port", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(false, types.NewHop(invalidPort, validChannel))), types.ErrInvalidForwarding},
 		{"invalid forwarding info channel", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(false, types.NewHop(validPort, invalidChannel))), types.ErrInvalidForwarding},
@@ -106,6 +109,12 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+// withRefundAddress sets the refund address of the provided MsgTransfer.
+func withRefundAddress(msg *types.MsgTransfer, refundAddress string) *types.MsgTransfer {
+	msg.RefundAddress = refundAddress
+	return msg
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -127,6 +136,222 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 		{"success: valid signer and valid params", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()), nil},
 		{"failure: invalid signer with valid params", types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()), ibcerrors.ErrInvalidAddress},
 		{"failure: empty signer with valid params", types.NewMsgUpdateParams(emptyAddr, types.DefaultParams()), ibcerrors.ErrInvalidAddress},
+		{"success: valid metadata channels", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{SendEnabled: true, ReceiveEnabled: true, MetadataChannels: []string{"channel-0", "channel-1"}}), nil},
+		{"failure: invalid metadata channel", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{SendEnabled: true, ReceiveEnabled: true, MetadataChannels: []string{"invalid channel"}}), host.ErrInvalidID},
+		{"failure: duplicate metadata channel", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{SendEnabled: true, ReceiveEnabled: true, MetadataChannels: []string{"channel-0", "channel-0"}}), ibcerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgUpdateChannelDenomRulesValidateBasic tests ValidateBasic for MsgUpdateChannelDenomRules
+func TestMsgUpdateChannelDenomRulesValidateBasic(t *testing.T) {
+	validRules := types.NewChannelDenomRules(validPort, validChannel, types.DENOM_FILTER_ALLOW, []string{coin.Denom}, 0)
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateChannelDenomRules
+		expError error
+	}{
+		{"success: valid signer and valid rules", types.NewMsgUpdateChannelDenomRules(ibctesting.TestAccAddress, validRules), nil},
+		{"failure: invalid signer with valid rules", types.NewMsgUpdateChannelDenomRules(invalidAddress, validRules), ibcerrors.ErrInvalidAddress},
+		{"failure: empty signer with valid rules", types.NewMsgUpdateChannelDenomRules(emptyAddr, validRules), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid rules", types.NewMsgUpdateChannelDenomRules(ibctesting.TestAccAddress, types.NewChannelDenomRules(validPort, validChannel, types.DENOM_FILTER_ALLOW, nil, 0)), types.ErrInvalidDenomRules},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgUpdateDenomMetadataValidateBasic tests ValidateBasic for MsgUpdateDenomMetadata
+func TestMsgUpdateDenomMetadataValidateBasic(t *testing.T) {
+	ibcDenom := types.NewDenom("uatom", types.NewHop(validPort, validChannel)).IBCDenom()
+	metadata := func(base string) banktypes.Metadata {
+		return banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: base, Exponent: 0},
+				{Denom: "atom", Exponent: 6},
+			},
+			Base:    base,
+			Display: "atom",
+			Name:    "Atom",
+			Symbol:  "ATOM",
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateDenomMetadata
+		expError error
+	}{
+		{"success: valid signer and valid metadata", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, metadata(ibcDenom)), nil},
+		{"failure: invalid signer with valid metadata", types.NewMsgUpdateDenomMetadata(invalidAddress, metadata(ibcDenom)), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid metadata", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, banktypes.Metadata{Base: ibcDenom}), types.ErrInvalidDenomMetadata},
+		{"failure: base is not an IBC denomination", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, metadata("uatom")), types.ErrInvalidDenomMetadata},
+		{"failure: base has invalid IBC denomination hash", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, metadata("ibc/abc")), types.ErrInvalidDenomMetadata},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgUpdateProtocolFeeConfigValidateBasic tests ValidateBasic for MsgUpdateProtocolFeeConfig
+func TestMsgUpdateProtocolFeeConfigValidateBasic(t *testing.T) {
+	validConfig := types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee(validPort, validChannel, 100, nil))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateProtocolFeeConfig
+		expError error
+	}{
+		{"success: valid signer and valid config", types.NewMsgUpdateProtocolFeeConfig(ibctesting.TestAccAddress, validConfig), nil},
+		{"success: valid signer and empty config", types.NewMsgUpdateProtocolFeeConfig(ibctesting.TestAccAddress, types.DefaultProtocolFeeConfig()), nil},
+		{"failure: invalid signer with valid config", types.NewMsgUpdateProtocolFeeConfig(invalidAddress, validConfig), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid config", types.NewMsgUpdateProtocolFeeConfig(ibctesting.TestAccAddress, types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee(validPort, validChannel, 10001, nil))), types.ErrInvalidProtocolFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgRegisterCanonicalAssetValidateBasic tests ValidateBasic for MsgRegisterCanonicalAsset
+func TestMsgRegisterCanonicalAssetValidateBasic(t *testing.T) {
+	validAsset := types.NewCanonicalAsset("canonical/atom", types.NewCanonicalRoute("transfer/channel-0/uatom", sdkmath.ZeroInt()))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRegisterCanonicalAsset
+		expError error
+	}{
+		{"success: valid signer and valid asset", types.NewMsgRegisterCanonicalAsset(ibctesting.TestAccAddress, validAsset), nil},
+		{"failure: invalid signer with valid asset", types.NewMsgRegisterCanonicalAsset(invalidAddress, validAsset), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid asset", types.NewMsgRegisterCanonicalAsset(ibctesting.TestAccAddress, types.NewCanonicalAsset("canonical/atom")), types.ErrInvalidCanonicalAsset},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgRegisterNativeMappingValidateBasic tests ValidateBasic for MsgRegisterNativeMapping
+func TestMsgRegisterNativeMappingValidateBasic(t *testing.T) {
+	validMapping := types.NewNativeMapping("uatom", "transfer/channel-0/uatom", "", sdkmath.ZeroInt())
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRegisterNativeMapping
+		expError error
+	}{
+		{"success: valid signer and valid mapping", types.NewMsgRegisterNativeMapping(ibctesting.TestAccAddress, validMapping), nil},
+		{"failure: invalid signer with valid mapping", types.NewMsgRegisterNativeMapping(invalidAddress, validMapping), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid mapping", types.NewMsgRegisterNativeMapping(ibctesting.TestAccAddress, types.NewNativeMapping("uatom", "uatom", "", sdkmath.ZeroInt())), types.ErrInvalidNativeMapping},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgWrapVoucherValidateBasic tests ValidateBasic for MsgWrapVoucher
+func TestMsgWrapVoucherValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgWrapVoucher
+		expError error
+	}{
+		{"success: valid voucher", types.NewMsgWrapVoucher(sender, ibcCoins[0]), nil},
+		{"failure: invalid sender", types.NewMsgWrapVoucher(invalidAddress, ibcCoins[0]), ibcerrors.ErrInvalidAddress},
+		{"failure: zero amount", types.NewMsgWrapVoucher(sender, sdk.NewCoin(ibcCoins[0].Denom, sdkmath.ZeroInt())), ibcerrors.ErrInvalidCoins},
+		{"failure: not a voucher", types.NewMsgWrapVoucher(sender, coin), ibcerrors.ErrInvalidCoins},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMsgUnwrapVoucherValidateBasic tests ValidateBasic for MsgUnwrapVoucher
+func TestMsgUnwrapVoucherValidateBasic(t *testing.T) {
+	canonical := sdk.NewCoin("canonical/atom", sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUnwrapVoucher
+		expError error
+	}{
+		{"success: valid canonical tokens and route", types.NewMsgUnwrapVoucher(sender, canonical, "transfer/channel-0/uatom"), nil},
+		{"failure: invalid sender", types.NewMsgUnwrapVoucher(invalidAddress, canonical, "transfer/channel-0/uatom"), ibcerrors.ErrInvalidAddress},
+		{"failure: zero amount", types.NewMsgUnwrapVoucher(sender, sdk.NewCoin(canonical.Denom, sdkmath.ZeroInt()), "transfer/channel-0/uatom"), types.ErrInvalidAmount},
+		{"failure: not a canonical token", types.NewMsgUnwrapVoucher(sender, coin, "transfer/channel-0/uatom"), ibcerrors.ErrInvalidCoins},
+		{"failure: route is not a voucher", types.NewMsgUnwrapVoucher(sender, canonical, "uatom"), types.ErrInvalidCanonicalAsset},
 	}
 
 	for _, tc := range testCases {
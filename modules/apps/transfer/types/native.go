@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewNativeMapping creates a new NativeMapping instance.
+func NewNativeMapping(localDenom, path, admin string, supplyCap sdkmath.Int) NativeMapping {
+	return NativeMapping{
+		LocalDenom: localDenom,
+		Path:       path,
+		Admin:      admin,
+		SupplyCap:  supplyCap,
+	}
+}
+
+// Validate performs a basic validation of the native mapping. The local denomination must be a valid
+// denomination which is neither an IBC nor a canonical denomination, the path must be the valid full
+// denomination path of a voucher, the admin must be empty or a valid address and the supply cap must
+// not be negative.
+func (m NativeMapping) Validate() error {
+	if err := sdk.ValidateDenom(m.LocalDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidNativeMapping, "invalid local denomination %s: %v", m.LocalDenom, err)
+	}
+	for _, prefix := range []string{DenomPrefix, CanonicalDenomPrefix} {
+		if strings.HasPrefix(m.LocalDenom, fmt.Sprintf("%s/", prefix)) {
+			return errorsmod.Wrapf(ErrInvalidNativeMapping, "local denomination %s cannot be prefixed with %s", m.LocalDenom, prefix)
+		}
+	}
+
+	denom := m.Denom()
+	if denom.IsNative() {
+		return errorsmod.Wrapf(ErrInvalidNativeMapping, "path %s must be the full denomination path of a voucher", m.Path)
+	}
+	if err := denom.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidNativeMapping, "invalid path %s: %v", m.Path, err)
+	}
+
+	if m.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+			return errorsmod.Wrapf(ErrInvalidNativeMapping, "invalid admin address %s: %v", m.Admin, err)
+		}
+	}
+
+	if m.SupplyCap.IsNil() || m.SupplyCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidNativeMapping, "supply cap of %s cannot be nil or negative", m.LocalDenom)
+	}
+
+	return nil
+}
+
+// Denom returns the denomination of the foreign asset as represented on this chain.
+func (m NativeMapping) Denom() Denom {
+	return ExtractDenomFromPath(m.Path)
+}
+
+// IsReceivedOver returns true if the foreign asset is received over the provided port and channel,
+// that is the channel over which the local denomination is sent back toward the asset origin.
+func (m NativeMapping) IsReceivedOver(portID, channelID string) bool {
+	return m.Denom().HasPrefix(portID, channelID)
+}
+
+// ExceedsCap returns true if the provided minted amount exceeds the supply cap of the mapping.
+func (m NativeMapping) ExceedsCap(minted sdkmath.Int) bool {
+	return m.SupplyCap.IsPositive() && minted.GT(m.SupplyCap)
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *TypesTestSuite) TestNativeMappingValidate() {
+	testCases := []struct {
+		name     string
+		mapping  types.NativeMapping
+		expError error
+	}{
+		{
+			"success: mapping with admin and supply cap",
+			types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", ibctesting.TestAccAddress, sdkmath.NewInt(1000)),
+			nil,
+		},
+		{
+			"success: mapping without admin and supply cap",
+			types.NewNativeMapping("uusdc", "transfer/channel-0/transfer/channel-5/uusdc", "", sdkmath.ZeroInt()),
+			nil,
+		},
+		{
+			"failure: invalid local denomination",
+			types.NewNativeMapping("u$dc", "transfer/channel-0/uusdc", "", sdkmath.ZeroInt()),
+			types.ErrInvalidNativeMapping,
+		},
+		{
+			"failure: local denomination is an IBC denomination",
+			types.NewNativeMapping("ibc/uusdc", "transfer/channel-0/uusdc", "", sdkmath.ZeroInt()),
+			types.ErrInvalidNativeMapping,
+		},
+		{
+			"failure: local denomination is a canonical denomination",
+			types.NewNativeMapping("canonical/uusdc", "transfer/channel-0/uusdc", "", sdkmath.ZeroInt()),
+			types.ErrInvalidNativeMapping,
+		},
+		{
+			"failure: path is a native denomination",
+			types.NewNativeMapping("uusdc", "uusdc", "", sdkmath.ZeroInt()),
+			types.ErrInvalidNativeMapping,
+		},
+		{
+			"failure: invalid admin",
+			types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", "invalid", sdkmath.ZeroInt()),
+			types.ErrInvalidNativeMapping,
+		},
+		{
+			"failure: negative supply cap",
+			types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", "", sdkmath.NewInt(-1)),
+			types.ErrInvalidNativeMapping,
+		},
+		{
+			"failure: nil supply cap",
+			types.NativeMapping{LocalDenom: "uusdc", Path: "transfer/channel-0/uusdc"},
+			types.ErrInvalidNativeMapping,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.mapping.Validate()
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestNativeMappingSupplyCap() {
+	mapping := types.NewNativeMapping("uusdc", "transfer/channel-0/uusdc", "", sdkmath.NewInt(1000))
+	suite.Require().False(mapping.ExceedsCap(sdkmath.NewInt(1000)))
+	suite.Require().True(mapping.ExceedsCap(sdkmath.NewInt(1001)))
+
+	mapping.SupplyCap = sdkmath.ZeroInt()
+	suite.Require().False(mapping.ExceedsCap(sdkmath.NewInt(1001)))
+
+	suite.Require().True(mapping.IsReceivedOver("transfer", "channel-0"))
+	suite.Require().False(mapping.IsReceivedOver("transfer", "channel-5"))
+}
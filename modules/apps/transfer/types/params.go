@@ -1,5 +1,14 @@
 package types
 
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
@@ -19,3 +28,27 @@ func NewParams(enableSend, enableReceive bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
 }
+
+// Validate performs a basic validation of the transfer parameters. The metadata channels
+// must be valid channel identifiers without duplicates.
+func (p Params) Validate() error {
+	seenChannels := make(map[string]bool)
+	for _, channelID := range p.MetadataChannels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return errorsmod.Wrapf(err, "invalid metadata channel ID (%s)", channelID)
+		}
+
+		if seenChannels[channelID] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate metadata channel ID (%s)", channelID)
+		}
+		seenChannels[channelID] = true
+	}
+
+	return nil
+}
+
+// PropagatesMetadata returns true if the bank metadata of the tokens sent over the provided
+// channel is propagated in the packet data.
+func (p Params) PropagatesMetadata(channelID string) bool {
+	return slices.Contains(p.MetadataChannels, channelID)
+}
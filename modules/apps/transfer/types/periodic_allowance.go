@@ -0,0 +1,76 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewPeriodicAllowance creates a new PeriodicAllowance instance. The first period starts
+// when the allowance is first used.
+func NewPeriodicAllowance(period time.Duration, periodSpendLimit sdk.Coins) *PeriodicAllowance {
+	return &PeriodicAllowance{
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+	}
+}
+
+// ValidateBasic performs a basic validation of the periodic allowance.
+func (p PeriodicAllowance) ValidateBasic() error {
+	if p.Period <= 0 {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "period must be positive, got %s", p.Period)
+	}
+
+	if p.PeriodSpendLimit.Empty() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "period spend limit cannot be empty")
+	}
+
+	if err := p.PeriodSpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period spend limit: %s", err.Error())
+	}
+
+	if err := p.PeriodCanSpend.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period can spend: %s", err.Error())
+	}
+
+	if !p.PeriodSpendLimit.IsAllGTE(p.PeriodCanSpend) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "period can spend %s exceeds period spend limit %s", p.PeriodCanSpend, p.PeriodSpendLimit)
+	}
+
+	return nil
+}
+
+// spend deducts the coins from the amount left to spend in the current period, resetting the
+// allowance first if the period has ended. An error is returned if the amount of any coin is
+// greater than the amount left to spend in the period.
+func (p *PeriodicAllowance) spend(blockTime time.Time, coins sdk.Coins) error {
+	p.tryResetPeriod(blockTime)
+
+	canSpend, isNegative := p.PeriodCanSpend.SafeSub(coins...)
+	if isNegative {
+		return errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount %s is more than period spend limit left %s", coins, p.PeriodCanSpend)
+	}
+
+	p.PeriodCanSpend = canSpend
+	return nil
+}
+
+// tryResetPeriod resets the amount left to spend to the period spend limit and starts a new
+// period if the block time is past the end of the current period. The next period starts at the
+// end of the current one, or at the block time if a whole period has elapsed since then.
+func (p *PeriodicAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(p.PeriodReset) {
+		return
+	}
+
+	p.PeriodCanSpend = p.PeriodSpendLimit
+	p.PeriodReset = p.PeriodReset.Add(p.Period)
+	if blockTime.After(p.PeriodReset) {
+		p.PeriodReset = blockTime.Add(p.Period)
+	}
+}
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// MaxProtocolFeeBasisPoints defines the maximum protocol fee of a channel in basis points.
+const MaxProtocolFeeBasisPoints = 10_000
+
+// NewProtocolFeeConfig creates a new ProtocolFeeConfig instance.
+func NewProtocolFeeConfig(feeCollector string, exemptAddresses []string, channelFees ...ChannelProtocolFee) ProtocolFeeConfig {
+	return ProtocolFeeConfig{
+		FeeCollector:    feeCollector,
+		ExemptAddresses: exemptAddresses,
+		ChannelFees:     channelFees,
+	}
+}
+
+// DefaultProtocolFeeConfig returns a ProtocolFeeConfig which does not charge any protocol fee.
+func DefaultProtocolFeeConfig() ProtocolFeeConfig {
+	return ProtocolFeeConfig{}
+}
+
+// Validate performs a basic validation of the protocol fee configuration. A valid fee collector
+// must be provided when channel fees are set, the exempt addresses must be valid and the channel
+// fees must be valid without duplicate channels.
+func (c ProtocolFeeConfig) Validate() error {
+	if len(c.ChannelFees) != 0 || c.FeeCollector != "" {
+		if _, err := sdk.AccAddressFromBech32(c.FeeCollector); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid fee collector address: %v", err)
+		}
+	}
+
+	seenAddresses := make(map[string]bool)
+	for _, address := range c.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid exempt address %s: %v", address, err)
+		}
+
+		if seenAddresses[address] {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "duplicate exempt address %s", address)
+		}
+		seenAddresses[address] = true
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, fee := range c.ChannelFees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+
+		channel := fmt.Sprintf("%s/%s", fee.PortId, fee.ChannelId)
+		if seenChannels[channel] {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "duplicate protocol fee for port ID (%s) channel ID (%s)", fee.PortId, fee.ChannelId)
+		}
+		seenChannels[channel] = true
+	}
+
+	return nil
+}
+
+// ChannelFee returns the protocol fee of the provided port and channel.
+func (c ProtocolFeeConfig) ChannelFee(portID, channelID string) (ChannelProtocolFee, bool) {
+	idx := slices.IndexFunc(c.ChannelFees, func(fee ChannelProtocolFee) bool {
+		return fee.PortId == portID && fee.ChannelId == channelID
+	})
+	if idx == -1 {
+		return ChannelProtocolFee{}, false
+	}
+
+	return c.ChannelFees[idx], true
+}
+
+// IsExempt returns true if the provided address is not charged the protocol fee.
+func (c ProtocolFeeConfig) IsExempt(address string) bool {
+	return slices.Contains(c.ExemptAddresses, address)
+}
+
+// NewChannelProtocolFee creates a new ChannelProtocolFee instance.
+func NewChannelProtocolFee(portID, channelID string, basisPoints uint32, minFees sdk.Coins) ChannelProtocolFee {
+	return ChannelProtocolFee{
+		PortId:      portID,
+		ChannelId:   channelID,
+		BasisPoints: basisPoints,
+		MinFees:     minFees,
+	}
+}
+
+// Validate performs a basic validation of the channel protocol fee. The identifiers must be valid,
+// the basis points must not exceed MaxProtocolFeeBasisPoints and the minimum fees must be valid.
+func (f ChannelProtocolFee) Validate() error {
+	if err := host.PortIdentifierValidator(f.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port ID (%s)", f.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID (%s)", f.ChannelId)
+	}
+
+	if f.BasisPoints > MaxProtocolFeeBasisPoints {
+		return errorsmod.Wrapf(ErrInvalidProtocolFee, "basis points (%d) cannot exceed %d", f.BasisPoints, MaxProtocolFeeBasisPoints)
+	}
+
+	if err := f.MinFees.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProtocolFee, "invalid minimum fees: %v", err)
+	}
+
+	return nil
+}
+
+// Fee returns the protocol fee charged on the provided coin, which is the greater of the basis
+// points of the coin amount and the minimum fee of the coin denomination.
+func (f ChannelProtocolFee) Fee(coin sdk.Coin) sdk.Coin {
+	amount := coin.Amount.MulRaw(int64(f.BasisPoints)).QuoRaw(MaxProtocolFeeBasisPoints)
+	amount = sdkmath.MaxInt(amount, f.MinFees.AmountOf(coin.Denom))
+
+	return sdk.NewCoin(coin.Denom, amount)
+}
+
+// NewPacketProtocolFee creates a new PacketProtocolFee instance.
+func NewPacketProtocolFee(portID, channelID string, sequence uint64, feeCollector string, fees sdk.Coins) PacketProtocolFee {
+	return PacketProtocolFee{
+		PortId:       portID,
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		FeeCollector: feeCollector,
+		Fees:         fees,
+	}
+}
+
+// Validate performs a basic validation of the protocol fee held for a packet.
+func (f PacketProtocolFee) Validate() error {
+	if err := host.PortIdentifierValidator(f.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port ID (%s)", f.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID (%s)", f.ChannelId)
+	}
+
+	if f.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidProtocolFee, "packet sequence cannot be 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(f.FeeCollector); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid fee collector address: %v", err)
+	}
+
+	if !f.Fees.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidProtocolFee, "invalid fees %s", f.Fees)
+	}
+
+	return nil
+}
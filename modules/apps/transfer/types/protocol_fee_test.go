@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *TypesTestSuite) TestProtocolFeeConfigValidate() {
+	channelFee := types.NewChannelProtocolFee("transfer", "channel-0", 100, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(10))))
+
+	testCases := []struct {
+		name     string
+		config   types.ProtocolFeeConfig
+		expError error
+	}{
+		{
+			"success: empty config",
+			types.DefaultProtocolFeeConfig(),
+			nil,
+		},
+		{
+			"success: channel fees with exempt addresses",
+			types.NewProtocolFeeConfig(ibctesting.TestAccAddress, []string{ibctesting.TestAccAddress}, channelFee),
+			nil,
+		},
+		{
+			"failure: channel fees without fee collector",
+			types.NewProtocolFeeConfig("", nil, channelFee),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid exempt address",
+			types.NewProtocolFeeConfig(ibctesting.TestAccAddress, []string{"invalid"}, channelFee),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: duplicate exempt address",
+			types.NewProtocolFeeConfig(ibctesting.TestAccAddress, []string{ibctesting.TestAccAddress, ibctesting.TestAccAddress}, channelFee),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"failure: duplicate channel fee",
+			types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, channelFee, channelFee),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"failure: invalid channel ID",
+			types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee("transfer", "", 100, nil)),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: basis points exceed maximum",
+			types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee("transfer", "channel-0", types.MaxProtocolFeeBasisPoints+1, nil)),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"failure: invalid minimum fees",
+			types.NewProtocolFeeConfig(ibctesting.TestAccAddress, nil, types.NewChannelProtocolFee("transfer", "channel-0", 100, sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdkmath.ZeroInt()}})),
+			types.ErrInvalidProtocolFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.config.Validate()
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestChannelProtocolFeeFee() {
+	testCases := []struct {
+		name   string
+		fee    types.ChannelProtocolFee
+		coin   sdk.Coin
+		expFee sdk.Coin
+	}{
+		{
+			"basis points fee",
+			types.NewChannelProtocolFee("transfer", "channel-0", 250, nil),
+			sdk.NewCoin("uatom", sdkmath.NewInt(1000)),
+			sdk.NewCoin("uatom", sdkmath.NewInt(25)),
+		},
+		{
+			"basis points fee is truncated",
+			types.NewChannelProtocolFee("transfer", "channel-0", 250, nil),
+			sdk.NewCoin("uatom", sdkmath.NewInt(39)),
+			sdk.NewCoin("uatom", sdkmath.ZeroInt()),
+		},
+		{
+			"minimum fee greater than basis points fee",
+			types.NewChannelProtocolFee("transfer", "channel-0", 250, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(50)))),
+			sdk.NewCoin("uatom", sdkmath.NewInt(1000)),
+			sdk.NewCoin("uatom", sdkmath.NewInt(50)),
+		},
+		{
+			"minimum fee of other denomination does not apply",
+			types.NewChannelProtocolFee("transfer", "channel-0", 250, sdk.NewCoins(sdk.NewCoin("uosmo", sdkmath.NewInt(50)))),
+			sdk.NewCoin("uatom", sdkmath.NewInt(1000)),
+			sdk.NewCoin("uatom", sdkmath.NewInt(25)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expFee, tc.fee.Fee(tc.coin))
+		})
+	}
+}
@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -398,657 +400,4586 @@ func (m *QueryTotalEscrowForDenomResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.transfer.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.transfer.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryDenomHashRequest)(nil), "ibc.applications.transfer.v1.QueryDenomHashRequest")
-	proto.RegisterType((*QueryDenomHashResponse)(nil), "ibc.applications.transfer.v1.QueryDenomHashResponse")
-	proto.RegisterType((*QueryEscrowAddressRequest)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressRequest")
-	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressResponse")
-	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
-	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
+// QueryChannelEscrowRequest is the request type for the ChannelEscrow RPC method.
+type QueryChannelEscrowRequest struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/transfer/v1/query.proto", fileDescriptor_a638e2800a01538c)
+func (m *QueryChannelEscrowRequest) Reset()         { *m = QueryChannelEscrowRequest{} }
+func (m *QueryChannelEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelEscrowRequest) ProtoMessage()    {}
+func (*QueryChannelEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{8}
 }
-
-var fileDescriptor_a638e2800a01538c = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xab, 0x26, 0x28, 0x8b, 0xca, 0x61, 0x1b, 0x3e, 0x6a, 0x05, 0xb7, 0xb2, 0x8a, 0x54,
-	0x15, 0xe2, 0x25, 0x6d, 0x21, 0x20, 0xb5, 0x48, 0xb4, 0x80, 0x28, 0xe2, 0x40, 0x53, 0x4e, 0x70,
-	0x88, 0xd6, 0xf6, 0xe2, 0x58, 0x8a, 0xbd, 0xae, 0x77, 0x13, 0x54, 0x45, 0xb9, 0xf0, 0x0b, 0x90,
-	0xfa, 0x3b, 0xf8, 0x1f, 0x3d, 0x56, 0x20, 0x21, 0x4e, 0x08, 0x25, 0xfc, 0x0b, 0x2e, 0xc8, 0xeb,
-	0x49, 0x49, 0x42, 0x1a, 0x25, 0x3d, 0x25, 0xbb, 0xf3, 0xde, 0x9b, 0x37, 0x9e, 0x67, 0xa3, 0x35,
-	0xdf, 0x76, 0x08, 0x8d, 0xa2, 0x86, 0xef, 0x50, 0xe9, 0xf3, 0x50, 0x10, 0x19, 0xd3, 0x50, 0x7c,
-	0x60, 0x31, 0x69, 0x95, 0xc9, 0x51, 0x93, 0xc5, 0xc7, 0x56, 0x14, 0x73, 0xc9, 0x71, 0xd1, 0xb7,
-	0x1d, 0x6b, 0x10, 0x69, 0xf5, 0x91, 0x56, 0xab, 0xac, 0x17, 0x3c, 0xee, 0x71, 0x05, 0x24, 0xc9,
-	0xbf, 0x94, 0xa3, 0x1b, 0x0e, 0x17, 0x01, 0x17, 0xc4, 0xa6, 0x82, 0x91, 0x56, 0xd9, 0x66, 0x92,
-	0x96, 0x89, 0xc3, 0xfd, 0x10, 0xea, 0x77, 0x27, 0x76, 0x3f, 0xd7, 0x4f, 0xc1, 0x45, 0x8f, 0x73,
-	0xaf, 0xc1, 0x08, 0x8d, 0x7c, 0x42, 0xc3, 0x90, 0x4b, 0xb0, 0xa1, 0xaa, 0x66, 0x01, 0xe1, 0x83,
-	0xc4, 0xed, 0x1b, 0x1a, 0xd3, 0x40, 0x54, 0xd9, 0x51, 0x93, 0x09, 0x69, 0x1e, 0xa2, 0xc5, 0xa1,
-	0x5b, 0x11, 0xf1, 0x50, 0x30, 0xbc, 0x8d, 0x72, 0x91, 0xba, 0xb9, 0xa5, 0xad, 0x68, 0x6b, 0x57,
-	0x37, 0x56, 0xad, 0x49, 0xc3, 0x59, 0xc0, 0x06, 0x8e, 0x59, 0x42, 0xd7, 0x95, 0xe8, 0x33, 0x16,
-	0xf2, 0xe0, 0x25, 0x15, 0x75, 0xe8, 0x86, 0x0b, 0x28, 0x2b, 0x63, 0xea, 0x30, 0xa5, 0x9a, 0xaf,
-	0xa6, 0x07, 0xf3, 0x1e, 0xba, 0x31, 0x0a, 0x07, 0x1b, 0x18, 0xcd, 0xd7, 0xa9, 0xa8, 0x03, 0x5c,
-	0xfd, 0x37, 0x0f, 0xd1, 0x92, 0x42, 0x3f, 0x17, 0x4e, 0xcc, 0x3f, 0x3e, 0x75, 0xdd, 0x98, 0x89,
-	0xfe, 0x38, 0xf8, 0x26, 0xba, 0x12, 0xf1, 0x58, 0xd6, 0x7c, 0x17, 0x38, 0xb9, 0xe4, 0xb8, 0xef,
-	0xe2, 0xdb, 0x08, 0x39, 0x75, 0x1a, 0x86, 0xac, 0x91, 0xd4, 0xe6, 0x54, 0x2d, 0x0f, 0x37, 0xfb,
-	0xae, 0xb9, 0x87, 0xf4, 0x71, 0xa2, 0x60, 0xe3, 0x0e, 0xba, 0xc6, 0x54, 0xa1, 0x46, 0xd3, 0x0a,
-	0x88, 0x2f, 0xb0, 0x41, 0xb8, 0x59, 0x41, 0xcb, 0x4a, 0xe4, 0x2d, 0x97, 0xb4, 0x91, 0x2a, 0xbd,
-	0xe0, 0xb1, 0x9a, 0x6a, 0xe0, 0x01, 0xb8, 0xc9, 0xb9, 0xff, 0x00, 0xd4, 0xc1, 0x7c, 0x8f, 0x56,
-	0x2e, 0x26, 0x82, 0x87, 0x0a, 0xca, 0xd1, 0x80, 0x37, 0x43, 0x09, 0x1b, 0x59, 0xb2, 0xd2, 0xe8,
-	0x58, 0x49, 0x74, 0x2c, 0x88, 0x8e, 0xb5, 0xc7, 0xfd, 0x70, 0x77, 0xfe, 0xf4, 0xe7, 0x72, 0xa6,
-	0x0a, 0xf0, 0x8d, 0x3f, 0x59, 0x94, 0x55, 0xea, 0xf8, 0x44, 0x43, 0xb9, 0x74, 0x53, 0xf8, 0xfe,
-	0xe4, 0x7d, 0xfe, 0x1f, 0x14, 0xbd, 0x3c, 0x03, 0x23, 0xb5, 0x6c, 0xae, 0x7e, 0xfa, 0xf6, 0xfb,
-	0x64, 0xce, 0xc0, 0x45, 0x02, 0x29, 0x1e, 0x4e, 0x6f, 0x1a, 0x16, 0xfc, 0x45, 0x43, 0xf9, 0xf3,
-	0xcd, 0xe3, 0xcd, 0x29, 0xda, 0x8c, 0xc6, 0x4a, 0xdf, 0x9a, 0x8d, 0x04, 0xf6, 0x1e, 0x28, 0x7b,
-	0x04, 0x97, 0xc6, 0xdb, 0x53, 0xab, 0xa9, 0x25, 0x91, 0x63, 0x82, 0xb4, 0x55, 0x52, 0x77, 0xd6,
-	0xd7, 0x3b, 0xf8, 0xbb, 0x86, 0x16, 0x86, 0x62, 0x82, 0x2b, 0x53, 0xb4, 0x1f, 0x97, 0x56, 0xfd,
-	0xd1, 0xec, 0x44, 0xf0, 0x5e, 0x55, 0xde, 0x5f, 0xe3, 0x57, 0xe3, 0xbd, 0x43, 0xb0, 0x05, 0x69,
-	0xff, 0x0b, 0x7d, 0x87, 0x24, 0xaf, 0x82, 0x20, 0x6d, 0x78, 0x41, 0x3a, 0x64, 0x38, 0xd3, 0xf8,
-	0xab, 0x86, 0x16, 0xc7, 0x24, 0x10, 0xef, 0x4c, 0xe1, 0xf2, 0xe2, 0xc8, 0xeb, 0x4f, 0x2e, 0x4b,
-	0x87, 0x51, 0xb7, 0xd5, 0xa8, 0x0f, 0xf1, 0xd6, 0x84, 0x35, 0x09, 0xd2, 0x56, 0xbf, 0xc9, 0x82,
-	0x88, 0x4c, 0xc4, 0x6a, 0xe9, 0x70, 0xbb, 0x07, 0xa7, 0x5d, 0x43, 0x3b, 0xeb, 0x1a, 0xda, 0xaf,
-	0xae, 0xa1, 0x7d, 0xee, 0x19, 0x99, 0xb3, 0x9e, 0x91, 0xf9, 0xd1, 0x33, 0x32, 0xef, 0x2a, 0x9e,
-	0x2f, 0xeb, 0x4d, 0xdb, 0x72, 0x78, 0x40, 0xe0, 0x2b, 0xec, 0xdb, 0x4e, 0xc9, 0xe3, 0xa4, 0xf5,
-	0x98, 0x04, 0xdc, 0x6d, 0x36, 0x98, 0x18, 0x69, 0x27, 0x8f, 0x23, 0x26, 0xec, 0x9c, 0xfa, 0x9e,
-	0x6e, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xe4, 0x12, 0x71, 0x1a, 0x06, 0x00, 0x00,
+func (m *QueryChannelEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelEscrowRequest.Merge(m, src)
+}
+func (m *QueryChannelEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelEscrowRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryChannelEscrowRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the ibc-transfer module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// DenomHash queries a denomination hash information.
-	DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error)
-	// EscrowAddress returns the escrow address for a particular port and channel id.
-	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
-	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
-	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
+func (m *QueryChannelEscrowRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryChannelEscrowRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryChannelEscrowResponse is the response type for the ChannelEscrow RPC method.
+type QueryChannelEscrowResponse struct {
+	// the amount of tokens escrowed for the channel as tracked by the transfer module
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// the balance of the escrow address of the channel, which exceeds the escrowed amount
+	// if tokens were sent directly to the escrow address
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryChannelEscrowResponse) Reset()         { *m = QueryChannelEscrowResponse{} }
+func (m *QueryChannelEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelEscrowResponse) ProtoMessage()    {}
+func (*QueryChannelEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{9}
+}
+func (m *QueryChannelEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryChannelEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelEscrowResponse.Merge(m, src)
+}
+func (m *QueryChannelEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelEscrowResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error) {
-	out := new(QueryDenomHashResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryChannelEscrowResponse) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error) {
-	out := new(QueryEscrowAddressResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/EscrowAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryChannelEscrowResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error) {
-	out := new(QueryTotalEscrowForDenomResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryChannelEscrowsRequest is the request type for the ChannelEscrows RPC method.
+type QueryChannelEscrowsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelEscrowsRequest) Reset()         { *m = QueryChannelEscrowsRequest{} }
+func (m *QueryChannelEscrowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelEscrowsRequest) ProtoMessage()    {}
+func (*QueryChannelEscrowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{10}
+}
+func (m *QueryChannelEscrowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelEscrowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelEscrowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryChannelEscrowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelEscrowsRequest.Merge(m, src)
+}
+func (m *QueryChannelEscrowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelEscrowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelEscrowsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the ibc-transfer module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// DenomHash queries a denomination hash information.
-	DenomHash(context.Context, *QueryDenomHashRequest) (*QueryDenomHashResponse, error)
-	// EscrowAddress returns the escrow address for a particular port and channel id.
-	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
-	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
-	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
+var xxx_messageInfo_QueryChannelEscrowsRequest proto.InternalMessageInfo
+
+func (m *QueryChannelEscrowsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryChannelEscrowsResponse is the response type for the ChannelEscrows RPC method.
+type QueryChannelEscrowsResponse struct {
+	// the amount of tokens escrowed for each channel with tokens in escrow
+	Escrows []ChannelEscrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryChannelEscrowsResponse) Reset()         { *m = QueryChannelEscrowsResponse{} }
+func (m *QueryChannelEscrowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelEscrowsResponse) ProtoMessage()    {}
+func (*QueryChannelEscrowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{11}
 }
-func (*UnimplementedQueryServer) DenomHash(ctx context.Context, req *QueryDenomHashRequest) (*QueryDenomHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomHash not implemented")
+func (m *QueryChannelEscrowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EscrowAddress not implemented")
+func (m *QueryChannelEscrowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelEscrowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
+func (m *QueryChannelEscrowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelEscrowsResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryChannelEscrowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelEscrowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelEscrowsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryChannelEscrowsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelEscrowsResponse) GetEscrows() []ChannelEscrow {
+	if m != nil {
+		return m.Escrows
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DenomHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/DenomHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomHash(ctx, req.(*QueryDenomHashRequest))
+func (m *QueryChannelEscrowsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EscrowAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EscrowAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/EscrowAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EscrowAddress(ctx, req.(*QueryEscrowAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryChannelDenomRulesRequest is the request type for the ChannelDenomRules RPC method.
+type QueryChannelDenomRulesRequest struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalEscrowForDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalEscrowForDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom",
+func (m *QueryChannelDenomRulesRequest) Reset()         { *m = QueryChannelDenomRulesRequest{} }
+func (m *QueryChannelDenomRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelDenomRulesRequest) ProtoMessage()    {}
+func (*QueryChannelDenomRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{12}
+}
+func (m *QueryChannelDenomRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelDenomRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelDenomRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalEscrowForDenom(ctx, req.(*QueryTotalEscrowForDenomRequest))
+}
+func (m *QueryChannelDenomRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelDenomRulesRequest.Merge(m, src)
+}
+func (m *QueryChannelDenomRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelDenomRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelDenomRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelDenomRulesRequest proto.InternalMessageInfo
+
+func (m *QueryChannelDenomRulesRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.transfer.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DenomHash",
-			Handler:    _Query_DenomHash_Handler,
-		},
-		{
-			MethodName: "EscrowAddress",
-			Handler:    _Query_EscrowAddress_Handler,
-		},
-		{
-			MethodName: "TotalEscrowForDenom",
-			Handler:    _Query_TotalEscrowForDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/transfer/v1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryChannelDenomRulesRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryChannelDenomRulesResponse is the response type for the ChannelDenomRules RPC method.
+type QueryChannelDenomRulesResponse struct {
+	// the effective denomination rules of the channel
+	Rules ChannelDenomRules `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryChannelDenomRulesResponse) Reset()         { *m = QueryChannelDenomRulesResponse{} }
+func (m *QueryChannelDenomRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelDenomRulesResponse) ProtoMessage()    {}
+func (*QueryChannelDenomRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{13}
+}
+func (m *QueryChannelDenomRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelDenomRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelDenomRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelDenomRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelDenomRulesResponse.Merge(m, src)
+}
+func (m *QueryChannelDenomRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelDenomRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelDenomRulesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelDenomRulesResponse proto.InternalMessageInfo
+
+func (m *QueryChannelDenomRulesResponse) GetRules() ChannelDenomRules {
+	if m != nil {
+		return m.Rules
 	}
-	return dAtA[:n], nil
+	return ChannelDenomRules{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllChannelDenomRulesRequest is the request type for the AllChannelDenomRules RPC method.
+type QueryAllChannelDenomRulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllChannelDenomRulesRequest) Reset()         { *m = QueryAllChannelDenomRulesRequest{} }
+func (m *QueryAllChannelDenomRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelDenomRulesRequest) ProtoMessage()    {}
+func (*QueryAllChannelDenomRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{14}
+}
+func (m *QueryAllChannelDenomRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelDenomRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelDenomRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllChannelDenomRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelDenomRulesRequest.Merge(m, src)
+}
+func (m *QueryAllChannelDenomRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelDenomRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelDenomRulesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllChannelDenomRulesRequest proto.InternalMessageInfo
+
+func (m *QueryAllChannelDenomRulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllChannelDenomRulesResponse is the response type for the AllChannelDenomRules RPC method.
+type QueryAllChannelDenomRulesResponse struct {
+	// the denomination rules of all channels which have rules set
+	Rules []ChannelDenomRules `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trace) > 0 {
-		i -= len(m.Trace)
-		copy(dAtA[i:], m.Trace)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trace)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllChannelDenomRulesResponse) Reset()         { *m = QueryAllChannelDenomRulesResponse{} }
+func (m *QueryAllChannelDenomRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelDenomRulesResponse) ProtoMessage()    {}
+func (*QueryAllChannelDenomRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{15}
+}
+func (m *QueryAllChannelDenomRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelDenomRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelDenomRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllChannelDenomRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelDenomRulesResponse.Merge(m, src)
+}
+func (m *QueryAllChannelDenomRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelDenomRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelDenomRulesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllChannelDenomRulesResponse proto.InternalMessageInfo
+
+func (m *QueryAllChannelDenomRulesResponse) GetRules() []ChannelDenomRules {
+	if m != nil {
+		return m.Rules
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllChannelDenomRulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryDenomHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+// QueryCanonicalAssetRequest is the request type for the CanonicalAsset RPC method.
+type QueryCanonicalAssetRequest struct {
+	// the canonical denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryCanonicalAssetRequest) Reset()         { *m = QueryCanonicalAssetRequest{} }
+func (m *QueryCanonicalAssetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalAssetRequest) ProtoMessage()    {}
+func (*QueryCanonicalAssetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{16}
+}
+func (m *QueryCanonicalAssetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalAssetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalAssetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCanonicalAssetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalAssetRequest.Merge(m, src)
+}
+func (m *QueryCanonicalAssetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalAssetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalAssetRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCanonicalAssetRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalAssetRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEscrowAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCanonicalAssetResponse is the response type for the CanonicalAsset RPC method.
+type QueryCanonicalAssetResponse struct {
+	// the canonical asset
+	Asset CanonicalAsset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	// the amounts of the route vouchers currently wrapped into the canonical denomination
+	Wrapped github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=wrapped,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wrapped"`
 }
 
-func (m *QueryEscrowAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryCanonicalAssetResponse) Reset()         { *m = QueryCanonicalAssetResponse{} }
+func (m *QueryCanonicalAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalAssetResponse) ProtoMessage()    {}
+func (*QueryCanonicalAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{17}
+}
+func (m *QueryCanonicalAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryCanonicalAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalAssetResponse.Merge(m, src)
+}
+func (m *QueryCanonicalAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanonicalAssetResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalAssetResponse) GetAsset() CanonicalAsset {
+	if m != nil {
+		return m.Asset
 	}
-	return len(dAtA) - i, nil
+	return CanonicalAsset{}
 }
 
-func (m *QueryEscrowAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCanonicalAssetResponse) GetWrapped() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Wrapped
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEscrowAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCanonicalAssetsRequest is the request type for the CanonicalAssets RPC method.
+type QueryCanonicalAssetsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEscrowAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EscrowAddress) > 0 {
-		i -= len(m.EscrowAddress)
-		copy(dAtA[i:], m.EscrowAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCanonicalAssetsRequest) Reset()         { *m = QueryCanonicalAssetsRequest{} }
+func (m *QueryCanonicalAssetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalAssetsRequest) ProtoMessage()    {}
+func (*QueryCanonicalAssetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{18}
+}
+func (m *QueryCanonicalAssetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalAssetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalAssetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCanonicalAssetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalAssetsRequest.Merge(m, src)
+}
+func (m *QueryCanonicalAssetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalAssetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalAssetsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalEscrowForDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCanonicalAssetsRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalAssetsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalEscrowForDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCanonicalAssetsResponse is the response type for the CanonicalAssets RPC method.
+type QueryCanonicalAssetsResponse struct {
+	// the registered canonical assets
+	Assets []CanonicalAsset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalEscrowForDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCanonicalAssetsResponse) Reset()         { *m = QueryCanonicalAssetsResponse{} }
+func (m *QueryCanonicalAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalAssetsResponse) ProtoMessage()    {}
+func (*QueryCanonicalAssetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{19}
+}
+func (m *QueryCanonicalAssetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalAssetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCanonicalAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalAssetsResponse.Merge(m, src)
+}
+func (m *QueryCanonicalAssetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalAssetsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalEscrowForDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCanonicalAssetsResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalAssetsResponse) GetAssets() []CanonicalAsset {
+	if m != nil {
+		return m.Assets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalEscrowForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCanonicalAssetsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+// QueryNativeMappingRequest is the request type for the NativeMapping RPC method.
+type QueryNativeMappingRequest struct {
+	// the local denomination
+	LocalDenom string `protobuf:"bytes,1,opt,name=local_denom,json=localDenom,proto3" json:"local_denom,omitempty"`
+}
+
+func (m *QueryNativeMappingRequest) Reset()         { *m = QueryNativeMappingRequest{} }
+func (m *QueryNativeMappingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNativeMappingRequest) ProtoMessage()    {}
+func (*QueryNativeMappingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{20}
+}
+func (m *QueryNativeMappingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNativeMappingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNativeMappingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryNativeMappingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNativeMappingRequest.Merge(m, src)
+}
+func (m *QueryNativeMappingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNativeMappingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNativeMappingRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryNativeMappingRequest proto.InternalMessageInfo
+
+func (m *QueryNativeMappingRequest) GetLocalDenom() string {
+	if m != nil {
+		return m.LocalDenom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// QueryNativeMappingResponse is the response type for the NativeMapping RPC method.
+type QueryNativeMappingResponse struct {
+	// the native mapping
+	Mapping NativeMapping `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping"`
+	// the amount of the local denomination currently minted
+	Minted types.Coin `protobuf:"bytes,2,opt,name=minted,proto3" json:"minted"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryNativeMappingResponse) Reset()         { *m = QueryNativeMappingResponse{} }
+func (m *QueryNativeMappingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNativeMappingResponse) ProtoMessage()    {}
+func (*QueryNativeMappingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{21}
+}
+func (m *QueryNativeMappingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNativeMappingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNativeMappingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryNativeMappingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNativeMappingResponse.Merge(m, src)
+}
+func (m *QueryNativeMappingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNativeMappingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNativeMappingResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Trace)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryNativeMappingResponse proto.InternalMessageInfo
+
+func (m *QueryNativeMappingResponse) GetMapping() NativeMapping {
+	if m != nil {
+		return m.Mapping
 	}
-	return n
+	return NativeMapping{}
 }
 
-func (m *QueryDenomHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryNativeMappingResponse) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *QueryEscrowAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryNativeMappingsRequest is the request type for the NativeMappings RPC method.
+type QueryNativeMappingsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEscrowAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryNativeMappingsRequest) Reset()         { *m = QueryNativeMappingsRequest{} }
+func (m *QueryNativeMappingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNativeMappingsRequest) ProtoMessage()    {}
+func (*QueryNativeMappingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{22}
+}
+func (m *QueryNativeMappingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNativeMappingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNativeMappingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryNativeMappingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNativeMappingsRequest.Merge(m, src)
+}
+func (m *QueryNativeMappingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNativeMappingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNativeMappingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalEscrowForDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryNativeMappingsRequest proto.InternalMessageInfo
+
+func (m *QueryNativeMappingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryNativeMappingsResponse is the response type for the NativeMappings RPC method.
+type QueryNativeMappingsResponse struct {
+	// the registered native mappings
+	Mappings []NativeMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryNativeMappingsResponse) Reset()         { *m = QueryNativeMappingsResponse{} }
+func (m *QueryNativeMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNativeMappingsResponse) ProtoMessage()    {}
+func (*QueryNativeMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{23}
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryNativeMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+func (m *QueryNativeMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNativeMappingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNativeMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNativeMappingsResponse.Merge(m, src)
+}
+func (m *QueryNativeMappingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNativeMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNativeMappingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNativeMappingsResponse proto.InternalMessageInfo
+
+func (m *QueryNativeMappingsResponse) GetMappings() []NativeMapping {
+	if m != nil {
+		return m.Mappings
+	}
+	return nil
+}
+
+func (m *QueryNativeMappingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProtocolFeeConfigRequest is the request type for the ProtocolFeeConfig RPC method.
+type QueryProtocolFeeConfigRequest struct {
+}
+
+func (m *QueryProtocolFeeConfigRequest) Reset()         { *m = QueryProtocolFeeConfigRequest{} }
+func (m *QueryProtocolFeeConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeeConfigRequest) ProtoMessage()    {}
+func (*QueryProtocolFeeConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{24}
+}
+func (m *QueryProtocolFeeConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeeConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeeConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolFeeConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeeConfigRequest.Merge(m, src)
+}
+func (m *QueryProtocolFeeConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeeConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeeConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolFeeConfigRequest proto.InternalMessageInfo
+
+// QueryProtocolFeeConfigResponse is the response type for the ProtocolFeeConfig RPC method.
+type QueryProtocolFeeConfigResponse struct {
+	// config defines the protocol fee configuration
+	Config ProtocolFeeConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (m *QueryProtocolFeeConfigResponse) Reset()         { *m = QueryProtocolFeeConfigResponse{} }
+func (m *QueryProtocolFeeConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeeConfigResponse) ProtoMessage()    {}
+func (*QueryProtocolFeeConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{25}
+}
+func (m *QueryProtocolFeeConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeeConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeeConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolFeeConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeeConfigResponse.Merge(m, src)
+}
+func (m *QueryProtocolFeeConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeeConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeeConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolFeeConfigResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolFeeConfigResponse) GetConfig() ProtocolFeeConfig {
+	if m != nil {
+		return m.Config
+	}
+	return ProtocolFeeConfig{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.transfer.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.transfer.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDenomHashRequest)(nil), "ibc.applications.transfer.v1.QueryDenomHashRequest")
+	proto.RegisterType((*QueryDenomHashResponse)(nil), "ibc.applications.transfer.v1.QueryDenomHashResponse")
+	proto.RegisterType((*QueryEscrowAddressRequest)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressRequest")
+	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressResponse")
+	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
+	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
+	proto.RegisterType((*QueryChannelEscrowRequest)(nil), "ibc.applications.transfer.v1.QueryChannelEscrowRequest")
+	proto.RegisterType((*QueryChannelEscrowResponse)(nil), "ibc.applications.transfer.v1.QueryChannelEscrowResponse")
+	proto.RegisterType((*QueryChannelEscrowsRequest)(nil), "ibc.applications.transfer.v1.QueryChannelEscrowsRequest")
+	proto.RegisterType((*QueryChannelEscrowsResponse)(nil), "ibc.applications.transfer.v1.QueryChannelEscrowsResponse")
+	proto.RegisterType((*QueryChannelDenomRulesRequest)(nil), "ibc.applications.transfer.v1.QueryChannelDenomRulesRequest")
+	proto.RegisterType((*QueryChannelDenomRulesResponse)(nil), "ibc.applications.transfer.v1.QueryChannelDenomRulesResponse")
+	proto.RegisterType((*QueryAllChannelDenomRulesRequest)(nil), "ibc.applications.transfer.v1.QueryAllChannelDenomRulesRequest")
+	proto.RegisterType((*QueryAllChannelDenomRulesResponse)(nil), "ibc.applications.transfer.v1.QueryAllChannelDenomRulesResponse")
+	proto.RegisterType((*QueryCanonicalAssetRequest)(nil), "ibc.applications.transfer.v1.QueryCanonicalAssetRequest")
+	proto.RegisterType((*QueryCanonicalAssetResponse)(nil), "ibc.applications.transfer.v1.QueryCanonicalAssetResponse")
+	proto.RegisterType((*QueryCanonicalAssetsRequest)(nil), "ibc.applications.transfer.v1.QueryCanonicalAssetsRequest")
+	proto.RegisterType((*QueryCanonicalAssetsResponse)(nil), "ibc.applications.transfer.v1.QueryCanonicalAssetsResponse")
+	proto.RegisterType((*QueryNativeMappingRequest)(nil), "ibc.applications.transfer.v1.QueryNativeMappingRequest")
+	proto.RegisterType((*QueryNativeMappingResponse)(nil), "ibc.applications.transfer.v1.QueryNativeMappingResponse")
+	proto.RegisterType((*QueryNativeMappingsRequest)(nil), "ibc.applications.transfer.v1.QueryNativeMappingsRequest")
+	proto.RegisterType((*QueryNativeMappingsResponse)(nil), "ibc.applications.transfer.v1.QueryNativeMappingsResponse")
+	proto.RegisterType((*QueryProtocolFeeConfigRequest)(nil), "ibc.applications.transfer.v1.QueryProtocolFeeConfigRequest")
+	proto.RegisterType((*QueryProtocolFeeConfigResponse)(nil), "ibc.applications.transfer.v1.QueryProtocolFeeConfigResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/query.proto", fileDescriptor_a638e2800a01538c)
+}
+
+var fileDescriptor_a638e2800a01538c = []byte{
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xd3, 0x66, 0xdb, 0x4e, 0x95, 0xa2, 0x4e, 0x03, 0xb4, 0x26, 0xdd, 0xb4, 0xa6, 0x3f,
+	0xd2, 0xa4, 0xf1, 0x74, 0x93, 0xd2, 0x24, 0x90, 0x16, 0x25, 0x29, 0x69, 0x13, 0x28, 0xa4, 0x5b,
+	0x24, 0x24, 0x38, 0xac, 0x66, 0xbd, 0x93, 0x8d, 0xc1, 0xeb, 0x71, 0x3d, 0x4e, 0xaa, 0x2a, 0xca,
+	0x85, 0xbf, 0x00, 0xa9, 0xff, 0x01, 0x47, 0x24, 0x4e, 0x80, 0x04, 0x37, 0xe0, 0xd4, 0x53, 0x55,
+	0x81, 0x84, 0x38, 0x51, 0x94, 0x70, 0xe0, 0xc0, 0x1f, 0x81, 0x3c, 0xf3, 0xec, 0xac, 0x77, 0xbd,
+	0x1b, 0xef, 0x66, 0x7b, 0x4a, 0x3c, 0x9e, 0xf7, 0xbd, 0xef, 0x7b, 0xf3, 0xe6, 0xf9, 0x5b, 0x34,
+	0x6a, 0x97, 0x2d, 0x42, 0x3d, 0xcf, 0xb1, 0x2d, 0x1a, 0xd8, 0xdc, 0x15, 0x24, 0xf0, 0xa9, 0x2b,
+	0xd6, 0x98, 0x4f, 0x36, 0x0b, 0xe4, 0xe1, 0x06, 0xf3, 0x1f, 0x9b, 0x9e, 0xcf, 0x03, 0x8e, 0x87,
+	0xed, 0xb2, 0x65, 0xd6, 0xef, 0x34, 0xa3, 0x9d, 0xe6, 0x66, 0x41, 0x1f, 0xaa, 0xf2, 0x2a, 0x97,
+	0x1b, 0x49, 0xf8, 0x9f, 0x8a, 0xd1, 0xf3, 0x16, 0x17, 0x35, 0x2e, 0x48, 0x99, 0x0a, 0x46, 0x36,
+	0x0b, 0x65, 0x16, 0xd0, 0x02, 0xb1, 0xb8, 0xed, 0xc2, 0xfb, 0xf1, 0xb6, 0xd9, 0x63, 0x7c, 0xb5,
+	0x79, 0xb8, 0xca, 0x79, 0xd5, 0x61, 0x84, 0x7a, 0x36, 0xa1, 0xae, 0xcb, 0x03, 0xa0, 0xa1, 0xde,
+	0x8e, 0xd5, 0xa7, 0x92, 0xbc, 0xe3, 0x84, 0x1e, 0xad, 0xda, 0xae, 0xdc, 0xac, 0xf6, 0x1a, 0x43,
+	0x08, 0xdf, 0x0f, 0x77, 0xac, 0x52, 0x9f, 0xd6, 0x44, 0x91, 0x3d, 0xdc, 0x60, 0x22, 0x30, 0x1e,
+	0xa0, 0x53, 0x89, 0x55, 0xe1, 0x71, 0x57, 0x30, 0x3c, 0x87, 0x72, 0x9e, 0x5c, 0x39, 0xad, 0x9d,
+	0xd3, 0x46, 0x8f, 0x4f, 0x5e, 0x30, 0xdb, 0x15, 0xc2, 0x84, 0x68, 0x88, 0x31, 0x26, 0xd0, 0xab,
+	0x12, 0xf4, 0x36, 0x73, 0x79, 0xed, 0x2e, 0x15, 0xeb, 0x90, 0x0d, 0x0f, 0xa1, 0x81, 0xc0, 0xa7,
+	0x16, 0x93, 0xa8, 0xc7, 0x8a, 0xea, 0xc1, 0xb8, 0x8a, 0x5e, 0x6b, 0xdc, 0x0e, 0x34, 0x30, 0x3a,
+	0xbc, 0x4e, 0xc5, 0x3a, 0x6c, 0x97, 0xff, 0x1b, 0x0f, 0xd0, 0x19, 0xb9, 0xfb, 0x3d, 0x61, 0xf9,
+	0xfc, 0xd1, 0x7c, 0xa5, 0xe2, 0x33, 0x11, 0xc9, 0xc1, 0xaf, 0xa3, 0x23, 0x1e, 0xf7, 0x83, 0x92,
+	0x5d, 0x81, 0x98, 0x5c, 0xf8, 0xb8, 0x5c, 0xc1, 0x67, 0x11, 0xb2, 0xd6, 0xa9, 0xeb, 0x32, 0x27,
+	0x7c, 0xd7, 0x2f, 0xdf, 0x1d, 0x83, 0x95, 0xe5, 0x8a, 0xb1, 0x88, 0xf4, 0x34, 0x50, 0xa0, 0x71,
+	0x11, 0x9d, 0x60, 0xf2, 0x45, 0x89, 0xaa, 0x37, 0x00, 0x3e, 0xc8, 0xea, 0xb7, 0x1b, 0xd3, 0x68,
+	0x44, 0x82, 0x7c, 0xcc, 0x03, 0xea, 0x28, 0xa4, 0x25, 0xee, 0x4b, 0x55, 0x75, 0x05, 0xa8, 0x84,
+	0xcf, 0x51, 0x01, 0xe4, 0x83, 0xf1, 0x19, 0x3a, 0xd7, 0x3a, 0x10, 0x38, 0x4c, 0xa3, 0x1c, 0xad,
+	0xf1, 0x0d, 0x37, 0x80, 0x13, 0x39, 0x63, 0xaa, 0xb3, 0x37, 0xc3, 0xb3, 0x37, 0xe1, 0xd4, 0xcd,
+	0x45, 0x6e, 0xbb, 0x0b, 0x87, 0x9f, 0xfe, 0x35, 0xd2, 0x57, 0x84, 0xed, 0x71, 0xbd, 0x16, 0x95,
+	0x58, 0x05, 0x7f, 0xd0, 0x7a, 0xfd, 0xa7, 0x21, 0x3d, 0x0d, 0x15, 0xc8, 0x56, 0xd1, 0x51, 0x55,
+	0x1a, 0x16, 0xe2, 0x1e, 0x6a, 0x4f, 0xf7, 0x5a, 0x48, 0xf7, 0x9b, 0x17, 0x23, 0xa3, 0x55, 0x3b,
+	0x58, 0xdf, 0x28, 0x9b, 0x16, 0xaf, 0x11, 0xe8, 0x6b, 0xf5, 0x67, 0x42, 0x54, 0xbe, 0x20, 0xc1,
+	0x63, 0x8f, 0x09, 0x19, 0x20, 0x8a, 0x31, 0x38, 0x66, 0xe8, 0x48, 0x99, 0x3a, 0xd4, 0xb5, 0xd8,
+	0xe9, 0xfe, 0xde, 0xe7, 0x89, 0xb0, 0x8d, 0x4a, 0x9a, 0xda, 0xb8, 0xe9, 0x96, 0x10, 0xda, 0xbb,
+	0x6d, 0x70, 0x3c, 0x97, 0x12, 0x3c, 0xd4, 0x48, 0x89, 0xd8, 0xac, 0xd2, 0x2a, 0x83, 0xd8, 0x62,
+	0x5d, 0xa4, 0xf1, 0x9d, 0x86, 0xde, 0x48, 0x4d, 0x03, 0x55, 0x7d, 0x1f, 0x1d, 0x51, 0xc2, 0x05,
+	0x14, 0x75, 0xbc, 0xfd, 0xad, 0x4c, 0xc0, 0x40, 0x57, 0x44, 0x08, 0xf8, 0x4e, 0x82, 0x74, 0xbf,
+	0x24, 0x7d, 0x79, 0x5f, 0xd2, 0x8a, 0x49, 0x82, 0xf5, 0x27, 0xe8, 0x6c, 0x3d, 0x69, 0xd5, 0xb5,
+	0x1b, 0x0e, 0x3b, 0xf0, 0x9d, 0xac, 0xa1, 0x7c, 0x2b, 0xe0, 0xb8, 0x20, 0x03, 0x7e, 0xb8, 0x00,
+	0x35, 0x27, 0x99, 0xca, 0xb1, 0x87, 0x03, 0x25, 0x51, 0x18, 0xc6, 0xe7, 0x70, 0x09, 0xe7, 0x1d,
+	0xa7, 0xa5, 0x94, 0x5e, 0x9d, 0xf4, 0x4f, 0x1a, 0x3a, 0xdf, 0x26, 0x59, 0xb3, 0xbc, 0x43, 0x07,
+	0x95, 0xd7, 0xbb, 0xf3, 0x9e, 0x8c, 0xee, 0x02, 0x75, 0xb9, 0x6b, 0x5b, 0xd4, 0x99, 0x17, 0x82,
+	0x05, 0xed, 0x07, 0xdc, 0xb3, 0xb8, 0xb3, 0x1b, 0x82, 0x40, 0xe9, 0x5d, 0x34, 0x40, 0xc3, 0x05,
+	0x28, 0xe9, 0xd5, 0x7d, 0x94, 0x26, 0x40, 0x22, 0x99, 0x12, 0x20, 0x1c, 0x08, 0x8f, 0x7c, 0xea,
+	0x79, 0xac, 0xf2, 0x52, 0x06, 0x02, 0x60, 0x1b, 0x2c, 0x55, 0xcf, 0xcb, 0x98, 0x08, 0xc3, 0xe9,
+	0x79, 0xa0, 0x70, 0x2b, 0x28, 0x27, 0x75, 0x47, 0x3d, 0xd2, 0x4d, 0xe5, 0x00, 0xa1, 0x77, 0x1d,
+	0x32, 0x07, 0x5f, 0x9c, 0x0f, 0x69, 0x60, 0x6f, 0xb2, 0x7b, 0xd4, 0xf3, 0x6c, 0xb7, 0x1a, 0x95,
+	0x66, 0x04, 0x1d, 0x77, 0xb8, 0x45, 0x9d, 0x52, 0x7d, 0x9b, 0x20, 0xb9, 0x24, 0x5b, 0xd7, 0xf8,
+	0x3a, 0xfa, 0xb4, 0x34, 0x84, 0xef, 0x0d, 0xc1, 0x9a, 0x5a, 0x82, 0xba, 0xee, 0x33, 0x04, 0x13,
+	0x28, 0xd1, 0x10, 0x04, 0x84, 0xf0, 0xa3, 0x5a, 0xb3, 0xdd, 0x80, 0x55, 0x40, 0xee, 0xfe, 0x1f,
+	0x55, 0xb5, 0x3d, 0xfe, 0x20, 0x24, 0xd0, 0x7b, 0x7e, 0xfc, 0x3f, 0x44, 0xd7, 0xa6, 0x31, 0x0d,
+	0xd4, 0xe2, 0x1e, 0x3a, 0x0a, 0x4a, 0x32, 0x7e, 0x11, 0xd2, 0x8a, 0x11, 0x43, 0xf4, 0xae, 0x01,
+	0x46, 0xe0, 0x93, 0xb0, 0xea, 0xf3, 0x80, 0x5b, 0xdc, 0x59, 0x62, 0x6c, 0x91, 0xbb, 0x6b, 0x76,
+	0xd4, 0x04, 0x06, 0x47, 0xf9, 0x56, 0x1b, 0x62, 0x69, 0x39, 0x4b, 0xae, 0x64, 0x9b, 0xed, 0x4d,
+	0x40, 0xd1, 0x79, 0x29, 0x90, 0xc9, 0x7f, 0x4f, 0xa1, 0x01, 0x99, 0x11, 0x3f, 0xd1, 0x50, 0x4e,
+	0xd9, 0x55, 0x7c, 0xad, 0x3d, 0x66, 0xb3, 0x5b, 0xd6, 0x0b, 0x1d, 0x44, 0x28, 0x21, 0xc6, 0x85,
+	0x2f, 0x7f, 0xff, 0xe7, 0x49, 0x7f, 0x1e, 0x0f, 0x13, 0xb0, 0xfd, 0x49, 0xbb, 0xaf, 0x1c, 0x33,
+	0xfe, 0x56, 0x43, 0xc7, 0x62, 0xfb, 0x8b, 0xa7, 0x32, 0xa4, 0x69, 0xf4, 0xd6, 0xfa, 0xf5, 0xce,
+	0x82, 0x80, 0xde, 0x5b, 0x92, 0x1e, 0xc1, 0x13, 0xe9, 0xf4, 0xe4, 0x25, 0x2d, 0x85, 0xbe, 0x9b,
+	0x09, 0xb2, 0x25, 0xed, 0xfa, 0xcd, 0xb1, 0xb1, 0x6d, 0xfc, 0x87, 0x86, 0x06, 0x13, 0x5e, 0x19,
+	0x4f, 0x67, 0x48, 0x9f, 0x66, 0xd9, 0xf5, 0x99, 0xce, 0x03, 0x81, 0x7b, 0x51, 0x72, 0xff, 0x00,
+	0xaf, 0xa4, 0x73, 0x07, 0x27, 0x21, 0xc8, 0xd6, 0x9e, 0xcb, 0xd8, 0x26, 0xa1, 0xf7, 0x10, 0x64,
+	0x0b, 0x1c, 0xc9, 0x36, 0x49, 0x1a, 0x7b, 0xfc, 0x9b, 0x86, 0x4e, 0xa5, 0xd8, 0x70, 0x7c, 0x33,
+	0x03, 0xcb, 0xd6, 0xbe, 0x5f, 0xbf, 0xd5, 0x6d, 0x38, 0x48, 0x9d, 0x93, 0x52, 0x6f, 0xe0, 0xeb,
+	0x6d, 0x8e, 0x49, 0x90, 0x2d, 0xf9, 0x37, 0x3c, 0x20, 0x12, 0x84, 0x60, 0x25, 0x25, 0x0e, 0x3f,
+	0xd3, 0xd0, 0x60, 0xc2, 0x0c, 0x66, 0x3a, 0xad, 0xb4, 0x1f, 0x0c, 0xfa, 0x4c, 0xe7, 0x81, 0x20,
+	0x61, 0x45, 0x4a, 0xb8, 0x8d, 0x17, 0x0e, 0x7e, 0x5a, 0xf8, 0x7b, 0x0d, 0x9d, 0x48, 0x64, 0x11,
+	0xb8, 0x63, 0x62, 0x71, 0x03, 0xce, 0x76, 0x11, 0x09, 0x9a, 0x26, 0xa4, 0xa6, 0xcb, 0xf8, 0x62,
+	0x5b, 0x4d, 0xa5, 0xc8, 0x73, 0xbf, 0xd0, 0xd0, 0xc9, 0x26, 0x9b, 0x86, 0xdf, 0xc9, 0x9e, 0xbf,
+	0xc9, 0x91, 0xea, 0x73, 0xdd, 0x05, 0x03, 0xff, 0x8f, 0x24, 0xff, 0x65, 0x7c, 0xe7, 0x20, 0x67,
+	0xa2, 0x66, 0x84, 0x72, 0x99, 0xbf, 0x6a, 0x68, 0x28, 0xcd, 0xd3, 0xe2, 0x2c, 0x17, 0xa0, 0x8d,
+	0xf3, 0xd6, 0xdf, 0xed, 0x3a, 0x1e, 0xa4, 0x5e, 0x91, 0x52, 0xdf, 0xc4, 0xe7, 0xdb, 0x0d, 0x3a,
+	0x25, 0xe2, 0xe7, 0xb0, 0xbb, 0x12, 0x4e, 0x29, 0x5b, 0x77, 0xa5, 0x19, 0x62, 0x7d, 0xb6, 0x8b,
+	0x48, 0xa0, 0x3c, 0x2b, 0x29, 0x4f, 0xe1, 0x42, 0x8b, 0xd3, 0x89, 0xa2, 0x4a, 0xca, 0xc0, 0xd5,
+	0x5d, 0x7f, 0xfc, 0xa3, 0x86, 0x5e, 0x49, 0xa2, 0x0a, 0xdc, 0x39, 0x93, 0xb8, 0xfa, 0x6f, 0x77,
+	0x13, 0x0a, 0x2a, 0x4c, 0xa9, 0x62, 0x14, 0x5f, 0xca, 0xa6, 0x22, 0x6c, 0xa1, 0xc1, 0x84, 0x4f,
+	0xc9, 0x34, 0xac, 0xd2, 0xbc, 0xa6, 0x3e, 0xd3, 0x79, 0x20, 0x90, 0xbe, 0x25, 0x49, 0xcf, 0xe0,
+	0x1b, 0xe9, 0xa4, 0x5d, 0x19, 0x54, 0x8a, 0x9c, 0x13, 0xd9, 0xaa, 0xb3, 0xb4, 0xb2, 0xfe, 0xe1,
+	0x80, 0x4a, 0x20, 0x67, 0x1b, 0x50, 0xa9, 0x76, 0x52, 0x9f, 0xed, 0x22, 0x32, 0xdb, 0x80, 0x6a,
+	0xd0, 0x81, 0x7f, 0xd1, 0xd0, 0xc9, 0x26, 0x2b, 0x95, 0x69, 0x40, 0xb5, 0xb2, 0x7a, 0xfa, 0x5c,
+	0x77, 0xc1, 0xc0, 0xbf, 0x20, 0xf9, 0x8f, 0xe3, 0x2b, 0x2d, 0xdc, 0x13, 0x04, 0x96, 0xd6, 0x18,
+	0x2b, 0x29, 0xab, 0xb7, 0x70, 0xff, 0xe9, 0x4e, 0x5e, 0x7b, 0xbe, 0x93, 0xd7, 0xfe, 0xde, 0xc9,
+	0x6b, 0x5f, 0xed, 0xe6, 0xfb, 0x9e, 0xef, 0xe6, 0xfb, 0xfe, 0xdc, 0xcd, 0xf7, 0x7d, 0x3a, 0xdd,
+	0xfc, 0x3b, 0xcf, 0x2e, 0x5b, 0x13, 0x55, 0x4e, 0x36, 0x67, 0x49, 0x8d, 0x57, 0xc2, 0xcb, 0xdf,
+	0x90, 0x43, 0xfe, 0xf8, 0x2b, 0xe7, 0x64, 0x9e, 0xa9, 0xff, 0x07, 0x00, 0x44, 0xd7, 0x29, 0x32,
+	0x38, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the ibc-transfer module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DenomHash queries a denomination hash information.
+	DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error)
+	// EscrowAddress returns the escrow address for a particular port and channel id.
+	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
+	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
+	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
+	// ChannelEscrow queries the amount of tokens escrowed for a channel together with the
+	// balance of its escrow address.
+	ChannelEscrow(ctx context.Context, in *QueryChannelEscrowRequest, opts ...grpc.CallOption) (*QueryChannelEscrowResponse, error)
+	// ChannelEscrows queries the amount of tokens escrowed for all channels.
+	ChannelEscrows(ctx context.Context, in *QueryChannelEscrowsRequest, opts ...grpc.CallOption) (*QueryChannelEscrowsResponse, error)
+	// ChannelDenomRules returns the effective denomination rules for a particular port and channel id.
+	ChannelDenomRules(ctx context.Context, in *QueryChannelDenomRulesRequest, opts ...grpc.CallOption) (*QueryChannelDenomRulesResponse, error)
+	// AllChannelDenomRules returns the denomination rules of all channels which have rules set.
+	AllChannelDenomRules(ctx context.Context, in *QueryAllChannelDenomRulesRequest, opts ...grpc.CallOption) (*QueryAllChannelDenomRulesResponse, error)
+	// CanonicalAsset returns a registered canonical asset and the amounts of its route vouchers wrapped.
+	CanonicalAsset(ctx context.Context, in *QueryCanonicalAssetRequest, opts ...grpc.CallOption) (*QueryCanonicalAssetResponse, error)
+	// CanonicalAssets returns all registered canonical assets.
+	CanonicalAssets(ctx context.Context, in *QueryCanonicalAssetsRequest, opts ...grpc.CallOption) (*QueryCanonicalAssetsResponse, error)
+	// NativeMapping returns the mapping of a local denomination and the amount minted.
+	NativeMapping(ctx context.Context, in *QueryNativeMappingRequest, opts ...grpc.CallOption) (*QueryNativeMappingResponse, error)
+	// NativeMappings returns all foreign assets mapped to local denominations.
+	NativeMappings(ctx context.Context, in *QueryNativeMappingsRequest, opts ...grpc.CallOption) (*QueryNativeMappingsResponse, error)
+	// ProtocolFeeConfig returns the protocol fee configuration.
+	ProtocolFeeConfig(ctx context.Context, in *QueryProtocolFeeConfigRequest, opts ...grpc.CallOption) (*QueryProtocolFeeConfigResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error) {
+	out := new(QueryDenomHashResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error) {
+	out := new(QueryEscrowAddressResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/EscrowAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error) {
+	out := new(QueryTotalEscrowForDenomResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelEscrow(ctx context.Context, in *QueryChannelEscrowRequest, opts ...grpc.CallOption) (*QueryChannelEscrowResponse, error) {
+	out := new(QueryChannelEscrowResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/ChannelEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelEscrows(ctx context.Context, in *QueryChannelEscrowsRequest, opts ...grpc.CallOption) (*QueryChannelEscrowsResponse, error) {
+	out := new(QueryChannelEscrowsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/ChannelEscrows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelDenomRules(ctx context.Context, in *QueryChannelDenomRulesRequest, opts ...grpc.CallOption) (*QueryChannelDenomRulesResponse, error) {
+	out := new(QueryChannelDenomRulesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/ChannelDenomRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllChannelDenomRules(ctx context.Context, in *QueryAllChannelDenomRulesRequest, opts ...grpc.CallOption) (*QueryAllChannelDenomRulesResponse, error) {
+	out := new(QueryAllChannelDenomRulesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/AllChannelDenomRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanonicalAsset(ctx context.Context, in *QueryCanonicalAssetRequest, opts ...grpc.CallOption) (*QueryCanonicalAssetResponse, error) {
+	out := new(QueryCanonicalAssetResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/CanonicalAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanonicalAssets(ctx context.Context, in *QueryCanonicalAssetsRequest, opts ...grpc.CallOption) (*QueryCanonicalAssetsResponse, error) {
+	out := new(QueryCanonicalAssetsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/CanonicalAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NativeMapping(ctx context.Context, in *QueryNativeMappingRequest, opts ...grpc.CallOption) (*QueryNativeMappingResponse, error) {
+	out := new(QueryNativeMappingResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/NativeMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NativeMappings(ctx context.Context, in *QueryNativeMappingsRequest, opts ...grpc.CallOption) (*QueryNativeMappingsResponse, error) {
+	out := new(QueryNativeMappingsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/NativeMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtocolFeeConfig(ctx context.Context, in *QueryProtocolFeeConfigRequest, opts ...grpc.CallOption) (*QueryProtocolFeeConfigResponse, error) {
+	out := new(QueryProtocolFeeConfigResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/ProtocolFeeConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the ibc-transfer module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DenomHash queries a denomination hash information.
+	DenomHash(context.Context, *QueryDenomHashRequest) (*QueryDenomHashResponse, error)
+	// EscrowAddress returns the escrow address for a particular port and channel id.
+	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
+	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
+	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
+	// ChannelEscrow queries the amount of tokens escrowed for a channel together with the
+	// balance of its escrow address.
+	ChannelEscrow(context.Context, *QueryChannelEscrowRequest) (*QueryChannelEscrowResponse, error)
+	// ChannelEscrows queries the amount of tokens escrowed for all channels.
+	ChannelEscrows(context.Context, *QueryChannelEscrowsRequest) (*QueryChannelEscrowsResponse, error)
+	// ChannelDenomRules returns the effective denomination rules for a particular port and channel id.
+	ChannelDenomRules(context.Context, *QueryChannelDenomRulesRequest) (*QueryChannelDenomRulesResponse, error)
+	// AllChannelDenomRules returns the denomination rules of all channels which have rules set.
+	AllChannelDenomRules(context.Context, *QueryAllChannelDenomRulesRequest) (*QueryAllChannelDenomRulesResponse, error)
+	// CanonicalAsset returns a registered canonical asset and the amounts of its route vouchers wrapped.
+	CanonicalAsset(context.Context, *QueryCanonicalAssetRequest) (*QueryCanonicalAssetResponse, error)
+	// CanonicalAssets returns all registered canonical assets.
+	CanonicalAssets(context.Context, *QueryCanonicalAssetsRequest) (*QueryCanonicalAssetsResponse, error)
+	// NativeMapping returns the mapping of a local denomination and the amount minted.
+	NativeMapping(context.Context, *QueryNativeMappingRequest) (*QueryNativeMappingResponse, error)
+	// NativeMappings returns all foreign assets mapped to local denominations.
+	NativeMappings(context.Context, *QueryNativeMappingsRequest) (*QueryNativeMappingsResponse, error)
+	// ProtocolFeeConfig returns the protocol fee configuration.
+	ProtocolFeeConfig(context.Context, *QueryProtocolFeeConfigRequest) (*QueryProtocolFeeConfigResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DenomHash(ctx context.Context, req *QueryDenomHashRequest) (*QueryDenomHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomHash not implemented")
+}
+func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowAddress not implemented")
+}
+func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
+}
+func (*UnimplementedQueryServer) ChannelEscrow(ctx context.Context, req *QueryChannelEscrowRequest) (*QueryChannelEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelEscrow not implemented")
+}
+func (*UnimplementedQueryServer) ChannelEscrows(ctx context.Context, req *QueryChannelEscrowsRequest) (*QueryChannelEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelEscrows not implemented")
+}
+func (*UnimplementedQueryServer) ChannelDenomRules(ctx context.Context, req *QueryChannelDenomRulesRequest) (*QueryChannelDenomRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDenomRules not implemented")
+}
+func (*UnimplementedQueryServer) AllChannelDenomRules(ctx context.Context, req *QueryAllChannelDenomRulesRequest) (*QueryAllChannelDenomRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllChannelDenomRules not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalAsset(ctx context.Context, req *QueryCanonicalAssetRequest) (*QueryCanonicalAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalAsset not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalAssets(ctx context.Context, req *QueryCanonicalAssetsRequest) (*QueryCanonicalAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalAssets not implemented")
+}
+func (*UnimplementedQueryServer) NativeMapping(ctx context.Context, req *QueryNativeMappingRequest) (*QueryNativeMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NativeMapping not implemented")
+}
+func (*UnimplementedQueryServer) NativeMappings(ctx context.Context, req *QueryNativeMappingsRequest) (*QueryNativeMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NativeMappings not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFeeConfig(ctx context.Context, req *QueryProtocolFeeConfigRequest) (*QueryProtocolFeeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFeeConfig not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/DenomHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomHash(ctx, req.(*QueryDenomHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/EscrowAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowAddress(ctx, req.(*QueryEscrowAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalEscrowForDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalEscrowForDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalEscrowForDenom(ctx, req.(*QueryTotalEscrowForDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/ChannelEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelEscrow(ctx, req.(*QueryChannelEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelEscrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelEscrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/ChannelEscrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelEscrows(ctx, req.(*QueryChannelEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelDenomRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelDenomRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelDenomRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/ChannelDenomRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelDenomRules(ctx, req.(*QueryChannelDenomRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllChannelDenomRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChannelDenomRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllChannelDenomRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/AllChannelDenomRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllChannelDenomRules(ctx, req.(*QueryAllChannelDenomRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanonicalAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/CanonicalAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalAsset(ctx, req.(*QueryCanonicalAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanonicalAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/CanonicalAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalAssets(ctx, req.(*QueryCanonicalAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NativeMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNativeMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NativeMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/NativeMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NativeMapping(ctx, req.(*QueryNativeMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NativeMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNativeMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NativeMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/NativeMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NativeMappings(ctx, req.(*QueryNativeMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolFeeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFeeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFeeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/ProtocolFeeConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFeeConfig(ctx, req.(*QueryProtocolFeeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.transfer.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DenomHash",
+			Handler:    _Query_DenomHash_Handler,
+		},
+		{
+			MethodName: "EscrowAddress",
+			Handler:    _Query_EscrowAddress_Handler,
+		},
+		{
+			MethodName: "TotalEscrowForDenom",
+			Handler:    _Query_TotalEscrowForDenom_Handler,
+		},
+		{
+			MethodName: "ChannelEscrow",
+			Handler:    _Query_ChannelEscrow_Handler,
+		},
+		{
+			MethodName: "ChannelEscrows",
+			Handler:    _Query_ChannelEscrows_Handler,
+		},
+		{
+			MethodName: "ChannelDenomRules",
+			Handler:    _Query_ChannelDenomRules_Handler,
+		},
+		{
+			MethodName: "AllChannelDenomRules",
+			Handler:    _Query_AllChannelDenomRules_Handler,
+		},
+		{
+			MethodName: "CanonicalAsset",
+			Handler:    _Query_CanonicalAsset_Handler,
+		},
+		{
+			MethodName: "CanonicalAssets",
+			Handler:    _Query_CanonicalAssets_Handler,
+		},
+		{
+			MethodName: "NativeMapping",
+			Handler:    _Query_NativeMapping_Handler,
+		},
+		{
+			MethodName: "NativeMappings",
+			Handler:    _Query_NativeMappings_Handler,
+		},
+		{
+			MethodName: "ProtocolFeeConfig",
+			Handler:    _Query_ProtocolFeeConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/transfer/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trace) > 0 {
+		i -= len(m.Trace)
+		copy(dAtA[i:], m.Trace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalEscrowForDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalEscrowForDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalEscrowForDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalEscrowForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelEscrowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelEscrowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelEscrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelEscrowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelEscrowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelEscrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelDenomRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelDenomRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelDenomRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelDenomRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelDenomRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelDenomRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelDenomRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelDenomRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelDenomRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelDenomRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelDenomRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelDenomRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalAssetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalAssetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Wrapped) > 0 {
+		for iNdEx := len(m.Wrapped) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wrapped[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalAssetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNativeMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNativeMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNativeMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LocalDenom) > 0 {
+		i -= len(m.LocalDenom)
+		copy(dAtA[i:], m.LocalDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LocalDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNativeMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNativeMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNativeMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Mapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNativeMappingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNativeMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNativeMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNativeMappingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNativeMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNativeMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeeConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeeConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeeConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeeConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeeConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeeConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalEscrowForDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChannelEscrowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelEscrowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelDenomRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelDenomRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rules.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChannelDenomRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChannelDenomRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalAssetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Wrapped) > 0 {
+		for _, e := range m.Wrapped {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCanonicalAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNativeMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNativeMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mapping.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNativeMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNativeMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeeConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProtocolFeeConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelEscrowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelEscrowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelEscrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelEscrowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelEscrowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelEscrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, ChannelEscrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelDenomRulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelDenomRulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelDenomRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelDenomRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelDenomRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelDenomRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelDenomRulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelDenomRulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelDenomRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelDenomRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelDenomRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelDenomRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, ChannelDenomRules{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCanonicalAssetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanonicalAssetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanonicalAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCanonicalAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanonicalAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanonicalAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wrapped = append(m.Wrapped, types.Coin{})
+			if err := m.Wrapped[len(m.Wrapped)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1070,7 +5001,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalAssetsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,15 +5024,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalAssetsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1128,10 +5059,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1156,7 +5087,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalAssetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1179,17 +5110,17 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalAssetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1199,23 +5130,61 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trace = string(dAtA[iNdEx:postIndex])
+			m.Assets = append(m.Assets, CanonicalAsset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1238,7 +5207,7 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNativeMappingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,15 +5230,15 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNativeMappingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNativeMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1297,7 +5266,7 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1320,7 +5289,7 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNativeMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1343,17 +5312,17 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNativeMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNativeMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1363,29 +5332,30 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Mapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1395,23 +5365,24 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1434,7 +5405,7 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNativeMappingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1457,17 +5428,17 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNativeMappingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNativeMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1477,23 +5448,27 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1516,7 +5491,7 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNativeMappingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1539,17 +5514,17 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNativeMappingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNativeMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1559,23 +5534,61 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Mappings = append(m.Mappings, NativeMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1598,7 +5611,7 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolFeeConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1621,15 +5634,65 @@ func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolFeeConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolFeeConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolFeeConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolFeeConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolFeeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1656,7 +5719,7 @@ func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// ReceiverHook defines the interface that modules may implement to be notified when tokens are
+// received by their module account.
+type ReceiverHook interface {
+	// OnRecvTokens is called after the coins received in the packet have been sent to the receiver,
+	// which is the module account the hook is registered for. The coins are net of any protocol fee
+	// and represented as on this chain, while the packet data carries the tokens, memo, sender and
+	// forwarding information as sent. The packet is rejected and the tokens are refunded to the
+	// sender if an error is returned.
+	OnRecvTokens(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketDataV2, receiver sdk.AccAddress, coins sdk.Coins) error
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// RefundHook defines the interface that modules may implement to be notified when the tokens
+// of a sent packet are refunded after the packet timed out or was acknowledged with an error.
+type RefundHook interface {
+	// OnRefundPacket is called after the refunded coins of the packet have been sent to the
+	// refund address. State changes are discarded and the error is logged if an error is returned,
+	// the refund itself is never reverted.
+	OnRefundPacket(ctx sdk.Context, packet channeltypes.Packet, refundAddress sdk.AccAddress, coins sdk.Coins) error
+}
+
+// NewPacketRefundAddress creates a new PacketRefundAddress instance.
+func NewPacketRefundAddress(portID, channelID string, sequence uint64, refundAddress string) PacketRefundAddress {
+	return PacketRefundAddress{
+		PortId:        portID,
+		ChannelId:     channelID,
+		Sequence:      sequence,
+		RefundAddress: refundAddress,
+	}
+}
+
+// Validate performs a basic validation of the refund address of a packet.
+func (r PacketRefundAddress) Validate() error {
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port ID (%s)", r.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID (%s)", r.ChannelId)
+	}
+
+	if r.Sequence == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.RefundAddress); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid refund address: %v", err)
+	}
+
+	return nil
+}
@@ -25,6 +25,17 @@ func (t Token) Validate() error {
 		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
 	}
 
+	if t.Metadata != nil {
+		if err := t.Metadata.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidDenomMetadata, err.Error())
+		}
+
+		// the metadata must describe the denomination of the token on the sending chain
+		if t.Metadata.Base != t.Denom.IBCDenom() {
+			return errorsmod.Wrapf(ErrInvalidDenomMetadata, "metadata base denom (%s) does not match token denom (%s)", t.Metadata.Base, t.Denom.IBCDenom())
+		}
+	}
+
 	return nil
 }
 
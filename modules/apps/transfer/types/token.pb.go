@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +30,8 @@ type Token struct {
 	Denom Denom `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom"`
 	// the token amount to be transferred
 	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// optional bank metadata of the token denomination on the sending chain
+	Metadata *types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -78,6 +81,13 @@ func (m *Token) GetAmount() string {
 	return ""
 }
 
+func (m *Token) GetMetadata() *types.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // Denom holds the base denom of a Token and a trace of the chains it was sent through.
 type Denom struct {
 	// the base token denomination
@@ -143,25 +153,28 @@ func init() {
 }
 
 var fileDescriptor_732b93aa1330663e = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0x1b, 0xb7, 0x0e, 0x96, 0xdd, 0x82, 0xc8, 0x18, 0x12, 0xe7, 0xbc, 0x14, 0xc4, 0x84,
-	0xd5, 0x83, 0x78, 0x10, 0x61, 0x78, 0xf0, 0xea, 0xf0, 0xb4, 0x93, 0x49, 0x16, 0x6b, 0x70, 0xed,
-	0x57, 0x9a, 0x6c, 0xe0, 0xbf, 0xf0, 0x67, 0xed, 0xb8, 0xa3, 0x27, 0x91, 0xf6, 0x8f, 0x48, 0x53,
-	0x27, 0x3b, 0xf5, 0xf6, 0x26, 0x3c, 0xdf, 0xfb, 0xc0, 0x8b, 0x23, 0x23, 0x15, 0x17, 0x79, 0xbe,
-	0x32, 0x4a, 0x38, 0x03, 0x99, 0xe5, 0xae, 0x10, 0x99, 0x7d, 0xd5, 0x05, 0xdf, 0xc4, 0xdc, 0xc1,
-	0xbb, 0xce, 0x58, 0x5e, 0x80, 0x03, 0x72, 0x6a, 0xa4, 0x62, 0x87, 0x24, 0xdb, 0x93, 0x6c, 0x13,
-	0x8f, 0x2e, 0x5b, 0x7a, 0xa6, 0xff, 0xb9, 0xa9, 0x1a, 0x1d, 0x27, 0x90, 0x80, 0x8f, 0xbc, 0x4e,
-	0xcd, 0xef, 0xe4, 0x05, 0x87, 0xcf, 0xb5, 0x8f, 0xdc, 0xe3, 0x70, 0xa9, 0x33, 0x48, 0x87, 0x68,
-	0x8c, 0xa2, 0x41, 0x7c, 0xc1, 0xda, 0xcc, 0xec, 0xa1, 0x46, 0x67, 0xdd, 0xed, 0xf7, 0x59, 0x30,
-	0x6f, 0xee, 0xc8, 0x09, 0xee, 0x89, 0x14, 0xd6, 0x99, 0x1b, 0x1e, 0x8d, 0x51, 0xd4, 0x9f, 0xff,
-	0xbd, 0x26, 0x0b, 0x1c, 0x7a, 0x9a, 0x10, 0xdc, 0x95, 0xc2, 0x6a, 0x2f, 0xe8, 0xcf, 0x7d, 0x26,
-	0x77, 0x38, 0x74, 0x85, 0x50, 0x7a, 0xd8, 0x19, 0x77, 0xa2, 0x41, 0x7c, 0xde, 0x66, 0x9d, 0xb2,
-	0x47, 0xc8, 0xf7, 0x4e, 0x7f, 0x35, 0x7b, 0xda, 0x96, 0x14, 0xed, 0x4a, 0x8a, 0x7e, 0x4a, 0x8a,
-	0x3e, 0x2b, 0x1a, 0xec, 0x2a, 0x1a, 0x7c, 0x55, 0x34, 0x58, 0xdc, 0x24, 0xc6, 0xbd, 0xad, 0x25,
-	0x53, 0x90, 0x72, 0x05, 0x36, 0x05, 0xcb, 0x8d, 0x54, 0x57, 0x09, 0xf0, 0xcd, 0x2d, 0x4f, 0x61,
-	0xb9, 0x5e, 0x69, 0x5b, 0x4f, 0x77, 0x30, 0x99, 0xfb, 0xc8, 0xb5, 0x95, 0x3d, 0xbf, 0xcb, 0xf5,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x51, 0x5a, 0x6f, 0xa4, 0x01, 0x00, 0x00,
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x6a, 0xeb, 0x30,
+	0x14, 0x86, 0xed, 0x9b, 0x38, 0xdc, 0x28, 0x9b, 0xb8, 0x5c, 0x4c, 0xb8, 0x57, 0x4d, 0xd3, 0xc5,
+	0x50, 0x2a, 0x61, 0x77, 0x28, 0x19, 0x4a, 0x21, 0x74, 0xe8, 0xd2, 0xa1, 0xa6, 0x53, 0x36, 0x49,
+	0x51, 0x5d, 0x93, 0xd8, 0xc7, 0x58, 0x8a, 0xa1, 0x6f, 0xd1, 0xbd, 0x2f, 0x94, 0x31, 0x63, 0xa7,
+	0x52, 0x92, 0x17, 0x29, 0x96, 0x9d, 0x90, 0x29, 0xdb, 0x7f, 0xa4, 0xff, 0xfc, 0xe7, 0xe3, 0x1c,
+	0x14, 0xa4, 0x42, 0x32, 0x5e, 0x14, 0xcb, 0x54, 0x72, 0x93, 0x42, 0xae, 0x99, 0x29, 0x79, 0xae,
+	0x5f, 0x54, 0xc9, 0xaa, 0x88, 0x19, 0x58, 0xa8, 0x9c, 0x16, 0x25, 0x18, 0xc0, 0xff, 0x52, 0x21,
+	0xe9, 0xb1, 0x93, 0xee, 0x9d, 0xb4, 0x8a, 0x86, 0x97, 0x27, 0x72, 0xc2, 0x83, 0x6e, 0xa2, 0x86,
+	0x7f, 0x12, 0x48, 0xc0, 0x4a, 0x56, 0xab, 0xf6, 0x95, 0x48, 0xd0, 0x19, 0x68, 0x26, 0x78, 0xbe,
+	0x60, 0x55, 0x28, 0x94, 0xe1, 0xa1, 0x2d, 0x9a, 0xff, 0xf1, 0x87, 0x8b, 0xbc, 0xe7, 0x1a, 0x08,
+	0xdf, 0x21, 0x6f, 0xae, 0x72, 0xc8, 0x7c, 0x77, 0xe4, 0x06, 0x83, 0xe8, 0x82, 0x9e, 0x42, 0xa3,
+	0xf7, 0xb5, 0x75, 0xda, 0x5d, 0x7f, 0x9d, 0x39, 0x71, 0xd3, 0x87, 0xff, 0xa2, 0x1e, 0xcf, 0x60,
+	0x95, 0x1b, 0xff, 0xd7, 0xc8, 0x0d, 0xfa, 0x71, 0x5b, 0xe1, 0x09, 0xfa, 0x9d, 0x29, 0xc3, 0xe7,
+	0xdc, 0x70, 0xbf, 0x63, 0xb3, 0xff, 0xd3, 0x86, 0x8a, 0x5a, 0x90, 0x96, 0x8a, 0x3e, 0xb6, 0xa6,
+	0xf8, 0x60, 0x1f, 0xcf, 0x90, 0x67, 0x07, 0x61, 0x8c, 0xba, 0x82, 0x6b, 0x65, 0xd9, 0xfa, 0xb1,
+	0xd5, 0xf8, 0x16, 0x79, 0xa6, 0xe4, 0x52, 0xf9, 0x9d, 0x51, 0x27, 0x18, 0x44, 0xe7, 0xa7, 0x80,
+	0x43, 0xfa, 0x00, 0xc5, 0x1e, 0xd7, 0x76, 0x4d, 0x9f, 0xd6, 0x5b, 0xe2, 0x6e, 0xb6, 0xc4, 0xfd,
+	0xde, 0x12, 0xf7, 0x7d, 0x47, 0x9c, 0xcd, 0x8e, 0x38, 0x9f, 0x3b, 0xe2, 0xcc, 0x6e, 0x92, 0xd4,
+	0xbc, 0xae, 0x04, 0x95, 0x90, 0xb1, 0x76, 0x7d, 0xa9, 0x90, 0x57, 0x09, 0xb0, 0x6a, 0xc2, 0x32,
+	0x98, 0xaf, 0x96, 0x4a, 0xd7, 0x67, 0x39, 0x3a, 0x87, 0x79, 0x2b, 0x94, 0x16, 0x3d, 0xbb, 0xd3,
+	0xeb, 0x9f, 0x01, 0x00, 0xd0, 0x8f, 0x6c, 0x48, 0x00, 0x02, 0x00, 0x00,
 }
 
 func (m *Token) Marshal() (dAtA []byte, err error) {
@@ -184,6 +197,18 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
@@ -271,6 +296,10 @@ func (m *Token) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovToken(uint64(l))
 	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
 	return n
 }
 
@@ -393,6 +422,42 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
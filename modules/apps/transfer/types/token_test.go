@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 const (
@@ -139,6 +140,42 @@ func TestValidate(t *testing.T) {
 			},
 			fmt.Errorf("invalid token denom: invalid trace: invalid hop source port ID : identifier cannot be blank: invalid identifier"),
 		},
+		{
+			"success: native token with metadata",
+			Token{
+				Denom:    NewDenom("uatom"),
+				Amount:   amount,
+				Metadata: newTestMetadata("uatom"),
+			},
+			nil,
+		},
+		{
+			"success: IBC token with metadata",
+			Token{
+				Denom:    NewDenom("uatom", NewHop("transfer", "channel-0")),
+				Amount:   amount,
+				Metadata: newTestMetadata(NewDenom("uatom", NewHop("transfer", "channel-0")).IBCDenom()),
+			},
+			nil,
+		},
+		{
+			"failure: invalid metadata",
+			Token{
+				Denom:    NewDenom("uatom"),
+				Amount:   amount,
+				Metadata: &banktypes.Metadata{Base: "uatom"},
+			},
+			ErrInvalidDenomMetadata,
+		},
+		{
+			"failure: metadata base does not match token denom",
+			Token{
+				Denom:    NewDenom("uatom", NewHop("transfer", "channel-0")),
+				Amount:   amount,
+				Metadata: newTestMetadata("uatom"),
+			},
+			ErrInvalidDenomMetadata,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -202,3 +239,16 @@ func TestToCoin(t *testing.T) {
 		})
 	}
 }
+
+func newTestMetadata(base string) *banktypes.Metadata {
+	return &banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    base,
+		Display: "atom",
+		Name:    "Atom",
+		Symbol:  "ATOM",
+	}
+}
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,6 +27,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomFilterMode defines how the denominations listed in a ChannelDenomRules
+// are applied to the tokens transferred over the channel.
+type DenomFilterMode int32
+
+const (
+	// Default zero value enumeration, no denomination filter is applied
+	DENOM_FILTER_NONE DenomFilterMode = 0
+	// only the listed denominations may be transferred over the channel
+	DENOM_FILTER_ALLOW DenomFilterMode = 1
+	// the listed denominations may not be transferred over the channel
+	DENOM_FILTER_DENY DenomFilterMode = 2
+)
+
+var DenomFilterMode_name = map[int32]string{
+	0: "DENOM_FILTER_MODE_NONE_UNSPECIFIED",
+	1: "DENOM_FILTER_MODE_ALLOW",
+	2: "DENOM_FILTER_MODE_DENY",
+}
+
+var DenomFilterMode_value = map[string]int32{
+	"DENOM_FILTER_MODE_NONE_UNSPECIFIED": 0,
+	"DENOM_FILTER_MODE_ALLOW":            1,
+	"DENOM_FILTER_MODE_DENY":             2,
+}
+
+func (x DenomFilterMode) String() string {
+	return proto.EnumName(DenomFilterMode_name, int32(x))
+}
+
+func (DenomFilterMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{0}
+}
+
 // Params defines the set of IBC transfer parameters.
 // NOTE: To prevent a single token from being transferred, set the
 // TransfersEnabled parameter to true and then set the bank module's SendEnabled
@@ -34,6 +71,10 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+	// metadata_channels contains the identifiers of the channels over which the bank metadata
+	// of the tokens sent is propagated in ics20-2 packet data. Only channels whose counterparty
+	// accepts tokens with metadata may be listed, as the packet data is otherwise rejected.
+	MetadataChannels []string `protobuf:"bytes,3,rep,name=metadata_channels,json=metadataChannels,proto3" json:"metadata_channels,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,6 +124,13 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMetadataChannels() []string {
+	if m != nil {
+		return m.MetadataChannels
+	}
+	return nil
+}
+
 // Forwarding defines a list of port ID, channel ID pairs determining the path
 // through which a packet must be forwarded, and an unwind boolean indicating if
 // the coin should be unwinded to its native chain before forwarding.
@@ -193,236 +241,3206 @@ func (m *Hop) GetChannelId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
-	proto.RegisterType((*Forwarding)(nil), "ibc.applications.transfer.v1.Forwarding")
-	proto.RegisterType((*Hop)(nil), "ibc.applications.transfer.v1.Hop")
+// ChannelDenomRules defines the rules restricting the tokens which may be sent
+// and received over a channel. The rules are evaluated against the full path of
+// the denomination as it is represented on this chain.
+type ChannelDenomRules struct {
+	// the port identifier of the channel
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the mode in which the denominations are filtered
+	FilterMode DenomFilterMode `protobuf:"varint,3,opt,name=filter_mode,json=filterMode,proto3,enum=ibc.applications.transfer.v1.DenomFilterMode" json:"filter_mode,omitempty"`
+	// the denominations the filter applies to. An entry is either a base denomination
+	// (e.g. uatom), matching every token with that base denomination regardless of its
+	// trace, or a full denomination path (e.g. transfer/channel-0/uatom), matching only
+	// the token with that exact trace.
+	Denoms []string `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// the maximum number of hops in the trace of the tokens. Zero means no limit.
+	MaxTraceLength uint64 `protobuf:"varint,5,opt,name=max_trace_length,json=maxTraceLength,proto3" json:"max_trace_length,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/transfer/v1/transfer.proto", fileDescriptor_5041673e96e97901)
+func (m *ChannelDenomRules) Reset()         { *m = ChannelDenomRules{} }
+func (m *ChannelDenomRules) String() string { return proto.CompactTextString(m) }
+func (*ChannelDenomRules) ProtoMessage()    {}
+func (*ChannelDenomRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
 }
-
-var fileDescriptor_5041673e96e97901 = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
-	0x10, 0x87, 0x93, 0xb6, 0x44, 0xbb, 0x15, 0x85, 0x45, 0xb4, 0x88, 0xa6, 0x7f, 0x2e, 0x16, 0xc4,
-	0x2c, 0xd5, 0x83, 0xa8, 0xb7, 0x8a, 0xd2, 0xde, 0xb4, 0x78, 0xf2, 0x52, 0x36, 0x9b, 0x35, 0x5d,
-	0x48, 0x76, 0x96, 0xdd, 0x34, 0xc5, 0xb7, 0xf0, 0xe8, 0xd1, 0xc7, 0xe9, 0xb1, 0x47, 0x4f, 0x22,
-	0xed, 0x8b, 0x48, 0xd2, 0x58, 0x7a, 0xf2, 0x36, 0xf3, 0xcd, 0x37, 0xc3, 0xb2, 0x3f, 0x74, 0x26,
-	0x7c, 0x46, 0xa8, 0x52, 0x91, 0x60, 0x34, 0x11, 0x20, 0x0d, 0x49, 0x34, 0x95, 0xe6, 0x95, 0x6b,
-	0x92, 0x76, 0xd7, 0xb5, 0xa7, 0x34, 0x24, 0x80, 0x8f, 0x85, 0xcf, 0xbc, 0x4d, 0xd9, 0x5b, 0x0b,
-	0x69, 0xf7, 0x68, 0x3f, 0x84, 0x10, 0x72, 0x91, 0x64, 0xd5, 0x6a, 0xa7, 0xfd, 0x8c, 0x9c, 0x47,
-	0xaa, 0x69, 0x6c, 0x70, 0x0b, 0xed, 0x18, 0x2e, 0x83, 0x11, 0x97, 0xd4, 0x8f, 0x78, 0x50, 0xb7,
-	0x9b, 0x76, 0x67, 0x7b, 0x58, 0xcb, 0xd8, 0xfd, 0x0a, 0xe1, 0x53, 0xb4, 0xa7, 0x39, 0xe3, 0x22,
-	0xe5, 0x6b, 0xab, 0x94, 0x5b, 0xbb, 0x05, 0x2e, 0xc4, 0x36, 0x45, 0xe8, 0x01, 0xf4, 0x94, 0xea,
-	0x40, 0xc8, 0x10, 0x1f, 0x20, 0x67, 0x22, 0xa7, 0x42, 0xfe, 0xdd, 0x2c, 0x3a, 0x7c, 0x8b, 0x2a,
-	0x63, 0x50, 0xa6, 0x5e, 0x6a, 0x96, 0x3b, 0xb5, 0x8b, 0x96, 0xf7, 0xdf, 0xf3, 0xbd, 0x3e, 0xa8,
-	0x5e, 0x65, 0xf6, 0xdd, 0xb0, 0x86, 0xf9, 0x52, 0xfb, 0x0e, 0x95, 0xfb, 0xa0, 0xf0, 0x21, 0xda,
-	0x52, 0xa0, 0x93, 0x91, 0x58, 0x1d, 0xaf, 0x0e, 0x9d, 0xac, 0x1d, 0x04, 0xf8, 0x04, 0x21, 0x36,
-	0xa6, 0x52, 0xf2, 0x28, 0x9b, 0x95, 0xf2, 0x59, 0xb5, 0x20, 0x83, 0xe0, 0xa6, 0xf2, 0xf1, 0xd9,
-	0xb0, 0x7a, 0x4f, 0xb3, 0x85, 0x6b, 0xcf, 0x17, 0xae, 0xfd, 0xb3, 0x70, 0xed, 0xf7, 0xa5, 0x6b,
-	0xcd, 0x97, 0xae, 0xf5, 0xb5, 0x74, 0xad, 0x97, 0xab, 0x50, 0x24, 0xe3, 0x89, 0xef, 0x31, 0x88,
-	0x09, 0x03, 0x13, 0x83, 0x21, 0xc2, 0x67, 0xe7, 0x21, 0x90, 0xf4, 0x9a, 0xc4, 0x10, 0x4c, 0x22,
-	0x6e, 0xb2, 0x60, 0x36, 0x02, 0x49, 0xde, 0x14, 0x37, 0xbe, 0x93, 0xff, 0xeb, 0xe5, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x58, 0xc2, 0xeb, 0x86, 0xba, 0x01, 0x00, 0x00,
+func (m *ChannelDenomRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelDenomRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelDenomRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelDenomRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDenomRules.Merge(m, src)
+}
+func (m *ChannelDenomRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelDenomRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDenomRules.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChannelDenomRules proto.InternalMessageInfo
+
+func (m *ChannelDenomRules) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChannelDenomRules) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReceiveEnabled {
-		i--
-		if m.ReceiveEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func (m *ChannelDenomRules) GetFilterMode() DenomFilterMode {
+	if m != nil {
+		return m.FilterMode
 	}
-	if m.SendEnabled {
-		i--
-		if m.SendEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return DENOM_FILTER_NONE
+}
+
+func (m *ChannelDenomRules) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Forwarding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChannelDenomRules) GetMaxTraceLength() uint64 {
+	if m != nil {
+		return m.MaxTraceLength
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Forwarding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CanonicalAsset defines a canonical denomination aggregating the vouchers of the same asset
+// received over different paths. The vouchers of each route can be wrapped 1:1 into the
+// canonical denomination and unwrapped back.
+type CanonicalAsset struct {
+	// the canonical denomination, which must be prefixed with "canonical/"
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the routes whose vouchers are equivalent to the canonical denomination
+	Routes []CanonicalRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 }
 
-func (m *Forwarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hops) > 0 {
-		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTransfer(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *CanonicalAsset) Reset()         { *m = CanonicalAsset{} }
+func (m *CanonicalAsset) String() string { return proto.CompactTextString(m) }
+func (*CanonicalAsset) ProtoMessage()    {}
+func (*CanonicalAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{4}
+}
+func (m *CanonicalAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Unwind {
-		i--
-		if m.Unwind {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+}
+func (m *CanonicalAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalAsset.Merge(m, src)
+}
+func (m *CanonicalAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalAsset proto.InternalMessageInfo
+
+func (m *CanonicalAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Hop) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CanonicalAsset) GetRoutes() []CanonicalRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Hop) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CanonicalRoute defines a voucher which can be wrapped into a canonical denomination.
+type CanonicalRoute struct {
+	// the full denomination path of the voucher (e.g. transfer/channel-0/uusdc)
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// the maximum amount of the voucher which may be wrapped at any time. Zero means no limit.
+	Cap cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
 }
 
-func (m *Hop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+func (m *CanonicalRoute) Reset()         { *m = CanonicalRoute{} }
+func (m *CanonicalRoute) String() string { return proto.CompactTextString(m) }
+func (*CanonicalRoute) ProtoMessage()    {}
+func (*CanonicalRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{5}
+}
+func (m *CanonicalRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CanonicalRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalRoute.Merge(m, src)
+}
+func (m *CanonicalRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalRoute.DiscardUnknown(m)
 }
 
-func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransfer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CanonicalRoute proto.InternalMessageInfo
+
+func (m *CanonicalRoute) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+// PacketCanonicalUnwrap defines the route vouchers unwrapped from canonical tokens to send a packet.
+// The vouchers are wrapped back into their canonical denomination if the packet is refunded.
+type PacketCanonicalUnwrap struct {
+	// the source port identifier of the packet
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the source channel identifier of the packet
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the route vouchers unwrapped
+	Vouchers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=vouchers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vouchers"`
+}
+
+func (m *PacketCanonicalUnwrap) Reset()         { *m = PacketCanonicalUnwrap{} }
+func (m *PacketCanonicalUnwrap) String() string { return proto.CompactTextString(m) }
+func (*PacketCanonicalUnwrap) ProtoMessage()    {}
+func (*PacketCanonicalUnwrap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{6}
+}
+func (m *PacketCanonicalUnwrap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketCanonicalUnwrap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketCanonicalUnwrap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.SendEnabled {
-		n += 2
+}
+func (m *PacketCanonicalUnwrap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketCanonicalUnwrap.Merge(m, src)
+}
+func (m *PacketCanonicalUnwrap) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketCanonicalUnwrap) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketCanonicalUnwrap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketCanonicalUnwrap proto.InternalMessageInfo
+
+func (m *PacketCanonicalUnwrap) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	if m.ReceiveEnabled {
-		n += 2
+	return ""
+}
+
+func (m *PacketCanonicalUnwrap) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return n
+	return ""
 }
 
-func (m *Forwarding) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PacketCanonicalUnwrap) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	var l int
-	_ = l
-	if m.Unwind {
-		n += 2
+	return 0
+}
+
+func (m *PacketCanonicalUnwrap) GetVouchers() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vouchers
 	}
-	if len(m.Hops) > 0 {
-		for _, e := range m.Hops {
+	return nil
+}
+
+// ProtocolFeeConfig defines the protocol fee charged on the tokens transferred over
+// specific channels.
+type ProtocolFeeConfig struct {
+	// the address receiving the protocol fees
+	FeeCollector string `protobuf:"bytes,1,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	// the addresses which are not charged the protocol fee when sending or receiving tokens
+	ExemptAddresses []string `protobuf:"bytes,2,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+	// the protocol fees of the channels. Transfers over channels without a fee are not charged.
+	// Tokens forwarded through this chain on a multi-hop transfer are charged neither when received
+	// nor when sent on the next hop, so the channel fees only apply to transfers sent from or finally
+	// received by accounts on this chain.
+	ChannelFees []ChannelProtocolFee `protobuf:"bytes,3,rep,name=channel_fees,json=channelFees,proto3" json:"channel_fees"`
+}
+
+func (m *ProtocolFeeConfig) Reset()         { *m = ProtocolFeeConfig{} }
+func (m *ProtocolFeeConfig) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFeeConfig) ProtoMessage()    {}
+func (*ProtocolFeeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{7}
+}
+func (m *ProtocolFeeConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFeeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFeeConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFeeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFeeConfig.Merge(m, src)
+}
+func (m *ProtocolFeeConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFeeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFeeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFeeConfig proto.InternalMessageInfo
+
+func (m *ProtocolFeeConfig) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+func (m *ProtocolFeeConfig) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
+func (m *ProtocolFeeConfig) GetChannelFees() []ChannelProtocolFee {
+	if m != nil {
+		return m.ChannelFees
+	}
+	return nil
+}
+
+// ChannelProtocolFee defines the protocol fee charged on each token transferred over a channel.
+// The fee charged is the greater of the basis points of the amount transferred and the minimum
+// fee of the token denomination.
+type ChannelProtocolFee struct {
+	// the port identifier of the channel
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the fee in basis points of the amount transferred
+	BasisPoints uint32 `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	// the minimum fees, keyed by the denomination of the tokens on this chain
+	MinFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_fees,json=minFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fees"`
+}
+
+func (m *ChannelProtocolFee) Reset()         { *m = ChannelProtocolFee{} }
+func (m *ChannelProtocolFee) String() string { return proto.CompactTextString(m) }
+func (*ChannelProtocolFee) ProtoMessage()    {}
+func (*ChannelProtocolFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{8}
+}
+func (m *ChannelProtocolFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelProtocolFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelProtocolFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelProtocolFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelProtocolFee.Merge(m, src)
+}
+func (m *ChannelProtocolFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelProtocolFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelProtocolFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelProtocolFee proto.InternalMessageInfo
+
+func (m *ChannelProtocolFee) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelProtocolFee) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelProtocolFee) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *ChannelProtocolFee) GetMinFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFees
+	}
+	return nil
+}
+
+// PacketProtocolFee defines the protocol fee charged on the tokens of a sent packet. The fee is
+// held by the module until the packet is acknowledged, and paid to the fee collector on a
+// successful acknowledgement or refunded to the sender otherwise.
+type PacketProtocolFee struct {
+	// the source port identifier of the packet
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the source channel identifier of the packet
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the address receiving the protocol fee
+	FeeCollector string `protobuf:"bytes,4,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	// the fees charged
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *PacketProtocolFee) Reset()         { *m = PacketProtocolFee{} }
+func (m *PacketProtocolFee) String() string { return proto.CompactTextString(m) }
+func (*PacketProtocolFee) ProtoMessage()    {}
+func (*PacketProtocolFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{9}
+}
+func (m *PacketProtocolFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketProtocolFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketProtocolFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketProtocolFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketProtocolFee.Merge(m, src)
+}
+func (m *PacketProtocolFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketProtocolFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketProtocolFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketProtocolFee proto.InternalMessageInfo
+
+func (m *PacketProtocolFee) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketProtocolFee) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketProtocolFee) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketProtocolFee) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+func (m *PacketProtocolFee) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// PacketRefundAddress defines the address to which the tokens of a sent packet are refunded
+// in place of the sender if the packet times out or is acknowledged with an error.
+type PacketRefundAddress struct {
+	// the source port identifier of the packet
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the source channel identifier of the packet
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the address receiving the refund
+	RefundAddress string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *PacketRefundAddress) Reset()         { *m = PacketRefundAddress{} }
+func (m *PacketRefundAddress) String() string { return proto.CompactTextString(m) }
+func (*PacketRefundAddress) ProtoMessage()    {}
+func (*PacketRefundAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{10}
+}
+func (m *PacketRefundAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketRefundAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketRefundAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketRefundAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketRefundAddress.Merge(m, src)
+}
+func (m *PacketRefundAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketRefundAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketRefundAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketRefundAddress proto.InternalMessageInfo
+
+func (m *PacketRefundAddress) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketRefundAddress) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketRefundAddress) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketRefundAddress) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+// ChannelEscrow defines the amount of tokens escrowed by the transfer module for a channel.
+type ChannelEscrow struct {
+	// the port identifier of the channel
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the amount of tokens escrowed for the channel
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ChannelEscrow) Reset()         { *m = ChannelEscrow{} }
+func (m *ChannelEscrow) String() string { return proto.CompactTextString(m) }
+func (*ChannelEscrow) ProtoMessage()    {}
+func (*ChannelEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{11}
+}
+func (m *ChannelEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEscrow.Merge(m, src)
+}
+func (m *ChannelEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEscrow proto.InternalMessageInfo
+
+func (m *ChannelEscrow) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelEscrow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// NativeMapping maps a foreign asset received over a channel to a local denomination. The local
+// denomination is minted in place of the voucher when the asset is received over the channel and
+// burned when it is sent back over the channel.
+type NativeMapping struct {
+	// the local denomination minted for the asset
+	LocalDenom string `protobuf:"bytes,1,opt,name=local_denom,json=localDenom,proto3" json:"local_denom,omitempty"`
+	// the full denomination path of the asset as represented on this chain (e.g. transfer/channel-0/uusdc)
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// the address allowed to update the supply cap and admin of the mapping. Empty means only
+	// the governance authority may update the mapping.
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	// the maximum amount of the local denomination which may be minted at any time. Zero means no limit.
+	SupplyCap cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap"`
+}
+
+func (m *NativeMapping) Reset()         { *m = NativeMapping{} }
+func (m *NativeMapping) String() string { return proto.CompactTextString(m) }
+func (*NativeMapping) ProtoMessage()    {}
+func (*NativeMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{12}
+}
+func (m *NativeMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NativeMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NativeMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NativeMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NativeMapping.Merge(m, src)
+}
+func (m *NativeMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *NativeMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_NativeMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NativeMapping proto.InternalMessageInfo
+
+func (m *NativeMapping) GetLocalDenom() string {
+	if m != nil {
+		return m.LocalDenom
+	}
+	return ""
+}
+
+func (m *NativeMapping) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *NativeMapping) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("ibc.applications.transfer.v1.DenomFilterMode", DenomFilterMode_name, DenomFilterMode_value)
+	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*Forwarding)(nil), "ibc.applications.transfer.v1.Forwarding")
+	proto.RegisterType((*Hop)(nil), "ibc.applications.transfer.v1.Hop")
+	proto.RegisterType((*ChannelDenomRules)(nil), "ibc.applications.transfer.v1.ChannelDenomRules")
+	proto.RegisterType((*CanonicalAsset)(nil), "ibc.applications.transfer.v1.CanonicalAsset")
+	proto.RegisterType((*CanonicalRoute)(nil), "ibc.applications.transfer.v1.CanonicalRoute")
+	proto.RegisterType((*PacketCanonicalUnwrap)(nil), "ibc.applications.transfer.v1.PacketCanonicalUnwrap")
+	proto.RegisterType((*ProtocolFeeConfig)(nil), "ibc.applications.transfer.v1.ProtocolFeeConfig")
+	proto.RegisterType((*ChannelProtocolFee)(nil), "ibc.applications.transfer.v1.ChannelProtocolFee")
+	proto.RegisterType((*PacketProtocolFee)(nil), "ibc.applications.transfer.v1.PacketProtocolFee")
+	proto.RegisterType((*PacketRefundAddress)(nil), "ibc.applications.transfer.v1.PacketRefundAddress")
+	proto.RegisterType((*ChannelEscrow)(nil), "ibc.applications.transfer.v1.ChannelEscrow")
+	proto.RegisterType((*NativeMapping)(nil), "ibc.applications.transfer.v1.NativeMapping")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/transfer.proto", fileDescriptor_5041673e96e97901)
+}
+
+var fileDescriptor_5041673e96e97901 = []byte{
+	// 1055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x8e, 0x1b, 0x3f, 0x27, 0xae, 0x33, 0xb4, 0xa9, 0x6b, 0x81, 0xe3, 0x2c, 0x42,
+	0x18, 0x42, 0xbc, 0x4d, 0x7a, 0x40, 0x80, 0x7a, 0x48, 0x1c, 0x5b, 0x75, 0x95, 0x38, 0x61, 0x69,
+	0x85, 0xca, 0x65, 0x35, 0xde, 0x1d, 0xdb, 0xa3, 0xec, 0xce, 0x2c, 0x3b, 0x63, 0x27, 0x3d, 0x72,
+	0x43, 0x3d, 0xf5, 0xc8, 0xa5, 0x12, 0x12, 0x12, 0x07, 0xc4, 0x91, 0x73, 0xcf, 0x3d, 0x56, 0x08,
+	0x09, 0x84, 0x44, 0x41, 0xc9, 0x99, 0xff, 0x01, 0xed, 0xec, 0xd8, 0x72, 0x48, 0x15, 0x44, 0x94,
+	0x9e, 0x76, 0xde, 0xcf, 0x79, 0xdf, 0xfb, 0xde, 0xcc, 0x2c, 0xac, 0xd2, 0xae, 0x6b, 0xe1, 0x30,
+	0xf4, 0xa9, 0x8b, 0x25, 0xe5, 0x4c, 0x58, 0x32, 0xc2, 0x4c, 0xf4, 0x48, 0x64, 0x8d, 0xd6, 0x27,
+	0xeb, 0x7a, 0x18, 0x71, 0xc9, 0xd1, 0x9b, 0xb4, 0xeb, 0xd6, 0xa7, 0x9d, 0xeb, 0x13, 0x87, 0xd1,
+	0x7a, 0xf9, 0x5a, 0x9f, 0xf7, 0xb9, 0x72, 0xb4, 0xe2, 0x55, 0x12, 0x53, 0xbe, 0xe9, 0x72, 0x11,
+	0x70, 0xe1, 0x24, 0x86, 0x44, 0xd0, 0xa6, 0x4a, 0x22, 0x59, 0x5d, 0x2c, 0x88, 0x35, 0x5a, 0xef,
+	0x12, 0x89, 0xd7, 0x2d, 0x97, 0x53, 0x96, 0xd8, 0xcd, 0xaf, 0x0c, 0xc8, 0xee, 0xe3, 0x08, 0x07,
+	0x02, 0xad, 0xc0, 0xbc, 0x20, 0xcc, 0x73, 0x08, 0xc3, 0x5d, 0x9f, 0x78, 0x25, 0xa3, 0x6a, 0xd4,
+	0xe6, 0xec, 0x7c, 0xac, 0x6b, 0x26, 0x2a, 0xf4, 0x2e, 0x5c, 0x8d, 0x88, 0x4b, 0xe8, 0x88, 0x4c,
+	0xbc, 0x66, 0x94, 0x57, 0x41, 0xab, 0xc7, 0x8e, 0xab, 0xb0, 0x18, 0x10, 0x89, 0x3d, 0x2c, 0xb1,
+	0xe3, 0x0e, 0x30, 0x63, 0xc4, 0x17, 0xa5, 0x74, 0x35, 0x5d, 0xcb, 0xd9, 0xc5, 0xb1, 0xa1, 0xa1,
+	0xf5, 0x26, 0x06, 0x68, 0xf1, 0xe8, 0x10, 0x47, 0x1e, 0x65, 0x7d, 0xb4, 0x04, 0xd9, 0x21, 0x3b,
+	0xa4, 0x6c, 0x5c, 0x80, 0x96, 0xd0, 0x27, 0x90, 0x19, 0xf0, 0x50, 0x94, 0x66, 0xaa, 0xe9, 0x5a,
+	0x7e, 0x63, 0xa5, 0x7e, 0x5e, 0x9f, 0xea, 0x77, 0x79, 0xb8, 0x95, 0x79, 0xfe, 0x72, 0x39, 0x65,
+	0xab, 0x20, 0xb3, 0x01, 0xe9, 0xbb, 0x3c, 0x44, 0x37, 0xe0, 0x4a, 0xc8, 0x23, 0xe9, 0xd0, 0x24,
+	0x79, 0xce, 0xce, 0xc6, 0x62, 0xdb, 0x43, 0x6f, 0x01, 0xe8, 0x32, 0x1d, 0x9a, 0x60, 0xca, 0xd9,
+	0x39, 0xad, 0x69, 0x7b, 0x1f, 0x67, 0xbe, 0xf9, 0x76, 0x39, 0x65, 0xfe, 0x61, 0xc0, 0xa2, 0x2e,
+	0x7a, 0x9b, 0x30, 0x1e, 0xd8, 0x43, 0x9f, 0x88, 0x8b, 0xe6, 0x44, 0x1d, 0xc8, 0xf7, 0xa8, 0x2f,
+	0x49, 0xe4, 0x04, 0xdc, 0x23, 0xa5, 0x74, 0xd5, 0xa8, 0x15, 0x36, 0xd6, 0xce, 0x87, 0xa5, 0xb6,
+	0x6d, 0xa9, 0xa8, 0x5d, 0xee, 0x11, 0x1b, 0x7a, 0x93, 0x75, 0xdc, 0x37, 0x2f, 0x36, 0x8b, 0x52,
+	0x46, 0xf5, 0x59, 0x4b, 0xa8, 0x06, 0xc5, 0x00, 0x1f, 0x39, 0x32, 0xc2, 0x2e, 0x71, 0x7c, 0xc2,
+	0xfa, 0x72, 0x50, 0x9a, 0xad, 0x1a, 0xb5, 0x8c, 0x5d, 0x08, 0xf0, 0xd1, 0xfd, 0x58, 0xbd, 0xa3,
+	0xb4, 0x66, 0x04, 0x85, 0x06, 0x66, 0x9c, 0x51, 0x17, 0xfb, 0x9b, 0x42, 0x10, 0x89, 0xae, 0xc1,
+	0xac, 0xca, 0xa2, 0x91, 0x25, 0x02, 0xba, 0x07, 0xd9, 0x88, 0x0f, 0x25, 0x19, 0x73, 0xf1, 0xc1,
+	0xf9, 0x45, 0x4f, 0x72, 0xda, 0x71, 0x90, 0xa6, 0x45, 0x67, 0x30, 0x5d, 0x28, 0x9c, 0xb6, 0x23,
+	0x04, 0x99, 0x10, 0xcb, 0x81, 0xde, 0x52, 0xad, 0xd1, 0x1d, 0x48, 0xbb, 0x38, 0x4c, 0x7a, 0xb8,
+	0xb5, 0x1a, 0x27, 0xf8, 0xfd, 0xe5, 0xf2, 0xf5, 0x64, 0xb4, 0x85, 0x77, 0x50, 0xa7, 0xdc, 0x0a,
+	0xb0, 0x1c, 0xd4, 0xdb, 0x4c, 0xfe, 0xfc, 0xd3, 0x1a, 0xe8, 0x13, 0xd0, 0x66, 0xd2, 0x8e, 0xe3,
+	0xcc, 0x5f, 0x0c, 0xb8, 0xbe, 0x8f, 0xdd, 0x03, 0x22, 0x27, 0x7b, 0x3d, 0x60, 0x87, 0x11, 0xbe,
+	0xf0, 0x40, 0xa0, 0x32, 0xcc, 0x09, 0xf2, 0xe5, 0x90, 0x30, 0x37, 0x61, 0x2e, 0x63, 0x4f, 0x64,
+	0xd4, 0x87, 0xb9, 0x11, 0x1f, 0xba, 0x03, 0x12, 0x25, 0x54, 0xe4, 0x37, 0x6e, 0xd6, 0x75, 0x45,
+	0xf1, 0x29, 0xac, 0xeb, 0x53, 0x58, 0x6f, 0x70, 0xca, 0xb6, 0x6e, 0xc5, 0x60, 0x7e, 0xf8, 0x73,
+	0xb9, 0xd6, 0xa7, 0x72, 0x30, 0xec, 0xd6, 0x5d, 0x1e, 0xe8, 0x03, 0xac, 0x3f, 0x6b, 0xc2, 0x3b,
+	0xb0, 0xe4, 0xa3, 0x90, 0x08, 0x15, 0x20, 0xec, 0x49, 0x72, 0xf3, 0x99, 0x01, 0x8b, 0xfb, 0xf1,
+	0x29, 0x76, 0xb9, 0xdf, 0x22, 0xa4, 0xc1, 0x59, 0x8f, 0xf6, 0xd1, 0xdb, 0xb0, 0xd0, 0x23, 0xc4,
+	0x71, 0xb9, 0xef, 0x13, 0x57, 0xf2, 0x48, 0x03, 0x9b, 0xef, 0xc5, 0x1e, 0x5a, 0x87, 0xde, 0x83,
+	0x22, 0x39, 0x22, 0x41, 0x28, 0x1d, 0xec, 0x79, 0x11, 0x11, 0x42, 0x93, 0x99, 0xb3, 0xaf, 0x26,
+	0xfa, 0xcd, 0xb1, 0x1a, 0x3d, 0x84, 0xf9, 0x71, 0x27, 0x7a, 0x84, 0x24, 0xa7, 0x38, 0xbf, 0x71,
+	0xeb, 0x3f, 0x38, 0x4f, 0x22, 0xa6, 0xaa, 0xd3, 0xbc, 0xe7, 0x75, 0xae, 0x16, 0x21, 0xc2, 0xfc,
+	0xd5, 0x00, 0x74, 0xd6, 0xf3, 0xc2, 0xa4, 0xac, 0xc0, 0x7c, 0x17, 0x0b, 0x2a, 0x9c, 0x90, 0x53,
+	0x26, 0x85, 0x22, 0x66, 0xc1, 0xce, 0x2b, 0xdd, 0xbe, 0x52, 0xa1, 0x1e, 0xcc, 0x05, 0x94, 0x25,
+	0x40, 0x5e, 0x03, 0x37, 0x57, 0x02, 0xca, 0x14, 0xb2, 0xbf, 0x63, 0x6a, 0xd4, 0xc4, 0x5d, 0x06,
+	0xb0, 0xf3, 0xa6, 0xed, 0x0c, 0xdd, 0x99, 0x57, 0xd0, 0xed, 0x40, 0x46, 0x41, 0x9e, 0xbd, 0x7c,
+	0xc8, 0x2a, 0xb1, 0xf9, 0xc4, 0x80, 0x37, 0x12, 0xbc, 0x36, 0xe9, 0x0d, 0x99, 0xa7, 0xc7, 0xe7,
+	0xb5, 0x20, 0x7e, 0x07, 0x0a, 0x91, 0xda, 0x64, 0x3c, 0xbb, 0x1a, 0xf2, 0x42, 0x34, 0xbd, 0xb5,
+	0xf9, 0xa3, 0x01, 0x0b, 0x7a, 0xb8, 0x9a, 0xc2, 0x8d, 0xf8, 0xe1, 0x85, 0x8b, 0x71, 0x21, 0x8b,
+	0x03, 0x3e, 0x64, 0xb2, 0x94, 0xbe, 0xfc, 0xfe, 0xe9, 0xd4, 0xe6, 0xf7, 0x06, 0x2c, 0x74, 0xb0,
+	0xa4, 0x23, 0xb2, 0x8b, 0xc3, 0x30, 0x7e, 0x08, 0x97, 0x21, 0xef, 0x73, 0x17, 0xfb, 0xce, 0xf4,
+	0x15, 0x0c, 0x4a, 0xa5, 0xde, 0x81, 0xc9, 0x4d, 0x39, 0x33, 0x75, 0x53, 0x5e, 0x83, 0x59, 0xec,
+	0x05, 0x94, 0xa9, 0xae, 0xe5, 0xec, 0x44, 0x40, 0xf7, 0x00, 0xc4, 0x30, 0x0c, 0xfd, 0x47, 0x4e,
+	0x7c, 0x8d, 0x66, 0xfe, 0xff, 0x35, 0x9a, 0x4b, 0xc2, 0x1b, 0x38, 0x7c, 0xff, 0x99, 0x01, 0x57,
+	0xff, 0xf5, 0x0e, 0xa1, 0x3b, 0x60, 0x6e, 0x37, 0x3b, 0x7b, 0xbb, 0x4e, 0xab, 0xbd, 0x73, 0xbf,
+	0x69, 0x3b, 0xbb, 0x7b, 0xdb, 0x4d, 0xa7, 0xb3, 0xd7, 0x69, 0x3a, 0x0f, 0x3a, 0x9f, 0xed, 0x37,
+	0x1b, 0xed, 0x56, 0xbb, 0xb9, 0x5d, 0x4c, 0x95, 0xaf, 0x3f, 0x7e, 0x5a, 0x5d, 0x3c, 0xe5, 0x19,
+	0x3b, 0xa1, 0xdb, 0x70, 0xe3, 0x6c, 0xf8, 0xe6, 0xce, 0xce, 0xde, 0xe7, 0x45, 0xa3, 0xbc, 0xf4,
+	0xf8, 0x69, 0x15, 0x9d, 0x32, 0x2b, 0x0b, 0x5a, 0x87, 0xa5, 0xb3, 0x41, 0xdb, 0xcd, 0xce, 0xc3,
+	0xe2, 0xcc, 0x2b, 0xf6, 0x89, 0x0d, 0xe5, 0xcc, 0xd7, 0xdf, 0x55, 0x52, 0x5b, 0x9f, 0x3e, 0x3f,
+	0xae, 0x18, 0x2f, 0x8e, 0x2b, 0xc6, 0x5f, 0xc7, 0x15, 0xe3, 0xc9, 0x49, 0x25, 0xf5, 0xe2, 0xa4,
+	0x92, 0xfa, 0xed, 0xa4, 0x92, 0xfa, 0xe2, 0xc3, 0xb3, 0xac, 0xd1, 0xae, 0xbb, 0xd6, 0xe7, 0xd6,
+	0xe8, 0x23, 0x2b, 0xe0, 0x5e, 0xfc, 0xde, 0xc7, 0x3f, 0x72, 0x53, 0x3f, 0x70, 0x8a, 0xca, 0x6e,
+	0x56, 0xfd, 0x4c, 0xdd, 0xfe, 0x67, 0x00, 0x6d, 0x58, 0x33, 0x6b, 0xea, 0x09, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataChannels) > 0 {
+		for iNdEx := len(m.MetadataChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataChannels[iNdEx])
+			copy(dAtA[i:], m.MetadataChannels[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.MetadataChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Forwarding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Forwarding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Forwarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Unwind {
+		i--
+		if m.Unwind {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelDenomRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelDenomRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelDenomRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTraceLength != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxTraceLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FilterMode != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.FilterMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketCanonicalUnwrap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketCanonicalUnwrap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketCanonicalUnwrap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vouchers) > 0 {
+		for iNdEx := len(m.Vouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtocolFeeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolFeeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFeeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelFees) > 0 {
+		for iNdEx := len(m.ChannelFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelProtocolFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelProtocolFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelProtocolFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFees) > 0 {
+		for iNdEx := len(m.MinFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BasisPoints != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketProtocolFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketProtocolFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketProtocolFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketRefundAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketRefundAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketRefundAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NativeMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NativeMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NativeMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LocalDenom) > 0 {
+		i -= len(m.LocalDenom)
+		copy(dAtA[i:], m.LocalDenom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.LocalDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	if len(m.MetadataChannels) > 0 {
+		for _, s := range m.MetadataChannels {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Forwarding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unwind {
+		n += 2
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Hop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelDenomRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.FilterMode != 0 {
+		n += 1 + sovTransfer(uint64(m.FilterMode))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.MaxTraceLength != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxTraceLength))
+	}
+	return n
+}
+
+func (m *CanonicalAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CanonicalRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *PacketCanonicalUnwrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTransfer(uint64(m.Sequence))
+	}
+	if len(m.Vouchers) > 0 {
+		for _, e := range m.Vouchers {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProtocolFeeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.ChannelFees) > 0 {
+		for _, e := range m.ChannelFees {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelProtocolFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovTransfer(uint64(m.BasisPoints))
+	}
+	if len(m.MinFees) > 0 {
+		for _, e := range m.MinFees {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketProtocolFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTransfer(uint64(m.Sequence))
+	}
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketRefundAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTransfer(uint64(m.Sequence))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
 			l = e.Size()
 			n += 1 + l + sovTransfer(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *NativeMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataChannels = append(m.MetadataChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Forwarding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Forwarding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Forwarding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unwind", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unwind = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, Hop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelDenomRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelDenomRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelDenomRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterMode", wireType)
+			}
+			m.FilterMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilterMode |= DenomFilterMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTraceLength", wireType)
+			}
+			m.MaxTraceLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTraceLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanonicalAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, CanonicalRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanonicalRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketCanonicalUnwrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketCanonicalUnwrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketCanonicalUnwrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vouchers = append(m.Vouchers, types.Coin{})
+			if err := m.Vouchers[len(m.Vouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolFeeConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFeeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFeeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelFees = append(m.ChannelFees, ChannelProtocolFee{})
+			if err := m.ChannelFees[len(m.ChannelFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelProtocolFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelProtocolFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelProtocolFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFees = append(m.MinFees, types.Coin{})
+			if err := m.MinFees[len(m.MinFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketProtocolFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketProtocolFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketProtocolFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Hop) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTransfer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTransfer(x uint64) (n int) {
-	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PacketRefundAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -445,17 +3463,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketRefundAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketRefundAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -465,17 +3483,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SendEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var v int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -485,12 +3547,43 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReceiveEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
@@ -512,7 +3605,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Forwarding) Unmarshal(dAtA []byte) error {
+func (m *ChannelEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -535,17 +3628,17 @@ func (m *Forwarding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Forwarding: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Forwarding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unwind", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -555,15 +3648,59 @@ func (m *Forwarding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Unwind = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -590,8 +3727,8 @@ func (m *Forwarding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hops = append(m.Hops, Hop{})
-			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -616,7 +3753,7 @@ func (m *Forwarding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Hop) Unmarshal(dAtA []byte) error {
+func (m *NativeMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -639,15 +3776,15 @@ func (m *Hop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Hop: wiretype end group for non-group")
+			return fmt.Errorf("proto: NativeMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Hop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NativeMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -675,11 +3812,11 @@ func (m *Hop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -707,7 +3844,73 @@ func (m *Hop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -12,6 +13,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
@@ -52,13 +54,17 @@ func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrNotFound, "requested port and channel allocation does not exist")
 	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if a.Allocations[index].isExpired(ctx.BlockTime()) {
+		return authz.AcceptResponse{}, errorsmod.Wrap(ErrInvalidAuthorization, "requested port and channel allocation has expired")
+	}
+
 	if err := validateForwarding(msgTransfer.Forwarding, a.Allocations[index].AllowedForwarding); err != nil {
 		return authz.AcceptResponse{}, err
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	if !isAllowedAddress(ctx, msgTransfer.Receiver, a.Allocations[index].AllowList) {
+	if !isAllowedReceiver(ctx, msgTransfer.Receiver, a.Allocations[index]) {
 		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
 	}
 
@@ -88,6 +94,15 @@ func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 		a.Allocations[index].SpendLimit = limitLeft
 	}
 
+	// the periodic allowance limits the amount of tokens spent in each period in addition to the spend limit
+	if a.Allocations[index].PeriodicAllowance != nil {
+		if err := a.Allocations[index].PeriodicAllowance.spend(ctx.BlockTime(), msgTransfer.GetCoins()); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		allocationModified = true
+	}
+
 	// if the spend limit is zero of the associated allocation then we delete it.
 	// NOTE: SpendLimit is an array of coins, with each one representing the remaining spend limit for an
 	// individual denomination.
@@ -95,6 +110,15 @@ func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 		a.Allocations = append(a.Allocations[:index], a.Allocations[index+1:]...)
 	}
 
+	// remove the allocations that have expired
+	allocationsLen := len(a.Allocations)
+	a.Allocations = slices.DeleteFunc(a.Allocations, func(allocation Allocation) bool {
+		return allocation.isExpired(ctx.BlockTime())
+	})
+	if len(a.Allocations) != allocationsLen {
+		allocationModified = true
+	}
+
 	if len(a.Allocations) == 0 {
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
 	}
@@ -147,6 +171,20 @@ func (a TransferAuthorization) ValidateBasic() error {
 			found[allocation.AllowList[i]] = true
 		}
 
+		if err := validateReceiverMatchers(allocation.AllowedReceiverPrefixes, "allowed receiver prefixes"); err != nil {
+			return err
+		}
+
+		if err := validateReceiverMatchers(allocation.AllowedReceiverHrps, "allowed receiver human readable parts"); err != nil {
+			return err
+		}
+
+		if allocation.PeriodicAllowance != nil {
+			if err := allocation.PeriodicAllowance.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+
 		for i := 0; i < len(allocation.AllowedForwarding); i++ {
 			for _, hop := range allocation.AllowedForwarding[i].Hops {
 				if err := hop.Validate(); err != nil {
@@ -159,24 +197,65 @@ func (a TransferAuthorization) ValidateBasic() error {
 	return nil
 }
 
-// isAllowedAddress returns a boolean indicating if the receiver address is valid for transfer.
+// isAllowedReceiver returns a boolean indicating if the receiver address is valid for transfer. The receiver
+// is valid if it is in the allow list, starts with one of the allowed prefixes or is a bech32 address with
+// one of the allowed human readable parts. Any receiver is valid if all of them are empty.
 // gasCostPerIteration gas is consumed for each iteration.
-func isAllowedAddress(ctx sdk.Context, receiver string, allowedAddrs []string) bool {
-	if len(allowedAddrs) == 0 {
+func isAllowedReceiver(ctx sdk.Context, receiver string, allocation Allocation) bool {
+	if len(allocation.AllowList) == 0 && len(allocation.AllowedReceiverPrefixes) == 0 && len(allocation.AllowedReceiverHrps) == 0 {
 		return true
 	}
 
 	gasCostPerIteration := ctx.KVGasConfig().IterNextCostFlat
 
-	for _, addr := range allowedAddrs {
+	for _, addr := range allocation.AllowList {
 		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "transfer authorization")
 		if addr == receiver {
 			return true
 		}
 	}
+
+	for _, prefix := range allocation.AllowedReceiverPrefixes {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "transfer authorization")
+		if strings.HasPrefix(receiver, prefix) {
+			return true
+		}
+	}
+
+	if len(allocation.AllowedReceiverHrps) == 0 {
+		return false
+	}
+
+	hrp, _, err := bech32.DecodeAndConvert(receiver)
+	if err != nil {
+		return false
+	}
+
+	for _, allowedHrp := range allocation.AllowedReceiverHrps {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "transfer authorization")
+		if hrp == allowedHrp {
+			return true
+		}
+	}
 	return false
 }
 
+// validateReceiverMatchers returns an error if any of the receiver prefixes or human readable parts is empty or duplicated.
+func validateReceiverMatchers(matchers []string, name string) error {
+	found := make(map[string]bool, 0)
+	for _, matcher := range matchers {
+		if strings.TrimSpace(matcher) == "" {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "empty entry in %s", name)
+		}
+		if found[matcher] {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate entry in %s %s", name, matcher)
+		}
+		found[matcher] = true
+	}
+
+	return nil
+}
+
 // validateForwarding performs the validation of forwarding info.
 func validateForwarding(forwarding *Forwarding, allowedForwarding []AllowedForwarding) error {
 	if forwarding == nil {
@@ -253,6 +332,11 @@ func UnboundedSpendLimit() sdkmath.Int {
 	return sdkmath.NewIntFromBigInt(maxUint256)
 }
 
+// isExpired returns true if the allocation has an expiration and the block time is not before it.
+func (a Allocation) isExpired(blockTime time.Time) bool {
+	return a.Expiration != nil && !blockTime.Before(*a.Expiration)
+}
+
 // getAllocationIndex ranges through a set of allocations, and returns the index of the allocation if found. If not, returns -1.
 func getAllocationIndex(msg MsgTransfer, allocations []Allocation) int {
 	for index, allocation := range allocations {
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -467,6 +468,134 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 			},
 		},
 
+		{
+			"success: periodic allowance deducted",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations[0].PeriodicAllowance = types.NewPeriodicAllowance(time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(150))))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				periodicAllowance := updatedAuthz.Allocations[0].PeriodicAllowance
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), periodicAllowance.PeriodCanSpend)
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().Add(time.Hour), periodicAllowance.PeriodReset)
+			},
+		},
+		{
+			"success: periodic allowance reset after the period ends",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations[0].PeriodicAllowance = types.NewPeriodicAllowance(time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(150))))
+				transferAuthz.Allocations[0].PeriodicAllowance.PeriodCanSpend = sdk.NewCoins()
+				transferAuthz.Allocations[0].PeriodicAllowance.PeriodReset = suite.chainA.GetContext().BlockTime().Add(-time.Minute)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				periodicAllowance := updatedAuthz.Allocations[0].PeriodicAllowance
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), periodicAllowance.PeriodCanSpend)
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().Add(time.Hour-time.Minute), periodicAllowance.PeriodReset)
+			},
+		},
+		{
+			"success: expired allocations are removed",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations = append(transferAuthz.Allocations, types.Allocation{
+					SourcePort:    types.PortID,
+					SourceChannel: "channel-9",
+					SpendLimit:    sdk.NewCoins(ibctesting.TestCoin),
+					Expiration:    &expiration,
+				})
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+				suite.Require().Len(updatedAuthz.Allocations, 1)
+			},
+		},
+		{
+			"success: receiver matches allowed prefix",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{}
+				transferAuthz.Allocations[0].AllowedReceiverPrefixes = []string{ibctesting.TestAccAddress[:10]}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
+		{
+			"success: receiver matches allowed human readable part",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{}
+				transferAuthz.Allocations[0].AllowedReceiverHrps = []string{"osmo", "cosmos"}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
+		{
+			"failure: periodic allowance exceeded",
+			func() {
+				transferAuthz.Allocations[0].PeriodicAllowance = types.NewPeriodicAllowance(time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: periodic allowance does not include denomination",
+			func() {
+				transferAuthz.Allocations[0].PeriodicAllowance = types.NewPeriodicAllowance(time.Hour, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(150))))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: allocation expired",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].Expiration = &expiration
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: receiver does not match allowed human readable part",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{}
+				transferAuthz.Allocations[0].AllowedReceiverHrps = []string{"osmo"}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInvalidAddress)
+				suite.Require().False(res.Accept)
+			},
+		},
 		{
 			"failure: unwind is not allowed",
 			func() {
@@ -645,6 +774,53 @@ func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 			},
 			false,
 		},
+		{
+			"success: with periodic allowance, expiration and receiver matchers",
+			func() {
+				expiration := time.Unix(1_000_000, 0)
+				transferAuthz.Allocations[0].PeriodicAllowance = types.NewPeriodicAllowance(24*time.Hour, sdk.NewCoins(ibctesting.TestCoin))
+				transferAuthz.Allocations[0].Expiration = &expiration
+				transferAuthz.Allocations[0].AllowedReceiverPrefixes = []string{"cosmos1"}
+				transferAuthz.Allocations[0].AllowedReceiverHrps = []string{"cosmos", "osmo"}
+			},
+			true,
+		},
+		{
+			"periodic allowance with zero period",
+			func() {
+				transferAuthz.Allocations[0].PeriodicAllowance = types.NewPeriodicAllowance(0, sdk.NewCoins(ibctesting.TestCoin))
+			},
+			false,
+		},
+		{
+			"periodic allowance with empty period spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicAllowance = types.NewPeriodicAllowance(time.Hour, sdk.NewCoins())
+			},
+			false,
+		},
+		{
+			"periodic allowance can spend exceeds period spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicAllowance = types.NewPeriodicAllowance(time.Hour, sdk.NewCoins(ibctesting.TestCoin))
+				transferAuthz.Allocations[0].PeriodicAllowance.PeriodCanSpend = sdk.NewCoins(ibctesting.TestCoin.Add(ibctesting.TestCoin))
+			},
+			false,
+		},
+		{
+			"empty allowed receiver prefix",
+			func() {
+				transferAuthz.Allocations[0].AllowedReceiverPrefixes = []string{""}
+			},
+			false,
+		},
+		{
+			"duplicate allowed receiver human readable part",
+			func() {
+				transferAuthz.Allocations[0].AllowedReceiverHrps = []string{"cosmos", "cosmos"}
+			},
+			false,
+		},
 		{
 			"fowarding hop with invalid port ID",
 			func() {
@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types2 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -58,6 +59,10 @@ type MsgTransfer struct {
 	Tokens []types.Coin `protobuf:"bytes,9,rep,name=tokens,proto3" json:"tokens"`
 	// optional forwarding information
 	Forwarding *Forwarding `protobuf:"bytes,10,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// optional address to which the tokens are refunded if the packet times out or fails on the
+	// destination chain. The address is stored on the sending chain and is not sent in the packet.
+	// The tokens are refunded to the sender if it is not set.
+	RefundAddress string `protobuf:"bytes,11,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -213,483 +218,2985 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgTransfer)(nil), "ibc.applications.transfer.v1.MsgTransfer")
-	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.applications.transfer.v1.MsgTransferResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.transfer.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateParamsResponse")
+// MsgUpdateChannelDenomRules is the Msg/UpdateChannelDenomRules request type.
+type MsgUpdateChannelDenomRules struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// rules defines the denomination rules of the channel. The rules of the channel
+	// are removed if they do not place any restriction.
+	Rules ChannelDenomRules `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules"`
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/transfer/v1/tx.proto", fileDescriptor_7401ed9bed2f8e09)
+func (m *MsgUpdateChannelDenomRules) Reset()         { *m = MsgUpdateChannelDenomRules{} }
+func (m *MsgUpdateChannelDenomRules) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChannelDenomRules) ProtoMessage()    {}
+func (*MsgUpdateChannelDenomRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{4}
 }
-
-var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0x58, 0x57, 0x36, 0x97, 0x6d, 0xcc, 0xa0, 0x2d, 0x8b, 0x50, 0x5a, 0x55, 0x4c, 0x2a,
-	0x9d, 0x66, 0xab, 0x43, 0x68, 0x62, 0xe2, 0xd4, 0x49, 0x68, 0x07, 0x26, 0x8d, 0x68, 0x5c, 0xb8,
-	0x4c, 0x49, 0xea, 0xa5, 0xd6, 0x1a, 0x3b, 0xd8, 0x6e, 0x81, 0x0b, 0x42, 0x48, 0x48, 0x88, 0x13,
-	0x1f, 0x81, 0x23, 0xc7, 0x7d, 0x8c, 0x1d, 0x77, 0xe4, 0x84, 0xd0, 0x76, 0xd8, 0x85, 0x0f, 0x81,
-	0xec, 0x38, 0x25, 0x70, 0x28, 0x70, 0x49, 0xfc, 0xde, 0xfb, 0xbd, 0x3f, 0xbf, 0x9f, 0x9f, 0x0c,
-	0xd6, 0x69, 0x14, 0xe3, 0x30, 0xcb, 0x86, 0x34, 0x0e, 0x15, 0xe5, 0x4c, 0x62, 0x25, 0x42, 0x26,
-	0x8f, 0x89, 0xc0, 0xe3, 0x2e, 0x56, 0xaf, 0x50, 0x26, 0xb8, 0xe2, 0xf0, 0x0e, 0x8d, 0x62, 0x54,
-	0x86, 0xa1, 0x02, 0x86, 0xc6, 0x5d, 0x6f, 0x39, 0x4c, 0x29, 0xe3, 0xd8, 0x7c, 0xf3, 0x04, 0xef,
-	0x76, 0xc2, 0x13, 0x6e, 0x8e, 0x58, 0x9f, 0xac, 0x77, 0x35, 0xe6, 0x32, 0xe5, 0x12, 0xa7, 0x32,
-	0xd1, 0xe5, 0x53, 0x99, 0xd8, 0x80, 0x6f, 0x03, 0x51, 0x28, 0x09, 0x1e, 0x77, 0x23, 0xa2, 0xc2,
-	0x2e, 0x8e, 0x39, 0x65, 0x36, 0xde, 0xd0, 0x63, 0xc6, 0x5c, 0x10, 0x1c, 0x0f, 0x29, 0x61, 0x4a,
-	0x67, 0xe7, 0x27, 0x0b, 0xd8, 0x98, 0xce, 0xa3, 0x18, 0xd6, 0x80, 0x5b, 0xef, 0xab, 0xa0, 0xbe,
-	0x2f, 0x93, 0x43, 0xeb, 0x85, 0x0d, 0x50, 0x97, 0x7c, 0x24, 0x62, 0x72, 0x94, 0x71, 0xa1, 0x5c,
-	0xa7, 0xe9, 0xb4, 0xe7, 0x03, 0x90, 0xbb, 0x0e, 0xb8, 0x50, 0x70, 0x1d, 0x2c, 0x5a, 0x40, 0x3c,
-	0x08, 0x19, 0x23, 0x43, 0xf7, 0x9a, 0xc1, 0x2c, 0xe4, 0xde, 0xdd, 0xdc, 0x09, 0x1f, 0x81, 0x59,
-	0xc5, 0x4f, 0x08, 0x73, 0x67, 0x9a, 0x4e, 0xbb, 0xbe, 0xb5, 0x86, 0x72, 0x56, 0x48, 0xb3, 0x42,
-	0x96, 0x15, 0xda, 0xe5, 0x94, 0xf5, 0xea, 0x67, 0xdf, 0x1a, 0x95, 0x2f, 0x57, 0xa7, 0x1d, 0xc7,
-	0x75, 0x82, 0x3c, 0x09, 0xae, 0x80, 0x9a, 0x24, 0xac, 0x4f, 0x84, 0x5b, 0x35, 0xc5, 0xad, 0x05,
-	0x3d, 0x30, 0x27, 0x48, 0x4c, 0xe8, 0x98, 0x08, 0x77, 0xd6, 0x44, 0x26, 0x36, 0x7c, 0x02, 0x16,
-	0x15, 0x4d, 0x09, 0x1f, 0xa9, 0xa3, 0x01, 0xa1, 0xc9, 0x40, 0xb9, 0x35, 0xd3, 0xda, 0x43, 0xfa,
-	0xc2, 0xb4, 0x60, 0xc8, 0xca, 0x34, 0xee, 0xa2, 0x3d, 0x83, 0xe8, 0xcd, 0x4f, 0x7a, 0x07, 0x0b,
-	0x36, 0x39, 0x8f, 0xc0, 0x0d, 0xb0, 0x5c, 0x54, 0xd3, 0x7f, 0xa9, 0xc2, 0x34, 0x73, 0xaf, 0x37,
-	0x9d, 0x76, 0x35, 0xb8, 0x69, 0x03, 0x87, 0x85, 0x1f, 0x42, 0x50, 0x4d, 0x49, 0xca, 0xdd, 0x39,
-	0x33, 0x92, 0x39, 0xc3, 0x6d, 0x50, 0x33, 0x5c, 0xa4, 0x3b, 0xdf, 0x9c, 0x99, 0xae, 0x40, 0x55,
-	0x4f, 0x11, 0x58, 0x38, 0xdc, 0x03, 0xe0, 0x98, 0x8b, 0x97, 0xa1, 0xe8, 0x53, 0x96, 0xb8, 0xc0,
-	0x70, 0x68, 0xa3, 0x69, 0x4b, 0x87, 0x1e, 0x4f, 0xf0, 0x41, 0x29, 0x77, 0xa7, 0xf3, 0xe1, 0x73,
-	0xa3, 0xf2, 0xee, 0xea, 0xb4, 0x63, 0xe5, 0xfb, 0x78, 0x75, 0xda, 0x59, 0xc9, 0xa7, 0xd8, 0x94,
-	0xfd, 0x13, 0x5c, 0xba, 0xf7, 0xd6, 0x36, 0xb8, 0x55, 0x32, 0x03, 0x22, 0x33, 0xce, 0x24, 0xd1,
-	0x82, 0x4b, 0xf2, 0x62, 0x44, 0x58, 0x4c, 0xcc, 0x2e, 0x54, 0x83, 0x89, 0xbd, 0x53, 0xd5, 0xe5,
-	0x5b, 0x6f, 0xc0, 0xd2, 0xbe, 0x4c, 0x9e, 0x65, 0xfd, 0x50, 0x91, 0x83, 0x50, 0x84, 0xa9, 0x34,
-	0xb7, 0x47, 0x13, 0x46, 0x84, 0x5d, 0x1f, 0x6b, 0xc1, 0x1e, 0xa8, 0x65, 0x06, 0x61, 0x56, 0xa6,
-	0xbe, 0x75, 0x77, 0x3a, 0xab, 0xbc, 0x5a, 0xa1, 0x4e, 0x9e, 0xb9, 0xb3, 0xf4, 0x8b, 0x93, 0x29,
-	0xda, 0x5a, 0x03, 0xab, 0x7f, 0xf4, 0x2f, 0x86, 0xdf, 0xfa, 0xe1, 0x80, 0x99, 0x7d, 0x99, 0xc0,
-	0x01, 0x98, 0x9b, 0xec, 0xf7, 0xbd, 0xe9, 0x3d, 0x4b, 0x1a, 0x78, 0xdd, 0x7f, 0x86, 0x4e, 0xe4,
-	0x52, 0xe0, 0xc6, 0x6f, 0x4a, 0x6c, 0xfe, 0xb5, 0x44, 0x19, 0xee, 0x3d, 0xf8, 0x2f, 0x78, 0xd1,
-	0xd5, 0x9b, 0x7d, 0xab, 0x37, 0xb8, 0xf7, 0xf4, 0xec, 0xc2, 0x77, 0xce, 0x2f, 0x7c, 0xe7, 0xfb,
-	0x85, 0xef, 0x7c, 0xba, 0xf4, 0x2b, 0xe7, 0x97, 0x7e, 0xe5, 0xeb, 0xa5, 0x5f, 0x79, 0xbe, 0x9d,
-	0x50, 0x35, 0x18, 0x45, 0x28, 0xe6, 0x29, 0xb6, 0xaf, 0x0b, 0x8d, 0xe2, 0xcd, 0x84, 0xe3, 0xf1,
-	0x43, 0x9c, 0xf2, 0xfe, 0x68, 0x48, 0xa4, 0x7e, 0x31, 0x4a, 0x2f, 0x85, 0x7a, 0x9d, 0x11, 0x19,
-	0xd5, 0xcc, 0x23, 0x71, 0xff, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x3b, 0x79, 0x37, 0x1b,
-	0x05, 0x00, 0x00,
+func (m *MsgUpdateChannelDenomRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChannelDenomRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChannelDenomRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChannelDenomRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChannelDenomRules.Merge(m, src)
+}
+func (m *MsgUpdateChannelDenomRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChannelDenomRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChannelDenomRules.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgUpdateChannelDenomRules proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Transfer defines a rpc handler method for MsgTransfer.
-	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
-	// UpdateParams defines a rpc handler for MsgUpdateParams.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgUpdateChannelDenomRulesResponse defines the response structure for executing a
+// MsgUpdateChannelDenomRules message.
+type MsgUpdateChannelDenomRulesResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateChannelDenomRulesResponse) Reset()         { *m = MsgUpdateChannelDenomRulesResponse{} }
+func (m *MsgUpdateChannelDenomRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChannelDenomRulesResponse) ProtoMessage()    {}
+func (*MsgUpdateChannelDenomRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{5}
+}
+func (m *MsgUpdateChannelDenomRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChannelDenomRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChannelDenomRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChannelDenomRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChannelDenomRulesResponse.Merge(m, src)
+}
+func (m *MsgUpdateChannelDenomRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChannelDenomRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChannelDenomRulesResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgUpdateChannelDenomRulesResponse proto.InternalMessageInfo
+
+// MsgRegisterCanonicalAsset is the Msg/RegisterCanonicalAsset request type.
+type MsgRegisterCanonicalAsset struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// asset defines the canonical asset to register. The routes of an already registered
+	// asset are replaced.
+	Asset CanonicalAsset `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
 }
 
-func (c *msgClient) Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
-	out := new(MsgTransferResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/Transfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterCanonicalAsset) Reset()         { *m = MsgRegisterCanonicalAsset{} }
+func (m *MsgRegisterCanonicalAsset) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCanonicalAsset) ProtoMessage()    {}
+func (*MsgRegisterCanonicalAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{6}
+}
+func (m *MsgRegisterCanonicalAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCanonicalAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCanonicalAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterCanonicalAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCanonicalAsset.Merge(m, src)
+}
+func (m *MsgRegisterCanonicalAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCanonicalAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCanonicalAsset.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterCanonicalAsset proto.InternalMessageInfo
+
+// MsgRegisterCanonicalAssetResponse defines the response structure for executing a
+// MsgRegisterCanonicalAsset message.
+type MsgRegisterCanonicalAssetResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Transfer defines a rpc handler method for MsgTransfer.
-	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
-	// UpdateParams defines a rpc handler for MsgUpdateParams.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgRegisterCanonicalAssetResponse) Reset()         { *m = MsgRegisterCanonicalAssetResponse{} }
+func (m *MsgRegisterCanonicalAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCanonicalAssetResponse) ProtoMessage()    {}
+func (*MsgRegisterCanonicalAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{7}
+}
+func (m *MsgRegisterCanonicalAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCanonicalAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCanonicalAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCanonicalAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCanonicalAssetResponse.Merge(m, src)
+}
+func (m *MsgRegisterCanonicalAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCanonicalAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCanonicalAssetResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgRegisterCanonicalAssetResponse proto.InternalMessageInfo
+
+// MsgWrapVoucher defines a msg to wrap a voucher into its canonical denomination.
+type MsgWrapVoucher struct {
+	// the sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the voucher to wrap
+	Voucher types.Coin `protobuf:"bytes,2,opt,name=voucher,proto3" json:"voucher"`
 }
 
-func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (*MsgTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+func (m *MsgWrapVoucher) Reset()         { *m = MsgWrapVoucher{} }
+func (m *MsgWrapVoucher) String() string { return proto.CompactTextString(m) }
+func (*MsgWrapVoucher) ProtoMessage()    {}
+func (*MsgWrapVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{8}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgWrapVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrapVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrapVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrapVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrapVoucher.Merge(m, src)
+}
+func (m *MsgWrapVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrapVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrapVoucher.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgWrapVoucher proto.InternalMessageInfo
+
+// MsgWrapVoucherResponse defines the Msg/WrapVoucher response type.
+type MsgWrapVoucherResponse struct {
+	// the canonical tokens minted for the voucher
+	Canonical types.Coin `protobuf:"bytes,1,opt,name=canonical,proto3" json:"canonical"`
 }
 
-func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Transfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Msg/Transfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransfer))
+func (m *MsgWrapVoucherResponse) Reset()         { *m = MsgWrapVoucherResponse{} }
+func (m *MsgWrapVoucherResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWrapVoucherResponse) ProtoMessage()    {}
+func (*MsgWrapVoucherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{9}
+}
+func (m *MsgWrapVoucherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrapVoucherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrapVoucherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWrapVoucherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrapVoucherResponse.Merge(m, src)
+}
+func (m *MsgWrapVoucherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrapVoucherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrapVoucherResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgWrapVoucherResponse proto.InternalMessageInfo
+
+func (m *MsgWrapVoucherResponse) GetCanonical() types.Coin {
+	if m != nil {
+		return m.Canonical
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.transfer.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Transfer",
-			Handler:    _Msg_Transfer_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/transfer/v1/tx.proto",
+// MsgUnwrapVoucher defines a msg to unwrap canonical tokens into the voucher of one of its routes.
+type MsgUnwrapVoucher struct {
+	// the sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the canonical tokens to unwrap
+	Canonical types.Coin `protobuf:"bytes,2,opt,name=canonical,proto3" json:"canonical"`
+	// the full denomination path of the route voucher to unwrap into
+	RoutePath string `protobuf:"bytes,3,opt,name=route_path,json=routePath,proto3" json:"route_path,omitempty"`
 }
 
-func (m *MsgTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnwrapVoucher) Reset()         { *m = MsgUnwrapVoucher{} }
+func (m *MsgUnwrapVoucher) String() string { return proto.CompactTextString(m) }
+func (*MsgUnwrapVoucher) ProtoMessage()    {}
+func (*MsgUnwrapVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{10}
+}
+func (m *MsgUnwrapVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnwrapVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnwrapVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnwrapVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnwrapVoucher.Merge(m, src)
+}
+func (m *MsgUnwrapVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnwrapVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnwrapVoucher.DiscardUnknown(m)
 }
 
-func (m *MsgTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnwrapVoucher proto.InternalMessageInfo
+
+// MsgUnwrapVoucherResponse defines the Msg/UnwrapVoucher response type.
+type MsgUnwrapVoucherResponse struct {
+	// the voucher released for the canonical tokens
+	Voucher types.Coin `protobuf:"bytes,1,opt,name=voucher,proto3" json:"voucher"`
 }
 
-func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Forwarding != nil {
-		{
-			size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUnwrapVoucherResponse) Reset()         { *m = MsgUnwrapVoucherResponse{} }
+func (m *MsgUnwrapVoucherResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnwrapVoucherResponse) ProtoMessage()    {}
+func (*MsgUnwrapVoucherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{11}
+}
+func (m *MsgUnwrapVoucherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnwrapVoucherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnwrapVoucherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.TimeoutTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *MsgUnwrapVoucherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnwrapVoucherResponse.Merge(m, src)
+}
+func (m *MsgUnwrapVoucherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnwrapVoucherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnwrapVoucherResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnwrapVoucherResponse proto.InternalMessageInfo
+
+func (m *MsgUnwrapVoucherResponse) GetVoucher() types.Coin {
+	if m != nil {
+		return m.Voucher
 	}
-	{
-		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+// MsgUpdateDenomMetadata is the Msg/UpdateDenomMetadata request type.
+type MsgUpdateDenomMetadata struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// metadata defines the bank metadata of an IBC denomination. The base denomination
+	// must be the IBC denomination of a token received by the chain.
+	Metadata types2.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *MsgUpdateDenomMetadata) Reset()         { *m = MsgUpdateDenomMetadata{} }
+func (m *MsgUpdateDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomMetadata) ProtoMessage()    {}
+func (*MsgUpdateDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{12}
+}
+func (m *MsgUpdateDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	{
-		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgUpdateDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomMetadata.Merge(m, src)
+}
+func (m *MsgUpdateDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenomMetadata proto.InternalMessageInfo
+
+// MsgUpdateDenomMetadataResponse defines the response structure for executing a
+// MsgUpdateDenomMetadata message.
+type MsgUpdateDenomMetadataResponse struct {
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Reset()         { *m = MsgUpdateDenomMetadataResponse{} }
+func (m *MsgUpdateDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{13}
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SourceChannel) > 0 {
-		i -= len(m.SourceChannel)
-		copy(dAtA[i:], m.SourceChannel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SourcePort) > 0 {
-		i -= len(m.SourcePort)
-		copy(dAtA[i:], m.SourcePort)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomMetadataResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateDenomMetadataResponse proto.InternalMessageInfo
+
+// MsgUpdateProtocolFeeConfig is the Msg/UpdateProtocolFeeConfig request type.
+type MsgUpdateProtocolFeeConfig struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// config defines the protocol fee configuration. The full configuration is replaced.
+	Config ProtocolFeeConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdateProtocolFeeConfig) Reset()         { *m = MsgUpdateProtocolFeeConfig{} }
+func (m *MsgUpdateProtocolFeeConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProtocolFeeConfig) ProtoMessage()    {}
+func (*MsgUpdateProtocolFeeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{14}
+}
+func (m *MsgUpdateProtocolFeeConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProtocolFeeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProtocolFeeConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateProtocolFeeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProtocolFeeConfig.Merge(m, src)
+}
+func (m *MsgUpdateProtocolFeeConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProtocolFeeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProtocolFeeConfig.DiscardUnknown(m)
 }
 
-func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateProtocolFeeConfig proto.InternalMessageInfo
+
+// MsgUpdateProtocolFeeConfigResponse defines the response structure for executing a
+// MsgUpdateProtocolFeeConfig message.
+type MsgUpdateProtocolFeeConfigResponse struct {
 }
 
-func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgUpdateProtocolFeeConfigResponse) Reset()         { *m = MsgUpdateProtocolFeeConfigResponse{} }
+func (m *MsgUpdateProtocolFeeConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProtocolFeeConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateProtocolFeeConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{15}
+}
+func (m *MsgUpdateProtocolFeeConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProtocolFeeConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProtocolFeeConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateProtocolFeeConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProtocolFeeConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateProtocolFeeConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProtocolFeeConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProtocolFeeConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateProtocolFeeConfigResponse proto.InternalMessageInfo
+
+// MsgRegisterNativeMapping is the Msg/RegisterNativeMapping request type.
+type MsgRegisterNativeMapping struct {
+	// signer address. A new mapping must be registered by the governance authority, the
+	// admin of an existing mapping may update its supply cap and admin.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// mapping defines the native mapping to register. The mapping of an already registered
+	// local denomination is replaced.
+	Mapping NativeMapping `protobuf:"bytes,2,opt,name=mapping,proto3" json:"mapping"`
+}
+
+func (m *MsgRegisterNativeMapping) Reset()         { *m = MsgRegisterNativeMapping{} }
+func (m *MsgRegisterNativeMapping) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterNativeMapping) ProtoMessage()    {}
+func (*MsgRegisterNativeMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{16}
+}
+func (m *MsgRegisterNativeMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterNativeMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterNativeMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterNativeMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterNativeMapping.Merge(m, src)
+}
+func (m *MsgRegisterNativeMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterNativeMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterNativeMapping.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterNativeMapping proto.InternalMessageInfo
+
+// MsgRegisterNativeMappingResponse defines the response structure for executing a
+// MsgRegisterNativeMapping message.
+type MsgRegisterNativeMappingResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRegisterNativeMappingResponse) Reset()         { *m = MsgRegisterNativeMappingResponse{} }
+func (m *MsgRegisterNativeMappingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterNativeMappingResponse) ProtoMessage()    {}
+func (*MsgRegisterNativeMappingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{17}
+}
+func (m *MsgRegisterNativeMappingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterNativeMappingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterNativeMappingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRegisterNativeMappingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterNativeMappingResponse.Merge(m, src)
+}
+func (m *MsgRegisterNativeMappingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterNativeMappingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterNativeMappingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgRegisterNativeMappingResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgTransfer)(nil), "ibc.applications.transfer.v1.MsgTransfer")
+	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.applications.transfer.v1.MsgTransferResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.transfer.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateChannelDenomRules)(nil), "ibc.applications.transfer.v1.MsgUpdateChannelDenomRules")
+	proto.RegisterType((*MsgUpdateChannelDenomRulesResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateChannelDenomRulesResponse")
+	proto.RegisterType((*MsgRegisterCanonicalAsset)(nil), "ibc.applications.transfer.v1.MsgRegisterCanonicalAsset")
+	proto.RegisterType((*MsgRegisterCanonicalAssetResponse)(nil), "ibc.applications.transfer.v1.MsgRegisterCanonicalAssetResponse")
+	proto.RegisterType((*MsgWrapVoucher)(nil), "ibc.applications.transfer.v1.MsgWrapVoucher")
+	proto.RegisterType((*MsgWrapVoucherResponse)(nil), "ibc.applications.transfer.v1.MsgWrapVoucherResponse")
+	proto.RegisterType((*MsgUnwrapVoucher)(nil), "ibc.applications.transfer.v1.MsgUnwrapVoucher")
+	proto.RegisterType((*MsgUnwrapVoucherResponse)(nil), "ibc.applications.transfer.v1.MsgUnwrapVoucherResponse")
+	proto.RegisterType((*MsgUpdateDenomMetadata)(nil), "ibc.applications.transfer.v1.MsgUpdateDenomMetadata")
+	proto.RegisterType((*MsgUpdateDenomMetadataResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateDenomMetadataResponse")
+	proto.RegisterType((*MsgUpdateProtocolFeeConfig)(nil), "ibc.applications.transfer.v1.MsgUpdateProtocolFeeConfig")
+	proto.RegisterType((*MsgUpdateProtocolFeeConfigResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateProtocolFeeConfigResponse")
+	proto.RegisterType((*MsgRegisterNativeMapping)(nil), "ibc.applications.transfer.v1.MsgRegisterNativeMapping")
+	proto.RegisterType((*MsgRegisterNativeMappingResponse)(nil), "ibc.applications.transfer.v1.MsgRegisterNativeMappingResponse")
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/tx.proto", fileDescriptor_7401ed9bed2f8e09)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_7401ed9bed2f8e09 = []byte{
+	// 1149 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xdb, 0xcd, 0x36, 0xfb, 0x96, 0xa6, 0xad, 0x0b, 0x89, 0x63, 0xd1, 0xcd, 0xb2, 0xb4,
+	0x52, 0x48, 0x1a, 0x5b, 0x1b, 0x4a, 0x43, 0xa3, 0x42, 0xdb, 0x04, 0x55, 0x91, 0xca, 0xa2, 0x60,
+	0xb5, 0x54, 0xe2, 0x12, 0xcd, 0x7a, 0x27, 0x5e, 0x2b, 0xeb, 0x19, 0xd7, 0x33, 0xbb, 0x81, 0x0b,
+	0x42, 0x3d, 0x01, 0x07, 0xa8, 0x2a, 0x38, 0x70, 0xe3, 0xc8, 0x81, 0x43, 0xee, 0xfc, 0x03, 0x3d,
+	0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0x90, 0x7f, 0x03, 0x79, 0x3c, 0x9e, 0x78, 0xeb, 0xec, 0x8f, 0xe4,
+	0x92, 0x78, 0xe6, 0x7d, 0xdf, 0xf7, 0xbe, 0x37, 0xf3, 0x66, 0xbc, 0x86, 0x1b, 0x7e, 0xd3, 0xb5,
+	0x51, 0x18, 0x76, 0x7c, 0x17, 0x71, 0x9f, 0x12, 0x66, 0xf3, 0x08, 0x11, 0xb6, 0x83, 0x23, 0xbb,
+	0x57, 0xb7, 0xf9, 0x37, 0x56, 0x18, 0x51, 0x4e, 0xf5, 0x77, 0xfd, 0xa6, 0x6b, 0x65, 0x61, 0x56,
+	0x0a, 0xb3, 0x7a, 0x75, 0xf3, 0x0a, 0x0a, 0x7c, 0x42, 0x6d, 0xf1, 0x37, 0x21, 0x98, 0x6f, 0x7b,
+	0xd4, 0xa3, 0xe2, 0xd1, 0x8e, 0x9f, 0xe4, 0xec, 0xac, 0x4b, 0x59, 0x40, 0x99, 0x1d, 0x30, 0x2f,
+	0x96, 0x0f, 0x98, 0x27, 0x03, 0x15, 0x19, 0x68, 0x22, 0x86, 0xed, 0x5e, 0xbd, 0x89, 0x39, 0xaa,
+	0xdb, 0x2e, 0xf5, 0x49, 0x2e, 0x4e, 0x76, 0x55, 0x3c, 0x1e, 0xc8, 0xf8, 0x7c, 0x5c, 0x86, 0x4b,
+	0x23, 0x6c, 0xbb, 0x1d, 0x1f, 0x13, 0x1e, 0xab, 0x27, 0x4f, 0x12, 0xb0, 0x34, 0xbc, 0xce, 0xb4,
+	0x18, 0x01, 0xae, 0xfd, 0x55, 0x80, 0x72, 0x83, 0x79, 0x8f, 0xe5, 0xac, 0x3e, 0x0f, 0x65, 0x46,
+	0xbb, 0x91, 0x8b, 0xb7, 0x43, 0x1a, 0x71, 0x43, 0xab, 0x6a, 0x0b, 0x25, 0x07, 0x92, 0xa9, 0x2d,
+	0x1a, 0x71, 0xfd, 0x06, 0x4c, 0x4b, 0x80, 0xdb, 0x46, 0x84, 0xe0, 0x8e, 0x71, 0x4e, 0x60, 0x2e,
+	0x26, 0xb3, 0x1b, 0xc9, 0xa4, 0x7e, 0x17, 0x26, 0x39, 0xdd, 0xc5, 0xc4, 0x38, 0x5f, 0xd5, 0x16,
+	0xca, 0x2b, 0x73, 0x56, 0x52, 0x95, 0x15, 0x57, 0x6d, 0xc9, 0xaa, 0xac, 0x0d, 0xea, 0x93, 0xf5,
+	0xf2, 0xab, 0x7f, 0xe7, 0x27, 0xfe, 0x3c, 0xda, 0x5f, 0xd4, 0x0c, 0xcd, 0x49, 0x48, 0xfa, 0x0c,
+	0x14, 0x19, 0x26, 0x2d, 0x1c, 0x19, 0x05, 0x21, 0x2e, 0x47, 0xba, 0x09, 0x53, 0x11, 0x76, 0xb1,
+	0xdf, 0xc3, 0x91, 0x31, 0x29, 0x22, 0x6a, 0xac, 0x7f, 0x0e, 0xd3, 0xdc, 0x0f, 0x30, 0xed, 0xf2,
+	0xed, 0x36, 0xf6, 0xbd, 0x36, 0x37, 0x8a, 0x22, 0xb5, 0x69, 0xc5, 0x1b, 0x1a, 0x2f, 0x98, 0x25,
+	0x97, 0xa9, 0x57, 0xb7, 0x36, 0x05, 0x62, 0xbd, 0xa4, 0x72, 0x3b, 0x17, 0x25, 0x39, 0x89, 0xe8,
+	0x4b, 0x70, 0x25, 0x55, 0x8b, 0xff, 0x33, 0x8e, 0x82, 0xd0, 0xb8, 0x50, 0xd5, 0x16, 0x0a, 0xce,
+	0x65, 0x19, 0x78, 0x9c, 0xce, 0xeb, 0x3a, 0x14, 0x02, 0x1c, 0x50, 0x63, 0x4a, 0x58, 0x12, 0xcf,
+	0xfa, 0x2a, 0x14, 0x45, 0x2d, 0xcc, 0x28, 0x55, 0xcf, 0x0f, 0x5f, 0x81, 0x42, 0xec, 0xc2, 0x91,
+	0x70, 0x7d, 0x13, 0x60, 0x87, 0x46, 0x7b, 0x28, 0x6a, 0xf9, 0xc4, 0x33, 0x40, 0xd4, 0xb0, 0x60,
+	0x0d, 0x6b, 0x4a, 0xeb, 0xa1, 0xc2, 0x3b, 0x19, 0x6e, 0xbc, 0x55, 0x11, 0xde, 0xe9, 0x92, 0xd6,
+	0x36, 0x6a, 0xb5, 0x22, 0xcc, 0x98, 0x51, 0x4e, 0xb6, 0x2a, 0x99, 0x7d, 0x90, 0x4c, 0xae, 0x2d,
+	0xfe, 0xf0, 0xc7, 0xfc, 0xc4, 0xf3, 0xa3, 0xfd, 0x45, 0xb9, 0xca, 0x3f, 0x1d, 0xed, 0x2f, 0xce,
+	0x24, 0x66, 0x97, 0x59, 0x6b, 0xd7, 0xce, 0xb4, 0x47, 0x6d, 0x15, 0xae, 0x66, 0x86, 0x0e, 0x66,
+	0x21, 0x25, 0x0c, 0xc7, 0xfb, 0xc2, 0xf0, 0xb3, 0x2e, 0x26, 0x2e, 0x16, 0x2d, 0x53, 0x70, 0xd4,
+	0x78, 0xad, 0x10, 0xcb, 0xd7, 0xbe, 0x83, 0x4b, 0x0d, 0xe6, 0x3d, 0x09, 0x5b, 0x88, 0xe3, 0x2d,
+	0x14, 0xa1, 0x80, 0x89, 0x4d, 0xf6, 0x3d, 0x82, 0x23, 0xd9, 0x65, 0x72, 0xa4, 0xaf, 0x43, 0x31,
+	0x14, 0x08, 0xd1, 0x59, 0xe5, 0x95, 0xeb, 0xc3, 0x8b, 0x4f, 0xd4, 0xd2, 0x45, 0x4c, 0x98, 0x6b,
+	0x97, 0x8e, 0x6b, 0x12, 0xa2, 0xb5, 0x39, 0x98, 0x7d, 0x23, 0x7f, 0x6a, 0xbe, 0xf6, 0x52, 0x03,
+	0x53, 0xc5, 0x64, 0xff, 0x7e, 0x86, 0x09, 0x0d, 0x9c, 0x6e, 0x07, 0x0f, 0xb6, 0xf9, 0x08, 0x26,
+	0xa3, 0x18, 0x20, 0x5d, 0xda, 0xc3, 0x5d, 0xe6, 0x74, 0xa5, 0xe1, 0x44, 0x23, 0xef, 0xf7, 0x3a,
+	0xd4, 0x06, 0x7b, 0x52, 0xd6, 0x7f, 0xd6, 0x60, 0xae, 0xc1, 0x3c, 0x07, 0x7b, 0x3e, 0xe3, 0x38,
+	0xda, 0x40, 0x84, 0x12, 0xdf, 0x45, 0x9d, 0x07, 0x8c, 0x61, 0x3e, 0xd0, 0xf9, 0x26, 0x4c, 0xa2,
+	0x18, 0x20, 0x9d, 0xdf, 0x1c, 0xe1, 0xbc, 0x4f, 0x34, 0xb5, 0x2d, 0x04, 0xf2, 0xb6, 0xdf, 0x87,
+	0xf7, 0x06, 0xfa, 0x51, 0xae, 0x39, 0x4c, 0x37, 0x98, 0xf7, 0x34, 0x42, 0xe1, 0x57, 0xb4, 0xeb,
+	0xb6, 0x71, 0x94, 0x39, 0xef, 0x5a, 0xdf, 0x79, 0xbf, 0x03, 0x17, 0x7a, 0x09, 0x44, 0x7a, 0x1d,
+	0x79, 0x8a, 0x52, 0x7c, 0xd6, 0x9a, 0xd0, 0xaa, 0x3d, 0x85, 0x99, 0xfe, 0xac, 0xaa, 0x7b, 0x3f,
+	0x81, 0x92, 0x9b, 0x3a, 0x35, 0xb4, 0xf1, 0xf2, 0x1c, 0x33, 0x6a, 0xbf, 0x6b, 0x70, 0x39, 0xde,
+	0x2b, 0xb2, 0x37, 0x46, 0x45, 0x7d, 0xb9, 0xce, 0x9d, 0x36, 0x97, 0x7e, 0x0d, 0x20, 0xa2, 0x5d,
+	0x8e, 0xb7, 0x43, 0xc4, 0xdb, 0xe2, 0x6e, 0x2d, 0x39, 0x25, 0x31, 0xb3, 0x85, 0x78, 0x3b, 0x5f,
+	0xf4, 0x13, 0x30, 0xde, 0xb4, 0xa6, 0xca, 0xce, 0x2c, 0xae, 0x76, 0xba, 0xc5, 0xad, 0x3d, 0xd7,
+	0x60, 0x46, 0xb5, 0xa7, 0xe8, 0xcb, 0x06, 0xe6, 0xa8, 0x85, 0x38, 0x1a, 0xd8, 0x74, 0xf7, 0x60,
+	0x2a, 0x90, 0x18, 0x59, 0xf7, 0xb5, 0xe3, 0x74, 0x64, 0x57, 0xa5, 0x4b, 0x85, 0x64, 0x4a, 0x45,
+	0xca, 0xf7, 0x5a, 0x15, 0x2a, 0x27, 0x7b, 0x50, 0x8d, 0xf6, 0x6b, 0xf6, 0x64, 0x6f, 0x45, 0x94,
+	0x53, 0x97, 0x76, 0x1e, 0x62, 0xbc, 0x41, 0xc9, 0x8e, 0xef, 0x0d, 0xb4, 0xda, 0x80, 0xa2, 0x2b,
+	0x10, 0xe3, 0x1d, 0xed, 0x9c, 0x70, 0x7a, 0x17, 0x25, 0x22, 0xc3, 0xcf, 0x76, 0x8e, 0xac, 0xcc,
+	0xbf, 0xd0, 0xc0, 0xc8, 0x9c, 0xa5, 0x2f, 0x10, 0xf7, 0x7b, 0xb8, 0x81, 0xc2, 0xd0, 0x27, 0x83,
+	0xad, 0x3f, 0x82, 0x0b, 0x41, 0x02, 0x91, 0xde, 0x97, 0x86, 0x7b, 0xef, 0x53, 0x4d, 0x77, 0x59,
+	0x2a, 0xe4, 0x8d, 0xd7, 0xa0, 0x3a, 0xc8, 0x51, 0x6a, 0x7b, 0xe5, 0xef, 0x12, 0x9c, 0x6f, 0x30,
+	0x4f, 0x6f, 0xc3, 0x94, 0xfa, 0x51, 0xf1, 0xc1, 0x70, 0x13, 0x99, 0x37, 0x8a, 0x59, 0x1f, 0x1b,
+	0xaa, 0xfa, 0x98, 0xc3, 0x5b, 0x7d, 0xef, 0x95, 0xe5, 0x91, 0x12, 0x59, 0xb8, 0xf9, 0xd1, 0xa9,
+	0xe0, 0x2a, 0xeb, 0x6f, 0x1a, 0xcc, 0x0e, 0x7a, 0x65, 0x7c, 0x3c, 0xa6, 0x64, 0x8e, 0x69, 0xde,
+	0x3f, 0x2b, 0x53, 0xf9, 0x7a, 0xa9, 0xc1, 0xcc, 0x80, 0xf7, 0xc1, 0xea, 0x48, 0xf1, 0x93, 0x89,
+	0xe6, 0xbd, 0x33, 0x12, 0x95, 0xa9, 0x67, 0x50, 0xce, 0x5e, 0xf7, 0x37, 0x47, 0xea, 0x65, 0xd0,
+	0xe6, 0xad, 0xd3, 0xa0, 0x55, 0xca, 0x3d, 0xb8, 0xd8, 0x7f, 0x23, 0x5b, 0xa3, 0x97, 0x36, 0x8b,
+	0x37, 0x6f, 0x9f, 0x0e, 0xaf, 0x12, 0xff, 0xa8, 0xc1, 0xd5, 0x93, 0x2e, 0xc6, 0x5b, 0x63, 0x6e,
+	0x6d, 0x1f, 0xcb, 0xbc, 0x7b, 0x16, 0xd6, 0x09, 0x4d, 0x9a, 0xbf, 0xfd, 0xc6, 0x6d, 0xd2, 0x1c,
+	0xd3, 0xbc, 0x7f, 0x56, 0xa6, 0xf2, 0xf5, 0x8b, 0x06, 0xef, 0x9c, 0x7c, 0xb1, 0xdd, 0x1e, 0xbb,
+	0xd5, 0xfa, 0x78, 0xe6, 0xa7, 0x67, 0xe3, 0xa5, 0x8e, 0xcc, 0xc9, 0xef, 0xe3, 0xaf, 0x80, 0xf5,
+	0x2f, 0x5f, 0x1d, 0x54, 0xb4, 0xd7, 0x07, 0x15, 0xed, 0xbf, 0x83, 0x8a, 0xf6, 0xe2, 0xb0, 0x32,
+	0xf1, 0xfa, 0xb0, 0x32, 0xf1, 0xcf, 0x61, 0x65, 0xe2, 0xeb, 0x55, 0xcf, 0xe7, 0xed, 0x6e, 0xd3,
+	0x72, 0x69, 0x60, 0xcb, 0x2f, 0x34, 0xbf, 0xe9, 0x2e, 0x7b, 0xd4, 0xee, 0xdd, 0xb1, 0x03, 0xda,
+	0x8a, 0x0f, 0x61, 0xfc, 0xd5, 0x95, 0xf9, 0xda, 0xe2, 0xdf, 0x86, 0x98, 0x35, 0x8b, 0xe2, 0x43,
+	0xeb, 0xc3, 0xff, 0x07, 0x00, 0xdd, 0x06, 0xd6, 0x6e, 0x7f, 0x0e, 0x00, 0x00,
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Transfer defines a rpc handler method for MsgTransfer.
+	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
+	// UpdateParams defines a rpc handler for MsgUpdateParams.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateChannelDenomRules defines a rpc handler for MsgUpdateChannelDenomRules.
+	UpdateChannelDenomRules(ctx context.Context, in *MsgUpdateChannelDenomRules, opts ...grpc.CallOption) (*MsgUpdateChannelDenomRulesResponse, error)
+	// RegisterCanonicalAsset defines a rpc handler for MsgRegisterCanonicalAsset.
+	RegisterCanonicalAsset(ctx context.Context, in *MsgRegisterCanonicalAsset, opts ...grpc.CallOption) (*MsgRegisterCanonicalAssetResponse, error)
+	// WrapVoucher defines a rpc handler for MsgWrapVoucher.
+	WrapVoucher(ctx context.Context, in *MsgWrapVoucher, opts ...grpc.CallOption) (*MsgWrapVoucherResponse, error)
+	// UnwrapVoucher defines a rpc handler for MsgUnwrapVoucher.
+	UnwrapVoucher(ctx context.Context, in *MsgUnwrapVoucher, opts ...grpc.CallOption) (*MsgUnwrapVoucherResponse, error)
+	// UpdateDenomMetadata defines a rpc handler for MsgUpdateDenomMetadata.
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// UpdateProtocolFeeConfig defines a rpc handler for MsgUpdateProtocolFeeConfig.
+	UpdateProtocolFeeConfig(ctx context.Context, in *MsgUpdateProtocolFeeConfig, opts ...grpc.CallOption) (*MsgUpdateProtocolFeeConfigResponse, error)
+	// RegisterNativeMapping defines a rpc handler for MsgRegisterNativeMapping.
+	RegisterNativeMapping(ctx context.Context, in *MsgRegisterNativeMapping, opts ...grpc.CallOption) (*MsgRegisterNativeMappingResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error) {
+	out := new(MsgTransferResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SourcePort)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateChannelDenomRules(ctx context.Context, in *MsgUpdateChannelDenomRules, opts ...grpc.CallOption) (*MsgUpdateChannelDenomRulesResponse, error) {
+	out := new(MsgUpdateChannelDenomRulesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateChannelDenomRules", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SourceChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RegisterCanonicalAsset(ctx context.Context, in *MsgRegisterCanonicalAsset, opts ...grpc.CallOption) (*MsgRegisterCanonicalAssetResponse, error) {
+	out := new(MsgRegisterCanonicalAssetResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/RegisterCanonicalAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Token.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WrapVoucher(ctx context.Context, in *MsgWrapVoucher, opts ...grpc.CallOption) (*MsgWrapVoucherResponse, error) {
+	out := new(MsgWrapVoucherResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/WrapVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UnwrapVoucher(ctx context.Context, in *MsgUnwrapVoucher, opts ...grpc.CallOption) (*MsgUnwrapVoucherResponse, error) {
+	out := new(MsgUnwrapVoucherResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UnwrapVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.TimeoutHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	return out, nil
+}
+
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateProtocolFeeConfig(ctx context.Context, in *MsgUpdateProtocolFeeConfig, opts ...grpc.CallOption) (*MsgUpdateProtocolFeeConfigResponse, error) {
+	out := new(MsgUpdateProtocolFeeConfigResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateProtocolFeeConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) RegisterNativeMapping(ctx context.Context, in *MsgRegisterNativeMapping, opts ...grpc.CallOption) (*MsgRegisterNativeMappingResponse, error) {
+	out := new(MsgRegisterNativeMappingResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/RegisterNativeMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Forwarding != nil {
-		l = m.Forwarding.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Transfer defines a rpc handler method for MsgTransfer.
+	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
+	// UpdateParams defines a rpc handler for MsgUpdateParams.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateChannelDenomRules defines a rpc handler for MsgUpdateChannelDenomRules.
+	UpdateChannelDenomRules(context.Context, *MsgUpdateChannelDenomRules) (*MsgUpdateChannelDenomRulesResponse, error)
+	// RegisterCanonicalAsset defines a rpc handler for MsgRegisterCanonicalAsset.
+	RegisterCanonicalAsset(context.Context, *MsgRegisterCanonicalAsset) (*MsgRegisterCanonicalAssetResponse, error)
+	// WrapVoucher defines a rpc handler for MsgWrapVoucher.
+	WrapVoucher(context.Context, *MsgWrapVoucher) (*MsgWrapVoucherResponse, error)
+	// UnwrapVoucher defines a rpc handler for MsgUnwrapVoucher.
+	UnwrapVoucher(context.Context, *MsgUnwrapVoucher) (*MsgUnwrapVoucherResponse, error)
+	// UpdateDenomMetadata defines a rpc handler for MsgUpdateDenomMetadata.
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// UpdateProtocolFeeConfig defines a rpc handler for MsgUpdateProtocolFeeConfig.
+	UpdateProtocolFeeConfig(context.Context, *MsgUpdateProtocolFeeConfig) (*MsgUpdateProtocolFeeConfigResponse, error)
+	// RegisterNativeMapping defines a rpc handler for MsgRegisterNativeMapping.
+	RegisterNativeMapping(context.Context, *MsgRegisterNativeMapping) (*MsgRegisterNativeMappingResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (*MsgTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChannelDenomRules(ctx context.Context, req *MsgUpdateChannelDenomRules) (*MsgUpdateChannelDenomRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelDenomRules not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCanonicalAsset(ctx context.Context, req *MsgRegisterCanonicalAsset) (*MsgRegisterCanonicalAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCanonicalAsset not implemented")
+}
+func (*UnimplementedMsgServer) WrapVoucher(ctx context.Context, req *MsgWrapVoucher) (*MsgWrapVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrapVoucher not implemented")
+}
+func (*UnimplementedMsgServer) UnwrapVoucher(ctx context.Context, req *MsgUnwrapVoucher) (*MsgUnwrapVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwrapVoucher not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDenomMetadata(ctx context.Context, req *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProtocolFeeConfig(ctx context.Context, req *MsgUpdateProtocolFeeConfig) (*MsgUpdateProtocolFeeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProtocolFeeConfig not implemented")
+}
+func (*UnimplementedMsgServer) RegisterNativeMapping(ctx context.Context, req *MsgRegisterNativeMapping) (*MsgRegisterNativeMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNativeMapping not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Transfer(ctx, req.(*MsgTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateChannelDenomRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChannelDenomRules)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChannelDenomRules(ctx, in)
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/UpdateChannelDenomRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChannelDenomRules(ctx, req.(*MsgUpdateChannelDenomRules))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterCanonicalAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCanonicalAsset)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCanonicalAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/RegisterCanonicalAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCanonicalAsset(ctx, req.(*MsgRegisterCanonicalAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WrapVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrapVoucher)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WrapVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/WrapVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WrapVoucher(ctx, req.(*MsgWrapVoucher))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnwrapVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnwrapVoucher)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnwrapVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/UnwrapVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnwrapVoucher(ctx, req.(*MsgUnwrapVoucher))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/UpdateDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProtocolFeeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProtocolFeeConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProtocolFeeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/UpdateProtocolFeeConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProtocolFeeConfig(ctx, req.(*MsgUpdateProtocolFeeConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterNativeMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterNativeMapping)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterNativeMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/RegisterNativeMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterNativeMapping(ctx, req.(*MsgRegisterNativeMapping))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.transfer.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Transfer",
+			Handler:    _Msg_Transfer_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateChannelDenomRules",
+			Handler:    _Msg_UpdateChannelDenomRules_Handler,
+		},
+		{
+			MethodName: "RegisterCanonicalAsset",
+			Handler:    _Msg_RegisterCanonicalAsset_Handler,
+		},
+		{
+			MethodName: "WrapVoucher",
+			Handler:    _Msg_WrapVoucher_Handler,
+		},
+		{
+			MethodName: "UnwrapVoucher",
+			Handler:    _Msg_UnwrapVoucher_Handler,
+		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateProtocolFeeConfig",
+			Handler:    _Msg_UpdateProtocolFeeConfig_Handler,
+		},
+		{
+			MethodName: "RegisterNativeMapping",
+			Handler:    _Msg_RegisterNativeMapping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/transfer/v1/tx.proto",
+}
+
+func (m *MsgTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Forwarding != nil {
+		{
+			size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChannelDenomRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChannelDenomRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChannelDenomRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChannelDenomRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChannelDenomRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChannelDenomRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCanonicalAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCanonicalAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCanonicalAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCanonicalAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCanonicalAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCanonicalAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrapVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrapVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrapVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Voucher.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrapVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrapVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrapVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Canonical.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnwrapVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnwrapVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnwrapVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoutePath) > 0 {
+		i -= len(m.RoutePath)
+		copy(dAtA[i:], m.RoutePath)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RoutePath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Canonical.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnwrapVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnwrapVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnwrapVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Voucher.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProtocolFeeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProtocolFeeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProtocolFeeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProtocolFeeConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProtocolFeeConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProtocolFeeConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterNativeMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterNativeMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterNativeMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Mapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterNativeMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterNativeMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterNativeMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Forwarding != nil {
+		l = m.Forwarding.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChannelDenomRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rules.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateChannelDenomRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCanonicalAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterCanonicalAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWrapVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Voucher.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWrapVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Canonical.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnwrapVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Canonical.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.RoutePath)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnwrapVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Voucher.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProtocolFeeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateProtocolFeeConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterNativeMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Mapping.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterNativeMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forwarding == nil {
+				m.Forwarding = &Forwarding{}
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChannelDenomRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChannelDenomRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChannelDenomRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChannelDenomRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChannelDenomRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChannelDenomRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCanonicalAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCanonicalAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCanonicalAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCanonicalAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCanonicalAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCanonicalAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgWrapVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -712,15 +3219,15 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWrapVoucher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWrapVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -748,11 +3255,177 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Voucher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrapVoucherResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrapVoucherResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrapVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canonical", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Canonical.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnwrapVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnwrapVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnwrapVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -780,11 +3453,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Canonical", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -811,15 +3484,97 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Canonical.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnwrapVoucherResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnwrapVoucherResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnwrapVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -829,27 +3584,78 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.Voucher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -877,11 +3683,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -908,66 +3714,115 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TimeoutTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProtocolFeeConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProtocolFeeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProtocolFeeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -977,29 +3832,27 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, types.Coin{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1026,10 +3879,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Forwarding == nil {
-				m.Forwarding = &Forwarding{}
-			}
-			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1054,7 +3904,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProtocolFeeConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1077,31 +3927,12 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProtocolFeeConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProtocolFeeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1123,7 +3954,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterNativeMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1146,10 +3977,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterNativeMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterNativeMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1186,7 +4017,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1213,7 +4044,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Mapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1238,7 +4069,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterNativeMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +4092,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterNativeMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterNativeMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -496,5 +496,18 @@ func (k *Keeper) ScheduleIBCSoftwareUpgrade(ctx sdk.Context, plan upgradetypes.P
 	// emitting an event for scheduling an upgrade plan
 	emitScheduleIBCSoftwareUpgradeEvent(ctx, plan.Name, plan.Height)
 
+	k.notifyUpgradePlanScheduled(ctx, plan, bz)
+
 	return nil
 }
+
+// notifyUpgradePlanScheduled notifies all light client modules implementing the UpgradePlanHandler
+// interface of the scheduled upgrade plan, in lexicographical order of their client types.
+func (k *Keeper) notifyUpgradePlanScheduled(ctx sdk.Context, plan upgradetypes.Plan, upgradedClientState []byte) {
+	for _, clientType := range k.router.ClientTypes() {
+		clientModule, _ := k.router.GetRoute(clientType)
+		if handler, ok := clientModule.(exported.UpgradePlanHandler); ok {
+			handler.OnUpgradePlanScheduled(ctx, plan.Name, plan.Height, upgradedClientState)
+		}
+	}
+}
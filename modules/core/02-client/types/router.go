@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
@@ -49,3 +50,14 @@ func (rtr *Router) GetRoute(clientType string) (exported.LightClientModule, bool
 	}
 	return rtr.routes[clientType], true
 }
+
+// ClientTypes returns the client types of all registered light client modules in lexicographical order.
+func (rtr *Router) ClientTypes() []string {
+	clientTypes := make([]string, 0, len(rtr.routes))
+	for clientType := range rtr.routes {
+		clientTypes = append(clientTypes, clientType)
+	}
+
+	sort.Strings(clientTypes)
+	return clientTypes
+}
@@ -133,3 +133,20 @@ func (suite *TypesTestSuite) TestHasGetRoute() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestClientTypes() {
+	suite.SetupTest()
+	cdc := suite.chainA.App.AppCodec()
+
+	storeProvider := types.NewStoreProvider(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+	tmLightClientModule := ibctm.NewLightClientModule(cdc, storeProvider)
+
+	router := types.NewRouter()
+	suite.Require().Empty(router.ClientTypes())
+
+	router.AddRoute(exported.Tendermint, &tmLightClientModule)
+	router.AddRoute(exported.Solomachine, &tmLightClientModule)
+	router.AddRoute(exported.Localhost, &tmLightClientModule)
+
+	suite.Require().Equal([]string{exported.Solomachine, exported.Tendermint, exported.Localhost}, router.ClientTypes())
+}
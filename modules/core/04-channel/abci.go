@@ -0,0 +1,33 @@
+package channel
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	coretelemetry "github.com/cosmos/ibc-go/v9/modules/core/internal/telemetry"
+)
+
+// BeginBlocker is used to report the number of in-flight packets and the age of the oldest unacknowledged
+// packet of every channel when telemetry is enabled. Both are read from counters and indexes maintained when
+// packets are sent and their commitments deleted, so the packet commitments of a channel are never iterated.
+func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+		inFlight := k.GetPacketsInFlight(ctx, channel.PortId, channel.ChannelId)
+
+		var oldestPacketAge time.Duration
+		if sendTime, found := k.GetOldestPacketSendTime(ctx, channel.PortId, channel.ChannelId); found {
+			oldestPacketAge = ctx.BlockTime().Sub(sendTime)
+		}
+
+		coretelemetry.ReportPacketBacklog(channel.PortId, channel.ChannelId, inFlight, oldestPacketAge)
+		return false
+	})
+}
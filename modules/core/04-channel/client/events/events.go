@@ -0,0 +1,222 @@
+/*
+Package events provides helpers for decoding the packet lifecycle events emitted by 04-channel.
+
+Every packet lifecycle step emits both a legacy event with string attributes (e.g. send_packet)
+and a typed protobuf event (e.g. ibc.core.channel.v1.EventSendPacket). The helpers in this package
+decode either form into a channeltypes.Packet so that indexers and relayers do not need to reparse
+the legacy attributes themselves.
+*/
+package events
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// typedEventTypes maps the legacy packet lifecycle event types to the event type of their typed counterpart.
+var typedEventTypes = map[string]string{
+	channeltypes.EventTypeSendPacket:        proto.MessageName(&channeltypes.EventSendPacket{}),
+	channeltypes.EventTypeRecvPacket:        proto.MessageName(&channeltypes.EventRecvPacket{}),
+	channeltypes.EventTypeWriteAck:          proto.MessageName(&channeltypes.EventWriteAcknowledgement{}),
+	channeltypes.EventTypeAcknowledgePacket: proto.MessageName(&channeltypes.EventAcknowledgePacket{}),
+	channeltypes.EventTypeTimeoutPacket:     proto.MessageName(&channeltypes.EventTimeoutPacket{}),
+}
+
+// IsPacketEvent returns true if the provided event is a legacy or typed packet lifecycle event.
+func IsPacketEvent(event abci.Event) bool {
+	return isLegacyPacketEvent(event) || isTypedPacketEvent(event)
+}
+
+// ParsePacket decodes the packet contained in a legacy or typed packet lifecycle event.
+// NOTE: the legacy acknowledge_packet and timeout_packet events do not contain the packet data,
+// the data of packets decoded from these events is therefore empty.
+func ParsePacket(event abci.Event) (channeltypes.Packet, error) {
+	switch {
+	case isTypedPacketEvent(event):
+		return parseTypedPacket(event)
+	case isLegacyPacketEvent(event):
+		return parseLegacyPacket(event)
+	default:
+		return channeltypes.Packet{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "event type %s is not a packet event", event.Type)
+	}
+}
+
+// ParsePackets decodes all packets of the given legacy event type (e.g. send_packet) found in the provided events.
+// As both forms are emitted for every packet, typed events are preferred and legacy events are only decoded
+// if no typed events of the requested type are present, for example for events emitted by older versions of ibc-go.
+// An error is returned if no packets are found.
+func ParsePackets(eventType string, events []abci.Event) ([]channeltypes.Packet, error) {
+	typedEventType, ok := typedEventTypes[eventType]
+	if !ok {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "event type %s is not a packet event", eventType)
+	}
+
+	packets, err := parsePackets(typedEventType, events)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(packets) == 0 {
+		packets, err = parsePackets(eventType, events)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrNotFound, "no %s events found", eventType)
+	}
+
+	return packets, nil
+}
+
+// ParseAcknowledgement decodes the acknowledgement contained in a legacy or typed write acknowledgement event.
+func ParseAcknowledgement(event abci.Event) ([]byte, error) {
+	switch event.Type {
+	case typedEventTypes[channeltypes.EventTypeWriteAck]:
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to parse typed event: %s", err)
+		}
+
+		writeAckEvent, ok := typedEvent.(*channeltypes.EventWriteAcknowledgement)
+		if !ok {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", (*channeltypes.EventWriteAcknowledgement)(nil), typedEvent)
+		}
+
+		return writeAckEvent.Acknowledgement, nil
+	case channeltypes.EventTypeWriteAck:
+		for _, attr := range event.Attributes {
+			if attr.Key == channeltypes.AttributeKeyAckHex {
+				ack, err := hex.DecodeString(attr.Value)
+				if err != nil {
+					return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid acknowledgement hex: %s", err)
+				}
+
+				return ack, nil
+			}
+		}
+
+		return nil, errorsmod.Wrapf(ibcerrors.ErrNotFound, "attribute %s not found", channeltypes.AttributeKeyAckHex)
+	default:
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "event type %s is not a write acknowledgement event", event.Type)
+	}
+}
+
+// parsePackets decodes the packets of all events with the given event type.
+func parsePackets(eventType string, events []abci.Event) ([]channeltypes.Packet, error) {
+	var packets []channeltypes.Packet
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		packet, err := ParsePacket(event)
+		if err != nil {
+			return nil, err
+		}
+
+		packets = append(packets, packet)
+	}
+
+	return packets, nil
+}
+
+// parseTypedPacket decodes the packet contained in a typed packet lifecycle event.
+func parseTypedPacket(event abci.Event) (channeltypes.Packet, error) {
+	typedEvent, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return channeltypes.Packet{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to parse typed event: %s", err)
+	}
+
+	switch typedEvent := typedEvent.(type) {
+	case *channeltypes.EventSendPacket:
+		return typedEvent.Packet, nil
+	case *channeltypes.EventRecvPacket:
+		return typedEvent.Packet, nil
+	case *channeltypes.EventWriteAcknowledgement:
+		return typedEvent.Packet, nil
+	case *channeltypes.EventAcknowledgePacket:
+		return typedEvent.Packet, nil
+	case *channeltypes.EventTimeoutPacket:
+		return typedEvent.Packet, nil
+	default:
+		return channeltypes.Packet{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "event type %s is not a packet event", event.Type)
+	}
+}
+
+// parseLegacyPacket decodes the packet contained in the string attributes of a legacy packet lifecycle event.
+func parseLegacyPacket(event abci.Event) (channeltypes.Packet, error) {
+	var packet channeltypes.Packet
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case channeltypes.AttributeKeyDataHex:
+			data, err := hex.DecodeString(attr.Value)
+			if err != nil {
+				return channeltypes.Packet{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid packet data hex: %s", err)
+			}
+
+			packet.Data = data
+		case channeltypes.AttributeKeySequence:
+			sequence, err := strconv.ParseUint(attr.Value, 10, 64)
+			if err != nil {
+				return channeltypes.Packet{}, errorsmod.Wrapf(ibcerrors.ErrInvalidSequence, "invalid packet sequence: %s", err)
+			}
+
+			packet.Sequence = sequence
+		case channeltypes.AttributeKeySrcPort:
+			packet.SourcePort = attr.Value
+		case channeltypes.AttributeKeySrcChannel:
+			packet.SourceChannel = attr.Value
+		case channeltypes.AttributeKeyDstPort:
+			packet.DestinationPort = attr.Value
+		case channeltypes.AttributeKeyDstChannel:
+			packet.DestinationChannel = attr.Value
+		case channeltypes.AttributeKeyTimeoutHeight:
+			height, err := clienttypes.ParseHeight(attr.Value)
+			if err != nil {
+				return channeltypes.Packet{}, err
+			}
+
+			packet.TimeoutHeight = height
+		case channeltypes.AttributeKeyTimeoutTimestamp:
+			timestamp, err := strconv.ParseUint(attr.Value, 10, 64)
+			if err != nil {
+				return channeltypes.Packet{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid packet timeout timestamp: %s", err)
+			}
+
+			packet.TimeoutTimestamp = timestamp
+		}
+	}
+
+	return packet, nil
+}
+
+// isLegacyPacketEvent returns true if the provided event is a legacy packet lifecycle event.
+func isLegacyPacketEvent(event abci.Event) bool {
+	_, ok := typedEventTypes[event.Type]
+	return ok
+}
+
+// isTypedPacketEvent returns true if the provided event is a typed packet lifecycle event.
+func isTypedPacketEvent(event abci.Event) bool {
+	for _, typedEventType := range typedEventTypes {
+		if event.Type == typedEventType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,309 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channelevents "github.com/cosmos/ibc-go/v9/modules/core/04-channel/client/events"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	ibcmock "github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+type EventsTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestEventsTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(EventsTestSuite))
+}
+
+func (suite *EventsTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+// sendPacket sends a mock packet from chainA and returns the packet along with the events emitted.
+func (suite *EventsTestSuite) sendPacket() (channeltypes.Packet, []abci.Event) {
+	ctx := suite.chainA.GetContext()
+	timeoutHeight := clienttypes.NewHeight(1, 1000)
+
+	channelCap := suite.chainA.GetChannelCapability(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	sequence, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	return packet, ctx.EventManager().ABCIEvents()
+}
+
+// filterEvents returns the events which do not have the provided event type.
+func filterEvents(events []abci.Event, eventType string) []abci.Event {
+	var filtered []abci.Event
+	for _, event := range events {
+		if event.Type != eventType {
+			filtered = append(filtered, event)
+		}
+	}
+
+	return filtered
+}
+
+func (suite *EventsTestSuite) TestTypedEventsEmitted() {
+	packet, events := suite.sendPacket()
+
+	var sendPacketEvent *channeltypes.EventSendPacket
+	for _, event := range events {
+		if event.Type == proto.MessageName(&channeltypes.EventSendPacket{}) {
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			suite.Require().NoError(err)
+
+			sendPacketEvent = typedEvent.(*channeltypes.EventSendPacket)
+		}
+	}
+
+	suite.Require().NotNil(sendPacketEvent)
+	suite.Require().Equal(packet, sendPacketEvent.Packet)
+	suite.Require().Equal(channeltypes.UNORDERED, sendPacketEvent.ChannelOrdering)
+	suite.Require().Equal(suite.path.EndpointA.ConnectionID, sendPacketEvent.ConnectionId)
+}
+
+func (suite *EventsTestSuite) TestParsePackets() {
+	var (
+		packet    channeltypes.Packet
+		eventType string
+		events    []abci.Event
+		expPacket channeltypes.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: send packet, typed and legacy events",
+			func() {},
+			nil,
+		},
+		{
+			"success: send packet, legacy events only",
+			func() {
+				events = filterEvents(events, proto.MessageName(&channeltypes.EventSendPacket{}))
+			},
+			nil,
+		},
+		{
+			"success: send packet, typed events only",
+			func() {
+				events = filterEvents(events, channeltypes.EventTypeSendPacket)
+			},
+			nil,
+		},
+		{
+			"success: recv packet",
+			func() {
+				res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+
+				eventType = channeltypes.EventTypeRecvPacket
+				events = res.Events
+			},
+			nil,
+		},
+		{
+			"success: write acknowledgement",
+			func() {
+				res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+
+				eventType = channeltypes.EventTypeWriteAck
+				events = res.Events
+			},
+			nil,
+		},
+		{
+			"success: acknowledge packet",
+			func() {
+				_, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+
+				res, err := suite.path.EndpointA.AcknowledgePacketWithResult(packet, ibcmock.MockAcknowledgement.Acknowledgement())
+				suite.Require().NoError(err)
+
+				eventType = channeltypes.EventTypeAcknowledgePacket
+				events = res.Events
+			},
+			nil,
+		},
+		{
+			"success: acknowledge packet, legacy events do not contain packet data",
+			func() {
+				_, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+
+				res, err := suite.path.EndpointA.AcknowledgePacketWithResult(packet, ibcmock.MockAcknowledgement.Acknowledgement())
+				suite.Require().NoError(err)
+
+				eventType = channeltypes.EventTypeAcknowledgePacket
+				events = filterEvents(res.Events, proto.MessageName(&channeltypes.EventAcknowledgePacket{}))
+				expPacket.Data = nil
+			},
+			nil,
+		},
+		{
+			"failure: no packet events found",
+			func() {
+				events = nil
+			},
+			ibcerrors.ErrNotFound,
+		},
+		{
+			"failure: event type is not a packet event",
+			func() {
+				eventType = channeltypes.EventTypeChannelOpenInit
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: invalid legacy packet data",
+			func() {
+				events = filterEvents(events, proto.MessageName(&channeltypes.EventSendPacket{}))
+				for i, event := range events {
+					if event.Type != channeltypes.EventTypeSendPacket {
+						continue
+					}
+
+					for j, attr := range event.Attributes {
+						if attr.Key == channeltypes.AttributeKeyDataHex {
+							events[i].Attributes[j].Value = "invalid hex"
+						}
+					}
+				}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			eventType = channeltypes.EventTypeSendPacket
+			packet, events = suite.sendPacket()
+			expPacket = packet
+
+			tc.malleate()
+
+			packets, err := channelevents.ParsePackets(eventType, events)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]channeltypes.Packet{expPacket}, packets)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(packets)
+			}
+		})
+	}
+}
+
+func (suite *EventsTestSuite) TestParseAcknowledgement() {
+	var event abci.Event
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: typed event",
+			func() {},
+			nil,
+		},
+		{
+			"success: legacy event",
+			func() {
+				event = suite.findEvent(channeltypes.EventTypeWriteAck)
+			},
+			nil,
+		},
+		{
+			"failure: legacy event without acknowledgement",
+			func() {
+				event = abci.Event{Type: channeltypes.EventTypeWriteAck}
+			},
+			ibcerrors.ErrNotFound,
+		},
+		{
+			"failure: event is not a write acknowledgement event",
+			func() {
+				event = suite.findEvent(channeltypes.EventTypeRecvPacket)
+			},
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			event = suite.findEvent(proto.MessageName(&channeltypes.EventWriteAcknowledgement{}))
+
+			tc.malleate()
+
+			ack, err := channelevents.ParseAcknowledgement(event)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(ibcmock.MockAcknowledgement.Acknowledgement(), ack)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(ack)
+			}
+		})
+	}
+}
+
+// findEvent sends and receives a mock packet and returns the first event of the receive transaction with the given type.
+func (suite *EventsTestSuite) findEvent(eventType string) abci.Event {
+	packet, _ := suite.sendPacket()
+
+	res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	for _, event := range res.Events {
+		if event.Type == eventType {
+			return event
+		}
+	}
+
+	suite.FailNow("event not found", eventType)
+	return abci.Event{}
+}
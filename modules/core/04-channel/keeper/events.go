@@ -4,12 +4,23 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
+// emitTypedEvent emits the typed counterpart of a legacy channel event. Typed events are emitted
+// alongside the legacy string-attribute events, which are retained for backwards compatibility.
+// The channel typed events always marshal successfully, a failure is therefore only logged.
+func emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		ctx.Logger().Error("failed to emit typed event", "type", proto.MessageName(event), "error", err)
+	}
+}
+
 // emitChannelOpenInitEvent emits a channel open init event
 func emitChannelOpenInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -26,6 +37,15 @@ func emitChannelOpenInitEvent(ctx sdk.Context, portID string, channelID string,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelOpenInit{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		Version:               channel.Version,
+	})
 }
 
 // emitChannelOpenTryEvent emits a channel open try event
@@ -45,6 +65,15 @@ func emitChannelOpenTryEvent(ctx sdk.Context, portID string, channelID string, c
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelOpenTry{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		Version:               channel.Version,
+	})
 }
 
 // emitChannelOpenAckEvent emits a channel open acknowledge event
@@ -63,6 +92,15 @@ func emitChannelOpenAckEvent(ctx sdk.Context, portID string, channelID string, c
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelOpenAck{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		Version:               channel.Version,
+	})
 }
 
 // emitChannelOpenConfirmEvent emits a channel open confirm event
@@ -81,6 +119,15 @@ func emitChannelOpenConfirmEvent(ctx sdk.Context, portID string, channelID strin
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelOpenConfirm{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		Version:               channel.Version,
+	})
 }
 
 // emitChannelCloseInitEvent emits a channel close init event
@@ -99,6 +146,14 @@ func emitChannelCloseInitEvent(ctx sdk.Context, portID string, channelID string,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelCloseInit{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+	})
 }
 
 // emitChannelCloseConfirmEvent emits a channel close confirm event
@@ -117,6 +172,14 @@ func emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID stri
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelCloseConfirm{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+	})
 }
 
 // emitSendPacketEvent emits an event with packet data along with other packet information for relayer
@@ -144,6 +207,12 @@ func emitSendPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Cha
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventSendPacket{
+		Packet:          packet,
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitRecvPacketEvent emits a receive packet event. It will be emitted both the first time a packet
@@ -171,6 +240,12 @@ func emitRecvPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Cha
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventRecvPacket{
+		Packet:          packet,
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitWriteAcknowledgementEvent emits an event that the relayer can query for
@@ -198,6 +273,13 @@ func emitWriteAcknowledgementEvent(ctx sdk.Context, packet types.Packet, channel
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventWriteAcknowledgement{
+		Packet:          packet,
+		Acknowledgement: acknowledgement,
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitAcknowledgePacketEvent emits an acknowledge packet event. It will be emitted both the first time
@@ -224,6 +306,12 @@ func emitAcknowledgePacketEvent(ctx sdk.Context, packet types.Packet, channel ty
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventAcknowledgePacket{
+		Packet:          packet,
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitTimeoutPacketEvent emits a timeout packet event. It will be emitted both the first time a packet
@@ -247,6 +335,12 @@ func emitTimeoutPacketEvent(ctx sdk.Context, packet types.Packet, channel types.
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventTimeoutPacket{
+		Packet:          packet,
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitChannelClosedEvent emits a channel closed event.
@@ -266,6 +360,15 @@ func emitChannelClosedEvent(ctx sdk.Context, packet types.Packet, channel types.
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelClosed{
+		PortId:                packet.GetSourcePort(),
+		ChannelId:             packet.GetSourceChannel(),
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		ChannelOrdering:       channel.Ordering,
+	})
 }
 
 // EmitChannelUpgradeInitEvent emits a channel upgrade init event
@@ -284,6 +387,15 @@ func EmitChannelUpgradeInitEvent(ctx sdk.Context, portID string, channelID strin
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelUpgradeInit{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		UpgradeSequence:       channel.UpgradeSequence,
+		Upgrade:               upgrade,
+	})
 }
 
 // EmitChannelUpgradeTryEvent emits a channel upgrade try event
@@ -302,6 +414,15 @@ func EmitChannelUpgradeTryEvent(ctx sdk.Context, portID string, channelID string
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelUpgradeTry{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		UpgradeSequence:       channel.UpgradeSequence,
+		Upgrade:               upgrade,
+	})
 }
 
 // EmitChannelUpgradeAckEvent emits a channel upgrade ack event
@@ -320,6 +441,15 @@ func EmitChannelUpgradeAckEvent(ctx sdk.Context, portID string, channelID string
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelUpgradeAck{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		UpgradeSequence:       channel.UpgradeSequence,
+		Upgrade:               upgrade,
+	})
 }
 
 // EmitChannelUpgradeConfirmEvent emits a channel upgrade confirm event
@@ -339,6 +469,15 @@ func EmitChannelUpgradeConfirmEvent(ctx sdk.Context, portID, channelID string, c
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelUpgradeConfirm{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		UpgradeSequence:       channel.UpgradeSequence,
+		ChannelState:          channel.State,
+	})
 }
 
 // EmitChannelUpgradeOpenEvent emits a channel upgrade open event
@@ -358,6 +497,15 @@ func EmitChannelUpgradeOpenEvent(ctx sdk.Context, portID string, channelID strin
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelUpgradeOpen{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		UpgradeSequence:       channel.UpgradeSequence,
+		ChannelState:          channel.State,
+	})
 }
 
 // EmitChannelUpgradeTimeoutEvent emits an upgrade timeout event.
@@ -378,6 +526,15 @@ func EmitChannelUpgradeTimeoutEvent(ctx sdk.Context, portID string, channelID st
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelUpgradeTimeout{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		UpgradeSequence:       channel.UpgradeSequence,
+		Upgrade:               upgrade,
+	})
 }
 
 // EmitErrorReceiptEvent emits an error receipt event
@@ -397,6 +554,15 @@ func EmitErrorReceiptEvent(ctx sdk.Context, portID string, channelID string, cha
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelUpgradeError{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		UpgradeSequence:       channel.UpgradeSequence,
+		ErrorReceipt:          err.Error(),
+	})
 }
 
 // EmitChannelUpgradeCancelEvent emits an upgraded cancelled event.
@@ -415,6 +581,15 @@ func EmitChannelUpgradeCancelEvent(ctx sdk.Context, portID string, channelID str
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelUpgradeCancel{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		UpgradeSequence:       channel.UpgradeSequence,
+		Upgrade:               upgrade,
+	})
 }
 
 // emitChannelFlushCompleteEvents emits an flushing event.
@@ -433,4 +608,12 @@ func emitChannelFlushCompleteEvent(ctx sdk.Context, portID string, channelID str
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	emitTypedEvent(ctx, &types.EventChannelFlushComplete{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ChannelState:          channel.State,
+	})
 }
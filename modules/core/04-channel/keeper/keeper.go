@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	db "github.com/cosmos/cosmos-db"
 
@@ -36,6 +37,9 @@ type Keeper struct {
 	connectionKeeper types.ConnectionKeeper
 	portKeeper       types.PortKeeper
 	scopedKeeper     exported.ScopedKeeper
+
+	// router is used to invoke application callbacks for packets delivered synchronously on localhost channels
+	router *porttypes.Router
 }
 
 // NewKeeper creates a new IBC channel Keeper instance
@@ -54,6 +58,12 @@ func NewKeeper(
 	}
 }
 
+// SetRouter sets the router used to invoke application callbacks for packets delivered synchronously
+// on localhost channels.
+func (k *Keeper) SetRouter(rtr *porttypes.Router) {
+	k.router = rtr
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+exported.ModuleName+"/"+types.SubModuleName)
@@ -222,6 +232,71 @@ func (k *Keeper) SetPacketCommitment(ctx sdk.Context, portID, channelID string,
 func (k *Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(host.PacketCommitmentKey(portID, channelID, sequence))
+
+	// packets sent before send times were stored are not counted as in flight
+	sendTimeKey := types.PacketSendTimeKey(portID, channelID, sequence)
+	if store.Has(sendTimeKey) {
+		store.Delete(sendTimeKey)
+		k.setPacketsInFlight(ctx, portID, channelID, k.GetPacketsInFlight(ctx, portID, channelID)-1)
+	}
+}
+
+// GetPacketSendTime returns the block time at which the in-flight packet with the given sequence was sent.
+func (k *Keeper) GetPacketSendTime(ctx sdk.Context, portID, channelID string, sequence uint64) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketSendTimeKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, int64(sdk.BigEndianToUint64(bz))), true
+}
+
+// setPacketSendTime stores the block time at which the packet with the given sequence was sent and counts
+// the packet as in flight. It is deleted together with the packet commitment once the packet is acknowledged
+// or timed out.
+func (k *Keeper) setPacketSendTime(ctx sdk.Context, portID, channelID string, sequence uint64, sendTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketSendTimeKey(portID, channelID, sequence), sdk.Uint64ToBigEndian(uint64(sendTime.UnixNano())))
+	k.setPacketsInFlight(ctx, portID, channelID, k.GetPacketsInFlight(ctx, portID, channelID)+1)
+}
+
+// GetPacketsInFlight returns the number of packets sent on the given channel which are neither acknowledged
+// nor timed out. Packets sent before send times were stored are not accounted for.
+func (k *Keeper) GetPacketsInFlight(ctx sdk.Context, portID, channelID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketsInFlightKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setPacketsInFlight sets the number of in-flight packets of the given channel. The key is deleted once
+// no packets are in flight.
+func (k *Keeper) setPacketsInFlight(ctx sdk.Context, portID, channelID string, inFlight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if inFlight == 0 {
+		store.Delete(types.PacketsInFlightKey(portID, channelID))
+		return
+	}
+
+	store.Set(types.PacketsInFlightKey(portID, channelID), sdk.Uint64ToBigEndian(inFlight))
+}
+
+// GetOldestPacketSendTime returns the send time of the in-flight packet with the lowest sequence on the given channel.
+// Packets sent before send times were stored are not accounted for.
+func (k *Keeper) GetOldestPacketSendTime(ctx sdk.Context, portID, channelID string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PacketSendTimePrefixKey(portID, channelID))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	if !iterator.Valid() {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, int64(sdk.BigEndianToUint64(iterator.Value()))), true
 }
 
 // SetPacketAcknowledgement sets the packet ack hash to the store
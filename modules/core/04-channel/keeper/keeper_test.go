@@ -5,6 +5,7 @@ import (
 	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
@@ -449,6 +450,63 @@ func (suite *KeeperTestSuite) TestGetAllPacketCommitmentsAtChannel() {
 	}
 }
 
+// TestPacketSendTime verifies that the send time of a packet is stored and the packet counted as in flight
+// when it is sent, and that both are updated together with the packet commitment once the packet is acknowledged.
+func (suite *KeeperTestSuite) TestPacketSendTime() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+	_, found := channelKeeper.GetOldestPacketSendTime(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().False(found)
+	suite.Require().Zero(channelKeeper.GetPacketsInFlight(suite.chainA.GetContext(), portID, channelID))
+
+	var (
+		packets   []types.Packet
+		sendTimes []time.Time
+	)
+	for i := 0; i < 2; i++ {
+		sendTime := suite.chainA.GetContext().BlockTime()
+
+		sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		storedTime, found := channelKeeper.GetPacketSendTime(suite.chainA.GetContext(), portID, channelID, sequence)
+		suite.Require().True(found)
+		suite.Require().True(sendTime.Equal(storedTime))
+
+		packets = append(packets, types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp))
+		sendTimes = append(sendTimes, sendTime)
+	}
+
+	// the send times are stored in block order
+	suite.Require().True(sendTimes[1].After(sendTimes[0]))
+
+	oldestSendTime, found := channelKeeper.GetOldestPacketSendTime(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().True(found)
+	suite.Require().True(sendTimes[0].Equal(oldestSendTime))
+	suite.Require().Equal(uint64(2), channelKeeper.GetPacketsInFlight(suite.chainA.GetContext(), portID, channelID))
+
+	// acknowledging the first packet deletes its send time
+	suite.Require().NoError(path.RelayPacket(packets[0]))
+
+	_, found = channelKeeper.GetPacketSendTime(suite.chainA.GetContext(), portID, channelID, packets[0].Sequence)
+	suite.Require().False(found)
+
+	oldestSendTime, found = channelKeeper.GetOldestPacketSendTime(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().True(found)
+	suite.Require().True(sendTimes[1].Equal(oldestSendTime))
+	suite.Require().Equal(uint64(1), channelKeeper.GetPacketsInFlight(suite.chainA.GetContext(), portID, channelID))
+
+	suite.Require().NoError(path.RelayPacket(packets[1]))
+
+	_, found = channelKeeper.GetOldestPacketSendTime(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().False(found)
+	suite.Require().Zero(channelKeeper.GetPacketsInFlight(suite.chainA.GetContext(), portID, channelID))
+}
+
 // TestSetPacketAcknowledgement verifies that packet acknowledgements are correctly
 // set in the keeper.
 func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	coretypes "github.com/cosmos/ibc-go/v9/modules/core/types"
+	localhost "github.com/cosmos/ibc-go/v9/modules/light-clients/09-localhost"
+)
+
+// MaxSynchronousDeliveryDepth defines the maximum number of rounds of synchronous packet deliveries performed
+// for a transaction, i.e. the maximum depth of packets sent on localhost channels from within the callbacks of
+// a synchronously delivered packet. Packets still pending after the last round must be relayed as usual.
+const MaxSynchronousDeliveryDepth = 8
+
+// isSynchronousDelivery returns true if packets sent on the provided channel must be delivered synchronously.
+// This is the case if the channel is built upon the localhost connection, the channel opted in to synchronous
+// delivery in the channel params and the packet is sent within a transaction. Packets sent outside of a
+// transaction, e.g. in the begin or end blocker, must be relayed as usual.
+func (k *Keeper) isSynchronousDelivery(ctx sdk.Context, channel types.Channel, channelID string) bool {
+	return channel.ConnectionHops[0] == exported.LocalhostConnectionID &&
+		len(ctx.TxBytes()) != 0 &&
+		k.GetParams(ctx).IsSynchronousDeliveryChannel(channelID)
+}
+
+// setPendingSynchronousDelivery stores the packet such that it is delivered once the transaction sending it
+// has executed all of its messages.
+func (k *Keeper) setPendingSynchronousDelivery(ctx sdk.Context, packet types.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingSynchronousDeliveryKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), k.cdc.MustMarshal(&packet))
+}
+
+// GetPendingSynchronousDeliveries returns all packets pending synchronous delivery.
+func (k *Keeper) GetPendingSynchronousDeliveries(ctx sdk.Context) []types.Packet {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyPendingSynchronousDeliveryPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var packets []types.Packet
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// DeliverPendingPackets delivers all packets sent on localhost channels which opted in to synchronous delivery.
+// It must be called once the messages of a transaction have been executed, such that packets are only delivered
+// after the applications and middlewares sending them have finished processing the send. Packets sent from within
+// the callbacks of a delivered packet are delivered in subsequent rounds, up to MaxSynchronousDeliveryDepth rounds.
+// The packets still pending after the last round are not delivered and must be relayed as usual.
+//
+// An error is returned if the delivery of any packet fails, in which case the transaction must be reverted.
+func (k *Keeper) DeliverPendingPackets(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	for depth := 0; ; depth++ {
+		packets := k.GetPendingSynchronousDeliveries(ctx)
+		if len(packets) == 0 {
+			return nil
+		}
+
+		for _, packet := range packets {
+			store.Delete(types.PendingSynchronousDeliveryKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+			if depth >= MaxSynchronousDeliveryDepth {
+				continue
+			}
+
+			// the packet may have been relayed within the transaction sending it
+			if len(k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())) == 0 {
+				continue
+			}
+
+			if err := k.deliverPacket(ctx, packet); err != nil {
+				return errorsmod.Wrapf(err, "synchronous delivery of packet with sequence %d on channel %s failed", packet.GetSequence(), packet.GetSourceChannel())
+			}
+		}
+	}
+}
+
+// deliverPacket receives the packet on the destination channel end and, if the destination application writes an
+// acknowledgement synchronously, acknowledges the packet on the source channel end. The packet handlers and
+// application callbacks are executed in the same way as when the packet is relayed using MsgRecvPacket and
+// MsgAcknowledgement, but are executed within the transaction sending the packet, such that all gas is accounted
+// to the sender. Applications which return an asynchronous acknowledgement must have the acknowledgement relayed as usual.
+//
+// NOTE: no relayer submits the packet, therefore the relayer address provided to the application callbacks is empty.
+func (k *Keeper) deliverPacket(ctx sdk.Context, packet types.Packet) error {
+	var relayer sdk.AccAddress
+	proofHeight := clienttypes.GetSelfHeight(ctx)
+
+	destModule, destCap, err := k.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	destCbs, err := k.route(destModule)
+	if err != nil {
+		return err
+	}
+
+	if err := k.RecvPacket(ctx, destCap, packet, localhost.SentinelProof, proofHeight); err != nil {
+		return errorsmod.Wrap(err, "receive packet verification failed")
+	}
+
+	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
+	cacheCtx, writeFn := ctx.CacheContext()
+	ack := destCbs.OnRecvPacket(cacheCtx, packet, relayer)
+	if ack == nil || ack.Success() {
+		// write application state changes for asynchronous and successful acknowledgements
+		writeFn()
+	} else {
+		// Modify events in cached context to reflect unsuccessful acknowledgement
+		ctx.EventManager().EmitEvents(coretypes.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
+	}
+
+	// the acknowledgement is written asynchronously by the destination application and must be relayed
+	if ack == nil {
+		return nil
+	}
+
+	if err := k.WriteAcknowledgement(ctx, destCap, packet, ack); err != nil {
+		return err
+	}
+
+	srcModule, srcCap, err := k.LookupModuleByChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	srcCbs, err := k.route(srcModule)
+	if err != nil {
+		return err
+	}
+
+	if err := k.AcknowledgePacket(ctx, srcCap, packet, ack.Acknowledgement(), localhost.SentinelProof, proofHeight); err != nil {
+		return errorsmod.Wrap(err, "acknowledge packet verification failed")
+	}
+
+	if err := srcCbs.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), relayer); err != nil {
+		return errorsmod.Wrap(err, "acknowledge packet callback failed")
+	}
+
+	return nil
+}
+
+// route returns the application callbacks registered in the router for the provided module.
+func (k *Keeper) route(module string) (porttypes.IBCModule, error) {
+	if k.router == nil {
+		return nil, errorsmod.Wrap(porttypes.ErrInvalidRoute, "router has not been set")
+	}
+
+	cbs, ok := k.router.GetRoute(module)
+	if !ok {
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	return cbs, nil
+}
@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v9/modules/light-clients/09-localhost"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	ibcmock "github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+// openLocalhostChannel opens a channel between two mock application channel ends on chainA using the
+// localhost connection and returns the channel identifiers of the initializing and the counterparty channel ends.
+func (suite *KeeperTestSuite) openLocalhostChannel() (string, string) {
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+	connectionHops := []string{exported.LocalhostConnectionID}
+
+	msgInit := types.NewMsgChannelOpenInit(ibctesting.MockPort, ibcmock.Version, types.UNORDERED, connectionHops, ibctesting.MockPort, signer)
+	res, err := suite.chainA.SendMsgs(msgInit)
+	suite.Require().NoError(err)
+
+	channelIDA, err := ibctesting.ParseChannelIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	msgTry := types.NewMsgChannelOpenTry(
+		ibctesting.MockPort, ibcmock.Version, types.UNORDERED, connectionHops, ibctesting.MockPort, channelIDA, ibcmock.Version,
+		localhost.SentinelProof, clienttypes.GetSelfHeight(suite.chainA.GetContext()), signer,
+	)
+	res, err = suite.chainA.SendMsgs(msgTry)
+	suite.Require().NoError(err)
+
+	channelIDB, err := ibctesting.ParseChannelIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	msgAck := types.NewMsgChannelOpenAck(ibctesting.MockPort, channelIDA, channelIDB, ibcmock.Version, localhost.SentinelProof, clienttypes.GetSelfHeight(suite.chainA.GetContext()), signer)
+	_, err = suite.chainA.SendMsgs(msgAck)
+	suite.Require().NoError(err)
+
+	msgConfirm := types.NewMsgChannelOpenConfirm(ibctesting.MockPort, channelIDB, localhost.SentinelProof, clienttypes.GetSelfHeight(suite.chainA.GetContext()), signer)
+	_, err = suite.chainA.SendMsgs(msgConfirm)
+	suite.Require().NoError(err)
+
+	return channelIDA, channelIDB
+}
+
+// enableSynchronousDelivery opts in the provided channel to synchronous packet delivery.
+func (suite *KeeperTestSuite) enableSynchronousDelivery(channelID string) {
+	params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	params.SynchronousDeliveryChannels = append(params.SynchronousDeliveryChannels, channelID)
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+}
+
+func (suite *KeeperTestSuite) TestSynchronousLocalhostDelivery() {
+	var (
+		ctx         sdk.Context
+		packetData  []byte
+		channelIDA  string
+		channelIDB  string
+		expPending  bool
+		expReceived bool
+		expAcked    bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: packet received and acknowledged",
+			func() {},
+			nil,
+		},
+		{
+			"success: packet received and acknowledged with error acknowledgement",
+			func() {
+				packetData = ibctesting.MockFailPacketData
+			},
+			nil,
+		},
+		{
+			"success: asynchronous acknowledgement is not delivered",
+			func() {
+				packetData = ibcmock.MockAsyncPacketData
+				expAcked = false
+			},
+			nil,
+		},
+		{
+			"success: channel did not opt in to synchronous delivery",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(ctx)
+				params.SynchronousDeliveryChannels = []string{channelIDB}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(ctx, params)
+
+				expPending, expReceived, expAcked = false, false, false
+			},
+			nil,
+		},
+		{
+			"success: packet not sent within a transaction",
+			func() {
+				ctx = ctx.WithTxBytes(nil)
+
+				expPending, expReceived, expAcked = false, false, false
+			},
+			nil,
+		},
+		{
+			"success: nested synchronous delivery",
+			func() {
+				suite.enableSynchronousDelivery(channelIDB)
+
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnRecvPacket = func(ctx sdk.Context, packet types.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+					// send a packet back to the source channel end once
+					if packet.GetSourceChannel() == channelIDA {
+						sequence, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, suite.chainA.GetChannelCapability(ibctesting.MockPort, channelIDB), ibctesting.MockPort, channelIDB, clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), ibctesting.MockPacketData)
+						suite.Require().NoError(err)
+
+						// the packet is delivered once the delivery of the packet being received completes
+						_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(ctx, ibctesting.MockPort, channelIDA, sequence)
+						suite.Require().False(found)
+					}
+
+					return ibcmock.MockAcknowledgement
+				}
+			},
+			nil,
+		},
+		{
+			"failure: acknowledgement callback fails",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnAcknowledgementPacket = func(ctx sdk.Context, packet types.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+					return ibcmock.MockApplicationCallbackError
+				}
+			},
+			ibcmock.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			channelIDA, channelIDB = suite.openLocalhostChannel()
+			suite.enableSynchronousDelivery(channelIDA)
+
+			// packets are only delivered synchronously when sent within a transaction
+			ctx = suite.chainA.GetContext().WithTxBytes([]byte("tx"))
+			packetData = ibctesting.MockPacketData
+			expPending, expReceived, expAcked = true, true, true
+
+			tc.malleate()
+
+			channelCap := suite.chainA.GetChannelCapability(ibctesting.MockPort, channelIDA)
+			sequence, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, ibctesting.MockPort, channelIDA, clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), packetData)
+			suite.Require().NoError(err)
+
+			// the packet is not delivered before the send returns
+			_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(ctx, ibctesting.MockPort, channelIDB, sequence)
+			suite.Require().False(found)
+
+			pending := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPendingSynchronousDeliveries(ctx)
+			suite.Require().Equal(expPending, len(pending) == 1)
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.DeliverPendingPackets(ctx)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPendingSynchronousDeliveries(ctx))
+
+			_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(ctx, ibctesting.MockPort, channelIDB, sequence)
+			suite.Require().Equal(expReceived, found)
+
+			_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(ctx, ibctesting.MockPort, channelIDB, sequence)
+			suite.Require().Equal(expAcked, found)
+
+			commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(ctx, ibctesting.MockPort, channelIDA, sequence)
+			suite.Require().Equal(expAcked, commitment == nil)
+
+			if expAcked {
+				suite.Require().Contains(ctx.EventManager().Events(), ibcmock.NewMockAckPacketEvent())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSynchronousLocalhostDeliveryMaxDepth() {
+	var depth int
+
+	channelIDA, channelIDB := suite.openLocalhostChannel()
+	suite.enableSynchronousDelivery(channelIDA)
+	suite.enableSynchronousDelivery(channelIDB)
+
+	// send a packet back to the source channel end on every receive
+	suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnRecvPacket = func(ctx sdk.Context, packet types.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+		depth++
+
+		_, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, suite.chainA.GetChannelCapability(ibctesting.MockPort, packet.GetDestChannel()), ibctesting.MockPort, packet.GetDestChannel(), clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		return ibcmock.MockAcknowledgement
+	}
+
+	ctx := suite.chainA.GetContext().WithTxBytes([]byte("tx"))
+	channelCap := suite.chainA.GetChannelCapability(ibctesting.MockPort, channelIDA)
+	_, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, ibctesting.MockPort, channelIDA, clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.DeliverPendingPackets(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(keeper.MaxSynchronousDeliveryDepth, depth)
+	suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPendingSynchronousDeliveries(ctx))
+
+	// the packet sent in the last round must be relayed as usual
+	lastChannelID := channelIDA
+	if depth%2 == 1 {
+		lastChannelID = channelIDB
+	}
+	suite.Require().Equal(uint64(1), suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketsInFlight(ctx, ibctesting.MockPort, lastChannelID))
+}
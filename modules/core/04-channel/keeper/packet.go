@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"slices"
 	"strconv"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
@@ -15,6 +18,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	coretelemetry "github.com/cosmos/ibc-go/v9/modules/core/internal/telemetry"
 )
 
 // SendPacket is called by a module in order to send an IBC packet on a channel.
@@ -88,6 +92,7 @@ func (k *Keeper) SendPacket(
 
 	k.SetNextSequenceSend(ctx, sourcePort, sourceChannel, sequence+1)
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
+	k.setPacketSendTime(ctx, sourcePort, sourceChannel, packet.GetSequence(), ctx.BlockTime())
 
 	emitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
@@ -100,6 +105,10 @@ func (k *Keeper) SendPacket(
 		"dst_channel", packet.GetDestChannel(),
 	)
 
+	if k.isSynchronousDelivery(ctx, channel, sourceChannel) {
+		k.setPendingSynchronousDelivery(ctx, packet)
+	}
+
 	return packet.GetSequence(), nil
 }
 
@@ -471,6 +480,8 @@ func (k *Keeper) AcknowledgePacket(
 
 	}
 
+	k.reportPacketLatency(ctx, connectionEnd.ClientId, packet, proofHeight)
+
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
@@ -498,6 +509,30 @@ func (k *Keeper) AcknowledgePacket(
 	return nil
 }
 
+// reportPacketLatency reports the latency between the block time at which the packet was sent and its
+// receipt and acknowledgement when telemetry is enabled. The time of receipt is approximated by the
+// counterparty timestamp at the proof height of the acknowledgement, an upper bound of the block time
+// at which the packet was received. Packets sent before send times were stored are not reported.
+func (k *Keeper) reportPacketLatency(ctx sdk.Context, clientID string, packet types.Packet, proofHeight exported.Height) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	// telemetry is local to the node and must not consume gas
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	sendTime, found := k.GetPacketSendTime(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+
+	if proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, clientID, proofHeight); err == nil {
+		coretelemetry.ReportPacketLatency(packet, "recv", time.Unix(0, int64(proofTimestamp)).Sub(sendTime))
+	}
+
+	coretelemetry.ReportPacketLatency(packet, "ack", ctx.BlockTime().Sub(sendTime))
+}
+
 // handleFlushState is called when a packet is acknowledged or timed out and the channel is in
 // FLUSHING state. It checks if the upgrade has timed out and if so, aborts the upgrade. If all
 // packets have completed their lifecycle, it sets the channel state to FLUSHCOMPLETE and
@@ -561,6 +561,9 @@ func (m *Timeout) GetTimestamp() uint64 {
 type Params struct {
 	// the relative timeout after which channel upgrades will time out.
 	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// the identifiers of the localhost channels which opted in to synchronous packet delivery. Packets sent
+	// on these channels are received and acknowledged at the end of the transaction sending them.
+	SynchronousDeliveryChannels []string `protobuf:"bytes,2,rep,name=synchronous_delivery_channels,json=synchronousDeliveryChannels,proto3" json:"synchronous_delivery_channels,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -603,6 +606,13 @@ func (m *Params) GetUpgradeTimeout() Timeout {
 	return Timeout{}
 }
 
+func (m *Params) GetSynchronousDeliveryChannels() []string {
+	if m != nil {
+		return m.SynchronousDeliveryChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -620,66 +630,68 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0x55, 0xb7, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x5f,
-	0x43, 0x5a, 0xac, 0x4c, 0xfa, 0x9b, 0x2b, 0x13, 0x7c, 0x74, 0x0a, 0xb9, 0xcd, 0x8e, 0x92, 0xac,
-	0xf0, 0x0d, 0x50, 0x1e, 0x44, 0x17, 0x3e, 0xb0, 0x67, 0x21, 0x7a, 0x01, 0xf1, 0x5f, 0x6c, 0x28,
-	0x56, 0x28, 0xa4, 0x4e, 0xef, 0x7c, 0x45, 0x44, 0x61, 0x42, 0x2c, 0x2f, 0x42, 0x05, 0x7a, 0xf6,
-	0x53, 0x12, 0x52, 0x3d, 0xf1, 0xa2, 0x95, 0x7a, 0xfd, 0x8b, 0xbe, 0x31, 0x1c, 0xb4, 0xcd, 0xb6,
-	0xd9, 0x37, 0x2f, 0x5a, 0xe6, 0x4b, 0xa3, 0x31, 0x1c, 0xb4, 0x7b, 0x5d, 0xa3, 0x6e, 0x3e, 0x33,
-	0x8d, 0x86, 0x92, 0xd0, 0x8e, 0x56, 0x6b, 0xfd, 0x70, 0x87, 0x80, 0x54, 0x00, 0x1e, 0x17, 0x81,
-	0x8a, 0xa4, 0x65, 0x57, 0x6b, 0x5d, 0x8e, 0xce, 0xa8, 0x08, 0x87, 0xdc, 0xd3, 0xb7, 0xbe, 0xeb,
-	0x74, 0x8d, 0xb6, 0x92, 0xd4, 0xf6, 0x57, 0x6b, 0x3d, 0x23, 0xcc, 0x4d, 0x24, 0x73, 0xee, 0xf1,
-	0x48, 0xe6, 0x39, 0x85, 0x03, 0xee, 0xa9, 0xb7, 0x3a, 0x3d, 0xa3, 0xa1, 0xc8, 0x1a, 0xac, 0xd6,
-	0x7a, 0x9a, 0x5b, 0x48, 0x87, 0x3c, 0xf7, 0x3e, 0x6b, 0x0d, 0x7a, 0x4d, 0xb3, 0xfd, 0x5c, 0x49,
-	0x69, 0x07, 0xab, 0xb5, 0x9e, 0x8d, 0x6d, 0x74, 0x06, 0xc7, 0x5b, 0x8c, 0x7a, 0xe7, 0xdb, 0x6e,
-	0xcb, 0xe8, 0x1b, 0x4a, 0x9a, 0xd7, 0xbf, 0x03, 0x6a, 0xf2, 0xdb, 0x5f, 0x8b, 0x89, 0xb3, 0xd7,
-	0x90, 0x62, 0x4f, 0x35, 0xfa, 0x04, 0x4e, 0x3a, 0x56, 0xc3, 0xb0, 0x86, 0xed, 0x4e, 0xdb, 0xb8,
-	0xd5, 0x3d, 0x2b, 0x30, 0xc2, 0x51, 0x19, 0x0a, 0x9c, 0x35, 0x68, 0xb3, 0x5f, 0xa3, 0xa1, 0x48,
-	0xda, 0xe1, 0x6a, 0xad, 0xe7, 0x6e, 0x80, 0xa8, 0x7d, 0xce, 0x89, 0x19, 0xa2, 0x7d, 0x61, 0x72,
-	0xe1, 0xcb, 0xde, 0xbb, 0xab, 0xa2, 0xf4, 0xfe, 0xaa, 0x28, 0xfd, 0x71, 0x55, 0x94, 0x7e, 0xbe,
-	0x2e, 0x26, 0xde, 0x5f, 0x17, 0x13, 0x1f, 0xae, 0x8b, 0x89, 0x97, 0x4f, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x39, 0xda, 0x3d, 0x84, 0x94, 0x08, 0x00, 0x00,
+	// 970 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x65, 0xea, 0x6f, 0x6c, 0xcb, 0xcc, 0xba, 0x75, 0x09, 0xd6, 0x91, 0x19, 0xa3, 0x45,
+	0x1d, 0x17, 0x91, 0xe2, 0xb4, 0x28, 0x9a, 0xde, 0x6c, 0x8b, 0x89, 0x89, 0xa8, 0x92, 0x40, 0xc9,
+	0x87, 0xe6, 0x42, 0x50, 0xe4, 0x56, 0x22, 0x22, 0x71, 0x55, 0xee, 0x4a, 0x81, 0xd1, 0x73, 0x81,
+	0x40, 0xa7, 0x5e, 0x7b, 0x10, 0x50, 0xa0, 0xaf, 0xd0, 0x87, 0xc8, 0x31, 0xc7, 0x9c, 0x8a, 0xc2,
+	0x7e, 0x87, 0x9e, 0x0b, 0xee, 0x2e, 0x2d, 0xc9, 0x30, 0x8c, 0xa2, 0x40, 0x6f, 0x3d, 0x69, 0xe7,
+	0x9b, 0x6f, 0xe6, 0x9b, 0x9d, 0x19, 0x2d, 0x08, 0x0f, 0xc2, 0x9e, 0x5f, 0xf3, 0x49, 0x8c, 0x6b,
+	0xfe, 0xc0, 0x8b, 0x22, 0x3c, 0xac, 0x4d, 0x8f, 0xd2, 0x63, 0x75, 0x1c, 0x13, 0x46, 0xd0, 0x76,
+	0xd8, 0xf3, 0xab, 0x09, 0xa5, 0x9a, 0xe2, 0xd3, 0x23, 0xe3, 0x83, 0x3e, 0xe9, 0x13, 0xee, 0xaf,
+	0x25, 0x27, 0x41, 0x35, 0xf6, 0x16, 0xd9, 0x86, 0x21, 0x8e, 0x18, 0x4f, 0xc6, 0x4f, 0x82, 0xb0,
+	0xff, 0x7b, 0x16, 0x0a, 0xa7, 0x22, 0x0b, 0x7a, 0x0c, 0x39, 0xca, 0x3c, 0x86, 0x75, 0xc5, 0x54,
+	0x0e, 0xca, 0x4f, 0x8c, 0xea, 0x2d, 0x3a, 0xd5, 0x4e, 0xc2, 0x70, 0x04, 0x11, 0x7d, 0x05, 0x45,
+	0x12, 0x07, 0x38, 0x0e, 0xa3, 0xbe, 0x9e, 0xbd, 0x23, 0xa8, 0x95, 0x90, 0x9c, 0x6b, 0x2e, 0x7a,
+	0x01, 0x1b, 0x3e, 0x99, 0x44, 0x0c, 0xc7, 0x63, 0x2f, 0x66, 0x17, 0xfa, 0x9a, 0xa9, 0x1c, 0xac,
+	0x3f, 0x79, 0x70, 0x6b, 0xec, 0xe9, 0x12, 0xf1, 0x44, 0x7d, 0xfb, 0xc7, 0x5e, 0xc6, 0x59, 0x09,
+	0x46, 0x9f, 0xc1, 0x96, 0x4f, 0xa2, 0x08, 0xfb, 0x2c, 0x24, 0x91, 0x3b, 0x20, 0x63, 0xaa, 0xab,
+	0xe6, 0xda, 0x41, 0xc9, 0x29, 0x2f, 0xe0, 0x33, 0x32, 0xa6, 0x48, 0x87, 0xc2, 0x14, 0xc7, 0x34,
+	0x24, 0x91, 0x9e, 0x33, 0x95, 0x83, 0x92, 0x93, 0x9a, 0xe8, 0x21, 0x68, 0x93, 0x71, 0x3f, 0xf6,
+	0x02, 0xec, 0x52, 0xfc, 0xc3, 0x04, 0x47, 0x3e, 0xd6, 0xf3, 0xa6, 0x72, 0xa0, 0x3a, 0x5b, 0x12,
+	0xef, 0x48, 0xf8, 0x1b, 0xf5, 0xcd, 0xaf, 0x7b, 0x99, 0xfd, 0xbf, 0xb2, 0x70, 0xcf, 0x0e, 0x70,
+	0xc4, 0xc2, 0xef, 0x43, 0x1c, 0xfc, 0xdf, 0xc0, 0x8f, 0xa0, 0x30, 0x26, 0x31, 0x73, 0xc3, 0x80,
+	0xf7, 0xad, 0xe4, 0xe4, 0x13, 0xd3, 0x0e, 0xd0, 0x7d, 0x00, 0x59, 0x4a, 0xe2, 0x2b, 0x70, 0x5f,
+	0x49, 0x22, 0x76, 0x70, 0x6b, 0xe3, 0x8b, 0x77, 0x35, 0xbe, 0x01, 0x1b, 0xcb, 0xf7, 0x59, 0x16,
+	0x56, 0xee, 0x10, 0xce, 0xde, 0x10, 0x96, 0xd9, 0xde, 0x67, 0x21, 0xdf, 0xf6, 0xfc, 0x57, 0x98,
+	0x21, 0x03, 0x8a, 0xd7, 0x15, 0x28, 0xbc, 0x82, 0x6b, 0x1b, 0xed, 0xc1, 0x3a, 0x25, 0x93, 0xd8,
+	0xc7, 0x6e, 0x92, 0x5c, 0x26, 0x03, 0x01, 0xb5, 0x49, 0xcc, 0xd0, 0xa7, 0x50, 0x96, 0x04, 0xa9,
+	0xc0, 0x07, 0x52, 0x72, 0x36, 0x05, 0x9a, 0xee, 0xc7, 0x43, 0xd0, 0x02, 0x4c, 0x59, 0x18, 0x79,
+	0xbc, 0xd3, 0x3c, 0x99, 0xca, 0x89, 0x5b, 0x4b, 0x38, 0xcf, 0x58, 0x83, 0xed, 0x65, 0x6a, 0x9a,
+	0x56, 0xb4, 0x1d, 0x2d, 0xb9, 0xd2, 0xdc, 0x08, 0xd4, 0xc0, 0x63, 0x1e, 0x6f, 0xff, 0x86, 0xc3,
+	0xcf, 0xe8, 0x39, 0x94, 0x59, 0x38, 0xc2, 0x64, 0xc2, 0xdc, 0x01, 0x0e, 0xfb, 0x03, 0xc6, 0x07,
+	0xb0, 0xbe, 0xb2, 0x63, 0xe2, 0x31, 0x98, 0x1e, 0x55, 0xcf, 0x38, 0x43, 0x2e, 0xc8, 0xa6, 0x8c,
+	0x13, 0x20, 0xfa, 0x1c, 0xee, 0xa5, 0x89, 0x92, 0x5f, 0xca, 0xbc, 0xd1, 0x58, 0xce, 0x49, 0x93,
+	0x8e, 0x6e, 0x8a, 0xcb, 0xd6, 0xfe, 0x08, 0xeb, 0xa2, 0xb3, 0x7c, 0xdf, 0xff, 0xed, 0x9c, 0x56,
+	0xc6, 0xb2, 0x76, 0x63, 0x2c, 0xe9, 0x95, 0xd5, 0xc5, 0x95, 0xa5, 0x78, 0x00, 0x45, 0x21, 0x6e,
+	0x07, 0xff, 0x85, 0xb2, 0x54, 0x69, 0xc1, 0xd6, 0xb1, 0xff, 0x2a, 0x22, 0xaf, 0x87, 0x38, 0xe8,
+	0xe3, 0x11, 0x8e, 0x18, 0xd2, 0x21, 0x1f, 0x63, 0x3a, 0x19, 0x32, 0xfd, 0xc3, 0xa4, 0xa8, 0xb3,
+	0x8c, 0x23, 0x6d, 0xb4, 0x03, 0x39, 0x1c, 0xc7, 0x24, 0xd6, 0x77, 0x12, 0xa1, 0xb3, 0x8c, 0x23,
+	0xcc, 0x13, 0x80, 0x62, 0x8c, 0xe9, 0x98, 0x44, 0x14, 0xef, 0x7b, 0x50, 0xe8, 0x8a, 0x6e, 0xa2,
+	0xaf, 0x21, 0x2f, 0x47, 0xa6, 0xfc, 0xc3, 0x91, 0x49, 0x3e, 0xda, 0x85, 0xd2, 0x62, 0x46, 0x59,
+	0x5e, 0xf8, 0x02, 0xd8, 0xff, 0x45, 0x49, 0x36, 0x3e, 0xf6, 0x46, 0x14, 0xbd, 0x80, 0xf4, 0x3f,
+	0xe6, 0xca, 0x19, 0x4a, 0xad, 0xdd, 0x5b, 0x9f, 0x11, 0x59, 0x99, 0x54, 0x2b, 0xcb, 0xd0, 0xb4,
+	0xde, 0x13, 0xb8, 0x4f, 0x2f, 0x22, 0x7f, 0x10, 0x93, 0x88, 0x4c, 0xa8, 0x1b, 0xe0, 0x61, 0x38,
+	0xc5, 0xf1, 0x45, 0xba, 0xb8, 0x54, 0xcf, 0xf2, 0x17, 0xe5, 0xe3, 0x25, 0x52, 0x5d, 0x72, 0xe4,
+	0x06, 0xd3, 0xc3, 0x9f, 0xb2, 0x90, 0xeb, 0xc8, 0x67, 0x71, 0xaf, 0xd3, 0x3d, 0xee, 0x5a, 0xee,
+	0x79, 0xd3, 0x6e, 0xda, 0x5d, 0xfb, 0xb8, 0x61, 0xbf, 0xb4, 0xea, 0xee, 0x79, 0xb3, 0xd3, 0xb6,
+	0x4e, 0xed, 0x67, 0xb6, 0x55, 0xd7, 0x32, 0xc6, 0xbd, 0xd9, 0xdc, 0xdc, 0x5c, 0x21, 0x20, 0x1d,
+	0x40, 0xc4, 0x25, 0xa0, 0xa6, 0x18, 0xc5, 0xd9, 0xdc, 0x54, 0x93, 0x33, 0xaa, 0xc0, 0xa6, 0xf0,
+	0x74, 0x9d, 0xef, 0x5a, 0x6d, 0xab, 0xa9, 0x65, 0x8d, 0xf5, 0xd9, 0xdc, 0x2c, 0x48, 0x73, 0x11,
+	0xc9, 0x9d, 0x6b, 0x22, 0x92, 0x7b, 0x76, 0x61, 0x43, 0x78, 0x4e, 0x1b, 0xad, 0x8e, 0x55, 0xd7,
+	0x54, 0x03, 0x66, 0x73, 0x33, 0x2f, 0x2c, 0x64, 0x42, 0x59, 0x78, 0x9f, 0x35, 0xce, 0x3b, 0x67,
+	0x76, 0xf3, 0xb9, 0x96, 0x33, 0x36, 0x66, 0x73, 0xb3, 0x98, 0xda, 0xe8, 0x10, 0xb6, 0x97, 0x18,
+	0xa7, 0xad, 0x6f, 0xdb, 0x0d, 0xab, 0x6b, 0x69, 0x79, 0x51, 0xff, 0x0a, 0x68, 0xa8, 0x6f, 0x7e,
+	0xab, 0x64, 0x0e, 0x5f, 0x43, 0x8e, 0xbf, 0xf7, 0xe8, 0x13, 0xd8, 0x69, 0x39, 0x75, 0xcb, 0x71,
+	0x9b, 0xad, 0xa6, 0x75, 0xe3, 0xf6, 0xbc, 0xc0, 0x04, 0x47, 0xfb, 0xb0, 0x25, 0x58, 0xe7, 0x4d,
+	0xfe, 0x6b, 0xd5, 0x35, 0xc5, 0xd8, 0x9c, 0xcd, 0xcd, 0xd2, 0x35, 0x90, 0x5c, 0x5f, 0x70, 0x52,
+	0x86, 0xbc, 0xbe, 0x34, 0x85, 0xf0, 0x49, 0xe7, 0xed, 0x65, 0x45, 0x79, 0x77, 0x59, 0x51, 0xfe,
+	0xbc, 0xac, 0x28, 0x3f, 0x5f, 0x55, 0x32, 0xef, 0xae, 0x2a, 0x99, 0xf7, 0x57, 0x95, 0xcc, 0xcb,
+	0xa7, 0xfd, 0x90, 0x0d, 0x26, 0xbd, 0xaa, 0x4f, 0x46, 0x35, 0x9f, 0xd0, 0x11, 0xa1, 0xb5, 0xb0,
+	0xe7, 0x3f, 0xea, 0x93, 0xda, 0xf4, 0x69, 0x6d, 0x44, 0x82, 0xc9, 0x10, 0x53, 0xf1, 0x9d, 0xf1,
+	0xf8, 0xcb, 0x47, 0xe9, 0x87, 0x0b, 0xbb, 0x18, 0x63, 0xda, 0xcb, 0xf3, 0x0f, 0x8d, 0x2f, 0xfe,
+	0x1e, 0x00, 0x99, 0xf8, 0xec, 0x2d, 0xd9, 0x08, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -1154,6 +1166,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SynchronousDeliveryChannels) > 0 {
+		for iNdEx := len(m.SynchronousDeliveryChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SynchronousDeliveryChannels[iNdEx])
+			copy(dAtA[i:], m.SynchronousDeliveryChannels[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.SynchronousDeliveryChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1400,6 +1421,12 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.UpgradeTimeout.Size()
 	n += 1 + l + sovChannel(uint64(l))
+	if len(m.SynchronousDeliveryChannels) > 0 {
+		for _, s := range m.SynchronousDeliveryChannels {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2857,6 +2884,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronousDeliveryChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SynchronousDeliveryChannels = append(m.SynchronousDeliveryChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
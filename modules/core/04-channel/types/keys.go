@@ -6,6 +6,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
@@ -31,6 +33,18 @@ const (
 
 	// ParamsKey defines the key to store the params in the keeper.
 	ParamsKey = "channelParams"
+
+	// KeyPacketSendTimePrefix is the key prefix used to store the block time at which
+	// in-flight packets were sent.
+	KeyPacketSendTimePrefix = "packetSendTime"
+
+	// KeyPacketsInFlightPrefix is the key prefix used to store the number of in-flight packets
+	// of a channel.
+	KeyPacketsInFlightPrefix = "packetsInFlight"
+
+	// KeyPendingSynchronousDeliveryPrefix is the key prefix used to store the packets sent on localhost
+	// channels which are pending synchronous delivery.
+	KeyPendingSynchronousDeliveryPrefix = "pendingSynchronousDelivery"
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -64,6 +78,28 @@ func ParseChannelSequence(channelID string) (uint64, error) {
 	return sequence, nil
 }
 
+// PacketSendTimePrefixKey returns the prefix key under which the send times of the in-flight
+// packets of the given channel are stored.
+func PacketSendTimePrefixKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPacketSendTimePrefix, host.ChannelPath(portID, channelID)))
+}
+
+// PacketSendTimeKey returns the key under which the send time of a packet is stored. The sequence
+// is big endian encoded such that the send times of a channel are iterated in sequence order.
+func PacketSendTimeKey(portID, channelID string, sequence uint64) []byte {
+	return append(PacketSendTimePrefixKey(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// PacketsInFlightKey returns the key under which the number of in-flight packets of the given channel is stored.
+func PacketsInFlightKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyPacketsInFlightPrefix, host.ChannelPath(portID, channelID)))
+}
+
+// PendingSynchronousDeliveryKey returns the key under which a packet pending synchronous delivery is stored.
+func PendingSynchronousDeliveryKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", KeyPendingSynchronousDeliveryPrefix, host.ChannelPath(portID, channelID))), sdk.Uint64ToBigEndian(sequence)...)
+}
+
 // FilteredPortPrefix returns the prefix key for the given port prefix.
 func FilteredPortPrefix(portPrefix string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", host.KeyChannelEndPrefix, host.KeyPortPrefix, portPrefix))
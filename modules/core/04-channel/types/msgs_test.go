@@ -1896,6 +1896,27 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
+		{
+			"success: synchronous delivery channels",
+			func() {
+				msg.Params.SynchronousDeliveryChannels = []string{ibctesting.FirstChannelID, "channel-1"}
+			},
+			nil,
+		},
+		{
+			"invalid params: invalid synchronous delivery channel",
+			func() {
+				msg.Params.SynchronousDeliveryChannels = []string{""}
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid params: duplicate synchronous delivery channel",
+			func() {
+				msg.Params.SynchronousDeliveryChannels = []string{ibctesting.FirstChannelID, ibctesting.FirstChannelID}
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
 	}
 
 	for _, tc := range testCases {
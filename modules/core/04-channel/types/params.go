@@ -1,11 +1,13 @@
 package types
 
 import (
+	"slices"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 // DefaultTimeout defines a default parameter for the channel upgrade protocol.
@@ -33,5 +35,23 @@ func (p Params) Validate() error {
 	if p.UpgradeTimeout.Timestamp == 0 {
 		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout timestamp invalid: %v", p.UpgradeTimeout.Timestamp)
 	}
+
+	seen := make(map[string]struct{}, len(p.SynchronousDeliveryChannels))
+	for _, channelID := range p.SynchronousDeliveryChannels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return errorsmod.Wrapf(err, "invalid synchronous delivery channel %s", channelID)
+		}
+		if _, ok := seen[channelID]; ok {
+			return errorsmod.Wrapf(ErrInvalidChannelIdentifier, "duplicate synchronous delivery channel %s", channelID)
+		}
+		seen[channelID] = struct{}{}
+	}
+
 	return nil
 }
+
+// IsSynchronousDeliveryChannel returns true if the channel with the given identifier opted in to
+// synchronous packet delivery.
+func (p Params) IsSynchronousDeliveryChannel(channelID string) bool {
+	return slices.Contains(p.SynchronousDeliveryChannels, channelID)
+}
@@ -12,4 +12,5 @@ var (
 	ErrInvalidProof       = errorsmod.Register(SubModuleName, 2, "invalid proof")
 	ErrInvalidPrefix      = errorsmod.Register(SubModuleName, 3, "invalid prefix")
 	ErrInvalidMerkleProof = errorsmod.Register(SubModuleName, 4, "invalid merkle proof")
+	ErrInvalidProofSpec   = errorsmod.Register(SubModuleName, 5, "invalid proof spec")
 )
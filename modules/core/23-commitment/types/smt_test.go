@@ -0,0 +1,415 @@
+package types_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+)
+
+const (
+	smtStoreName   = "smtStoreKey"
+	otherStoreName = "otherStoreKey"
+)
+
+var smtPlaceholder = make([]byte, sha256.Size)
+
+type smtLeaf struct {
+	key   []byte
+	value []byte
+	path  []byte
+}
+
+// smtStore is a minimal sparse merkle tree test store following the format of ics23.SmtSpec,
+// as implemented by github.com/celestiaorg/smt. Leaves are positioned by the bits of the
+// hashed key, subtrees containing a single leaf are replaced by that leaf and empty subtrees
+// are represented by a placeholder of zero bytes.
+type smtStore struct {
+	leaves map[string]smtLeaf
+}
+
+func newSMTStore() *smtStore {
+	return &smtStore{leaves: make(map[string]smtLeaf)}
+}
+
+func (s *smtStore) Set(key, value []byte) {
+	path := sha256.Sum256(key)
+	s.leaves[string(key)] = smtLeaf{key: key, value: value, path: path[:]}
+}
+
+// sortedLeaves returns the leaves of the tree ordered by their hashed key.
+func (s *smtStore) sortedLeaves() []smtLeaf {
+	leaves := make([]smtLeaf, 0, len(s.leaves))
+	for _, leaf := range s.leaves {
+		leaves = append(leaves, leaf)
+	}
+
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].path, leaves[j].path) < 0
+	})
+
+	return leaves
+}
+
+func (s *smtStore) Root() []byte {
+	return subtreeHash(s.sortedLeaves(), 0)
+}
+
+// GetProof returns an existence proof if the key is present in the tree, otherwise
+// a non-existence proof consisting of existence proofs of the neighbouring leaves.
+func (s *smtStore) GetProof(key []byte) *ics23.CommitmentProof {
+	leaves := s.sortedLeaves()
+	path := sha256.Sum256(key)
+
+	idx := sort.Search(len(leaves), func(i int) bool {
+		return bytes.Compare(leaves[i].path, path[:]) >= 0
+	})
+
+	if idx < len(leaves) && bytes.Equal(leaves[idx].key, key) {
+		return &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{Exist: existenceProof(leaves, leaves[idx])},
+		}
+	}
+
+	nonexist := &ics23.NonExistenceProof{Key: key}
+	if idx > 0 {
+		nonexist.Left = existenceProof(leaves, leaves[idx-1])
+	}
+	if idx < len(leaves) {
+		nonexist.Right = existenceProof(leaves, leaves[idx])
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonexist},
+	}
+}
+
+// splitLeaves returns the index of the first leaf with the bit at the given depth set.
+func splitLeaves(leaves []smtLeaf, depth int) int {
+	return sort.Search(len(leaves), func(i int) bool {
+		return leaves[i].path[depth/8]&(1<<(7-depth%8)) != 0
+	})
+}
+
+func subtreeHash(leaves []smtLeaf, depth int) []byte {
+	switch len(leaves) {
+	case 0:
+		return smtPlaceholder
+	case 1:
+		hash, err := ics23.SmtSpec.LeafSpec.Apply(leaves[0].key, leaves[0].value)
+		if err != nil {
+			panic(err)
+		}
+
+		return hash
+	default:
+		split := splitLeaves(leaves, depth)
+		left, right := subtreeHash(leaves[:split], depth+1), subtreeHash(leaves[split:], depth+1)
+
+		hash := sha256.Sum256(append(append([]byte{1}, left...), right...))
+		return hash[:]
+	}
+}
+
+func existenceProof(leaves []smtLeaf, leaf smtLeaf) *ics23.ExistenceProof {
+	var innerOps []*ics23.InnerOp
+
+	for depth := 0; len(leaves) > 1; depth++ {
+		split := splitLeaves(leaves, depth)
+
+		// inner ops are ordered from the leaf to the root
+		if leaf.path[depth/8]&(1<<(7-depth%8)) == 0 {
+			innerOps = append([]*ics23.InnerOp{{
+				Hash:   ics23.HashOp_SHA256,
+				Prefix: []byte{1},
+				Suffix: subtreeHash(leaves[split:], depth+1),
+			}}, innerOps...)
+			leaves = leaves[:split]
+		} else {
+			innerOps = append([]*ics23.InnerOp{{
+				Hash:   ics23.HashOp_SHA256,
+				Prefix: append([]byte{1}, subtreeHash(leaves[:split], depth+1)...),
+			}}, innerOps...)
+			leaves = leaves[split:]
+		}
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   leaf.key,
+		Value: leaf.value,
+		Leaf: &ics23.LeafOp{
+			Hash:         ics23.SmtSpec.LeafSpec.Hash,
+			PrehashKey:   ics23.SmtSpec.LeafSpec.PrehashKey,
+			PrehashValue: ics23.SmtSpec.LeafSpec.PrehashValue,
+			Length:       ics23.SmtSpec.LeafSpec.Length,
+			Prefix:       ics23.SmtSpec.LeafSpec.Prefix,
+		},
+		Path: innerOps,
+	}
+}
+
+// smtCommitment commits to the root of the SMT test store and to the root of another store
+// using a tendermint simple merkle tree, in the same way as the SDK multistore. It returns
+// the commitment root and the merkle proof for the provided key in the SMT store.
+func (suite *MerkleTestSuite) smtCommitment(store *smtStore, key []byte) ([]byte, types.MerkleProof) {
+	smtRoot := store.Root()
+	otherRoot := sha256.Sum256([]byte("other store root"))
+
+	leafOp := ics23.TendermintSpec.LeafSpec
+	otherLeaf, err := leafOp.Apply([]byte(otherStoreName), otherRoot[:])
+	suite.Require().NoError(err)
+
+	smtLeaf, err := leafOp.Apply([]byte(smtStoreName), smtRoot)
+	suite.Require().NoError(err)
+
+	// store names are sorted, otherStoreKey is the left child of the root
+	innerOp := &ics23.InnerOp{
+		Hash:   ics23.HashOp_SHA256,
+		Prefix: append([]byte{1}, otherLeaf...),
+	}
+
+	root, err := innerOp.Apply(smtLeaf)
+	suite.Require().NoError(err)
+
+	storeProof := &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Exist{
+			Exist: &ics23.ExistenceProof{
+				Key:   []byte(smtStoreName),
+				Value: smtRoot,
+				Leaf:  leafOp,
+				Path:  []*ics23.InnerOp{innerOp},
+			},
+		},
+	}
+
+	return root, types.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{store.GetProof(key), storeProof},
+	}
+}
+
+// absentKey returns a key which is not present in the store and for which the
+// provided function returns true when given the hashed key.
+func (suite *MerkleTestSuite) absentKey(store *smtStore, fn func(path []byte) bool) []byte {
+	for i := 0; ; i++ {
+		key := []byte(fmt.Sprintf("absent-%d", i))
+		path := sha256.Sum256(key)
+		if _, found := store.leaves[string(key)]; !found && fn(path[:]) {
+			return key
+		}
+	}
+}
+
+func (suite *MerkleTestSuite) newSMTStore() *smtStore {
+	store := newSMTStore()
+	for i := 0; i < 20; i++ {
+		store.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i)))
+	}
+
+	store.Set([]byte("MYKEY"), []byte("MYVALUE"))
+
+	return store
+}
+
+func (suite *MerkleTestSuite) TestVerifyMembershipSMT() {
+	var (
+		specs []*ics23.ProofSpec
+		path  [][]byte
+		value []byte
+		proof types.MerkleProof
+		root  []byte
+	)
+
+	store := suite.newSMTStore()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: mixed spec stack with SMT stores committed to by an SMT",
+			func() {
+				// commit to the store root in a second SMT rather than a tendermint simple merkle tree
+				rootStore := newSMTStore()
+				rootStore.Set([]byte(smtStoreName), store.Root())
+				rootStore.Set([]byte(otherStoreName), []byte("other store root"))
+
+				root = rootStore.Root()
+				proof.Proofs[1] = rootStore.GetProof([]byte(smtStoreName))
+				specs = []*ics23.ProofSpec{ics23.SmtSpec, ics23.SmtSpec}
+			},
+			true,
+		},
+		{
+			"wrong value",
+			func() {
+				value = []byte("WRONGVALUE")
+			},
+			false,
+		},
+		{
+			"wrong key",
+			func() {
+				path = [][]byte{[]byte(smtStoreName), []byte("key-1")}
+			},
+			false,
+		},
+		{
+			"wrong store key",
+			func() {
+				path = [][]byte{[]byte(otherStoreName), []byte("MYKEY")}
+			},
+			false,
+		},
+		{
+			"wrong root",
+			func() {
+				root = []byte("WRONGROOT")
+			},
+			false,
+		},
+		{
+			"SDK proof specs",
+			func() {
+				specs = types.GetSDKSpecs()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			specs = types.GetSMTSpecs()
+			path = [][]byte{[]byte(smtStoreName), []byte("MYKEY")}
+			value = []byte("MYVALUE")
+			root, proof = suite.smtCommitment(store, []byte("MYKEY"))
+
+			tc.malleate()
+
+			merkleRoot := types.NewMerkleRoot(root)
+			err := proof.VerifyMembership(specs, &merkleRoot, types.NewMerklePath(path...), value)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidProof)
+			}
+		})
+	}
+}
+
+func (suite *MerkleTestSuite) TestVerifyNonMembershipSMT() {
+	var (
+		specs    []*ics23.ProofSpec
+		key      []byte
+		proofKey []byte
+		root     []byte
+	)
+
+	store := suite.newSMTStore()
+	leaves := store.sortedLeaves()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: absent key between two leaves",
+			func() {},
+			true,
+		},
+		{
+			"success: absent key left of all leaves",
+			func() {
+				key = suite.absentKey(store, func(path []byte) bool {
+					return bytes.Compare(path, leaves[0].path) < 0
+				})
+			},
+			true,
+		},
+		{
+			"success: absent key right of all leaves",
+			func() {
+				key = suite.absentKey(store, func(path []byte) bool {
+					return bytes.Compare(path, leaves[len(leaves)-1].path) > 0
+				})
+			},
+			true,
+		},
+		{
+			"existing key",
+			func() {
+				key = []byte("MYKEY")
+			},
+			false,
+		},
+		{
+			"proof for different absent key",
+			func() {
+				proofKey = suite.absentKey(store, func(path []byte) bool {
+					return bytes.Compare(path, leaves[0].path) < 0
+				})
+			},
+			false,
+		},
+		{
+			"wrong root",
+			func() {
+				root = []byte("WRONGROOT")
+			},
+			false,
+		},
+		{
+			"SDK proof specs",
+			func() {
+				specs = types.GetSDKSpecs()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			specs = types.GetSMTSpecs()
+			key = suite.absentKey(store, func(path []byte) bool {
+				return bytes.Compare(path, leaves[0].path) > 0 && bytes.Compare(path, leaves[len(leaves)-1].path) < 0
+			})
+			proofKey = nil
+			root = nil
+
+			tc.malleate()
+
+			if proofKey == nil {
+				proofKey = key
+			}
+
+			commitmentRoot, proof := suite.smtCommitment(store, proofKey)
+			if root == nil {
+				root = commitmentRoot
+			}
+
+			merkleRoot := types.NewMerkleRoot(root)
+			err := proof.VerifyNonMembership(specs, &merkleRoot, types.NewMerklePath([]byte(smtStoreName), key))
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidProof)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package types
+
+import (
+	ics23 "github.com/cosmos/ics23/go"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// var representing the proofspecs for a chain using a sparse merkle tree (SMT) for its stores
+var smtSpecs = []*ics23.ProofSpec{ics23.SmtSpec, ics23.TendermintSpec}
+
+// GetSMTSpecs is a getter function for the proofspecs of a chain which commits to the state of each
+// store using a sparse merkle tree (SMT) and to the store roots using a tendermint simple merkle tree.
+// The SMT spec supports absence proofs by comparing the hashed keys of the neighbouring leaves.
+func GetSMTSpecs() []*ics23.ProofSpec {
+	return smtSpecs
+}
+
+// ValidateProofSpecs performs basic validation of a stack of proof specs. The specs are ordered
+// from the lowest subtree to the root, as expected by MerkleProof verification. Each spec is
+// validated independently, allowing different commitment schemes to be combined, for example
+// an SMT store committed to by a tendermint simple merkle tree.
+func ValidateProofSpecs(specs []*ics23.ProofSpec) error {
+	if len(specs) == 0 {
+		return errorsmod.Wrap(ErrInvalidProofSpec, "proof specs cannot be empty")
+	}
+
+	for i, spec := range specs {
+		if err := ValidateProofSpec(spec); err != nil {
+			return errorsmod.Wrapf(err, "proof spec at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateProofSpec performs basic validation of a single proof spec. It ensures that the
+// leaf and inner node formats are fully defined and internally consistent so that proofs
+// can be verified against the spec.
+func ValidateProofSpec(spec *ics23.ProofSpec) error {
+	if spec == nil {
+		return errorsmod.Wrap(ErrInvalidProofSpec, "proof spec cannot be nil")
+	}
+
+	if err := validateLeafSpec(spec.LeafSpec); err != nil {
+		return err
+	}
+
+	if err := validateInnerSpec(spec.InnerSpec); err != nil {
+		return err
+	}
+
+	if spec.MinDepth < 0 || spec.MaxDepth < 0 {
+		return errorsmod.Wrapf(ErrInvalidProofSpec, "depth cannot be negative (min depth: %d, max depth: %d)", spec.MinDepth, spec.MaxDepth)
+	}
+
+	if spec.MaxDepth > 0 && spec.MinDepth > spec.MaxDepth {
+		return errorsmod.Wrapf(ErrInvalidProofSpec, "min depth (%d) cannot be greater than max depth (%d)", spec.MinDepth, spec.MaxDepth)
+	}
+
+	// keys may only be compared after hashing if the leaf commits to the hashed key
+	if spec.PrehashKeyBeforeComparison && spec.LeafSpec.PrehashKey == ics23.HashOp_NO_HASH {
+		return errorsmod.Wrap(ErrInvalidProofSpec, "prehash key before comparison requires leaf spec to prehash key")
+	}
+
+	return nil
+}
+
+// validateLeafSpec validates the leaf operation of a proof spec.
+func validateLeafSpec(leaf *ics23.LeafOp) error {
+	if leaf == nil {
+		return errorsmod.Wrap(ErrInvalidProofSpec, "leaf spec cannot be nil")
+	}
+
+	if err := validateHashOp(leaf.Hash, false); err != nil {
+		return errorsmod.Wrap(err, "leaf spec hash")
+	}
+
+	if err := validateHashOp(leaf.PrehashKey, true); err != nil {
+		return errorsmod.Wrap(err, "leaf spec prehash key")
+	}
+
+	if err := validateHashOp(leaf.PrehashValue, true); err != nil {
+		return errorsmod.Wrap(err, "leaf spec prehash value")
+	}
+
+	if _, ok := ics23.LengthOp_name[int32(leaf.Length)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidProofSpec, "leaf spec has unknown length operation %d", leaf.Length)
+	}
+
+	// the leaf prefix distinguishes leaf nodes from inner nodes
+	if len(leaf.Prefix) == 0 {
+		return errorsmod.Wrap(ErrInvalidProofSpec, "leaf spec prefix cannot be empty")
+	}
+
+	return nil
+}
+
+// validateInnerSpec validates the inner node specification of a proof spec.
+func validateInnerSpec(inner *ics23.InnerSpec) error {
+	if inner == nil {
+		return errorsmod.Wrap(ErrInvalidProofSpec, "inner spec cannot be nil")
+	}
+
+	if err := validateHashOp(inner.Hash, false); err != nil {
+		return errorsmod.Wrap(err, "inner spec hash")
+	}
+
+	if len(inner.ChildOrder) < 2 {
+		return errorsmod.Wrapf(ErrInvalidProofSpec, "inner spec must have at least 2 children, got %d", len(inner.ChildOrder))
+	}
+
+	// child order must be a permutation of [0, len(child order))
+	seen := make([]bool, len(inner.ChildOrder))
+	for _, child := range inner.ChildOrder {
+		if child < 0 || int(child) >= len(inner.ChildOrder) || seen[child] {
+			return errorsmod.Wrapf(ErrInvalidProofSpec, "inner spec child order %v is not a permutation of its indices", inner.ChildOrder)
+		}
+		seen[child] = true
+	}
+
+	if inner.ChildSize <= 0 {
+		return errorsmod.Wrapf(ErrInvalidProofSpec, "inner spec child size must be positive, got %d", inner.ChildSize)
+	}
+
+	if inner.MinPrefixLength < 0 || inner.MaxPrefixLength < inner.MinPrefixLength {
+		return errorsmod.Wrapf(ErrInvalidProofSpec, "invalid inner spec prefix length bounds (min: %d, max: %d)", inner.MinPrefixLength, inner.MaxPrefixLength)
+	}
+
+	if len(inner.EmptyChild) != 0 && len(inner.EmptyChild) != int(inner.ChildSize) {
+		return errorsmod.Wrapf(ErrInvalidProofSpec, "inner spec empty child length (%d) must equal child size (%d)", len(inner.EmptyChild), inner.ChildSize)
+	}
+
+	return nil
+}
+
+// validateHashOp returns an error if the hash operation is unknown, or if no hash
+// is used when it is not allowed.
+func validateHashOp(hashOp ics23.HashOp, allowNoHash bool) error {
+	if _, ok := ics23.HashOp_name[int32(hashOp)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidProofSpec, "unknown hash operation %d", hashOp)
+	}
+
+	if !allowNoHash && hashOp == ics23.HashOp_NO_HASH {
+		return errorsmod.Wrap(ErrInvalidProofSpec, "hash operation cannot be NO_HASH")
+	}
+
+	return nil
+}
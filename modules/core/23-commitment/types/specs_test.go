@@ -0,0 +1,183 @@
+package types_test
+
+import (
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+)
+
+// copySpec returns a deep copy of the provided proof spec which may be safely modified.
+func copySpec(spec *ics23.ProofSpec) *ics23.ProofSpec {
+	leaf := *spec.LeafSpec
+	inner := *spec.InnerSpec
+	inner.ChildOrder = append([]int32(nil), spec.InnerSpec.ChildOrder...)
+
+	specCopy := *spec
+	specCopy.LeafSpec = &leaf
+	specCopy.InnerSpec = &inner
+
+	return &specCopy
+}
+
+func TestValidateProofSpecs(t *testing.T) {
+	var specs []*ics23.ProofSpec
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: SDK specs",
+			func() {},
+			nil,
+		},
+		{
+			"success: SMT specs",
+			func() {
+				specs = types.GetSMTSpecs()
+			},
+			nil,
+		},
+		{
+			"success: mixed specs",
+			func() {
+				specs = []*ics23.ProofSpec{ics23.SmtSpec, ics23.IavlSpec, ics23.TendermintSpec}
+			},
+			nil,
+		},
+		{
+			"failure: specs are empty",
+			func() {
+				specs = nil
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: spec is nil",
+			func() {
+				specs = []*ics23.ProofSpec{ics23.SmtSpec, nil}
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: leaf spec is nil",
+			func() {
+				specs[0].LeafSpec = nil
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: inner spec is nil",
+			func() {
+				specs[1].InnerSpec = nil
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: leaf hash is NO_HASH",
+			func() {
+				specs[0].LeafSpec.Hash = ics23.HashOp_NO_HASH
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: unknown leaf prehash value operation",
+			func() {
+				specs[0].LeafSpec.PrehashValue = ics23.HashOp(100)
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: unknown leaf length operation",
+			func() {
+				specs[0].LeafSpec.Length = ics23.LengthOp(100)
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: leaf prefix is empty",
+			func() {
+				specs[0].LeafSpec.Prefix = nil
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: inner hash is NO_HASH",
+			func() {
+				specs[1].InnerSpec.Hash = ics23.HashOp_NO_HASH
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: inner spec has a single child",
+			func() {
+				specs[0].InnerSpec.ChildOrder = []int32{0}
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: child order is not a permutation",
+			func() {
+				specs[0].InnerSpec.ChildOrder = []int32{1, 1}
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: child size is zero",
+			func() {
+				specs[0].InnerSpec.ChildSize = 0
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: max prefix length less than min prefix length",
+			func() {
+				specs[0].InnerSpec.MaxPrefixLength = specs[0].InnerSpec.MinPrefixLength - 1
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: empty child length does not match child size",
+			func() {
+				specs[0].InnerSpec.EmptyChild = []byte{0}
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: min depth greater than max depth",
+			func() {
+				specs[0].MinDepth = 10
+				specs[0].MaxDepth = 5
+			},
+			types.ErrInvalidProofSpec,
+		},
+		{
+			"failure: prehash key before comparison without prehashing key",
+			func() {
+				specs[0].PrehashKeyBeforeComparison = true
+			},
+			types.ErrInvalidProofSpec,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			specs = []*ics23.ProofSpec{copySpec(ics23.IavlSpec), copySpec(ics23.TendermintSpec)}
+
+			tc.malleate()
+
+			err := types.ValidateProofSpecs(specs)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/ante"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v9/modules/light-clients/09-localhost"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -641,3 +643,102 @@ func (suite *AnteTestSuite) TestAnteDecoratorReCheckTx() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestSynchronousDeliveryDecorator() {
+	var (
+		channelIDA string
+		channelIDB string
+		expAcked   bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: transfer is received and acknowledged within the transaction",
+			func() {},
+		},
+		{
+			"success: channel did not opt in to synchronous delivery",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.SynchronousDeliveryChannels = nil
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expAcked = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			channelIDA, channelIDB = suite.openLocalhostTransferChannel()
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.SynchronousDeliveryChannels = []string{channelIDA}
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			expAcked = true
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+			msg := transfertypes.NewMsgTransfer(transfertypes.PortID, channelIDA, sdk.NewCoins(coin), sender.String(), receiver.String(), clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), "", nil)
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), transfertypes.PortID, channelIDA, 1)
+			suite.Require().Equal(expAcked, commitment == nil)
+
+			voucherDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, channelIDB)).IBCDenom()
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, voucherDenom)
+			if expAcked {
+				suite.Require().Equal(coin.Amount, balance.Amount)
+			} else {
+				suite.Require().True(balance.IsZero())
+			}
+
+			suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPendingSynchronousDeliveries(suite.chainA.GetContext()))
+		})
+	}
+}
+
+// openLocalhostTransferChannel opens a transfer channel on chainA using the localhost connection and returns
+// the channel identifiers of the initializing and the counterparty channel ends.
+func (suite *AnteTestSuite) openLocalhostTransferChannel() (string, string) {
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+	connectionHops := []string{exported.LocalhostConnectionID}
+	proofHeight := clienttypes.GetSelfHeight(suite.chainA.GetContext())
+
+	msgInit := channeltypes.NewMsgChannelOpenInit(transfertypes.PortID, transfertypes.V1, channeltypes.UNORDERED, connectionHops, transfertypes.PortID, signer)
+	res, err := suite.chainA.SendMsgs(msgInit)
+	suite.Require().NoError(err)
+
+	channelIDA, err := ibctesting.ParseChannelIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	msgTry := channeltypes.NewMsgChannelOpenTry(transfertypes.PortID, transfertypes.V1, channeltypes.UNORDERED, connectionHops, transfertypes.PortID, channelIDA, transfertypes.V1, localhost.SentinelProof, proofHeight, signer)
+	res, err = suite.chainA.SendMsgs(msgTry)
+	suite.Require().NoError(err)
+
+	channelIDB, err := ibctesting.ParseChannelIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	msgAck := channeltypes.NewMsgChannelOpenAck(transfertypes.PortID, channelIDA, channelIDB, transfertypes.V1, localhost.SentinelProof, proofHeight, signer)
+	_, err = suite.chainA.SendMsgs(msgAck)
+	suite.Require().NoError(err)
+
+	msgConfirm := channeltypes.NewMsgChannelOpenConfirm(transfertypes.PortID, channelIDB, localhost.SentinelProof, proofHeight, signer)
+	_, err = suite.chainA.SendMsgs(msgConfirm)
+	suite.Require().NoError(err)
+
+	return channelIDA, channelIDB
+}
@@ -0,0 +1,28 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/keeper"
+)
+
+type SynchronousDeliveryDecorator struct {
+	k *keeper.Keeper
+}
+
+func NewSynchronousDeliveryDecorator(k *keeper.Keeper) SynchronousDeliveryDecorator {
+	return SynchronousDeliveryDecorator{k: k}
+}
+
+// PostHandle delivers the packets sent by the transaction on localhost channels which opted in to synchronous
+// delivery. Packets are only delivered if all messages of the transaction executed successfully, and the post
+// handler returns an error if the delivery of any packet fails, such that all state changes of the transaction are reverted.
+func (sdd SynchronousDeliveryDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if success {
+		if err := sdd.k.ChannelKeeper.DeliverPendingPackets(ctx); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -122,6 +122,15 @@ type LightClientModule interface {
 	) error
 }
 
+// UpgradePlanHandler is an optional interface which may be implemented by light client modules
+// to be notified when an IBC software upgrade plan is scheduled on the chain. The upgradedClientState
+// is the protobuf encoded client state which counterparty clients will be upgraded to.
+// Light client modules are responsible for handling any failures of their clients, the notification
+// of other light client modules and the scheduling of the plan are not affected by them.
+type UpgradePlanHandler interface {
+	OnUpgradePlanScheduled(ctx sdk.Context, planName string, planHeight int64, upgradedClientState []byte)
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -1,6 +1,8 @@
 package telemetry
 
 import (
+	"time"
+
 	metrics "github.com/hashicorp/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -34,6 +36,32 @@ func ReportAcknowledgePacket(packet types.Packet) {
 	)
 }
 
+// ReportPacketLatency reports the latency in milliseconds between the block time at which a packet
+// was sent and the given stage of the packet lifecycle.
+func ReportPacketLatency(packet types.Packet, stage string, latency time.Duration) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels(
+		[]string{"ibc", "packet", "latency", stage},
+		float32(latency.Milliseconds()),
+		addPacketLabels(packet),
+	)
+}
+
+// ReportPacketBacklog reports the number of in-flight packets and the age in milliseconds
+// of the oldest unacknowledged packet of a channel.
+func ReportPacketBacklog(portID, channelID string, inFlight uint64, oldestPacketAge time.Duration) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(ibcmetrics.LabelPortID, portID),
+		telemetry.NewLabel(ibcmetrics.LabelChannelID, channelID),
+	}
+
+	telemetry.SetGaugeWithLabels([]string{"ibc", "channel", "packets", "in_flight"}, float32(inFlight), labels)
+	telemetry.SetGaugeWithLabels([]string{"ibc", "channel", "packets", "oldest_age"}, float32(oldestPacketAge.Milliseconds()), labels)
+}
+
 func addPacketLabels(packet types.Packet) []metrics.Label {
 	return []metrics.Label{
 		telemetry.NewLabel(ibcmetrics.LabelSourcePort, packet.SourcePort),
@@ -1,9 +1,13 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
-// ConvertToErrorEvents is a wrapper around convertToErrorEvents
+	coretypes "github.com/cosmos/ibc-go/v9/modules/core/types"
+)
+
+// ConvertToErrorEvents is a wrapper around coretypes.ConvertToErrorEvents
 // to allow the function to be directly called in tests.
 func ConvertToErrorEvents(events sdk.Events) sdk.Events {
-	return convertToErrorEvents(events)
+	return coretypes.ConvertToErrorEvents(events)
 }
@@ -28,6 +28,8 @@ type Keeper struct {
 
 	cdc codec.BinaryCodec
 
+	relayerHooks types.RelayerHooks
+
 	authority string
 }
 
@@ -92,6 +94,18 @@ func (k *Keeper) SetRouter(rtr *porttypes.Router) {
 
 	k.PortKeeper.Router = rtr
 	k.PortKeeper.Router.Seal()
+
+	k.ChannelKeeper.SetRouter(rtr)
+}
+
+// SetRelayerHooks sets the RelayerHooks invoked after the successful execution of client updates and
+// channel handshakes. The method panics if the hooks have already been set.
+func (k *Keeper) SetRelayerHooks(hooks types.RelayerHooks) {
+	if k.relayerHooks != nil {
+		panic(errors.New("cannot set relayer hooks twice"))
+	}
+
+	k.relayerHooks = hooks
 }
 
 // GetAuthority returns the ibc module's authority.
@@ -55,10 +55,24 @@ func (k *Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateC
 		return nil, err
 	}
 
+	// the latest height and timestamp must be retrieved prior to the update as the consensus state may be pruned
+	var (
+		prevHeight    clienttypes.Height
+		prevTimestamp uint64
+	)
+	if k.relayerHooks != nil {
+		prevHeight = k.ClientKeeper.GetClientLatestHeight(ctx, msg.ClientId)
+		prevTimestamp, _ = k.ClientKeeper.GetClientTimestampAtHeight(ctx, msg.ClientId, prevHeight)
+	}
+
 	if err = k.ClientKeeper.UpdateClient(ctx, msg.ClientId, clientMsg); err != nil {
 		return nil, err
 	}
 
+	if k.relayerHooks != nil {
+		k.afterClientUpdated(ctx, msg.ClientId, prevHeight, prevTimestamp, msg.Signer)
+	}
+
 	return &clienttypes.MsgUpdateClientResponse{}, nil
 }
 
@@ -333,6 +347,10 @@ func (k *Keeper) ChannelOpenAck(goCtx context.Context, msg *channeltypes.MsgChan
 
 	ctx.Logger().Info("channel open ack succeeded", "channel-id", msg.ChannelId, "port-id", msg.PortId)
 
+	if k.relayerHooks != nil {
+		k.afterChannelOpened(ctx, msg.PortId, msg.ChannelId, msg.Signer)
+	}
+
 	return &channeltypes.MsgChannelOpenAckResponse{}, nil
 }
 
@@ -373,6 +391,10 @@ func (k *Keeper) ChannelOpenConfirm(goCtx context.Context, msg *channeltypes.Msg
 
 	ctx.Logger().Info("channel open confirm succeeded", "channel-id", msg.ChannelId, "port-id", msg.PortId)
 
+	if k.relayerHooks != nil {
+		k.afterChannelOpened(ctx, msg.PortId, msg.ChannelId, msg.Signer)
+	}
+
 	return &channeltypes.MsgChannelOpenConfirmResponse{}, nil
 }
 
@@ -497,7 +519,7 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 		writeFn()
 	} else {
 		// Modify events in cached context to reflect unsuccessful acknowledgement
-		ctx.EventManager().EmitEvents(convertToErrorEvents(cacheCtx.EventManager().Events()))
+		ctx.EventManager().EmitEvents(coretypes.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
 	}
 
 	// Set packet acknowledgement only if the acknowledgement is not nil.
@@ -1102,22 +1124,32 @@ func (k *Keeper) UpdateChannelParams(goCtx context.Context, msg *channeltypes.Ms
 	return &channeltypes.MsgUpdateParamsResponse{}, nil
 }
 
-// convertToErrorEvents converts all events to error events by appending the
-// error attribute prefix to each event's attribute key.
-func convertToErrorEvents(events sdk.Events) sdk.Events {
-	if events == nil {
-		return nil
+// afterClientUpdated invokes the AfterClientUpdated relayer hook if the client update advanced the latest height of the client.
+func (k *Keeper) afterClientUpdated(ctx sdk.Context, clientID string, prevHeight clienttypes.Height, prevTimestamp uint64, signer string) {
+	relayer, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return
 	}
 
-	newEvents := make(sdk.Events, len(events))
-	for i, event := range events {
-		newAttributes := make([]sdk.Attribute, len(event.Attributes))
-		for j, attribute := range event.Attributes {
-			newAttributes[j] = sdk.NewAttribute(coretypes.ErrorAttributeKeyPrefix+attribute.Key, attribute.Value)
-		}
+	latestHeight := k.ClientKeeper.GetClientLatestHeight(ctx, clientID)
+	if !latestHeight.GT(prevHeight) {
+		return
+	}
+
+	k.relayerHooks.AfterClientUpdated(ctx, clientID, prevHeight, prevTimestamp, latestHeight, relayer)
+}
 
-		newEvents[i] = sdk.NewEvent(coretypes.ErrorAttributeKeyPrefix+event.Type, newAttributes...)
+// afterChannelOpened invokes the AfterChannelOpened relayer hook for the client underlying the opened channel.
+func (k *Keeper) afterChannelOpened(ctx sdk.Context, portID, channelID, signer string) {
+	relayer, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return
+	}
+
+	clientID, _, err := k.ChannelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return
 	}
 
-	return newEvents
+	k.relayerHooks.AfterChannelOpened(ctx, clientID, portID, channelID, relayer)
 }
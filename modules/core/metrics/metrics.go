@@ -16,6 +16,22 @@ const (
 	LabelDestinationPort    = "destination_port"
 	LabelDestinationChannel = "destination_channel"
 	LabelTimeoutType        = "timeout_type"
+	LabelRefundType         = "refund_type"
 	LabelDenom              = "denom"
 	LabelSource             = "source"
+
+	// 04-channel labels
+
+	LabelPortID    = "port_id"
+	LabelChannelID = "channel_id"
+
+	// 27-interchain-accounts labels
+
+	LabelConnectionID = "connection_id"
+	LabelSuccess      = "success"
+
+	// 08-wasm labels
+
+	LabelChecksum   = "checksum"
+	LabelEntryPoint = "entry_point"
 )
@@ -3,9 +3,22 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
 // ParamSubspace defines the expected Subspace interface for module parameters.
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 }
+
+// RelayerHooks defines the hooks invoked by the core IBC message server after the successful execution of the
+// client updates and channel handshakes submitted by relayers, allowing applications to incentivize them.
+// The hooks cannot fail the execution of the message.
+type RelayerHooks interface {
+	// AfterClientUpdated is called after a client update advances the latest height of a client.
+	// The latest height of the client and the timestamp of its consensus state prior to the update are provided.
+	AfterClientUpdated(ctx sdk.Context, clientID string, prevHeight exported.Height, prevTimestamp uint64, latestHeight exported.Height, relayer sdk.AccAddress)
+	// AfterChannelOpened is called after a channel handshake is completed by a MsgChannelOpenAck or a MsgChannelOpenConfirm.
+	AfterChannelOpened(ctx sdk.Context, clientID, portID, channelID string, relayer sdk.AccAddress)
+}
@@ -5,7 +5,6 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
@@ -56,7 +55,7 @@ func (cs *ClientState) VerifyMembership(
 	path exported.Path,
 	value []byte,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, proof)
 	if err != nil {
 		return err
 	}
@@ -89,7 +88,7 @@ func (cs *ClientState) VerifyMembership(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
@@ -112,7 +111,7 @@ func (cs *ClientState) VerifyNonMembership(
 	proof []byte,
 	path exported.Path,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, proof)
 	if err != nil {
 		return err
 	}
@@ -145,7 +144,7 @@ func (cs *ClientState) VerifyNonMembership(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
@@ -157,43 +156,37 @@ func (cs *ClientState) VerifyNonMembership(
 }
 
 // produceVerificationArgs performs the basic checks on the arguments that are
-// shared between the verification functions and returns the public key of the
-// consensus state, the unmarshalled proof representing the signature and timestamp.
+// shared between the verification functions and returns the unmarshalled proof
+// representing the signature, the timestamp and the current sequence.
 func produceVerificationArgs(
 	cdc codec.BinaryCodec,
 	cs *ClientState,
 	proof []byte,
-) (cryptotypes.PubKey, signing.SignatureData, uint64, uint64, error) {
+) (signing.SignatureData, uint64, uint64, error) {
 	if proof == nil {
-		return nil, nil, 0, 0, errorsmod.Wrap(ErrInvalidProof, "proof cannot be empty")
+		return nil, 0, 0, errorsmod.Wrap(ErrInvalidProof, "proof cannot be empty")
 	}
 
 	var timestampedSigData TimestampedSignatureData
 	if err := cdc.Unmarshal(proof, &timestampedSigData); err != nil {
-		return nil, nil, 0, 0, errorsmod.Wrapf(err, "failed to unmarshal proof into type %T", timestampedSigData)
+		return nil, 0, 0, errorsmod.Wrapf(err, "failed to unmarshal proof into type %T", timestampedSigData)
 	}
 
 	timestamp := timestampedSigData.Timestamp
 	if len(timestampedSigData.SignatureData) == 0 {
-		return nil, nil, 0, 0, errorsmod.Wrap(ErrInvalidProof, "signature data cannot be empty")
+		return nil, 0, 0, errorsmod.Wrap(ErrInvalidProof, "signature data cannot be empty")
 	}
 
 	sigData, err := UnmarshalSignatureData(cdc, timestampedSigData.SignatureData)
 	if err != nil {
-		return nil, nil, 0, 0, err
+		return nil, 0, 0, err
 	}
 
 	if cs.ConsensusState.GetTimestamp() > timestamp {
-		return nil, nil, 0, 0, errorsmod.Wrapf(ErrInvalidProof, "the consensus state timestamp is greater than the signature timestamp (%d >= %d)", cs.ConsensusState.GetTimestamp(), timestamp)
+		return nil, 0, 0, errorsmod.Wrapf(ErrInvalidProof, "the consensus state timestamp is greater than the signature timestamp (%d >= %d)", cs.ConsensusState.GetTimestamp(), timestamp)
 	}
 
-	sequence := cs.Sequence
-	publicKey, err := cs.ConsensusState.GetPubKey()
-	if err != nil {
-		return nil, nil, 0, 0, err
-	}
-
-	return publicKey, sigData, timestamp, sequence, nil
+	return sigData, timestamp, cs.Sequence, nil
 }
 
 // sets the client state to the store
@@ -35,22 +35,22 @@ func (suite *SoloMachineTestSuite) TestClientStateValidate() {
 			},
 			{
 				"sequence is zero",
-				solomachine.NewClientState(0, &solomachine.ConsensusState{sm.ConsensusState().PublicKey, sm.Diversifier, sm.Time}),
+				solomachine.NewClientState(0, &solomachine.ConsensusState{sm.ConsensusState().PublicKey, sm.Diversifier, sm.Time, nil}),
 				false,
 			},
 			{
 				"timestamp is zero",
-				solomachine.NewClientState(1, &solomachine.ConsensusState{sm.ConsensusState().PublicKey, sm.Diversifier, 0}),
+				solomachine.NewClientState(1, &solomachine.ConsensusState{sm.ConsensusState().PublicKey, sm.Diversifier, 0, nil}),
 				false,
 			},
 			{
 				"diversifier is blank",
-				solomachine.NewClientState(1, &solomachine.ConsensusState{sm.ConsensusState().PublicKey, "  ", 1}),
+				solomachine.NewClientState(1, &solomachine.ConsensusState{sm.ConsensusState().PublicKey, "  ", 1, nil}),
 				false,
 			},
 			{
 				"pubkey is empty",
-				solomachine.NewClientState(1, &solomachine.ConsensusState{nil, sm.Diversifier, sm.Time}),
+				solomachine.NewClientState(1, &solomachine.ConsensusState{nil, sm.Diversifier, sm.Time, nil}),
 				false,
 			},
 		}
@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
@@ -48,6 +49,18 @@ func (cs ConsensusState) ValidateBasic() error {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "diversifier cannot contain only spaces")
 	}
 
+	if cs.SignerSet != nil {
+		if cs.PublicKey != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "public key must be empty when a signer set is provided")
+		}
+
+		if err := cs.SignerSet.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+		}
+
+		return nil
+	}
+
 	publicKey, err := cs.GetPubKey()
 	if err != nil || publicKey == nil || len(publicKey.Bytes()) == 0 {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "public key cannot be empty")
@@ -55,3 +68,19 @@ func (cs ConsensusState) ValidateBasic() error {
 
 	return nil
 }
+
+// VerifySignature verifies that the provided signature data was produced over the sign bytes
+// by the solo machine. If the consensus state contains a signer set the signature must be
+// produced by a quorum of the signer set, otherwise the public key is used for verification.
+func (cs ConsensusState) VerifySignature(signBytes []byte, sigData signing.SignatureData) error {
+	if cs.SignerSet != nil {
+		return cs.SignerSet.VerifySignature(signBytes, sigData)
+	}
+
+	publicKey, err := cs.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	return VerifySignature(publicKey, signBytes, sigData)
+}
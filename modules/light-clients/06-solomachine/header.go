@@ -38,7 +38,8 @@ func (h Header) GetPubKey() (cryptotypes.PubKey, error) {
 }
 
 // ValidateBasic ensures that the timestamp, signature and public key have all
-// been initialized.
+// been initialized. If a new signer set or a signer key rotation is provided it
+// is validated in place of the public key.
 func (h Header) ValidateBasic() error {
 	if h.Timestamp == 0 {
 		return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "timestamp cannot be zero")
@@ -52,6 +53,30 @@ func (h Header) ValidateBasic() error {
 		return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "signature cannot be empty")
 	}
 
+	if h.SignerKeyRotation != nil {
+		if h.NewPublicKey != nil || h.NewSignerSet != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "new public key and signer set must be empty when a signer key rotation is provided")
+		}
+
+		if err := h.SignerKeyRotation.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidHeader, err.Error())
+		}
+
+		return nil
+	}
+
+	if h.NewSignerSet != nil {
+		if h.NewPublicKey != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "new public key must be empty when a new signer set is provided")
+		}
+
+		if err := h.NewSignerSet.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(clienttypes.ErrInvalidHeader, err.Error())
+		}
+
+		return nil
+	}
+
 	newPublicKey, err := h.GetPubKey()
 	if err != nil || newPublicKey == nil || len(newPublicKey.Bytes()) == 0 {
 		return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "new public key cannot be empty")
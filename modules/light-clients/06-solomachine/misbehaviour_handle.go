@@ -36,8 +36,8 @@ func (cs ClientState) verifyMisbehaviour(cdc codec.BinaryCodec, misbehaviour *Mi
 	return nil
 }
 
-// verifySignatureAndData verifies that the currently registered public key has signed
-// over the provided data and that the data is valid. The data is valid if it can be
+// verifySignatureAndData verifies that the currently registered public key (or a quorum
+// of the current signer set) has signed over the provided data and that the data is valid. The data is valid if it can be
 // unmarshaled into the specified data type.
 func (cs ClientState) verifySignatureAndData(cdc codec.BinaryCodec, misbehaviour *Misbehaviour, sigAndData *SignatureAndData) error {
 	// do not check misbehaviour timestamp since we want to allow processing of past misbehaviour
@@ -63,10 +63,5 @@ func (cs ClientState) verifySignatureAndData(cdc codec.BinaryCodec, misbehaviour
 		return err
 	}
 
-	publicKey, err := cs.ConsensusState.GetPubKey()
-	if err != nil {
-		return err
-	}
-
-	return VerifySignature(publicKey, data, sigData)
+	return cs.ConsensusState.VerifySignature(data, sigData)
 }
@@ -18,8 +18,8 @@ import (
 // It will update the consensus state to the substitute's consensus state and
 // the sequence to the substitute's current sequence. An error is returned if
 // the client has been disallowed to be updated by a governance proposal,
-// the substitute is not a solo machine, or the current public key equals
-// the new public key.
+// the substitute is not a solo machine, or the current public key (or signer
+// set) equals the new public key (or signer set).
 func (cs ClientState) CheckSubstituteAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
 	_ storetypes.KVStore, substituteClient exported.ClientState,
@@ -29,18 +29,24 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "substitute client state type %T, expected  %T", substituteClient, &ClientState{})
 	}
 
-	subjectPublicKey, err := cs.ConsensusState.GetPubKey()
-	if err != nil {
-		return errorsmod.Wrap(err, "failed to get consensus public key")
-	}
-
-	substitutePublicKey, err := substituteClientState.ConsensusState.GetPubKey()
-	if err != nil {
-		return errorsmod.Wrap(err, "failed to get substitute client public key")
-	}
-
-	if reflect.DeepEqual(subjectPublicKey, substitutePublicKey) {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "subject and substitute have the same public key")
+	if cs.ConsensusState.SignerSet != nil || substituteClientState.ConsensusState.SignerSet != nil {
+		if reflect.DeepEqual(cs.ConsensusState.SignerSet, substituteClientState.ConsensusState.SignerSet) {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "subject and substitute have the same signer set")
+		}
+	} else {
+		subjectPublicKey, err := cs.ConsensusState.GetPubKey()
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to get consensus public key")
+		}
+
+		substitutePublicKey, err := substituteClientState.ConsensusState.GetPubKey()
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to get substitute client public key")
+		}
+
+		if reflect.DeepEqual(subjectPublicKey, substitutePublicKey) {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "subject and substitute have the same public key")
+		}
 	}
 
 	// update to substitute parameters
@@ -0,0 +1,8 @@
+/*
+Package signer implements the signing side of the solo machine light client.
+It allows off-chain services to act as a solo machine by producing the headers
+and the signatures over the proofs which are verified by the 06-solomachine
+light client on a counterparty chain. The package is agnostic of the keys used
+for signing, which are abstracted by the Signer interface.
+*/
+package signer
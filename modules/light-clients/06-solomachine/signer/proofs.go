@@ -0,0 +1,110 @@
+package signer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// The functions below generate the proofs for each of the paths verified by core IBC
+// through 03-connection. The identifiers provided are those of the objects stored by
+// the solo machine, i.e. the counterparty identifiers from the perspective of the chain
+// verifying the proof. Each generated proof increments the solo machine sequence.
+
+// ClientStateProof generates a proof of the client state stored by the solo machine
+// for the provided client identifier.
+func (sm *SoloMachine) ClientStateProof(clientID string, clientState exported.ClientState) ([]byte, error) {
+	data, err := clienttypes.MarshalClientState(sm.cdc, clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.GenerateProof(host.FullClientStateKey(clientID), data)
+}
+
+// ConsensusStateProof generates a proof of the consensus state stored by the solo machine
+// for the provided client identifier at the given height.
+func (sm *SoloMachine) ConsensusStateProof(clientID string, consensusHeight exported.Height, consensusState exported.ConsensusState) ([]byte, error) {
+	data, err := clienttypes.MarshalConsensusState(sm.cdc, consensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.GenerateProof(host.FullConsensusStateKey(clientID, consensusHeight), data)
+}
+
+// ConnectionStateProof generates a proof of the connection end stored by the solo machine
+// for the provided connection identifier.
+func (sm *SoloMachine) ConnectionStateProof(connectionID string, connection connectiontypes.ConnectionEnd) ([]byte, error) {
+	data, err := sm.cdc.Marshal(&connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.GenerateProof(host.ConnectionKey(connectionID), data)
+}
+
+// ChannelStateProof generates a proof of the channel end stored by the solo machine
+// for the provided port and channel identifiers.
+func (sm *SoloMachine) ChannelStateProof(portID, channelID string, channel channeltypes.Channel) ([]byte, error) {
+	data, err := sm.cdc.Marshal(&channel)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.GenerateProof(host.ChannelKey(portID, channelID), data)
+}
+
+// PacketCommitmentProof generates a proof of the packet commitment stored by the solo machine
+// for a packet sent by the solo machine.
+func (sm *SoloMachine) PacketCommitmentProof(packet channeltypes.Packet) ([]byte, error) {
+	commitment := channeltypes.CommitPacket(sm.cdc, packet)
+
+	return sm.GenerateProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), commitment)
+}
+
+// PacketAcknowledgementProof generates a proof of the acknowledgement written by the solo machine
+// for a packet received by the solo machine.
+func (sm *SoloMachine) PacketAcknowledgementProof(packet channeltypes.Packet, acknowledgement []byte) ([]byte, error) {
+	path := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+	return sm.GenerateProof(path, channeltypes.CommitAcknowledgement(acknowledgement))
+}
+
+// PacketReceiptAbsenceProof generates a proof of the absence of a packet receipt on the solo machine
+// for a packet sent to the solo machine.
+func (sm *SoloMachine) PacketReceiptAbsenceProof(packet channeltypes.Packet) ([]byte, error) {
+	return sm.GenerateProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), nil)
+}
+
+// NextSequenceRecvProof generates a proof of the next sequence receive stored by the solo machine
+// for the provided port and channel identifiers.
+func (sm *SoloMachine) NextSequenceRecvProof(portID, channelID string, nextSequenceRecv uint64) ([]byte, error) {
+	return sm.GenerateProof(host.NextSequenceRecvKey(portID, channelID), sdk.Uint64ToBigEndian(nextSequenceRecv))
+}
+
+// ChannelUpgradeProof generates a proof of the upgrade stored by the solo machine for the
+// provided port and channel identifiers.
+func (sm *SoloMachine) ChannelUpgradeProof(portID, channelID string, upgrade channeltypes.Upgrade) ([]byte, error) {
+	data, err := sm.cdc.Marshal(&upgrade)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.GenerateProof(host.ChannelUpgradeKey(portID, channelID), data)
+}
+
+// ChannelUpgradeErrorProof generates a proof of the upgrade error receipt stored by the solo machine
+// for the provided port and channel identifiers.
+func (sm *SoloMachine) ChannelUpgradeErrorProof(portID, channelID string, errorReceipt channeltypes.ErrorReceipt) ([]byte, error) {
+	data, err := sm.cdc.Marshal(&errorReceipt)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.GenerateProof(host.ChannelUpgradeErrorKey(portID, channelID), data)
+}
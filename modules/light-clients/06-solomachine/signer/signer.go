@@ -0,0 +1,108 @@
+package signer
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var (
+	_ Signer = (*KeySigner)(nil)
+	_ Signer = (*MultiSigner)(nil)
+)
+
+// Signer defines the interface used by a solo machine to sign over data.
+// Implementations are free to hold keys in memory, delegate to a hardware
+// device or request signatures from a remote service.
+type Signer interface {
+	// Sign returns the signature data over the provided sign bytes.
+	Sign(signBytes []byte) (signing.SignatureData, error)
+}
+
+// KeySigner is a Signer which signs using a single private key.
+type KeySigner struct {
+	privKey cryptotypes.PrivKey
+}
+
+// NewKeySigner returns a new KeySigner instance for the provided private key.
+func NewKeySigner(privKey cryptotypes.PrivKey) *KeySigner {
+	return &KeySigner{
+		privKey: privKey,
+	}
+}
+
+// Sign implements Signer. It returns a single signature over the provided sign bytes.
+func (s KeySigner) Sign(signBytes []byte) (signing.SignatureData, error) {
+	if s.privKey == nil {
+		return nil, errors.New("private key cannot be nil")
+	}
+
+	sig, err := s.privKey.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signing.SingleSignatureData{
+		Signature: sig,
+	}, nil
+}
+
+// MultiSigner is a Signer which combines the signatures of a list of signers into
+// a multi signature. The position of a signer in the list corresponds to the index
+// of the public key in a multisig public key, or of the signer in a solo machine
+// signer set. A nil entry indicates that the member at that index does not sign,
+// which allows a subset of a signer set which reaches the threshold to sign.
+type MultiSigner struct {
+	signers []Signer
+}
+
+// NewMultiSigner returns a new MultiSigner instance for the provided signers.
+func NewMultiSigner(signers ...Signer) *MultiSigner {
+	return &MultiSigner{
+		signers: signers,
+	}
+}
+
+// Sign implements Signer. Each non-nil signer signs over the provided sign bytes and the
+// signatures are combined into a MultiSignatureData with the bit array indicating the signers.
+func (s MultiSigner) Sign(signBytes []byte) (signing.SignatureData, error) {
+	if len(s.signers) == 0 {
+		return nil, errors.New("multi signer must contain at least one signer")
+	}
+
+	sigData := multisig.NewMultisig(len(s.signers))
+	for i, signer := range s.signers {
+		if signer == nil {
+			continue
+		}
+
+		sig, err := signer.Sign(signBytes)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to sign with signer at index %d", i)
+		}
+
+		multisig.AddSignature(sigData, sig, i)
+	}
+
+	if len(sigData.Signatures) == 0 {
+		return nil, errors.New("multi signer must contain at least one non-nil signer")
+	}
+
+	return sigData, nil
+}
+
+// GenerateSignature signs over the sign bytes with the provided signer and returns the
+// marshaled signature data in the format expected by the solo machine light client.
+func GenerateSignature(cdc codec.BinaryCodec, signer Signer, signBytes []byte) ([]byte, error) {
+	sigData, err := signer.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.Marshal(signing.SignatureDataToProto(sigData))
+}
@@ -0,0 +1,159 @@
+package signer
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	solomachine "github.com/cosmos/ibc-go/v9/modules/light-clients/06-solomachine"
+)
+
+// SoloMachine tracks the state of a solo machine as seen by the counterparty light client
+// and produces the headers and proofs which the light client verifies. The sequence is
+// incremented for each generated proof and header, in the same way the light client
+// increments its sequence upon successful verification. The caller is responsible for
+// persisting the state and for only using a SoloMachine instance for a single light client.
+type SoloMachine struct {
+	cdc    codec.BinaryCodec
+	signer Signer
+
+	Sequence    uint64
+	Timestamp   uint64
+	Diversifier string
+}
+
+// NewSoloMachine returns a new SoloMachine instance.
+func NewSoloMachine(cdc codec.BinaryCodec, signer Signer, sequence, timestamp uint64, diversifier string) *SoloMachine {
+	return &SoloMachine{
+		cdc:         cdc,
+		signer:      signer,
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+	}
+}
+
+// Signer returns the signer currently used by the solo machine.
+func (sm *SoloMachine) Signer() Signer {
+	return sm.signer
+}
+
+// SignBytes returns the solo machine sign bytes for the provided path and data using
+// the current sequence, timestamp and diversifier.
+func (sm *SoloMachine) SignBytes(path, data []byte) *solomachine.SignBytes {
+	return &solomachine.SignBytes{
+		Sequence:    sm.Sequence,
+		Timestamp:   sm.Timestamp,
+		Diversifier: sm.Diversifier,
+		Path:        path,
+		Data:        data,
+	}
+}
+
+// GenerateSignature marshals the sign bytes and returns the marshaled signature data over them.
+func (sm *SoloMachine) GenerateSignature(signBytes *solomachine.SignBytes) ([]byte, error) {
+	bz, err := sm.cdc.Marshal(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return GenerateSignature(sm.cdc, sm.signer, bz)
+}
+
+// GenerateProof signs over the provided path and data and returns the marshaled
+// TimestampedSignatureData to be used as a proof. The sequence is incremented.
+func (sm *SoloMachine) GenerateProof(path, data []byte) ([]byte, error) {
+	sig, err := sm.GenerateSignature(sm.SignBytes(path, data))
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := sm.cdc.Marshal(&solomachine.TimestampedSignatureData{
+		SignatureData: sig,
+		Timestamp:     sm.Timestamp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sm.Sequence++
+
+	return proof, nil
+}
+
+// CreateHeader returns a header updating the light client to the new public key and diversifier.
+// The header is signed by the current signer. Upon success the sequence is incremented and the new
+// signer and diversifier are used for all subsequent signatures.
+func (sm *SoloMachine) CreateHeader(newPublicKey cryptotypes.PubKey, newDiversifier string, newSigner Signer) (*solomachine.Header, error) {
+	publicKey, err := codectypes.NewAnyWithValue(newPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.createHeader(&solomachine.HeaderData{
+		NewPubKey:      publicKey,
+		NewDiversifier: newDiversifier,
+	}, newSigner)
+}
+
+// CreateSignerSetHeader returns a header updating the light client to the new signer set and diversifier.
+// The header must be signed by a quorum of the current signer set. Upon success the sequence is incremented
+// and the new signer and diversifier are used for all subsequent signatures.
+func (sm *SoloMachine) CreateSignerSetHeader(newSignerSet *solomachine.SignerSet, newDiversifier string, newSigner Signer) (*solomachine.Header, error) {
+	if newSignerSet == nil {
+		return nil, errors.New("new signer set cannot be nil")
+	}
+
+	return sm.createHeader(&solomachine.HeaderData{
+		NewDiversifier: newDiversifier,
+		NewSignerSet:   newSignerSet,
+	}, newSigner)
+}
+
+// CreateSignerKeyRotationHeader returns a header rotating the public key of the signer at the given index of the
+// current signer set. The header must be signed by a quorum of the current signer set. Upon success the sequence is
+// incremented and the new signer and diversifier are used for all subsequent signatures.
+func (sm *SoloMachine) CreateSignerKeyRotationHeader(signerIndex uint64, newPublicKey cryptotypes.PubKey, newDiversifier string, newSigner Signer) (*solomachine.Header, error) {
+	rotation, err := solomachine.NewSignerKeyRotation(signerIndex, newPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm.createHeader(&solomachine.HeaderData{
+		NewDiversifier:    newDiversifier,
+		SignerKeyRotation: rotation,
+	}, newSigner)
+}
+
+func (sm *SoloMachine) createHeader(headerData *solomachine.HeaderData, newSigner Signer) (*solomachine.Header, error) {
+	if newSigner == nil {
+		return nil, errors.New("new signer cannot be nil")
+	}
+
+	dataBz, err := sm.cdc.Marshal(headerData)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := sm.GenerateSignature(sm.SignBytes([]byte(solomachine.SentinelHeaderPath), dataBz))
+	if err != nil {
+		return nil, err
+	}
+
+	header := &solomachine.Header{
+		Timestamp:         sm.Timestamp,
+		Signature:         sig,
+		NewPublicKey:      headerData.NewPubKey,
+		NewDiversifier:    headerData.NewDiversifier,
+		NewSignerSet:      headerData.NewSignerSet,
+		SignerKeyRotation: headerData.SignerKeyRotation,
+	}
+
+	sm.Sequence++
+	sm.signer = newSigner
+	sm.Diversifier = headerData.NewDiversifier
+
+	return header, nil
+}
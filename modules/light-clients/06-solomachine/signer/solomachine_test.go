@@ -0,0 +1,420 @@
+package signer_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v9/modules/light-clients/06-solomachine"
+	"github.com/cosmos/ibc-go/v9/modules/light-clients/06-solomachine/signer"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	"github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+const (
+	clientID    = "06-solomachine-0"
+	diversifier = "diversifier"
+)
+
+type SignerTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+}
+
+func (suite *SignerTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func TestSignerTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(SignerTestSuite))
+}
+
+// createClient stores a solo machine client on chainA using the provided consensus state.
+func (suite *SignerTestSuite) createClient(sequence uint64, consensusState *solomachine.ConsensusState) exported.LightClientModule {
+	suite.Require().NoError(consensusState.ValidateBasic())
+
+	clientState := solomachine.NewClientState(sequence, consensusState)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+
+	return lightClientModule
+}
+
+// createKeyClient stores a solo machine client on chainA using the public key of the provided private key.
+func (suite *SignerTestSuite) createKeyClient(privKey cryptotypes.PrivKey, timestamp uint64) exported.LightClientModule {
+	publicKey, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	suite.Require().NoError(err)
+
+	return suite.createClient(1, &solomachine.ConsensusState{
+		PublicKey:   publicKey,
+		Diversifier: diversifier,
+		Timestamp:   timestamp,
+	})
+}
+
+func (suite *SignerTestSuite) TestProofs() {
+	var (
+		path  []byte
+		value []byte
+		proof []byte
+	)
+
+	packet := channeltypes.NewPacket(
+		mock.MockPacketData, 1,
+		mock.PortID, "channel-on-solomachine",
+		mock.PortID, ibctesting.FirstChannelID,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(sm *signer.SoloMachine)
+	}{
+		{
+			"client state",
+			func(sm *signer.SoloMachine) {
+				clientState := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, clientID, diversifier, 1).ClientState()
+
+				var err error
+				value, err = clienttypes.MarshalClientState(suite.chainA.Codec, clientState)
+				suite.Require().NoError(err)
+
+				path = host.FullClientStateKey("client-on-solomachine")
+				proof, err = sm.ClientStateProof("client-on-solomachine", clientState)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"consensus state",
+			func(sm *signer.SoloMachine) {
+				consensusState := suite.chainA.LatestCommittedHeader.ConsensusState()
+				height := suite.chainA.LatestCommittedHeader.GetHeight()
+
+				var err error
+				value, err = clienttypes.MarshalConsensusState(suite.chainA.Codec, consensusState)
+				suite.Require().NoError(err)
+
+				path = host.FullConsensusStateKey("client-on-solomachine", height)
+				proof, err = sm.ConsensusStateProof("client-on-solomachine", height, consensusState)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"connection state",
+			func(sm *signer.SoloMachine) {
+				counterparty := connectiontypes.NewCounterparty(clientID, ibctesting.FirstConnectionID, suite.chainA.GetPrefix())
+				connection := connectiontypes.NewConnectionEnd(connectiontypes.TRYOPEN, "client-on-solomachine", counterparty, []*connectiontypes.Version{ibctesting.ConnectionVersion}, 0)
+
+				var err error
+				value, err = suite.chainA.Codec.Marshal(&connection)
+				suite.Require().NoError(err)
+
+				path = host.ConnectionKey("connection-on-solomachine")
+				proof, err = sm.ConnectionStateProof("connection-on-solomachine", connection)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"channel state",
+			func(sm *signer.SoloMachine) {
+				counterparty := channeltypes.NewCounterparty(mock.PortID, ibctesting.FirstChannelID)
+				channel := channeltypes.NewChannel(channeltypes.TRYOPEN, channeltypes.UNORDERED, counterparty, []string{"connection-on-solomachine"}, mock.Version)
+
+				var err error
+				value, err = suite.chainA.Codec.Marshal(&channel)
+				suite.Require().NoError(err)
+
+				path = host.ChannelKey(mock.PortID, "channel-on-solomachine")
+				proof, err = sm.ChannelStateProof(mock.PortID, "channel-on-solomachine", channel)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"packet commitment",
+			func(sm *signer.SoloMachine) {
+				value = channeltypes.CommitPacket(suite.chainA.Codec, packet)
+				path = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+				var err error
+				proof, err = sm.PacketCommitmentProof(packet)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"packet acknowledgement",
+			func(sm *signer.SoloMachine) {
+				ack := mock.MockAcknowledgement.Acknowledgement()
+				value = channeltypes.CommitAcknowledgement(ack)
+				path = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+				var err error
+				proof, err = sm.PacketAcknowledgementProof(packet, ack)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"packet receipt absence",
+			func(sm *signer.SoloMachine) {
+				value = nil
+				path = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+				var err error
+				proof, err = sm.PacketReceiptAbsenceProof(packet)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"next sequence recv",
+			func(sm *signer.SoloMachine) {
+				value = sdk.Uint64ToBigEndian(5)
+				path = host.NextSequenceRecvKey(mock.PortID, "channel-on-solomachine")
+
+				var err error
+				proof, err = sm.NextSequenceRecvProof(mock.PortID, "channel-on-solomachine", 5)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"channel upgrade",
+			func(sm *signer.SoloMachine) {
+				upgrade := channeltypes.NewUpgrade(
+					channeltypes.NewUpgradeFields(channeltypes.UNORDERED, []string{"connection-on-solomachine"}, mock.UpgradeVersion),
+					channeltypes.NewTimeout(clienttypes.ZeroHeight(), 100),
+					0,
+				)
+
+				var err error
+				value, err = suite.chainA.Codec.Marshal(&upgrade)
+				suite.Require().NoError(err)
+
+				path = host.ChannelUpgradeKey(mock.PortID, "channel-on-solomachine")
+				proof, err = sm.ChannelUpgradeProof(mock.PortID, "channel-on-solomachine", upgrade)
+				suite.Require().NoError(err)
+			},
+		},
+		{
+			"channel upgrade error",
+			func(sm *signer.SoloMachine) {
+				errorReceipt := channeltypes.NewUpgradeError(1, channeltypes.ErrInvalidUpgrade).GetErrorReceipt()
+
+				var err error
+				value, err = suite.chainA.Codec.Marshal(&errorReceipt)
+				suite.Require().NoError(err)
+
+				path = host.ChannelUpgradeErrorKey(mock.PortID, "channel-on-solomachine")
+				proof, err = sm.ChannelUpgradeErrorProof(mock.PortID, "channel-on-solomachine", errorReceipt)
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			privKey := secp256k1.GenPrivKey()
+			lightClientModule := suite.createKeyClient(privKey, 10)
+			sm := signer.NewSoloMachine(suite.chainA.Codec, signer.NewKeySigner(privKey), 1, 10, diversifier)
+
+			tc.malleate(sm)
+
+			suite.Require().Equal(uint64(2), sm.Sequence)
+
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainA.GetPrefix(), commitmenttypes.NewMerklePath(path))
+			suite.Require().NoError(err)
+
+			if value == nil {
+				err = lightClientModule.VerifyNonMembership(suite.chainA.GetContext(), clientID, clienttypes.ZeroHeight(), 0, 0, proof, merklePath)
+			} else {
+				err = lightClientModule.VerifyMembership(suite.chainA.GetContext(), clientID, clienttypes.ZeroHeight(), 0, 0, proof, merklePath, value)
+			}
+			suite.Require().NoError(err)
+
+			// the light client sequence must match the signer sequence
+			suite.Require().Equal(clienttypes.NewHeight(0, sm.Sequence), lightClientModule.LatestHeight(suite.chainA.GetContext(), clientID))
+		})
+	}
+}
+
+func (suite *SignerTestSuite) TestCreateHeader() {
+	var (
+		sm       *signer.SoloMachine
+		newKey   cryptotypes.PrivKey
+		expError error
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: single key rotated to new key",
+			func() {
+				newKey = ed25519.GenPrivKey()
+			},
+		},
+		{
+			"failure: header signed by key which is not registered",
+			func() {
+				sm = signer.NewSoloMachine(suite.chainA.Codec, signer.NewKeySigner(secp256k1.GenPrivKey()), 1, 10, diversifier)
+				newKey = ed25519.GenPrivKey()
+				expError = solomachine.ErrInvalidHeader
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			expError = nil
+
+			privKey := secp256k1.GenPrivKey()
+			lightClientModule := suite.createKeyClient(privKey, 10)
+			sm = signer.NewSoloMachine(suite.chainA.Codec, signer.NewKeySigner(privKey), 1, 10, diversifier)
+
+			tc.malleate()
+
+			header, err := sm.CreateHeader(newKey.PubKey(), "new-diversifier", signer.NewKeySigner(newKey))
+			suite.Require().NoError(err)
+			suite.Require().NoError(header.ValidateBasic())
+
+			err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), clientID, header)
+			if expError != nil {
+				suite.Require().ErrorIs(err, expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+			lightClientModule.UpdateState(suite.chainA.GetContext(), clientID, header)
+
+			// proofs generated after the update must be signed by the new key
+			proof, err := sm.PacketReceiptAbsenceProof(channeltypes.Packet{DestinationPort: mock.PortID, DestinationChannel: ibctesting.FirstChannelID, Sequence: 1})
+			suite.Require().NoError(err)
+
+			path := host.PacketReceiptKey(mock.PortID, ibctesting.FirstChannelID, 1)
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainA.GetPrefix(), commitmenttypes.NewMerklePath(path))
+			suite.Require().NoError(err)
+
+			err = lightClientModule.VerifyNonMembership(suite.chainA.GetContext(), clientID, clienttypes.ZeroHeight(), 0, 0, proof, merklePath)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *SignerTestSuite) TestCreateSignerSetHeader() {
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	suite.Require().NoError(err)
+
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256r1Key, ed25519.GenPrivKey()}
+
+	signers := make([]*solomachine.Signer, len(privKeys))
+	for i, privKey := range privKeys {
+		signers[i], err = solomachine.NewSigner(privKey.PubKey(), 1)
+		suite.Require().NoError(err)
+	}
+
+	lightClientModule := suite.createClient(1, &solomachine.ConsensusState{
+		Diversifier: diversifier,
+		Timestamp:   10,
+		SignerSet:   solomachine.NewSignerSet(2, signers...),
+	})
+
+	// only the secp256r1 and ed25519 signers are available to sign
+	quorum := signer.NewMultiSigner(nil, signer.NewKeySigner(privKeys[1]), signer.NewKeySigner(privKeys[2]))
+	sm := signer.NewSoloMachine(suite.chainA.Codec, quorum, 1, 10, diversifier)
+
+	// rotate the secp256k1 signer key
+	newKey := secp256k1.GenPrivKey()
+	newSigner, err := solomachine.NewSigner(newKey.PubKey(), 1)
+	suite.Require().NoError(err)
+
+	newSignerSet := solomachine.NewSignerSet(2, newSigner, signers[1], signers[2])
+	newQuorum := signer.NewMultiSigner(signer.NewKeySigner(newKey), nil, signer.NewKeySigner(privKeys[2]))
+
+	header, err := sm.CreateSignerSetHeader(newSignerSet, diversifier, newQuorum)
+	suite.Require().NoError(err)
+	suite.Require().NoError(header.ValidateBasic())
+
+	err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), clientID, header)
+	suite.Require().NoError(err)
+
+	lightClientModule.UpdateState(suite.chainA.GetContext(), clientID, header)
+
+	path := host.NextSequenceRecvKey(mock.PortID, ibctesting.FirstChannelID)
+	proof, err := sm.NextSequenceRecvProof(mock.PortID, ibctesting.FirstChannelID, 1)
+	suite.Require().NoError(err)
+
+	merklePath, err := commitmenttypes.ApplyPrefix(suite.chainA.GetPrefix(), commitmenttypes.NewMerklePath(path))
+	suite.Require().NoError(err)
+
+	err = lightClientModule.VerifyMembership(suite.chainA.GetContext(), clientID, clienttypes.ZeroHeight(), 0, 0, proof, merklePath, sdk.Uint64ToBigEndian(1))
+	suite.Require().NoError(err)
+}
+
+func (suite *SignerTestSuite) TestCreateSignerKeyRotationHeader() {
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	suite.Require().NoError(err)
+
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256r1Key, ed25519.GenPrivKey()}
+
+	signers := make([]*solomachine.Signer, len(privKeys))
+	for i, privKey := range privKeys {
+		signers[i], err = solomachine.NewSigner(privKey.PubKey(), 1)
+		suite.Require().NoError(err)
+	}
+
+	lightClientModule := suite.createClient(1, &solomachine.ConsensusState{
+		Diversifier: diversifier,
+		Timestamp:   10,
+		SignerSet:   solomachine.NewSignerSet(2, signers...),
+	})
+
+	// only the secp256r1 and ed25519 signers are available to sign
+	quorum := signer.NewMultiSigner(nil, signer.NewKeySigner(privKeys[1]), signer.NewKeySigner(privKeys[2]))
+	sm := signer.NewSoloMachine(suite.chainA.Codec, quorum, 1, 10, diversifier)
+
+	// rotate the secp256k1 signer key
+	newKey := secp256k1.GenPrivKey()
+	newQuorum := signer.NewMultiSigner(signer.NewKeySigner(newKey), nil, signer.NewKeySigner(privKeys[2]))
+
+	header, err := sm.CreateSignerKeyRotationHeader(0, newKey.PubKey(), diversifier, newQuorum)
+	suite.Require().NoError(err)
+	suite.Require().NoError(header.ValidateBasic())
+
+	err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), clientID, header)
+	suite.Require().NoError(err)
+
+	lightClientModule.UpdateState(suite.chainA.GetContext(), clientID, header)
+
+	path := host.NextSequenceRecvKey(mock.PortID, ibctesting.FirstChannelID)
+	proof, err := sm.NextSequenceRecvProof(mock.PortID, ibctesting.FirstChannelID, 1)
+	suite.Require().NoError(err)
+
+	merklePath, err := commitmenttypes.ApplyPrefix(suite.chainA.GetPrefix(), commitmenttypes.NewMerklePath(path))
+	suite.Require().NoError(err)
+
+	err = lightClientModule.VerifyMembership(suite.chainA.GetContext(), clientID, clienttypes.ZeroHeight(), 0, 0, proof, merklePath, sdk.Uint64ToBigEndian(1))
+	suite.Require().NoError(err)
+}
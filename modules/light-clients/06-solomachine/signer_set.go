@@ -0,0 +1,259 @@
+package solomachine
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _, _, _ codectypes.UnpackInterfacesMessage = (*Signer)(nil), (*SignerSet)(nil), (*SignerKeyRotation)(nil)
+
+// NewSigner creates a new Signer instance.
+func NewSigner(publicKey cryptotypes.PubKey, weight uint64) (*Signer, error) {
+	anyPublicKey, err := codectypes.NewAnyWithValue(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		PublicKey: anyPublicKey,
+		Weight:    weight,
+	}, nil
+}
+
+// NewSignerSet creates a new SignerSet instance.
+func NewSignerSet(threshold uint64, signers ...*Signer) *SignerSet {
+	return &SignerSet{
+		Signers:   signers,
+		Threshold: threshold,
+	}
+}
+
+// NewSignerKeyRotation creates a new SignerKeyRotation instance.
+func NewSignerKeyRotation(signerIndex uint64, newPublicKey cryptotypes.PubKey) (*SignerKeyRotation, error) {
+	anyPublicKey, err := codectypes.NewAnyWithValue(newPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignerKeyRotation{
+		SignerIndex:  signerIndex,
+		NewPublicKey: anyPublicKey,
+	}, nil
+}
+
+// GetPubKey unmarshals the signer public key into a cryptotypes.PubKey type.
+// An error is returned if the public key is nil or the cached value
+// is not a PubKey.
+func (s Signer) GetPubKey() (cryptotypes.PubKey, error) {
+	if s.PublicKey == nil {
+		return nil, errorsmod.Wrap(ErrInvalidSignerSet, "signer PublicKey cannot be nil")
+	}
+
+	publicKey, ok := s.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidSignerSet, "signer PublicKey is not cryptotypes.PubKey")
+	}
+
+	return publicKey, nil
+}
+
+// ValidateBasic ensures that the signer has a non-empty public key and a non-zero weight.
+func (s Signer) ValidateBasic() error {
+	if s.Weight == 0 {
+		return errorsmod.Wrap(ErrInvalidSignerSet, "signer weight cannot be 0")
+	}
+
+	publicKey, err := s.GetPubKey()
+	if err != nil || publicKey == nil || len(publicKey.Bytes()) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignerSet, "signer public key cannot be empty")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (s Signer) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(s.PublicKey, new(cryptotypes.PubKey))
+}
+
+// GetPubKey unmarshals the new public key of the rotation into a cryptotypes.PubKey type.
+// An error is returned if the new public key is nil or the cached value is not a PubKey.
+func (r SignerKeyRotation) GetPubKey() (cryptotypes.PubKey, error) {
+	if r.NewPublicKey == nil {
+		return nil, errorsmod.Wrap(ErrInvalidSignerSet, "signer key rotation NewPublicKey cannot be nil")
+	}
+
+	publicKey, ok := r.NewPublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidSignerSet, "signer key rotation NewPublicKey is not cryptotypes.PubKey")
+	}
+
+	return publicKey, nil
+}
+
+// ValidateBasic ensures that the new public key of the rotation is not empty.
+func (r SignerKeyRotation) ValidateBasic() error {
+	publicKey, err := r.GetPubKey()
+	if err != nil || publicKey == nil || len(publicKey.Bytes()) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignerSet, "signer key rotation new public key cannot be empty")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (r SignerKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(r.NewPublicKey, new(cryptotypes.PubKey))
+}
+
+// TotalWeight returns the combined weight of all signers in the set.
+func (ss SignerSet) TotalWeight() uint64 {
+	var total uint64
+	for _, signer := range ss.Signers {
+		total += signer.Weight
+	}
+
+	return total
+}
+
+// ValidateBasic ensures that the signer set contains at least one signer, that each
+// signer is valid and unique and that the threshold is attainable by the set.
+func (ss SignerSet) ValidateBasic() error {
+	if len(ss.Signers) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignerSet, "signer set cannot be empty")
+	}
+
+	if ss.Threshold == 0 {
+		return errorsmod.Wrap(ErrInvalidSignerSet, "threshold cannot be 0")
+	}
+
+	var totalWeight uint64
+	seenPubKeys := make(map[string]struct{}, len(ss.Signers))
+	for i, signer := range ss.Signers {
+		if signer == nil {
+			return errorsmod.Wrapf(ErrInvalidSignerSet, "signer at index %d cannot be nil", i)
+		}
+
+		if err := signer.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid signer at index %d", i)
+		}
+
+		if signer.Weight > math.MaxUint64-totalWeight {
+			return errorsmod.Wrap(ErrInvalidSignerSet, "total signer weight overflows uint64")
+		}
+		totalWeight += signer.Weight
+
+		publicKey, err := signer.GetPubKey()
+		if err != nil {
+			return err
+		}
+
+		key := publicKey.Type() + string(publicKey.Bytes())
+		if _, found := seenPubKeys[key]; found {
+			return errorsmod.Wrapf(ErrInvalidSignerSet, "duplicate signer public key at index %d", i)
+		}
+		seenPubKeys[key] = struct{}{}
+	}
+
+	if ss.Threshold > totalWeight {
+		return errorsmod.Wrapf(ErrInvalidSignerSet, "threshold cannot be greater than the total signer weight (%d > %d)", ss.Threshold, totalWeight)
+	}
+
+	return nil
+}
+
+// RotateSignerKey returns a copy of the signer set in which the public key of the signer at the index of the
+// rotation is replaced by the new public key of the rotation. The weights of all signers and the threshold are
+// unchanged. An error is returned if the index is out of range or the resulting signer set is invalid, e.g. if
+// the new public key is already used by another signer.
+func (ss SignerSet) RotateSignerKey(rotation SignerKeyRotation) (*SignerSet, error) {
+	if rotation.SignerIndex >= uint64(len(ss.Signers)) {
+		return nil, errorsmod.Wrapf(ErrInvalidSignerSet, "signer index %d is out of range for signer set of size %d", rotation.SignerIndex, len(ss.Signers))
+	}
+
+	signers := make([]*Signer, len(ss.Signers))
+	copy(signers, ss.Signers)
+	signers[rotation.SignerIndex] = &Signer{
+		PublicKey: rotation.NewPublicKey,
+		Weight:    ss.Signers[rotation.SignerIndex].Weight,
+	}
+
+	signerSet := NewSignerSet(ss.Threshold, signers...)
+	if err := signerSet.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return signerSet, nil
+}
+
+// VerifySignature verifies that the provided signature data was produced over the sign bytes
+// by a quorum of the signer set. The signature data must be of type MultiSignatureData where
+// the bit array indicates which signers, in the order of the signer set, provided a signature.
+// Each individual signature is verified against the public key of the corresponding signer and
+// the combined weight of the signers must be greater than or equal to the threshold.
+func (ss SignerSet) VerifySignature(signBytes []byte, sigData signing.SignatureData) error {
+	data, ok := sigData.(*signing.MultiSignatureData)
+	if !ok {
+		return errorsmod.Wrapf(ErrSignatureVerificationFailed, "invalid signature data type, expected %T, got %T", (*signing.MultiSignatureData)(nil), sigData)
+	}
+
+	if data.BitArray == nil {
+		return errorsmod.Wrap(ErrSignatureVerificationFailed, "signature bit array cannot be nil")
+	}
+
+	if data.BitArray.Count() != len(ss.Signers) {
+		return errorsmod.Wrapf(ErrSignatureVerificationFailed, "bit array size is incorrect, expected: %d, got: %d", len(ss.Signers), data.BitArray.Count())
+	}
+
+	if numSetBits := data.BitArray.NumTrueBitsBefore(data.BitArray.Count()); numSetBits != len(data.Signatures) {
+		return errorsmod.Wrapf(ErrSignatureVerificationFailed, "number of signatures does not match the number of set bits, expected: %d, got: %d", numSetBits, len(data.Signatures))
+	}
+
+	var (
+		signedWeight uint64
+		sigIndex     int
+	)
+	for i, signer := range ss.Signers {
+		if !data.BitArray.GetIndex(i) {
+			continue
+		}
+
+		publicKey, err := signer.GetPubKey()
+		if err != nil {
+			return err
+		}
+
+		if err := VerifySignature(publicKey, signBytes, data.Signatures[sigIndex]); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify signature of signer at index %d", i)
+		}
+
+		signedWeight += signer.Weight
+		sigIndex++
+	}
+
+	if signedWeight < ss.Threshold {
+		return errorsmod.Wrapf(ErrSignatureVerificationFailed, "insufficient signer weight, expected at least %d, got %d", ss.Threshold, signedWeight)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (ss SignerSet) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, signer := range ss.Signers {
+		if signer == nil {
+			continue
+		}
+
+		if err := signer.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
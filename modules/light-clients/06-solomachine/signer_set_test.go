@@ -0,0 +1,689 @@
+package solomachine_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v9/modules/light-clients/06-solomachine"
+)
+
+// generateMixedSignerSet generates a signer set consisting of a secp256k1, a secp256r1 and an
+// ed25519 key with the provided weights and threshold.
+func (suite *SoloMachineTestSuite) generateMixedSignerSet(threshold uint64, weights ...uint64) ([]cryptotypes.PrivKey, *solomachine.SignerSet) {
+	suite.Require().Len(weights, 3)
+
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	suite.Require().NoError(err)
+
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256r1Key, ed25519.GenPrivKey()}
+
+	signers := make([]*solomachine.Signer, len(privKeys))
+	for i, privKey := range privKeys {
+		signers[i], err = solomachine.NewSigner(privKey.PubKey(), weights[i])
+		suite.Require().NoError(err)
+	}
+
+	return privKeys, solomachine.NewSignerSet(threshold, signers...)
+}
+
+// signWithSignerSet signs over the sign bytes with the private keys at the provided indices
+// and returns the marshaled multi signature data.
+func (suite *SoloMachineTestSuite) signWithSignerSet(privKeys []cryptotypes.PrivKey, signBytes []byte, indices ...int) []byte {
+	sigData := multisig.NewMultisig(len(privKeys))
+	for _, i := range indices {
+		sig, err := privKeys[i].Sign(signBytes)
+		suite.Require().NoError(err)
+
+		multisig.AddSignature(sigData, &signing.SingleSignatureData{Signature: sig}, i)
+	}
+
+	bz, err := suite.chainA.Codec.Marshal(signing.SignatureDataToProto(sigData))
+	suite.Require().NoError(err)
+
+	return bz
+}
+
+func (suite *SoloMachineTestSuite) TestSignerSetValidateBasic() {
+	var signerSet *solomachine.SignerSet
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: threshold equals total weight",
+			func() {
+				signerSet.Threshold = signerSet.TotalWeight()
+			},
+			nil,
+		},
+		{
+			"failure: empty signer set",
+			func() {
+				signerSet.Signers = nil
+			},
+			solomachine.ErrInvalidSignerSet,
+		},
+		{
+			"failure: threshold is zero",
+			func() {
+				signerSet.Threshold = 0
+			},
+			solomachine.ErrInvalidSignerSet,
+		},
+		{
+			"failure: threshold is greater than total weight",
+			func() {
+				signerSet.Threshold = signerSet.TotalWeight() + 1
+			},
+			solomachine.ErrInvalidSignerSet,
+		},
+		{
+			"failure: nil signer",
+			func() {
+				signerSet.Signers[1] = nil
+			},
+			solomachine.ErrInvalidSignerSet,
+		},
+		{
+			"failure: signer weight is zero",
+			func() {
+				signerSet.Signers[0].Weight = 0
+			},
+			solomachine.ErrInvalidSignerSet,
+		},
+		{
+			"failure: signer public key is nil",
+			func() {
+				signerSet.Signers[0].PublicKey = nil
+			},
+			solomachine.ErrInvalidSignerSet,
+		},
+		{
+			"failure: duplicate signer public key",
+			func() {
+				signerSet.Signers[2].PublicKey = signerSet.Signers[0].PublicKey
+			},
+			solomachine.ErrInvalidSignerSet,
+		},
+		{
+			"failure: total weight overflows",
+			func() {
+				signerSet.Signers[0].Weight = ^uint64(0)
+			},
+			solomachine.ErrInvalidSignerSet,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			_, signerSet = suite.generateMixedSignerSet(3, 1, 2, 3)
+
+			tc.malleate()
+
+			err := signerSet.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestSignerSetVerifySignature() {
+	var (
+		privKeys  []cryptotypes.PrivKey
+		signerSet *solomachine.SignerSet
+		signature []byte
+	)
+
+	signBytes := []byte("sign bytes")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: all signers",
+			func() {
+				signature = suite.signWithSignerSet(privKeys, signBytes, 0, 1, 2)
+			},
+			nil,
+		},
+		{
+			"success: secp256r1 and ed25519 signers reach threshold",
+			func() {
+				signature = suite.signWithSignerSet(privKeys, signBytes, 1, 2)
+			},
+			nil,
+		},
+		{
+			"success: single signer with sufficient weight",
+			func() {
+				signature = suite.signWithSignerSet(privKeys, signBytes, 2)
+			},
+			nil,
+		},
+		{
+			"failure: insufficient signer weight",
+			func() {
+				signature = suite.signWithSignerSet(privKeys, signBytes, 0, 1)
+			},
+			solomachine.ErrSignatureVerificationFailed,
+		},
+		{
+			"failure: no signatures",
+			func() {
+				signature = suite.signWithSignerSet(privKeys, signBytes)
+			},
+			solomachine.ErrSignatureVerificationFailed,
+		},
+		{
+			"failure: signature by key outside of signer set",
+			func() {
+				privKeys[2] = ed25519.GenPrivKey()
+				signature = suite.signWithSignerSet(privKeys, signBytes, 2)
+			},
+			solomachine.ErrSignatureVerificationFailed,
+		},
+		{
+			"failure: bit array size does not match signer set",
+			func() {
+				signature = suite.signWithSignerSet(append(privKeys, ed25519.GenPrivKey()), signBytes, 0, 1, 2)
+			},
+			solomachine.ErrSignatureVerificationFailed,
+		},
+		{
+			"failure: single signature data",
+			func() {
+				sig, err := privKeys[2].Sign(signBytes)
+				suite.Require().NoError(err)
+
+				signature, err = suite.chainA.Codec.Marshal(signing.SignatureDataToProto(&signing.SingleSignatureData{Signature: sig}))
+				suite.Require().NoError(err)
+			},
+			solomachine.ErrSignatureVerificationFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			privKeys, signerSet = suite.generateMixedSignerSet(4, 1, 2, 4)
+
+			tc.malleate()
+
+			sigData, err := solomachine.UnmarshalSignatureData(suite.chainA.Codec, signature)
+			suite.Require().NoError(err)
+
+			err = signerSet.VerifySignature(signBytes, sigData)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestSignerSetKeyRotation() {
+	var (
+		header      *solomachine.Header
+		newPrivKeys []cryptotypes.PrivKey
+	)
+
+	clientID := suite.solomachine.ClientID
+	diversifier := suite.solomachine.Diversifier
+	timestamp := suite.solomachine.Time
+
+	privKeys, signerSet := suite.generateMixedSignerSet(2, 1, 1, 1)
+
+	// createHeader constructs a header rotating the secp256k1 signer key. The header is signed by the signers at the
+	// given indices of the current signer set, or of the new signer set if signWithNewKeys is true.
+	createHeader := func(signWithNewKeys bool, indices ...int) {
+		newPrivKeys = append([]cryptotypes.PrivKey{secp256k1.GenPrivKey()}, privKeys[1:]...)
+
+		newSigner, err := solomachine.NewSigner(newPrivKeys[0].PubKey(), 1)
+		suite.Require().NoError(err)
+
+		newSignerSet := solomachine.NewSignerSet(signerSet.Threshold, newSigner, signerSet.Signers[1], signerSet.Signers[2])
+
+		dataBz, err := suite.chainA.Codec.Marshal(&solomachine.HeaderData{
+			NewDiversifier: diversifier,
+			NewSignerSet:   newSignerSet,
+		})
+		suite.Require().NoError(err)
+
+		signBytes, err := suite.chainA.Codec.Marshal(&solomachine.SignBytes{
+			Sequence:    1,
+			Timestamp:   timestamp,
+			Diversifier: diversifier,
+			Path:        []byte(solomachine.SentinelHeaderPath),
+			Data:        dataBz,
+		})
+		suite.Require().NoError(err)
+
+		signingKeys := privKeys
+		if signWithNewKeys {
+			signingKeys = newPrivKeys
+		}
+
+		header = &solomachine.Header{
+			Timestamp:      timestamp,
+			Signature:      suite.signWithSignerSet(signingKeys, signBytes, indices...),
+			NewDiversifier: diversifier,
+			NewSignerSet:   newSignerSet,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: rotation signed by current quorum",
+			func() {
+				createHeader(false, 0, 1)
+			},
+			nil,
+		},
+		{
+			"failure: rotation signed by less than current quorum",
+			func() {
+				createHeader(false, 2)
+			},
+			solomachine.ErrInvalidHeader,
+		},
+		{
+			"failure: rotation signed by new key instead of current quorum",
+			func() {
+				createHeader(true, 0)
+			},
+			solomachine.ErrInvalidHeader,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			consensusState := &solomachine.ConsensusState{
+				Diversifier: diversifier,
+				Timestamp:   timestamp,
+				SignerSet:   signerSet,
+			}
+			suite.Require().NoError(consensusState.ValidateBasic())
+
+			clientState := solomachine.NewClientState(1, consensusState)
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+
+			tc.malleate()
+
+			suite.Require().NoError(header.ValidateBasic())
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), clientID, header)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				consensusHeights := lightClientModule.UpdateState(suite.chainA.GetContext(), clientID, header)
+				suite.Require().Len(consensusHeights, 1)
+				suite.Require().Equal(clienttypes.NewHeight(0, 2), consensusHeights[0])
+
+				newClientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+
+				smClientState, ok := newClientState.(*solomachine.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Nil(smClientState.ConsensusState.PublicKey)
+
+				newPubKey, err := smClientState.ConsensusState.SignerSet.Signers[0].GetPubKey()
+				suite.Require().NoError(err)
+				suite.Require().Equal(newPrivKeys[0].PubKey(), newPubKey)
+
+				// the rotated key must now be accepted as part of the quorum
+				signBytes := []byte("sign bytes")
+				sigData, err := solomachine.UnmarshalSignatureData(suite.chainA.Codec, suite.signWithSignerSet(newPrivKeys, signBytes, 0, 2))
+				suite.Require().NoError(err)
+				suite.Require().NoError(smClientState.ConsensusState.VerifySignature(signBytes, sigData))
+
+				// the replaced key must no longer be accepted
+				sigData, err = solomachine.UnmarshalSignatureData(suite.chainA.Codec, suite.signWithSignerSet(privKeys, signBytes, 0, 2))
+				suite.Require().NoError(err)
+				suite.Require().Error(smClientState.ConsensusState.VerifySignature(signBytes, sigData))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestSignerKeyRotation() {
+	var (
+		consensusState *solomachine.ConsensusState
+		rotation       *solomachine.SignerKeyRotation
+		signingKeys    []cryptotypes.PrivKey
+		signerIndices  []int
+	)
+
+	clientID := suite.solomachine.ClientID
+	diversifier := suite.solomachine.Diversifier
+	timestamp := suite.solomachine.Time
+
+	privKeys, signerSet := suite.generateMixedSignerSet(2, 1, 1, 1)
+	newPrivKey := secp256k1.GenPrivKey()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: rotation signed by current quorum",
+			func() {},
+			nil,
+		},
+		{
+			"success: rotation signed by current quorum excluding the rotated signer",
+			func() {
+				signerIndices = []int{1, 2}
+			},
+			nil,
+		},
+		{
+			"failure: rotation signed by less than current quorum",
+			func() {
+				signerIndices = []int{1}
+			},
+			solomachine.ErrInvalidHeader,
+		},
+		{
+			"failure: rotation signed with the new key in place of the rotated signer",
+			func() {
+				signingKeys = []cryptotypes.PrivKey{newPrivKey, privKeys[1], privKeys[2]}
+				signerIndices = []int{0, 1}
+			},
+			solomachine.ErrInvalidHeader,
+		},
+		{
+			"failure: signer index out of range",
+			func() {
+				rotation.SignerIndex = 3
+			},
+			solomachine.ErrInvalidHeader,
+		},
+		{
+			"failure: new public key is the key of another signer",
+			func() {
+				var err error
+				rotation, err = solomachine.NewSignerKeyRotation(0, privKeys[1].PubKey())
+				suite.Require().NoError(err)
+			},
+			solomachine.ErrInvalidHeader,
+		},
+		{
+			"failure: consensus state has no signer set",
+			func() {
+				publicKey, err := codectypes.NewAnyWithValue(privKeys[0].PubKey())
+				suite.Require().NoError(err)
+
+				consensusState.SignerSet = nil
+				consensusState.PublicKey = publicKey
+			},
+			solomachine.ErrInvalidHeader,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			consensusState = &solomachine.ConsensusState{
+				Diversifier: diversifier,
+				Timestamp:   timestamp,
+				SignerSet:   signerSet,
+			}
+
+			var err error
+			rotation, err = solomachine.NewSignerKeyRotation(0, newPrivKey.PubKey())
+			suite.Require().NoError(err)
+
+			signingKeys = privKeys
+			signerIndices = []int{0, 1}
+
+			tc.malleate()
+
+			clientState := solomachine.NewClientState(1, consensusState)
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+
+			dataBz, err := suite.chainA.Codec.Marshal(&solomachine.HeaderData{
+				NewDiversifier:    diversifier,
+				SignerKeyRotation: rotation,
+			})
+			suite.Require().NoError(err)
+
+			signBytes, err := suite.chainA.Codec.Marshal(&solomachine.SignBytes{
+				Sequence:    1,
+				Timestamp:   timestamp,
+				Diversifier: diversifier,
+				Path:        []byte(solomachine.SentinelHeaderPath),
+				Data:        dataBz,
+			})
+			suite.Require().NoError(err)
+
+			header := &solomachine.Header{
+				Timestamp:         timestamp,
+				Signature:         suite.signWithSignerSet(signingKeys, signBytes, signerIndices...),
+				NewDiversifier:    diversifier,
+				SignerKeyRotation: rotation,
+			}
+			suite.Require().NoError(header.ValidateBasic())
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), clientID, header)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				consensusHeights := lightClientModule.UpdateState(suite.chainA.GetContext(), clientID, header)
+				suite.Require().Equal([]exported.Height{clienttypes.NewHeight(0, 2)}, consensusHeights)
+
+				newClientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+
+				smClientState, ok := newClientState.(*solomachine.ClientState)
+				suite.Require().True(ok)
+
+				// only the key of the rotated signer is replaced
+				newSignerSet := smClientState.ConsensusState.SignerSet
+				suite.Require().Equal(signerSet.Threshold, newSignerSet.Threshold)
+				suite.Require().Len(newSignerSet.Signers, len(signerSet.Signers))
+				for i, signer := range newSignerSet.Signers {
+					suite.Require().Equal(signerSet.Signers[i].Weight, signer.Weight)
+
+					pubKey, err := signer.GetPubKey()
+					suite.Require().NoError(err)
+
+					expPubKey := privKeys[i].PubKey()
+					if i == 0 {
+						expPubKey = newPrivKey.PubKey()
+					}
+					suite.Require().Equal(expPubKey, pubKey)
+				}
+
+				// the rotated key must now be accepted as part of the quorum and the replaced key rejected
+				newPrivKeys := []cryptotypes.PrivKey{newPrivKey, privKeys[1], privKeys[2]}
+				msg := []byte("sign bytes")
+
+				sigData, err := solomachine.UnmarshalSignatureData(suite.chainA.Codec, suite.signWithSignerSet(newPrivKeys, msg, 0, 2))
+				suite.Require().NoError(err)
+				suite.Require().NoError(smClientState.ConsensusState.VerifySignature(msg, sigData))
+
+				sigData, err = solomachine.UnmarshalSignatureData(suite.chainA.Codec, suite.signWithSignerSet(privKeys, msg, 0, 2))
+				suite.Require().NoError(err)
+				suite.Require().Error(smClientState.ConsensusState.VerifySignature(msg, sigData))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestSignerKeyRotationHeaderValidateBasic() {
+	_, signerSet := suite.generateMixedSignerSet(2, 1, 1, 1)
+
+	rotation, err := solomachine.NewSignerKeyRotation(0, secp256k1.GenPrivKey().PubKey())
+	suite.Require().NoError(err)
+
+	header := &solomachine.Header{
+		Timestamp:         suite.solomachine.Time,
+		Signature:         []byte("signature"),
+		SignerKeyRotation: rotation,
+	}
+	suite.Require().NoError(header.ValidateBasic())
+
+	header.NewSignerSet = signerSet
+	suite.Require().ErrorIs(header.ValidateBasic(), clienttypes.ErrInvalidHeader)
+
+	header.NewSignerSet = nil
+	header.SignerKeyRotation = &solomachine.SignerKeyRotation{}
+	suite.Require().ErrorIs(header.ValidateBasic(), clienttypes.ErrInvalidHeader)
+}
+
+func (suite *SoloMachineTestSuite) TestSignerSetMisbehaviour() {
+	clientID := suite.solomachine.ClientID
+	diversifier := suite.solomachine.Diversifier
+	timestamp := suite.solomachine.Time
+
+	privKeys, signerSet := suite.generateMixedSignerSet(2, 1, 1, 1)
+
+	// newSignatureAndData signs over the given path and data at sequence 1 with the signers at the given indices.
+	newSignatureAndData := func(path, data []byte, indices ...int) *solomachine.SignatureAndData {
+		signBytes, err := suite.chainA.Codec.Marshal(&solomachine.SignBytes{
+			Sequence:    1,
+			Timestamp:   timestamp,
+			Diversifier: diversifier,
+			Path:        path,
+			Data:        data,
+		})
+		suite.Require().NoError(err)
+
+		return &solomachine.SignatureAndData{
+			Signature: suite.signWithSignerSet(privKeys, signBytes, indices...),
+			Path:      path,
+			Data:      data,
+			Timestamp: timestamp,
+		}
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.FullClientStateKey("counterparty"))
+	path, err := suite.chainA.Codec.Marshal(&merklePath)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		misbehaviour *solomachine.Misbehaviour
+		expErr       error
+	}{
+		{
+			"success: conflicting signatures from different quorums",
+			&solomachine.Misbehaviour{
+				Sequence:     1,
+				SignatureOne: newSignatureAndData(path, []byte("data one"), 0, 1),
+				SignatureTwo: newSignatureAndData(path, []byte("data two"), 1, 2),
+			},
+			nil,
+		},
+		{
+			"failure: second signature does not reach quorum",
+			&solomachine.Misbehaviour{
+				Sequence:     1,
+				SignatureOne: newSignatureAndData(path, []byte("data one"), 0, 1),
+				SignatureTwo: newSignatureAndData(path, []byte("data two"), 2),
+			},
+			solomachine.ErrSignatureVerificationFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientState := solomachine.NewClientState(1, &solomachine.ConsensusState{
+				Diversifier: diversifier,
+				Timestamp:   timestamp,
+				SignerSet:   signerSet,
+			})
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+
+			suite.Require().NoError(tc.misbehaviour.ValidateBasic())
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), clientID, tc.misbehaviour)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), clientID, tc.misbehaviour))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestConsensusStateWithSignerSetValidateBasic() {
+	_, signerSet := suite.generateMixedSignerSet(2, 1, 1, 1)
+
+	publicKey, err := codectypes.NewAnyWithValue(suite.solomachine.PublicKey)
+	suite.Require().NoError(err)
+
+	consensusState := &solomachine.ConsensusState{
+		Diversifier: suite.solomachine.Diversifier,
+		Timestamp:   suite.solomachine.Time,
+		SignerSet:   signerSet,
+	}
+	suite.Require().NoError(consensusState.ValidateBasic())
+
+	consensusState.PublicKey = publicKey
+	suite.Require().ErrorIs(consensusState.ValidateBasic(), clienttypes.ErrInvalidConsensus)
+
+	consensusState.PublicKey = nil
+	consensusState.SignerSet.Threshold = 0
+	suite.Require().ErrorIs(consensusState.ValidateBasic(), clienttypes.ErrInvalidConsensus)
+}
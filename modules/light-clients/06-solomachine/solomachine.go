@@ -18,15 +18,45 @@ func (cs ClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (cs ConsensusState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if cs.SignerSet != nil {
+		if err := cs.SignerSet.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return unpacker.UnpackAny(cs.PublicKey, new(cryptotypes.PubKey))
 }
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (h Header) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if h.NewSignerSet != nil {
+		if err := h.NewSignerSet.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	if h.SignerKeyRotation != nil {
+		if err := h.SignerKeyRotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return unpacker.UnpackAny(h.NewPublicKey, new(cryptotypes.PubKey))
 }
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (hd HeaderData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if hd.NewSignerSet != nil {
+		if err := hd.NewSignerSet.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	if hd.SignerKeyRotation != nil {
+		if err := hd.SignerKeyRotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return unpacker.UnpackAny(hd.NewPubKey, new(cryptotypes.PubKey))
 }
@@ -78,6 +78,9 @@ type ConsensusState struct {
 	// misbehaviour.
 	Diversifier string `protobuf:"bytes,2,opt,name=diversifier,proto3" json:"diversifier,omitempty"`
 	Timestamp   uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// weighted set of signers of the solo machine, used in place of the public
+	// key when the solo machine is operated by a quorum of independent keys.
+	SignerSet *SignerSet `protobuf:"bytes,4,opt,name=signer_set,json=signerSet,proto3" json:"signer_set,omitempty"`
 }
 
 func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
@@ -115,10 +118,12 @@ var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
 
 // Header defines a solo machine consensus header
 type Header struct {
-	Timestamp      uint64     `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Signature      []byte     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	NewPublicKey   *types.Any `protobuf:"bytes,3,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
-	NewDiversifier string     `protobuf:"bytes,4,opt,name=new_diversifier,json=newDiversifier,proto3" json:"new_diversifier,omitempty"`
+	Timestamp         uint64             `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature         []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	NewPublicKey      *types.Any         `protobuf:"bytes,3,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	NewDiversifier    string             `protobuf:"bytes,4,opt,name=new_diversifier,json=newDiversifier,proto3" json:"new_diversifier,omitempty"`
+	NewSignerSet      *SignerSet         `protobuf:"bytes,5,opt,name=new_signer_set,json=newSignerSet,proto3" json:"new_signer_set,omitempty"`
+	SignerKeyRotation *SignerKeyRotation `protobuf:"bytes,6,opt,name=signer_key_rotation,json=signerKeyRotation,proto3" json:"signer_key_rotation,omitempty"`
 }
 
 func (m *Header) Reset()         { *m = Header{} }
@@ -330,6 +335,10 @@ type HeaderData struct {
 	NewPubKey *types.Any `protobuf:"bytes,1,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
 	// header diversifier
 	NewDiversifier string `protobuf:"bytes,2,opt,name=new_diversifier,json=newDiversifier,proto3" json:"new_diversifier,omitempty"`
+	// header signer set
+	NewSignerSet *SignerSet `protobuf:"bytes,3,opt,name=new_signer_set,json=newSignerSet,proto3" json:"new_signer_set,omitempty"`
+	// header rotation of a single signer key
+	SignerKeyRotation *SignerKeyRotation `protobuf:"bytes,4,opt,name=signer_key_rotation,json=signerKeyRotation,proto3" json:"signer_key_rotation,omitempty"`
 }
 
 func (m *HeaderData) Reset()         { *m = HeaderData{} }
@@ -365,6 +374,134 @@ func (m *HeaderData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HeaderData proto.InternalMessageInfo
 
+// Signer defines a single member of a solo machine signer set along with its
+// voting weight.
+type Signer struct {
+	// public key of the signer
+	PublicKey *types.Any `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// voting weight of the signer
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *Signer) Reset()         { *m = Signer{} }
+func (m *Signer) String() string { return proto.CompactTextString(m) }
+func (*Signer) ProtoMessage()    {}
+func (*Signer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{8}
+}
+func (m *Signer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Signer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Signer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Signer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signer.Merge(m, src)
+}
+func (m *Signer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Signer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Signer proto.InternalMessageInfo
+
+// SignerKeyRotation defines the rotation of the public key of a single signer of the
+// current signer set. The weight of the signer and the remaining signers are unchanged.
+type SignerKeyRotation struct {
+	// index of the signer in the current signer set
+	SignerIndex uint64 `protobuf:"varint,1,opt,name=signer_index,json=signerIndex,proto3" json:"signer_index,omitempty"`
+	// new public key of the signer
+	NewPublicKey *types.Any `protobuf:"bytes,2,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+}
+
+func (m *SignerKeyRotation) Reset()         { *m = SignerKeyRotation{} }
+func (m *SignerKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*SignerKeyRotation) ProtoMessage()    {}
+func (*SignerKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{9}
+}
+func (m *SignerKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerKeyRotation.Merge(m, src)
+}
+func (m *SignerKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerKeyRotation proto.InternalMessageInfo
+
+// SignerSet defines a weighted set of solo machine signers. A signature is
+// valid if the combined weight of the signers which produced it is greater
+// than or equal to the threshold. The public keys may be of any supported
+// key type, allowing mixed sets of e.g. secp256k1, secp256r1 and ed25519 keys.
+type SignerSet struct {
+	// the ordered list of signers
+	Signers []*Signer `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+	// the minimum combined weight required for a valid signature
+	Threshold uint64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *SignerSet) Reset()         { *m = SignerSet{} }
+func (m *SignerSet) String() string { return proto.CompactTextString(m) }
+func (*SignerSet) ProtoMessage()    {}
+func (*SignerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{10}
+}
+func (m *SignerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSet.Merge(m, src)
+}
+func (m *SignerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSet proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.solomachine.v3.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.solomachine.v3.ConsensusState")
@@ -374,6 +511,9 @@ func init() {
 	proto.RegisterType((*TimestampedSignatureData)(nil), "ibc.lightclients.solomachine.v3.TimestampedSignatureData")
 	proto.RegisterType((*SignBytes)(nil), "ibc.lightclients.solomachine.v3.SignBytes")
 	proto.RegisterType((*HeaderData)(nil), "ibc.lightclients.solomachine.v3.HeaderData")
+	proto.RegisterType((*Signer)(nil), "ibc.lightclients.solomachine.v3.Signer")
+	proto.RegisterType((*SignerKeyRotation)(nil), "ibc.lightclients.solomachine.v3.SignerKeyRotation")
+	proto.RegisterType((*SignerSet)(nil), "ibc.lightclients.solomachine.v3.SignerSet")
 }
 
 func init() {
@@ -381,46 +521,57 @@ func init() {
 }
 
 var fileDescriptor_264187157b9220a4 = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xce, 0xb6, 0xfe, 0x55, 0xcd, 0x3a, 0xbf, 0x14, 0x59, 0x3d, 0x84, 0x82, 0xdc, 0xa8, 0x12,
-	0xa2, 0x97, 0xd8, 0x34, 0x41, 0x48, 0x94, 0x53, 0xdb, 0x08, 0x21, 0x01, 0x02, 0xb9, 0x15, 0x42,
-	0x5c, 0xa2, 0xb5, 0xbd, 0x71, 0x56, 0xd8, 0xbb, 0xa9, 0x77, 0x9d, 0x28, 0x88, 0x07, 0x40, 0xe2,
-	0xc2, 0x85, 0x3b, 0x27, 0xae, 0xbc, 0x06, 0xc7, 0x1e, 0x39, 0x56, 0xc9, 0x8b, 0x20, 0xaf, 0xed,
-	0xd8, 0x71, 0xd3, 0xe4, 0xc0, 0x6d, 0x66, 0x3c, 0xf3, 0xed, 0xf7, 0xcd, 0x1f, 0xc3, 0x23, 0x62,
-	0x3b, 0xa6, 0x4f, 0xbc, 0x81, 0x70, 0x7c, 0x82, 0xa9, 0xe0, 0x26, 0x67, 0x3e, 0x0b, 0x90, 0x33,
-	0x20, 0x14, 0x9b, 0xa3, 0x4e, 0xd1, 0x35, 0x86, 0x21, 0x13, 0x4c, 0xdb, 0x27, 0xb6, 0x63, 0x14,
-	0x4b, 0x8c, 0x62, 0xce, 0xa8, 0xb3, 0xb7, 0xeb, 0x31, 0x8f, 0xc9, 0x5c, 0x33, 0xb6, 0x92, 0xb2,
-	0xbd, 0xbb, 0x1e, 0x63, 0x9e, 0x8f, 0x4d, 0xe9, 0xd9, 0x51, 0xdf, 0x44, 0x74, 0x92, 0x7c, 0x3a,
-	0xf8, 0x09, 0xa0, 0x7a, 0x26, 0xb1, 0xce, 0x05, 0x12, 0x58, 0xdb, 0x83, 0xdb, 0x1c, 0x5f, 0x46,
-	0x98, 0x3a, 0xb8, 0x01, 0x9a, 0xe0, 0x50, 0xb1, 0xe6, 0xbe, 0x76, 0x0f, 0x56, 0x09, 0xef, 0xf5,
-	0x43, 0xf6, 0x09, 0xd3, 0xc6, 0x46, 0x13, 0x1c, 0x6e, 0x5b, 0xdb, 0x84, 0x3f, 0x97, 0xbe, 0xf6,
-	0x1e, 0xee, 0x38, 0x8c, 0x72, 0x4c, 0x79, 0xc4, 0x7b, 0x3c, 0xc6, 0x6a, 0x6c, 0x36, 0xc1, 0xa1,
-	0xda, 0x36, 0x8d, 0x35, 0xa4, 0x8d, 0xb3, 0xac, 0x4e, 0x52, 0xb0, 0xea, 0xce, 0x82, 0x7f, 0xac,
-	0x7c, 0xf9, 0xb1, 0x5f, 0x39, 0xf8, 0x0a, 0x60, 0x7d, 0x31, 0x51, 0xeb, 0x40, 0x38, 0x8c, 0x6c,
-	0x9f, 0x38, 0xbd, 0x8f, 0x78, 0x22, 0xd9, 0xaa, 0xed, 0x5d, 0x23, 0xd1, 0x6a, 0x64, 0x5a, 0x8d,
-	0x13, 0x3a, 0xb1, 0xaa, 0x49, 0xde, 0x4b, 0x3c, 0xd1, 0x9a, 0x50, 0x75, 0xc9, 0x08, 0x87, 0x9c,
-	0xf4, 0x09, 0x0e, 0xa5, 0x8c, 0xaa, 0x55, 0x0c, 0x69, 0xf7, 0x61, 0x55, 0x90, 0x00, 0x73, 0x81,
-	0x82, 0xa1, 0xd4, 0xa0, 0x58, 0x79, 0x20, 0x65, 0xf3, 0x0b, 0xc0, 0xad, 0x17, 0x18, 0xb9, 0xe5,
-	0x74, 0x50, 0x4a, 0x8f, 0xbf, 0x72, 0xe2, 0x51, 0x24, 0xa2, 0x10, 0xcb, 0xc7, 0x6a, 0x56, 0x1e,
-	0xd0, 0x8e, 0x61, 0x9d, 0xe2, 0x71, 0xaf, 0xa0, 0x62, 0x73, 0x85, 0x8a, 0x1a, 0xc5, 0xe3, 0xb7,
-	0x73, 0x21, 0x0f, 0xe1, 0x4e, 0x5c, 0x5b, 0x14, 0xa3, 0x48, 0x31, 0x31, 0x64, 0x37, 0x8f, 0xa6,
-	0x8c, 0xaf, 0x01, 0xac, 0xbd, 0x26, 0xdc, 0xc6, 0x03, 0x34, 0x22, 0x2c, 0x0a, 0x57, 0x4e, 0xfa,
-	0x1d, 0xfc, 0x7f, 0x4e, 0xb2, 0xc7, 0x68, 0xc2, 0x5c, 0x6d, 0x1f, 0xad, 0x1d, 0xe5, 0x79, 0x56,
-	0x75, 0x42, 0xdd, 0x2e, 0x12, 0xc8, 0xaa, 0xcd, 0x71, 0xde, 0xd0, 0x12, 0xae, 0x18, 0xb3, 0x54,
-	0xee, 0x3f, 0xe1, 0x5e, 0x8c, 0x59, 0x2a, 0xf1, 0x33, 0xbc, 0x53, 0xce, 0x5b, 0xec, 0x3f, 0x28,
-	0xf7, 0x5f, 0x83, 0xca, 0x10, 0x89, 0x41, 0x3a, 0x18, 0x69, 0xc7, 0x31, 0x17, 0x09, 0x24, 0xa9,
-	0xd5, 0x2c, 0x69, 0x2f, 0xce, 0x58, 0x59, 0xbe, 0x12, 0x18, 0x36, 0x2e, 0xb2, 0x10, 0x76, 0xe7,
-	0x44, 0x24, 0x8b, 0x07, 0xb0, 0x9e, 0xeb, 0x96, 0xe8, 0x09, 0x95, 0xbc, 0x1b, 0xdd, 0x1b, 0xcf,
-	0x6c, 0x2c, 0x7f, 0xe6, 0x3b, 0x80, 0xd5, 0x18, 0xfc, 0x74, 0x22, 0x30, 0x5f, 0x39, 0xc4, 0x95,
-	0x68, 0xe5, 0x3b, 0xd8, 0xbc, 0x79, 0x07, 0x59, 0x73, 0x94, 0x25, 0xcd, 0xf9, 0x2f, 0x6f, 0x4e,
-	0xca, 0xeb, 0x12, 0xc2, 0xe4, 0x20, 0xa4, 0x92, 0xc7, 0x50, 0x4d, 0x17, 0x7b, 0xfd, 0x6d, 0x26,
-	0x5b, 0x7d, 0xcb, 0x4a, 0x6f, 0xdc, 0xbe, 0xd2, 0xa7, 0xfd, 0xdf, 0x53, 0x1d, 0x5c, 0x4d, 0x75,
-	0x70, 0x3d, 0xd5, 0xc1, 0xb7, 0x99, 0x5e, 0xb9, 0x9a, 0xe9, 0x95, 0x3f, 0x33, 0xbd, 0xf2, 0xe1,
-	0x95, 0x47, 0xc4, 0x20, 0xb2, 0x0d, 0x87, 0x05, 0xa6, 0xc3, 0x78, 0xc0, 0xb8, 0x49, 0x6c, 0xa7,
-	0xe5, 0x31, 0x73, 0xf4, 0xd4, 0x0c, 0x98, 0x1b, 0xf9, 0x98, 0x27, 0xbf, 0xde, 0x56, 0xf6, 0xef,
-	0x7d, 0xf4, 0xa4, 0x55, 0xd8, 0xb9, 0x67, 0x05, 0xdb, 0xde, 0x92, 0x7c, 0x3b, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x0a, 0x37, 0x9c, 0x84, 0xb1, 0x05, 0x00, 0x00,
+	// 786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xf3, 0x44,
+	0x10, 0x8e, 0x13, 0x37, 0x34, 0xe3, 0x34, 0xa5, 0xa6, 0x42, 0xa6, 0x54, 0x69, 0xa8, 0x84, 0x1a,
+	0x21, 0xd5, 0xa6, 0x09, 0x42, 0xa2, 0x9c, 0xfa, 0x21, 0x44, 0x55, 0x10, 0x95, 0x5b, 0x21, 0x04,
+	0x87, 0xc8, 0x1f, 0x1b, 0x67, 0xd5, 0x64, 0x37, 0x78, 0xd7, 0x49, 0x83, 0xf8, 0x01, 0x1c, 0xb9,
+	0x70, 0xe7, 0x04, 0x7f, 0x85, 0x63, 0x4f, 0x88, 0x63, 0xd5, 0x8a, 0xff, 0x81, 0xbc, 0x5e, 0x27,
+	0x8e, 0x5b, 0xd2, 0xbc, 0x5f, 0xb7, 0x9d, 0xc9, 0xcc, 0x33, 0xcf, 0x3c, 0x99, 0x19, 0x19, 0x0e,
+	0xb0, 0xeb, 0x59, 0x7d, 0x1c, 0xf4, 0xb8, 0xd7, 0xc7, 0x88, 0x70, 0x66, 0x31, 0xda, 0xa7, 0x03,
+	0xc7, 0xeb, 0x61, 0x82, 0xac, 0x51, 0x3b, 0x6b, 0x9a, 0xc3, 0x90, 0x72, 0xaa, 0xef, 0x60, 0xd7,
+	0x33, 0xb3, 0x29, 0x66, 0x36, 0x66, 0xd4, 0xde, 0xda, 0x0c, 0x68, 0x40, 0x45, 0xac, 0x15, 0xbf,
+	0x92, 0xb4, 0xad, 0xf7, 0x02, 0x4a, 0x83, 0x3e, 0xb2, 0x84, 0xe5, 0x46, 0x5d, 0xcb, 0x21, 0x93,
+	0xe4, 0xa7, 0xdd, 0x3f, 0x14, 0xd0, 0x4e, 0x04, 0xd6, 0x25, 0x77, 0x38, 0xd2, 0xb7, 0x60, 0x95,
+	0xa1, 0x1f, 0x23, 0x44, 0x3c, 0x64, 0x28, 0x0d, 0xa5, 0xa9, 0xda, 0x53, 0x5b, 0x7f, 0x1f, 0x2a,
+	0x98, 0x75, 0xba, 0x21, 0xfd, 0x09, 0x11, 0xa3, 0xd8, 0x50, 0x9a, 0xab, 0xf6, 0x2a, 0x66, 0x5f,
+	0x08, 0x5b, 0xff, 0x0e, 0xd6, 0x3d, 0x4a, 0x18, 0x22, 0x2c, 0x62, 0x1d, 0x16, 0x63, 0x19, 0xa5,
+	0x86, 0xd2, 0xd4, 0x5a, 0x96, 0xf9, 0x0c, 0x69, 0xf3, 0x24, 0xcd, 0x13, 0x14, 0xec, 0x9a, 0x37,
+	0x67, 0x1f, 0xaa, 0xbf, 0xfc, 0xbe, 0x53, 0xd8, 0xfd, 0x5b, 0x81, 0xda, 0x7c, 0xa0, 0xde, 0x06,
+	0x18, 0x46, 0x6e, 0x1f, 0x7b, 0x9d, 0x6b, 0x34, 0x11, 0x6c, 0xb5, 0xd6, 0xa6, 0x99, 0xf4, 0x6a,
+	0xa6, 0xbd, 0x9a, 0x47, 0x64, 0x62, 0x57, 0x92, 0xb8, 0x73, 0x34, 0xd1, 0x1b, 0xa0, 0xf9, 0x78,
+	0x84, 0x42, 0x86, 0xbb, 0x18, 0x85, 0xa2, 0x8d, 0x8a, 0x9d, 0x75, 0xe9, 0xdb, 0x50, 0xe1, 0x78,
+	0x80, 0x18, 0x77, 0x06, 0x43, 0xd1, 0x83, 0x6a, 0xcf, 0x1c, 0xfa, 0x19, 0x00, 0xc3, 0x01, 0x41,
+	0x61, 0x87, 0x21, 0x6e, 0xa8, 0xa2, 0xe8, 0x47, 0xcf, 0xb6, 0x78, 0x29, 0x52, 0x2e, 0x11, 0xb7,
+	0x2b, 0x2c, 0x7d, 0xca, 0xc6, 0xfe, 0x2d, 0x42, 0xf9, 0x4b, 0xe4, 0xf8, 0xf9, 0xca, 0x4a, 0xbe,
+	0xf2, 0x36, 0x88, 0x5c, 0x87, 0x47, 0x21, 0x12, 0xbc, 0xab, 0xf6, 0xcc, 0xa1, 0x1f, 0x42, 0x8d,
+	0xa0, 0x71, 0x27, 0x23, 0x48, 0x69, 0x81, 0x20, 0x55, 0x82, 0xc6, 0x17, 0x53, 0x4d, 0xf6, 0x60,
+	0x3d, 0xce, 0xcd, 0xea, 0xa2, 0x0a, 0x5d, 0x62, 0xc8, 0xd3, 0x8c, 0x34, 0x17, 0x49, 0x91, 0x8c,
+	0x00, 0x2b, 0x2f, 0x2c, 0x40, 0x5c, 0x7a, 0x6a, 0xe9, 0x2e, 0xbc, 0x23, 0xd1, 0xae, 0xd1, 0xa4,
+	0x13, 0x52, 0xee, 0x70, 0x4c, 0x89, 0x51, 0x16, 0xb0, 0xad, 0x25, 0x61, 0xcf, 0xd1, 0xc4, 0x96,
+	0x99, 0xf6, 0x06, 0xcb, 0xbb, 0xa4, 0xce, 0x77, 0x0a, 0x54, 0xbf, 0xc6, 0xcc, 0x45, 0x3d, 0x67,
+	0x84, 0x69, 0x14, 0x2e, 0x1c, 0xf5, 0x6f, 0x61, 0x6d, 0x2a, 0x6d, 0x87, 0x92, 0x44, 0x6f, 0xad,
+	0x75, 0xb0, 0x14, 0x21, 0x91, 0x75, 0x44, 0xfc, 0x53, 0x87, 0x3b, 0x76, 0x75, 0x8a, 0xf3, 0x0d,
+	0xc9, 0xe1, 0xf2, 0x31, 0x35, 0x4a, 0xaf, 0x8e, 0x7b, 0x35, 0xa6, 0xb2, 0xc5, 0x9f, 0xe1, 0xed,
+	0x7c, 0xdc, 0xfc, 0xd4, 0x28, 0xf9, 0xa9, 0xd1, 0x41, 0x1d, 0x3a, 0xbc, 0x27, 0xc7, 0x49, 0xbc,
+	0x63, 0x9f, 0xef, 0x70, 0x47, 0x50, 0xab, 0xda, 0xaa, 0x2f, 0x51, 0x66, 0x93, 0xa9, 0xe6, 0x26,
+	0x53, 0x56, 0x47, 0x60, 0x5c, 0xa5, 0x2e, 0xe4, 0x4f, 0x89, 0x08, 0x16, 0x1f, 0x42, 0x6d, 0xd6,
+	0xb7, 0x40, 0x4f, 0xa8, 0xac, 0xb1, 0xb9, 0xb0, 0xb9, 0x32, 0xc5, 0xa7, 0xcb, 0xfc, 0xa6, 0x40,
+	0x25, 0x06, 0x3f, 0x9e, 0x70, 0xc4, 0x16, 0xfe, 0x89, 0x0b, 0xd1, 0xf2, 0x87, 0xa0, 0xf4, 0xf8,
+	0x10, 0xa4, 0xe2, 0xa8, 0x4f, 0x88, 0xb3, 0x32, 0x13, 0x47, 0xf2, 0xfa, 0xb3, 0x08, 0x90, 0xec,
+	0xb1, 0x68, 0xe5, 0x13, 0xd0, 0xe4, 0x3e, 0x3e, 0x7f, 0x9d, 0x92, 0x65, 0xfc, 0x9f, 0x4d, 0x2c,
+	0x2e, 0xb9, 0x89, 0xa5, 0x37, 0xb3, 0x89, 0xea, 0xeb, 0xdf, 0xc4, 0x1f, 0xa0, 0x9c, 0x44, 0xbf,
+	0xdc, 0x05, 0x7f, 0x17, 0xca, 0x63, 0x14, 0x33, 0x91, 0xff, 0xa9, 0xb4, 0x24, 0xf8, 0x0d, 0x6c,
+	0x3c, 0xa2, 0xa2, 0x7f, 0x00, 0x55, 0xd9, 0x1b, 0x26, 0x3e, 0xba, 0x91, 0x93, 0xa2, 0x25, 0xbe,
+	0xb3, 0xd8, 0xf5, 0xc4, 0xfd, 0x2c, 0x2e, 0x7b, 0x3f, 0x65, 0xe5, 0x10, 0x2a, 0x33, 0x35, 0x8f,
+	0xe0, 0xad, 0x04, 0x9d, 0x19, 0x4a, 0xa3, 0xd4, 0xd4, 0x5a, 0x7b, 0x4b, 0x2a, 0x68, 0xa7, 0x79,
+	0x62, 0x7c, 0x7b, 0x21, 0x62, 0x3d, 0xda, 0xf7, 0xa7, 0xe3, 0x9b, 0x3a, 0x92, 0x9a, 0xc7, 0xdd,
+	0xbf, 0xee, 0xeb, 0xca, 0xed, 0x7d, 0x5d, 0xb9, 0xbb, 0xaf, 0x2b, 0xbf, 0x3e, 0xd4, 0x0b, 0xb7,
+	0x0f, 0xf5, 0xc2, 0x3f, 0x0f, 0xf5, 0xc2, 0xf7, 0x5f, 0x05, 0x98, 0xf7, 0x22, 0xd7, 0xf4, 0xe8,
+	0xc0, 0xf2, 0x28, 0x1b, 0x50, 0x66, 0x61, 0xd7, 0xdb, 0x0f, 0xa8, 0x35, 0xfa, 0xcc, 0x1a, 0x50,
+	0x3f, 0xea, 0x23, 0x96, 0x7c, 0x7d, 0xec, 0xa7, 0x9f, 0x1f, 0x1f, 0x7f, 0xba, 0x9f, 0xa1, 0xf4,
+	0x79, 0xe6, 0xed, 0x96, 0x45, 0xf7, 0xed, 0xff, 0x06, 0x00, 0x1f, 0x9e, 0x04, 0xcb, 0xb4, 0x08,
+	0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -493,6 +644,18 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignerSet != nil {
+		{
+			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintSolomachine(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -540,6 +703,30 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignerKeyRotation != nil {
+		{
+			size, err := m.SignerKeyRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NewSignerSet != nil {
+		{
+			size, err := m.NewSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.NewDiversifier) > 0 {
 		i -= len(m.NewDiversifier)
 		copy(dAtA[i:], m.NewDiversifier)
@@ -784,6 +971,30 @@ func (m *HeaderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignerKeyRotation != nil {
+		{
+			size, err := m.SignerKeyRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewSignerSet != nil {
+		{
+			size, err := m.NewSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.NewDiversifier) > 0 {
 		i -= len(m.NewDiversifier)
 		copy(dAtA[i:], m.NewDiversifier)
@@ -806,6 +1017,128 @@ func (m *HeaderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Signer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Signer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Signer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPublicKey != nil {
+		{
+			size, err := m.NewPublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignerIndex != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.SignerIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSolomachine(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSolomachine(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSolomachine(v)
 	base := offset
@@ -853,6 +1186,10 @@ func (m *ConsensusState) Size() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovSolomachine(uint64(m.Timestamp))
 	}
+	if m.SignerSet != nil {
+		l = m.SignerSet.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
 	return n
 }
 
@@ -877,6 +1214,14 @@ func (m *Header) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSolomachine(uint64(l))
 	}
+	if m.NewSignerSet != nil {
+		l = m.NewSignerSet.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.SignerKeyRotation != nil {
+		l = m.SignerKeyRotation.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
 	return n
 }
 
@@ -981,24 +1326,82 @@ func (m *HeaderData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSolomachine(uint64(l))
 	}
+	if m.NewSignerSet != nil {
+		l = m.NewSignerSet.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.SignerKeyRotation != nil {
+		l = m.SignerKeyRotation.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
 	return n
 }
 
-func sovSolomachine(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Signer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovSolomachine(uint64(m.Weight))
+	}
+	return n
 }
-func sozSolomachine(x uint64) (n int) {
-	return sovSolomachine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SignerKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerIndex != 0 {
+		n += 1 + sovSolomachine(uint64(m.SignerIndex))
+	}
+	if m.NewPublicKey != nil {
+		l = m.NewPublicKey.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
 }
-func (m *ClientState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSolomachine
+
+func (m *SignerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovSolomachine(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovSolomachine(uint64(m.Threshold))
+	}
+	return n
+}
+
+func sovSolomachine(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSolomachine(x uint64) (n int) {
+	return sovSolomachine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -1231,6 +1634,42 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignerSet == nil {
+				m.SignerSet = &SignerSet{}
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
@@ -1402,6 +1841,78 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			}
 			m.NewDiversifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewSignerSet == nil {
+				m.NewSignerSet = &SignerSet{}
+			}
+			if err := m.NewSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerKeyRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignerKeyRotation == nil {
+				m.SignerKeyRotation = &SignerKeyRotation{}
+			}
+			if err := m.SignerKeyRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
@@ -2123,6 +2634,391 @@ func (m *HeaderData) Unmarshal(dAtA []byte) error {
 			}
 			m.NewDiversifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewSignerSet == nil {
+				m.NewSignerSet = &SignerSet{}
+			}
+			if err := m.NewSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerKeyRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignerKeyRotation == nil {
+				m.SignerKeyRotation = &SignerKeyRotation{}
+			}
+			if err := m.SignerKeyRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Signer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerIndex", wireType)
+			}
+			m.SignerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPublicKey == nil {
+				m.NewPublicKey = &types.Any{}
+			}
+			if err := m.NewPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &Signer{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
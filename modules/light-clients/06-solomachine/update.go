@@ -12,8 +12,8 @@ import (
 )
 
 // VerifyClientMessage introspects the provided ClientMessage and checks its validity
-// A Solomachine Header is considered valid if the currently registered public key has signed over the new public key with the correct sequence
-// A Solomachine Misbehaviour is considered valid if duplicate signatures of the current public key are found on two different messages at a given sequence
+// A Solomachine Header is considered valid if the currently registered public key (or a quorum of the current signer set) has signed over the new public key or signer set with the correct sequence
+// A Solomachine Misbehaviour is considered valid if duplicate signatures of the current public key (or quorum) are found on two different messages at a given sequence
 func (cs ClientState) VerifyClientMessage(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) error {
 	switch msg := clientMsg.(type) {
 	case *Header:
@@ -34,10 +34,24 @@ func (cs ClientState) verifyHeader(cdc codec.BinaryCodec, header *Header) error
 		)
 	}
 
-	// assert currently registered public key signed over the new public key with correct sequence
+	// assert currently registered public key or signer set quorum signed over the new public key
+	// or signer set with correct sequence
 	headerData := &HeaderData{
-		NewPubKey:      header.NewPublicKey,
-		NewDiversifier: header.NewDiversifier,
+		NewPubKey:         header.NewPublicKey,
+		NewDiversifier:    header.NewDiversifier,
+		NewSignerSet:      header.NewSignerSet,
+		SignerKeyRotation: header.SignerKeyRotation,
+	}
+
+	// a signer key rotation must apply to the current signer set
+	if header.SignerKeyRotation != nil {
+		if cs.ConsensusState.SignerSet == nil {
+			return errorsmod.Wrap(ErrInvalidHeader, "signer key rotation requires the consensus state to have a signer set")
+		}
+
+		if _, err := cs.ConsensusState.SignerSet.RotateSignerKey(*header.SignerKeyRotation); err != nil {
+			return errorsmod.Wrap(ErrInvalidHeader, err.Error())
+		}
 	}
 
 	dataBz, err := cdc.Marshal(headerData)
@@ -63,19 +77,14 @@ func (cs ClientState) verifyHeader(cdc codec.BinaryCodec, header *Header) error
 		return err
 	}
 
-	publicKey, err := cs.ConsensusState.GetPubKey()
-	if err != nil {
-		return err
-	}
-
-	if err := VerifySignature(publicKey, data, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(data, sigData); err != nil {
 		return errorsmod.Wrap(ErrInvalidHeader, err.Error())
 	}
 
 	return nil
 }
 
-// UpdateState updates the consensus state to the new public key and an incremented sequence.
+// UpdateState updates the consensus state to the new public key, signer set or rotated signer key and an incremented sequence.
 // A list containing the updated consensus height is returned.
 // If the provided clientMsg is not of type Header, the handler will no-op and return an empty slice.
 func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
@@ -90,6 +99,17 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 		PublicKey:   smHeader.NewPublicKey,
 		Diversifier: smHeader.NewDiversifier,
 		Timestamp:   smHeader.Timestamp,
+		SignerSet:   smHeader.NewSignerSet,
+	}
+
+	// the signer key rotation has been verified against the current signer set in VerifyClientMessage
+	if smHeader.SignerKeyRotation != nil {
+		signerSet, err := cs.ConsensusState.SignerSet.RotateSignerKey(*smHeader.SignerKeyRotation)
+		if err != nil {
+			panic(err)
+		}
+
+		consensusState.SignerSet = signerSet
 	}
 
 	cs.Sequence++
@@ -169,6 +169,19 @@ func (cs ClientState) Validate() error {
 	return nil
 }
 
+// validateProofSpecs performs the full structural validation of the client state proof specs.
+// Proof specs may combine different commitment schemes, e.g. an SMT store committed to by a
+// tendermint simple merkle tree, as long as each individual spec is valid.
+// It is only applied when new proof specs are introduced, i.e. on client creation and upgrade,
+// so that client states already in store are not invalidated by the stricter checks.
+func (cs ClientState) validateProofSpecs() error {
+	if err := commitmenttypes.ValidateProofSpecs(cs.ProofSpecs); err != nil {
+		return errorsmod.Wrap(ErrInvalidProofSpecs, err.Error())
+	}
+
+	return nil
+}
+
 // ZeroCustomFields returns a ClientState that is a copy of the current ClientState
 // with all client customizable fields zeroed out. All chain specific fields must
 // remain unchanged. This client state will be used to verify chain upgrades when a
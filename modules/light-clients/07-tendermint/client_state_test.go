@@ -114,6 +114,27 @@ func (suite *TendermintTestSuite) TestValidate() {
 			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{ics23.TendermintSpec, nil}, upgradePath),
 			expErr:      ibctm.ErrInvalidProofSpecs,
 		},
+		{
+			name:        "valid client with SMT proof specs",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSMTSpecs(), upgradePath),
+			expErr:      nil,
+		},
+		{
+			name:        "valid client with mixed proof specs",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{ics23.SmtSpec, ics23.IavlSpec, ics23.TendermintSpec}, upgradePath),
+			expErr:      nil,
+		},
+		{
+			name:        "valid client with IAVL and tendermint proof specs",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{ics23.IavlSpec, ics23.TendermintSpec}, upgradePath),
+			expErr:      nil,
+		},
+		{
+			// structural proof spec checks are only applied on client creation and upgrade
+			name:        "valid client already in store with spec without leaf spec",
+			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, []*ics23.ProofSpec{{InnerSpec: ics23.SmtSpec.InnerSpec}, ics23.TendermintSpec}, upgradePath),
+			expErr:      nil,
+		},
 		{
 			name:        "invalid upgrade path",
 			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), invalidUpgradePath),
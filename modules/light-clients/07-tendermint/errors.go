@@ -20,4 +20,5 @@ var (
 	ErrInvalidProofSpecs       = errorsmod.Register(ModuleName, 13, "invalid proof specs")
 	ErrInvalidValidatorSet     = errorsmod.Register(ModuleName, 14, "invalid validator set")
 	ErrInvalidTrustLevel       = errorsmod.Register(ModuleName, 15, "invalid trust level")
+	ErrInvalidHeaderChain      = errorsmod.Register(ModuleName, 16, "invalid header chain")
 )
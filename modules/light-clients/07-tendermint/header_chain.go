@@ -0,0 +1,111 @@
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*HeaderChain)(nil)
+
+// MaxHeaderChainLength is the maximum number of headers which may be submitted in a single HeaderChain.
+const MaxHeaderChainLength = 16
+
+// NewHeaderChain creates a new HeaderChain instance. Consensus states are stored for the provided
+// consensus heights and for the last header in the chain.
+func NewHeaderChain(headers []*Header, consensusHeights []clienttypes.Height) *HeaderChain {
+	return &HeaderChain{
+		Headers:          headers,
+		ConsensusHeights: consensusHeights,
+	}
+}
+
+// ClientType defines that the HeaderChain is a Tendermint consensus algorithm
+func (HeaderChain) ClientType() string {
+	return exported.Tendermint
+}
+
+// LastHeader returns the last header in the chain, which updates the client to its latest height.
+// NOTE: the header chain is checked to be non empty in ValidateBasic.
+func (hc HeaderChain) LastHeader() *Header {
+	return hc.Headers[len(hc.Headers)-1]
+}
+
+// ValidateBasic ensures that the header chain is non empty and does not exceed the maximum length,
+// that each header is valid and trusts the header preceding it, and that each consensus height
+// corresponds to a header in the chain.
+func (hc HeaderChain) ValidateBasic() error {
+	if len(hc.Headers) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeaderChain, "header chain cannot be empty")
+	}
+
+	if len(hc.Headers) > MaxHeaderChainLength {
+		return errorsmod.Wrapf(ErrInvalidHeaderChain, "header chain length %d exceeds maximum length %d", len(hc.Headers), MaxHeaderChainLength)
+	}
+
+	for i, header := range hc.Headers {
+		if header == nil {
+			return errorsmod.Wrapf(ErrInvalidHeaderChain, "header at index %d cannot be nil", i)
+		}
+
+		if err := header.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "header at index %d failed basic validation", i)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		// each header must be verified against the consensus state of the previous header
+		if !header.TrustedHeight.EQ(hc.Headers[i-1].GetHeight()) {
+			return errorsmod.Wrapf(ErrInvalidHeaderChain, "header at index %d trusted height %s does not match previous header height %s", i, header.TrustedHeight, hc.Headers[i-1].GetHeight())
+		}
+	}
+
+	for i, height := range hc.ConsensusHeights {
+		if i > 0 && !height.GT(hc.ConsensusHeights[i-1]) {
+			return errorsmod.Wrapf(ErrInvalidHeaderChain, "consensus heights must be strictly increasing, got %s after %s", height, hc.ConsensusHeights[i-1])
+		}
+
+		if !hc.hasHeaderAtHeight(height) {
+			return errorsmod.Wrapf(ErrInvalidHeaderChain, "consensus height %s does not match the height of a header in the chain", height)
+		}
+	}
+
+	return nil
+}
+
+// hasHeaderAtHeight returns true if the chain contains a header at the provided height.
+func (hc HeaderChain) hasHeaderAtHeight(height exported.Height) bool {
+	for _, header := range hc.Headers {
+		if header.GetHeight().EQ(height) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// consensusHeaders returns the headers for which a consensus state is stored, ordered by height.
+func (hc HeaderChain) consensusHeaders() []*Header {
+	var headers []*Header
+	for i, header := range hc.Headers {
+		if i == len(hc.Headers)-1 || hc.isConsensusHeight(header.GetHeight()) {
+			headers = append(headers, header)
+		}
+	}
+
+	return headers
+}
+
+// isConsensusHeight returns true if the provided height is one of the chosen consensus heights.
+func (hc HeaderChain) isConsensusHeight(height exported.Height) bool {
+	for _, consensusHeight := range hc.ConsensusHeights {
+		if consensusHeight.EQ(height) {
+			return true
+		}
+	}
+
+	return false
+}
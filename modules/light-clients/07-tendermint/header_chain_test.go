@@ -0,0 +1,302 @@
+package tendermint_test
+
+import (
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// createHeaderChain returns a chain of headers of the counterparty chain, each trusting the header
+// preceding it. The first header trusts the latest consensus state of the endpoint client.
+func (suite *TendermintTestSuite) createHeaderChain(endpoint *ibctesting.Endpoint, length int) []*ibctm.Header {
+	trustedHeight, ok := endpoint.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	headers := make([]*ibctm.Header, length)
+	for i := range headers {
+		suite.coordinator.CommitNBlocks(endpoint.Counterparty.Chain, 2)
+
+		header, err := endpoint.Counterparty.Chain.IBCClientHeader(endpoint.Counterparty.Chain.LatestCommittedHeader, trustedHeight)
+		suite.Require().NoError(err)
+
+		headers[i] = header
+		trustedHeight, ok = header.GetHeight().(clienttypes.Height)
+		suite.Require().True(ok)
+	}
+
+	return headers
+}
+
+func (suite *TendermintTestSuite) TestHeaderChainValidateBasic() {
+	var headerChain *ibctm.HeaderChain
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: no consensus heights",
+			func() {
+				headerChain.ConsensusHeights = nil
+			},
+			nil,
+		},
+		{
+			"failure: empty header chain",
+			func() {
+				headerChain.Headers = nil
+			},
+			ibctm.ErrInvalidHeaderChain,
+		},
+		{
+			"failure: header chain exceeds maximum length",
+			func() {
+				for len(headerChain.Headers) <= ibctm.MaxHeaderChainLength {
+					headerChain.Headers = append(headerChain.Headers, headerChain.Headers[0])
+				}
+			},
+			ibctm.ErrInvalidHeaderChain,
+		},
+		{
+			"failure: nil header",
+			func() {
+				headerChain.Headers[1] = nil
+			},
+			ibctm.ErrInvalidHeaderChain,
+		},
+		{
+			"failure: invalid header",
+			func() {
+				headerChain.Headers[1].ValidatorSet = nil
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: header does not trust previous header",
+			func() {
+				headerChain.Headers[2].TrustedHeight = headerChain.Headers[0].TrustedHeight
+			},
+			ibctm.ErrInvalidHeaderChain,
+		},
+		{
+			"failure: consensus heights are not increasing",
+			func() {
+				headerChain.ConsensusHeights = []clienttypes.Height{headerChain.Headers[1].TrustedHeight, headerChain.Headers[0].TrustedHeight}
+			},
+			ibctm.ErrInvalidHeaderChain,
+		},
+		{
+			"failure: consensus height does not match a header",
+			func() {
+				headerChain.ConsensusHeights = []clienttypes.Height{headerChain.Headers[0].TrustedHeight}
+			},
+			ibctm.ErrInvalidHeaderChain,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			headers := suite.createHeaderChain(path.EndpointA, 3)
+			headerChain = ibctm.NewHeaderChain(headers, []clienttypes.Height{headers[1].TrustedHeight})
+
+			tc.malleate()
+
+			err := headerChain.ValidateBasic()
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyHeaderChain() {
+	var (
+		path        *ibctesting.Path
+		headerChain *ibctm.HeaderChain
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			true,
+			nil,
+		},
+		{
+			"success: single header",
+			func() {
+				headerChain.Headers = headerChain.Headers[:1]
+			},
+			true,
+			nil,
+		},
+		{
+			"failure: empty header chain",
+			func() {
+				headerChain.Headers = nil
+			},
+			false,
+			ibctm.ErrInvalidHeaderChain,
+		},
+		{
+			"failure: first header trusted consensus state not found",
+			func() {
+				headerChain.Headers[0].TrustedHeight.RevisionHeight++
+			},
+			false,
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: header does not trust previous header height",
+			func() {
+				headerChain.Headers[2].TrustedHeight = headerChain.Headers[0].TrustedHeight
+			},
+			false,
+			ibctm.ErrInvalidHeaderChain,
+		},
+		{
+			"failure: header trusted validators do not match previous header next validators",
+			func() {
+				privVal := cmttypes.NewMockPV()
+				pubKey, err := privVal.GetPubKey()
+				suite.Require().NoError(err)
+
+				valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 100)})
+				trustedVals, err := valSet.ToProto()
+				suite.Require().NoError(err)
+
+				headerChain.Headers[2].TrustedValidators = trustedVals
+			},
+			false,
+			ibctm.ErrInvalidValidatorSet,
+		},
+		{
+			"failure: intermediate header signed by unknown validator set",
+			func() {
+				privVal := cmttypes.NewMockPV()
+				pubKey, err := privVal.GetPubKey()
+				suite.Require().NoError(err)
+
+				altVal := cmttypes.NewValidator(pubKey, 100)
+				altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+				altSigners := getAltSigners(altVal, privVal)
+
+				header := headerChain.Headers[1]
+				headerChain.Headers[1] = suite.chainB.CreateTMClientHeader(
+					suite.chainB.ChainID, header.Header.Height, header.TrustedHeight, header.GetTime(),
+					altValSet, altValSet, suite.chainB.Vals, altSigners,
+				)
+			},
+			false,
+			nil, // error returned by tendermint light client verification
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			headerChain = ibctm.NewHeaderChain(suite.createHeaderChain(path.EndpointA, 3), nil)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), path.EndpointA.ClientID, headerChain)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				if tc.expErr != nil {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestUpdateClientWithHeaderChain() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	// the client lags behind the counterparty by several headers
+	headers := suite.createHeaderChain(path.EndpointA, 4)
+	headerChain := ibctm.NewHeaderChain(headers, []clienttypes.Height{headers[1].TrustedHeight})
+	suite.Require().NoError(headerChain.ValidateBasic())
+
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, headerChain, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+	suite.Require().Equal(headers[3].GetHeight(), clientState.LatestHeight)
+
+	// consensus states are only stored for the chosen heights and the last header
+	for i, header := range headers {
+		consensusState, found := suite.chainA.GetConsensusState(path.EndpointA.ClientID, header.GetHeight())
+
+		expFound := i == 0 || i == len(headers)-1
+		suite.Require().Equal(expFound, found, "header at index %d", i)
+
+		if expFound {
+			suite.Require().Equal(&ibctm.ConsensusState{
+				Timestamp:          header.GetTime(),
+				Root:               commitmenttypes.NewMerkleRoot(header.Header.GetAppHash()),
+				NextValidatorsHash: header.Header.NextValidatorsHash,
+			}, consensusState)
+		}
+	}
+}
+
+func (suite *TendermintTestSuite) TestCheckForMisbehaviourHeaderChain() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	headers := suite.createHeaderChain(path.EndpointA, 3)
+	headerChain := ibctm.NewHeaderChain(headers, nil)
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	suite.Require().False(lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), path.EndpointA.ClientID, headerChain))
+
+	// store a conflicting consensus state at the height of an intermediate header
+	conflictingConsensusState := headers[1].ConsensusState()
+	conflictingConsensusState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting app hash"))
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, headers[1].GetHeight(), conflictingConsensusState)
+
+	suite.Require().True(lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), path.EndpointA.ClientID, headerChain))
+}
@@ -43,6 +43,10 @@ func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientSt
 		return err
 	}
 
+	if err := clientState.validateProofSpecs(); err != nil {
+		return err
+	}
+
 	var consensusState ConsensusState
 	if err := l.cdc.Unmarshal(consensusStateBz, &consensusState); err != nil {
 		return fmt.Errorf("failed to unmarshal consensus state bytes into consensus state: %w", err)
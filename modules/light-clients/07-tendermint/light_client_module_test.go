@@ -11,6 +11,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	ics23 "github.com/cosmos/ics23/go"
+
 	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
@@ -49,6 +51,13 @@ func (suite *TendermintTestSuite) TestInitialize() {
 			},
 			ibctm.ErrInvalidChainID,
 		},
+		{
+			"invalid client state: proof spec without leaf spec",
+			func() {
+				clientState.(*ibctm.ClientState).ProofSpecs = []*ics23.ProofSpec{{InnerSpec: ics23.IavlSpec.InnerSpec}, ics23.TendermintSpec}
+			},
+			ibctm.ErrInvalidProofSpecs,
+		},
 		{
 			"invalid client state: solomachine client state",
 			func() {
@@ -256,6 +256,53 @@ func (m *Header) GetTrustedValidators() *types2.ValidatorSet {
 	return nil
 }
 
+// HeaderChain defines an ordered list of Headers used to update the client in a
+// single client message. The first Header must be trusted by a ConsensusState
+// stored by the client and each subsequent Header must be trusted by the Header
+// preceding it, i.e. its TrustedHeight and TrustedValidators must match the height
+// and the next validators of the previous Header. The Headers are verified
+// sequentially, allowing a client which lags behind to catch up atomically.
+// ConsensusStates are only stored for the heights in ConsensusHeights and for the
+// last Header in the chain.
+type HeaderChain struct {
+	Headers []*Header `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	// heights of the intermediate headers for which a consensus state is stored
+	ConsensusHeights []types.Height `protobuf:"bytes,2,rep,name=consensus_heights,json=consensusHeights,proto3" json:"consensus_heights"`
+}
+
+func (m *HeaderChain) Reset()         { *m = HeaderChain{} }
+func (m *HeaderChain) String() string { return proto.CompactTextString(m) }
+func (*HeaderChain) ProtoMessage()    {}
+func (*HeaderChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{4}
+}
+func (m *HeaderChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderChain.Merge(m, src)
+}
+func (m *HeaderChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderChain proto.InternalMessageInfo
+
 // Fraction defines the protobuf message type for tmmath.Fraction that only
 // supports positive values.
 type Fraction struct {
@@ -267,7 +314,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{4}
+	return fileDescriptor_c6d6cf2b288949be, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,6 +362,7 @@ func init() {
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermint.v1.ConsensusState")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermint.v1.Misbehaviour")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.tendermint.v1.Header")
+	proto.RegisterType((*HeaderChain)(nil), "ibc.lightclients.tendermint.v1.HeaderChain")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.tendermint.v1.Fraction")
 }
 
@@ -323,66 +371,69 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xc7, 0xe3, 0x34, 0xdb, 0x26, 0x93, 0x64, 0xfb, 0xfb, 0x8d, 0x56, 0xc8, 0xad, 0xaa, 0x24,
-	0xf4, 0x00, 0xb9, 0xd4, 0xde, 0x64, 0x91, 0x10, 0x2c, 0x1c, 0x48, 0x77, 0xa1, 0x5d, 0xb6, 0x50,
-	0xb9, 0xc0, 0x81, 0x8b, 0x35, 0xb6, 0x27, 0xf6, 0x68, 0x6d, 0x8f, 0x35, 0x33, 0x0e, 0x29, 0x27,
-	0x8e, 0x1c, 0xf7, 0xc8, 0x91, 0x97, 0xc0, 0xcb, 0xd8, 0x63, 0x2f, 0x48, 0x9c, 0x0a, 0x4a, 0xdf,
-	0x05, 0x27, 0x34, 0x7f, 0x9c, 0x98, 0xb2, 0x62, 0x2b, 0x2e, 0xd1, 0x33, 0xf3, 0x7c, 0x9f, 0x4f,
-	0x66, 0x9e, 0x3f, 0x63, 0xe0, 0x92, 0x20, 0x74, 0x53, 0x12, 0x27, 0x22, 0x4c, 0x09, 0xce, 0x05,
-	0x77, 0x05, 0xce, 0x23, 0xcc, 0x32, 0x92, 0x0b, 0x77, 0x31, 0xa9, 0xad, 0x9c, 0x82, 0x51, 0x41,
-	0xe1, 0x80, 0x04, 0xa1, 0x53, 0x0f, 0x70, 0x6a, 0x92, 0xc5, 0x64, 0x7f, 0x54, 0x8b, 0x17, 0x97,
-	0x05, 0xe6, 0xee, 0x02, 0xa5, 0x24, 0x42, 0x82, 0x32, 0x4d, 0xd8, 0x3f, 0xf8, 0x87, 0x42, 0xfd,
-	0x56, 0xde, 0x90, 0xf2, 0x8c, 0x72, 0x97, 0x84, 0x7c, 0xfa, 0x48, 0x9e, 0xa0, 0x60, 0x94, 0xce,
-	0x2b, 0xef, 0x20, 0xa6, 0x34, 0x4e, 0xb1, 0xab, 0x56, 0x41, 0x39, 0x77, 0xa3, 0x92, 0x21, 0x41,
-	0x68, 0x6e, 0xfc, 0xc3, 0xdb, 0x7e, 0x41, 0x32, 0xcc, 0x05, 0xca, 0x8a, 0x4a, 0x20, 0xef, 0x1b,
-	0x52, 0x86, 0x5d, 0x7d, 0x7c, 0xf9, 0x0f, 0xda, 0x32, 0x82, 0x77, 0x37, 0x02, 0x9a, 0x65, 0x44,
-	0x64, 0x95, 0x68, 0xbd, 0x32, 0xc2, 0x07, 0x31, 0x8d, 0xa9, 0x32, 0x5d, 0x69, 0xe9, 0xdd, 0xc3,
-	0xd5, 0x3d, 0xd0, 0x3d, 0x56, 0xbc, 0x0b, 0x81, 0x04, 0x86, 0x7b, 0xa0, 0x1d, 0x26, 0x88, 0xe4,
-	0x3e, 0x89, 0x6c, 0x6b, 0x64, 0x8d, 0x3b, 0xde, 0x8e, 0x5a, 0x9f, 0x46, 0xf0, 0x4b, 0xd0, 0x15,
-	0xac, 0xe4, 0xc2, 0x4f, 0xf1, 0x02, 0xa7, 0x76, 0x73, 0x64, 0x8d, 0xbb, 0xd3, 0xb1, 0xf3, 0xef,
-	0xf9, 0x75, 0x3e, 0x65, 0x28, 0x94, 0x17, 0x9e, 0xb5, 0x5e, 0x5d, 0x0f, 0x1b, 0x1e, 0x50, 0x88,
-	0xe7, 0x92, 0x00, 0x9f, 0x83, 0x5d, 0xb5, 0x22, 0x79, 0xec, 0x17, 0x98, 0x11, 0x1a, 0xd9, 0x5b,
-	0x0a, 0xba, 0xe7, 0xe8, 0xb4, 0x38, 0x55, 0x5a, 0x9c, 0x27, 0x26, 0x6d, 0xb3, 0xb6, 0xa4, 0xfc,
-	0xf4, 0xfb, 0xd0, 0xf2, 0xee, 0x57, 0xb1, 0xe7, 0x2a, 0x14, 0x7e, 0x01, 0xfe, 0x57, 0xe6, 0x01,
-	0xcd, 0xa3, 0x1a, 0xae, 0x75, 0x77, 0xdc, 0xee, 0x3a, 0xd8, 0xf0, 0x3e, 0x07, 0xbb, 0x19, 0x5a,
-	0xfa, 0x61, 0x4a, 0xc3, 0x17, 0x7e, 0xc4, 0xc8, 0x5c, 0xd8, 0xf7, 0xee, 0x8e, 0xeb, 0x67, 0x68,
-	0x79, 0x2c, 0x43, 0x9f, 0xc8, 0x48, 0xf8, 0x14, 0xf4, 0xe7, 0x8c, 0x7e, 0x8f, 0x73, 0x3f, 0xc1,
-	0x32, 0x57, 0xf6, 0xb6, 0x42, 0xed, 0xab, 0xec, 0xc9, 0xea, 0x39, 0xa6, 0xa8, 0x8b, 0x89, 0x73,
-	0xa2, 0x14, 0x26, 0x5f, 0x3d, 0x1d, 0xa6, 0xf7, 0x24, 0x26, 0x45, 0x02, 0x73, 0x51, 0x61, 0x76,
-	0xee, 0x8a, 0xd1, 0x61, 0x06, 0xf3, 0x18, 0x74, 0x55, 0x97, 0xfa, 0xbc, 0xc0, 0x21, 0xb7, 0xdb,
-	0xa3, 0x2d, 0x05, 0xd1, 0x9d, 0xec, 0xa8, 0x4e, 0x96, 0x84, 0x73, 0xa9, 0xb9, 0x28, 0x70, 0xe8,
-	0x81, 0xa2, 0x32, 0x39, 0x7c, 0x1b, 0xf4, 0xca, 0x22, 0x66, 0x28, 0xc2, 0x7e, 0x81, 0x44, 0x62,
-	0x77, 0x46, 0x5b, 0xe3, 0x8e, 0xd7, 0x35, 0x7b, 0xe7, 0x48, 0x24, 0xf0, 0x63, 0xb0, 0x87, 0xd2,
-	0x94, 0x7e, 0xe7, 0x97, 0x45, 0x84, 0x04, 0xf6, 0xd1, 0x5c, 0x60, 0xe6, 0xe3, 0x65, 0x41, 0xd8,
-	0xa5, 0x0d, 0x46, 0xd6, 0xb8, 0x3d, 0x6b, 0xda, 0x96, 0xf7, 0x96, 0x12, 0x7d, 0xad, 0x34, 0x9f,
-	0x48, 0xc9, 0x53, 0xa5, 0x80, 0xa7, 0x60, 0xf8, 0x9a, 0xf0, 0x8c, 0xf0, 0x00, 0x27, 0x68, 0x41,
-	0x68, 0xc9, 0xec, 0xee, 0x1a, 0x72, 0x70, 0x1b, 0x72, 0x56, 0xd3, 0x7d, 0xd8, 0xfa, 0xf1, 0xe7,
-	0x61, 0xe3, 0xf0, 0x87, 0x26, 0xb8, 0x7f, 0x4c, 0x73, 0x8e, 0x73, 0x5e, 0x72, 0xdd, 0xe7, 0x33,
-	0xd0, 0x59, 0x8f, 0x9a, 0x6a, 0x74, 0x99, 0x80, 0xdb, 0x75, 0xfd, 0xaa, 0x52, 0xe8, 0xc2, 0xbe,
-	0x94, 0x85, 0xdd, 0x84, 0xc1, 0x8f, 0x40, 0x8b, 0x51, 0x2a, 0xcc, 0x24, 0x1c, 0xd6, 0x8a, 0xb0,
-	0x99, 0xbd, 0xc5, 0xc4, 0x39, 0xc3, 0xec, 0x45, 0x8a, 0x3d, 0x4a, 0xab, 0x62, 0xa8, 0x28, 0x38,
-	0x07, 0x0f, 0x72, 0xbc, 0x14, 0xfe, 0xfa, 0xb9, 0xe1, 0x7e, 0x82, 0x78, 0xa2, 0x46, 0xa0, 0x37,
-	0x7b, 0xef, 0xcf, 0xeb, 0xe1, 0xc3, 0x98, 0x88, 0xa4, 0x0c, 0x24, 0x4e, 0x8e, 0x33, 0x16, 0xc1,
-	0x5c, 0x6c, 0x8c, 0x94, 0x04, 0xdc, 0x0d, 0x2e, 0x05, 0xe6, 0xce, 0x09, 0x5e, 0xce, 0xa4, 0xe1,
-	0x41, 0x49, 0xfc, 0x66, 0x0d, 0x3c, 0x41, 0x3c, 0x31, 0x29, 0xf8, 0xd5, 0x02, 0xbd, 0x7a, 0x66,
-	0xe0, 0x10, 0x74, 0x74, 0xaf, 0xac, 0x27, 0x5d, 0xa5, 0xb3, 0xad, 0x37, 0x4f, 0xe5, 0x3c, 0xb5,
-	0x13, 0x8c, 0x22, 0xcc, 0xfc, 0x89, 0xb9, 0xe1, 0x3b, 0x6f, 0x9a, 0xf5, 0x13, 0xa5, 0x9f, 0x75,
-	0x57, 0xd7, 0xc3, 0x1d, 0x6d, 0x4f, 0xbc, 0x1d, 0x0d, 0x99, 0xd4, 0x78, 0x53, 0x33, 0xe6, 0xff,
-	0x81, 0x37, 0xad, 0x78, 0x53, 0x73, 0xaf, 0x5f, 0x9a, 0x60, 0x5b, 0xbb, 0xe0, 0x29, 0xe8, 0x73,
-	0x12, 0xe7, 0x38, 0xf2, 0xb5, 0xc4, 0x94, 0x75, 0x50, 0x87, 0xea, 0x97, 0xfb, 0x42, 0xc9, 0x0c,
-	0xbd, 0x75, 0x75, 0x3d, 0xb4, 0xbc, 0x1e, 0xaf, 0xed, 0xc1, 0x63, 0xd0, 0x5f, 0x97, 0xc5, 0xe7,
-	0xb8, 0x2a, 0xf1, 0x6b, 0x50, 0xeb, 0x64, 0x5f, 0x60, 0xe1, 0xf5, 0x16, 0xb5, 0x15, 0xfc, 0x0c,
-	0xe8, 0x27, 0x4a, 0x1d, 0x48, 0x4d, 0xeb, 0xd6, 0x1d, 0xa7, 0xb5, 0x6f, 0xe2, 0xcc, 0xb8, 0x9e,
-	0x01, 0x58, 0x81, 0x36, 0xcd, 0x62, 0xde, 0xb6, 0x37, 0x1d, 0xe9, 0xff, 0x26, 0x72, 0xd3, 0x14,
-	0x87, 0xcf, 0x40, 0xbb, 0x7a, 0x94, 0xe1, 0x01, 0xe8, 0xe4, 0x65, 0x86, 0x99, 0xf4, 0xa8, 0x7c,
-	0xb5, 0xbc, 0xcd, 0x06, 0x1c, 0x81, 0x6e, 0x84, 0x73, 0x9a, 0x91, 0x5c, 0xf9, 0x9b, 0xca, 0x5f,
-	0xdf, 0x9a, 0x45, 0xaf, 0x56, 0x03, 0xeb, 0x6a, 0x35, 0xb0, 0xfe, 0x58, 0x0d, 0xac, 0x97, 0x37,
-	0x83, 0xc6, 0xd5, 0xcd, 0xa0, 0xf1, 0xdb, 0xcd, 0xa0, 0xf1, 0xed, 0xb3, 0xbf, 0x35, 0xaf, 0xfe,
-	0x44, 0x06, 0xe1, 0x51, 0x4c, 0xdd, 0xc5, 0x07, 0x6e, 0x46, 0xa3, 0x32, 0xc5, 0x5c, 0x7f, 0xc8,
-	0x8f, 0xaa, 0x2f, 0xf9, 0xc3, 0xf7, 0x8f, 0x36, 0x97, 0x79, 0xbc, 0x31, 0x83, 0x6d, 0x35, 0x91,
-	0x8f, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xc5, 0x40, 0xb7, 0xfd, 0x07, 0x00, 0x00,
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4f, 0x6f, 0xe3, 0xc4,
+	0x1b, 0xc7, 0xeb, 0x24, 0xdb, 0x26, 0x93, 0x74, 0xbb, 0x3b, 0x5a, 0xfd, 0xe4, 0x56, 0x55, 0x92,
+	0x5f, 0x0f, 0x90, 0x4b, 0xed, 0x4d, 0x16, 0x09, 0xc1, 0x82, 0x04, 0xc9, 0x2e, 0xb4, 0xcb, 0x16,
+	0x2a, 0x17, 0x38, 0x70, 0xb1, 0xc6, 0xf6, 0xc4, 0x1e, 0xad, 0xed, 0xb1, 0x3c, 0xe3, 0x90, 0x72,
+	0xe2, 0xc8, 0x71, 0x8f, 0x1c, 0x39, 0xf0, 0x02, 0x78, 0x19, 0x7b, 0xec, 0x05, 0x89, 0x53, 0x41,
+	0xe9, 0xbb, 0xe0, 0x84, 0xe6, 0x8f, 0x1d, 0x53, 0x56, 0x6c, 0xc4, 0xa5, 0x7a, 0xe6, 0x99, 0xef,
+	0xf3, 0xe9, 0x3c, 0xcf, 0x33, 0xcf, 0xc4, 0xc0, 0x26, 0x9e, 0x6f, 0xc7, 0x24, 0x8c, 0xb8, 0x1f,
+	0x13, 0x9c, 0x72, 0x66, 0x73, 0x9c, 0x06, 0x38, 0x4f, 0x48, 0xca, 0xed, 0xc5, 0xb8, 0xb6, 0xb2,
+	0xb2, 0x9c, 0x72, 0x0a, 0xfb, 0xc4, 0xf3, 0xad, 0x7a, 0x80, 0x55, 0x93, 0x2c, 0xc6, 0x07, 0xc3,
+	0x5a, 0x3c, 0xbf, 0xcc, 0x30, 0xb3, 0x17, 0x28, 0x26, 0x01, 0xe2, 0x34, 0x57, 0x84, 0x83, 0xc3,
+	0x7f, 0x28, 0xe4, 0xdf, 0x72, 0xd7, 0xa7, 0x2c, 0xa1, 0xcc, 0x26, 0x3e, 0x9b, 0x3c, 0x12, 0x27,
+	0xc8, 0x72, 0x4a, 0xe7, 0xe5, 0x6e, 0x3f, 0xa4, 0x34, 0x8c, 0xb1, 0x2d, 0x57, 0x5e, 0x31, 0xb7,
+	0x83, 0x22, 0x47, 0x9c, 0xd0, 0x54, 0xef, 0x0f, 0x6e, 0xef, 0x73, 0x92, 0x60, 0xc6, 0x51, 0x92,
+	0x95, 0x02, 0x91, 0xaf, 0x4f, 0x73, 0x6c, 0xab, 0xe3, 0x8b, 0xff, 0xa0, 0x2c, 0x2d, 0x78, 0x7b,
+	0x2d, 0xa0, 0x49, 0x42, 0x78, 0x52, 0x8a, 0xaa, 0x95, 0x16, 0x3e, 0x08, 0x69, 0x48, 0xa5, 0x69,
+	0x0b, 0x4b, 0x79, 0x8f, 0x56, 0x77, 0x40, 0x77, 0x26, 0x79, 0x17, 0x1c, 0x71, 0x0c, 0xf7, 0x41,
+	0xdb, 0x8f, 0x10, 0x49, 0x5d, 0x12, 0x98, 0xc6, 0xd0, 0x18, 0x75, 0x9c, 0x1d, 0xb9, 0x3e, 0x0d,
+	0xe0, 0x17, 0xa0, 0xcb, 0xf3, 0x82, 0x71, 0x37, 0xc6, 0x0b, 0x1c, 0x9b, 0x8d, 0xa1, 0x31, 0xea,
+	0x4e, 0x46, 0xd6, 0xbf, 0xd7, 0xd7, 0xfa, 0x24, 0x47, 0xbe, 0x48, 0x78, 0xda, 0x7a, 0x75, 0x3d,
+	0xd8, 0x72, 0x80, 0x44, 0x3c, 0x17, 0x04, 0xf8, 0x1c, 0xec, 0xc9, 0x15, 0x49, 0x43, 0x37, 0xc3,
+	0x39, 0xa1, 0x81, 0xd9, 0x94, 0xd0, 0x7d, 0x4b, 0x95, 0xc5, 0x2a, 0xcb, 0x62, 0x3d, 0xd1, 0x65,
+	0x9b, 0xb6, 0x05, 0xe5, 0xc7, 0xdf, 0x07, 0x86, 0x73, 0xb7, 0x8c, 0x3d, 0x97, 0xa1, 0xf0, 0x73,
+	0x70, 0xaf, 0x48, 0x3d, 0x9a, 0x06, 0x35, 0x5c, 0x6b, 0x73, 0xdc, 0x5e, 0x15, 0xac, 0x79, 0x9f,
+	0x81, 0xbd, 0x04, 0x2d, 0x5d, 0x3f, 0xa6, 0xfe, 0x0b, 0x37, 0xc8, 0xc9, 0x9c, 0x9b, 0x77, 0x36,
+	0xc7, 0xed, 0x26, 0x68, 0x39, 0x13, 0xa1, 0x4f, 0x44, 0x24, 0x7c, 0x0a, 0x76, 0xe7, 0x39, 0xfd,
+	0x0e, 0xa7, 0x6e, 0x84, 0x45, 0xad, 0xcc, 0x6d, 0x89, 0x3a, 0x90, 0xd5, 0x13, 0xdd, 0xb3, 0x74,
+	0x53, 0x17, 0x63, 0xeb, 0x44, 0x2a, 0x74, 0xbd, 0x7a, 0x2a, 0x4c, 0xf9, 0x04, 0x26, 0x46, 0x1c,
+	0x33, 0x5e, 0x62, 0x76, 0x36, 0xc5, 0xa8, 0x30, 0x8d, 0x79, 0x0c, 0xba, 0xf2, 0x96, 0xba, 0x2c,
+	0xc3, 0x3e, 0x33, 0xdb, 0xc3, 0xa6, 0x84, 0xa8, 0x9b, 0x6c, 0xc9, 0x9b, 0x2c, 0x08, 0xe7, 0x42,
+	0x73, 0x91, 0x61, 0xdf, 0x01, 0x59, 0x69, 0x32, 0xf8, 0x7f, 0xd0, 0x2b, 0xb2, 0x30, 0x47, 0x01,
+	0x76, 0x33, 0xc4, 0x23, 0xb3, 0x33, 0x6c, 0x8e, 0x3a, 0x4e, 0x57, 0xfb, 0xce, 0x11, 0x8f, 0xe0,
+	0x87, 0x60, 0x1f, 0xc5, 0x31, 0xfd, 0xd6, 0x2d, 0xb2, 0x00, 0x71, 0xec, 0xa2, 0x39, 0xc7, 0xb9,
+	0x8b, 0x97, 0x19, 0xc9, 0x2f, 0x4d, 0x30, 0x34, 0x46, 0xed, 0x69, 0xc3, 0x34, 0x9c, 0xff, 0x49,
+	0xd1, 0x57, 0x52, 0xf3, 0xb1, 0x90, 0x3c, 0x95, 0x0a, 0x78, 0x0a, 0x06, 0xaf, 0x09, 0x4f, 0x08,
+	0xf3, 0x70, 0x84, 0x16, 0x84, 0x16, 0xb9, 0xd9, 0xad, 0x20, 0x87, 0xb7, 0x21, 0x67, 0x35, 0xdd,
+	0xfb, 0xad, 0x1f, 0x7e, 0x1a, 0x6c, 0x1d, 0x7d, 0xdf, 0x00, 0x77, 0x67, 0x34, 0x65, 0x38, 0x65,
+	0x05, 0x53, 0xf7, 0x7c, 0x0a, 0x3a, 0xd5, 0xa8, 0xc9, 0x8b, 0x2e, 0x0a, 0x70, 0xbb, 0xaf, 0x5f,
+	0x96, 0x0a, 0xd5, 0xd8, 0x97, 0xa2, 0xb1, 0xeb, 0x30, 0xf8, 0x01, 0x68, 0xe5, 0x94, 0x72, 0x3d,
+	0x09, 0x47, 0xb5, 0x26, 0xac, 0x67, 0x6f, 0x31, 0xb6, 0xce, 0x70, 0xfe, 0x22, 0xc6, 0x0e, 0xa5,
+	0x65, 0x33, 0x64, 0x14, 0x9c, 0x83, 0x07, 0x29, 0x5e, 0x72, 0xb7, 0x7a, 0x6e, 0x98, 0x1b, 0x21,
+	0x16, 0xc9, 0x11, 0xe8, 0x4d, 0xdf, 0xf9, 0xf3, 0x7a, 0xf0, 0x30, 0x24, 0x3c, 0x2a, 0x3c, 0x81,
+	0x13, 0xe3, 0x8c, 0xb9, 0x37, 0xe7, 0x6b, 0x23, 0x26, 0x1e, 0xb3, 0xbd, 0x4b, 0x8e, 0x99, 0x75,
+	0x82, 0x97, 0x53, 0x61, 0x38, 0x50, 0x10, 0xbf, 0xae, 0x80, 0x27, 0x88, 0x45, 0xba, 0x04, 0xbf,
+	0x1a, 0xa0, 0x57, 0xaf, 0x0c, 0x1c, 0x80, 0x8e, 0xba, 0x2b, 0xd5, 0xa4, 0xcb, 0x72, 0xb6, 0x95,
+	0xf3, 0x54, 0xcc, 0x53, 0x3b, 0xc2, 0x28, 0xc0, 0xb9, 0x3b, 0xd6, 0x19, 0xbe, 0xf5, 0xa6, 0x59,
+	0x3f, 0x91, 0xfa, 0x69, 0x77, 0x75, 0x3d, 0xd8, 0x51, 0xf6, 0xd8, 0xd9, 0x51, 0x90, 0x71, 0x8d,
+	0x37, 0x31, 0x9b, 0xff, 0x95, 0x37, 0x29, 0x79, 0x13, 0x9d, 0xd7, 0x2f, 0x0d, 0xb0, 0xad, 0xb6,
+	0xe0, 0x29, 0xd8, 0x65, 0x24, 0x4c, 0x71, 0xe0, 0x2a, 0x89, 0x6e, 0x6b, 0xbf, 0x0e, 0x55, 0x2f,
+	0xf7, 0x85, 0x94, 0x69, 0x7a, 0xeb, 0xea, 0x7a, 0x60, 0x38, 0x3d, 0x56, 0xf3, 0xc1, 0x19, 0xd8,
+	0xad, 0xda, 0xe2, 0x32, 0x5c, 0xb6, 0xf8, 0x35, 0xa8, 0xaa, 0xd8, 0x17, 0x98, 0x3b, 0xbd, 0x45,
+	0x6d, 0x05, 0x3f, 0x05, 0xea, 0x89, 0x92, 0x07, 0x92, 0xd3, 0xda, 0xdc, 0x70, 0x5a, 0x77, 0x75,
+	0x9c, 0x1e, 0xd7, 0x33, 0x00, 0x4b, 0xd0, 0xfa, 0xb2, 0x98, 0xad, 0x8d, 0x8e, 0x74, 0x5f, 0x47,
+	0x56, 0x4e, 0x76, 0xf4, 0xb3, 0x01, 0xba, 0x2a, 0xcf, 0x99, 0x78, 0xd9, 0xe1, 0x47, 0x40, 0xd7,
+	0x94, 0x99, 0xc6, 0xb0, 0xb9, 0x79, 0x5f, 0xca, 0x56, 0x30, 0x78, 0x06, 0xee, 0xfb, 0xe5, 0x78,
+	0xe9, 0x5c, 0x99, 0xd9, 0xd0, 0xaf, 0xca, 0x9b, 0x92, 0xbd, 0x57, 0x85, 0x2a, 0x37, 0xd3, 0x9d,
+	0x7d, 0x06, 0xda, 0xe5, 0x6f, 0x07, 0x3c, 0x04, 0x9d, 0xb4, 0x48, 0x70, 0x2e, 0x12, 0x90, 0x6d,
+	0x6d, 0x39, 0x6b, 0x07, 0x1c, 0x82, 0x6e, 0x80, 0x53, 0x9a, 0x90, 0x54, 0xee, 0x37, 0xe4, 0x7e,
+	0xdd, 0x35, 0x0d, 0x5e, 0xad, 0xfa, 0xc6, 0xd5, 0xaa, 0x6f, 0xfc, 0xb1, 0xea, 0x1b, 0x2f, 0x6f,
+	0xfa, 0x5b, 0x57, 0x37, 0xfd, 0xad, 0xdf, 0x6e, 0xfa, 0x5b, 0xdf, 0x3c, 0xfb, 0xdb, 0x8c, 0xa9,
+	0x5f, 0x72, 0xcf, 0x3f, 0x0e, 0xa9, 0xbd, 0x78, 0xcf, 0x4e, 0x68, 0x50, 0xc4, 0x98, 0xa9, 0xef,
+	0x8d, 0xe3, 0xf2, 0x83, 0xe3, 0xe1, 0xbb, 0xc7, 0xeb, 0x72, 0x3c, 0x5e, 0x9b, 0xde, 0xb6, 0x7c,
+	0x38, 0x1e, 0xfd, 0x35, 0x00, 0x29, 0x28, 0x66, 0x13, 0xa4, 0x08, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -683,6 +734,57 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HeaderChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusHeights) > 0 {
+		for iNdEx := len(m.ConsensusHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Fraction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -831,6 +933,27 @@ func (m *Header) Size() (n int) {
 	return n
 }
 
+func (m *HeaderChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
+	}
+	if len(m.ConsensusHeights) > 0 {
+		for _, e := range m.ConsensusHeights {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Fraction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1733,6 +1856,124 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HeaderChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusHeights = append(m.ConsensusHeights, types.Height{})
+			if err := m.ConsensusHeights[len(m.ConsensusHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Fraction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
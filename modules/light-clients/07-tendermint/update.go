@@ -19,7 +19,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
-// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+// VerifyClientMessage checks if the clientMessage is of type Header, HeaderChain or Misbehaviour and verifies the message
 func (cs *ClientState) VerifyClientMessage(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
 	clientMsg exported.ClientMessage,
@@ -27,6 +27,8 @@ func (cs *ClientState) VerifyClientMessage(
 	switch msg := clientMsg.(type) {
 	case *Header:
 		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+	case *HeaderChain:
+		return cs.verifyHeaderChain(ctx, clientStore, cdc, msg)
 	case *Misbehaviour:
 		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
 	default:
@@ -46,14 +48,50 @@ func (cs *ClientState) verifyHeader(
 	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
 	header *Header,
 ) error {
-	currentTimestamp := ctx.BlockTime()
-
 	// Retrieve trusted consensus states for each Header in misbehaviour
 	consState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedHeight)
 	}
 
+	return cs.verifyHeaderWithConsensusState(ctx, header, consState)
+}
+
+// verifyHeaderChain verifies each header in the chain sequentially. The first header is verified
+// against the trusted consensus state stored at its trusted height, and each subsequent header is
+// verified against the consensus state of the header preceding it. An error is returned if any
+// header in the chain fails verification.
+func (cs *ClientState) verifyHeaderChain(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	headerChain *HeaderChain,
+) error {
+	if len(headerChain.Headers) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeaderChain, "header chain cannot be empty")
+	}
+
+	if err := cs.verifyHeader(ctx, clientStore, cdc, headerChain.Headers[0]); err != nil {
+		return errorsmod.Wrap(err, "failed to verify header at index 0")
+	}
+
+	for i := 1; i < len(headerChain.Headers); i++ {
+		header, trustedHeader := headerChain.Headers[i], headerChain.Headers[i-1]
+		if !header.TrustedHeight.EQ(trustedHeader.GetHeight()) {
+			return errorsmod.Wrapf(ErrInvalidHeaderChain, "header at index %d trusted height %s does not match previous header height %s", i, header.TrustedHeight, trustedHeader.GetHeight())
+		}
+
+		if err := cs.verifyHeaderWithConsensusState(ctx, header, trustedHeader.ConsensusState()); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify header at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// verifyHeaderWithConsensusState verifies the header against the provided trusted consensus state.
+// The checks performed are documented in verifyHeader.
+func (cs *ClientState) verifyHeaderWithConsensusState(ctx sdk.Context, header *Header, consState *ConsensusState) error {
+	currentTimestamp := ctx.BlockTime()
+
 	if err := checkTrustedHeader(header, consState); err != nil {
 		return err
 	}
@@ -131,10 +169,17 @@ func (cs *ClientState) verifyHeader(
 // UpdateState must only be used to update within a single revision, thus header revision number and trusted height's revision
 // number must be the same. To update to a new revision, use a separate upgrade path
 // UpdateState will prune the oldest consensus state if it is expired.
-// If the provided clientMsg is not of type of Header then the handler will noop and empty slice is returned.
+// If the provided clientMsg is a HeaderChain, a consensus state is only created for the chosen consensus heights
+// and for the last header in the chain, and the list of those heights is returned.
+// If the provided clientMsg is not of type of Header or HeaderChain then the handler will noop and empty slice is returned.
 func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
-	header, ok := clientMsg.(*Header)
-	if !ok {
+	var headers []*Header
+	switch msg := clientMsg.(type) {
+	case *Header:
+		headers = []*Header{msg}
+	case *HeaderChain:
+		headers = msg.consensusHeaders()
+	default:
 		// clientMsg is invalid Misbehaviour, no update necessary
 		return []exported.Height{}
 	}
@@ -145,10 +190,22 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 		cs.pruneOldestConsensusState(ctx, cdc, clientStore)
 	}
 
+	heights := make([]exported.Height, 0, len(headers))
+	for _, header := range headers {
+		heights = append(heights, cs.updateConsensusState(ctx, cdc, clientStore, header))
+	}
+
+	return heights
+}
+
+// updateConsensusState creates and stores the consensus state for the provided header, updating
+// the client state latest height if the header is at a future height. If a consensus state already
+// exists for the header height the update is a no-op. The header height is returned.
+func (cs *ClientState) updateConsensusState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, header *Header) exported.Height {
 	// check for duplicate update
 	if _, found := GetConsensusState(clientStore, cdc, header.GetHeight()); found {
 		// perform no-op
-		return []exported.Height{header.GetHeight()}
+		return header.GetHeight()
 	}
 
 	height, ok := header.GetHeight().(clienttypes.Height)
@@ -166,11 +223,11 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 	}
 
 	// set client state, consensus state and associated metadata
-	setClientState(clientStore, cdc, &cs)
+	setClientState(clientStore, cdc, cs)
 	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
 	setConsensusMetadata(ctx, clientStore, header.GetHeight())
 
-	return []exported.Height{height}
+	return height
 }
 
 // pruneOldestConsensusState will retrieve the earliest consensus state for this clientID and check if it is expired. If it is,
@@ -106,6 +106,10 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 		return errorsmod.Wrap(err, "updated client state failed basic validation")
 	}
 
+	if err := newClientState.validateProofSpecs(); err != nil {
+		return errorsmod.Wrap(err, "upgraded client state contains invalid proof specs")
+	}
+
 	// The new consensus state is merely used as a trusted kernel against which headers on the new
 	// chain can be verified. The root is just a stand-in sentinel value as it cannot be known in advance, thus no proof verification will pass.
 	// The timestamp and the NextValidatorsHash of the consensus state is the blocktime and NextValidatorsHash
@@ -37,6 +37,10 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		newSubmitStoreCodeProposalCmd(),
 		newMigrateContractCmd(),
+		newSubmitRemoveChecksumProposalCmd(),
+		newSubmitSetChecksumDeprecatedProposalCmd(),
+		newSubmitMigrateContractsProposalCmd(),
+		newMarkClientExpiredCmd(),
 	)
 
 	return txCmd
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -20,7 +21,10 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
-const FlagAuthority = "authority"
+const (
+	FlagAuthority = "authority"
+	FlagForce     = "force"
+)
 
 // newSubmitStoreCodeProposalCmd returns the command to send a proposal to store new wasm bytecode.
 func newSubmitStoreCodeProposalCmd() *cobra.Command {
@@ -41,13 +45,9 @@ func newSubmitStoreCodeProposalCmd() *cobra.Command {
 				return err
 			}
 
-			authority, _ := cmd.Flags().GetString(FlagAuthority)
-			if authority != "" {
-				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
-					return fmt.Errorf("invalid authority address: %w", err)
-				}
-			} else {
-				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			authority, err := getAuthority(cmd)
+			if err != nil {
+				return err
 			}
 
 			code, err := os.ReadFile(args[0])
@@ -72,14 +72,7 @@ func newSubmitStoreCodeProposalCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().String(FlagAuthority, "", "The address of the wasm client module authority (defaults to gov)")
-
-	flags.AddTxFlagsToCmd(cmd)
-	govcli.AddGovPropFlagsToCmd(cmd)
-	err := cmd.MarkFlagRequired(govcli.FlagTitle)
-	if err != nil {
-		panic(err)
-	}
+	addProposalFlags(cmd)
 
 	return cmd
 }
@@ -126,3 +119,219 @@ func newMigrateContractCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// newMarkClientExpiredCmd returns the command to notify the contract of an expired client.
+func newMarkClientExpiredCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mark-client-expired [client-id]",
+		Short:   "Notifies the contract of an expired client",
+		Long:    "Marks the client with the specified client ID as expired and notifies its contract, which must support lifecycle hooks",
+		Example: fmt.Sprintf("%s tx %s-wasm mark-client-expired 08-wasm-0", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMarkClientExpired(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// newSubmitRemoveChecksumProposalCmd returns the command to send a proposal to remove a checksum.
+func newSubmitRemoveChecksumProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-checksum [checksum]",
+		Short:   "Creates a proposal to remove a checksum",
+		Long:    "Creates a proposal to remove a checksum. The removal fails if light clients still reference the checksum, unless the force flag is set",
+		Example: fmt.Sprintf("%s tx %s-wasm remove-checksum b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, err := getAuthority(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checksum format: %w", err)
+			}
+
+			force, err := cmd.Flags().GetBool(FlagForce)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveChecksum{
+				Signer:   authority,
+				Checksum: checksum,
+				Force:    force,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create a remove checksum proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().Bool(FlagForce, false, "Remove the checksum even if light clients still reference it")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// newSubmitSetChecksumDeprecatedProposalCmd returns the command to send a proposal to change the deprecation state of a checksum.
+func newSubmitSetChecksumDeprecatedProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-checksum-deprecated [checksum] [deprecated]",
+		Short:   "Creates a proposal to deprecate or undeprecate a checksum",
+		Long:    "Creates a proposal to deprecate or undeprecate a checksum. New light clients may not be created using a deprecated checksum, existing light clients are not affected",
+		Example: fmt.Sprintf("%s tx %s-wasm set-checksum-deprecated b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab true", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, err := getAuthority(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checksum format: %w", err)
+			}
+
+			deprecated, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid deprecated value: %w", err)
+			}
+
+			msg := types.NewMsgSetChecksumDeprecated(authority, checksum, deprecated)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create a set checksum deprecated proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// newSubmitMigrateContractsProposalCmd returns the command to send a proposal to migrate the contracts
+// of all light clients using a checksum.
+func newSubmitMigrateContractsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-contracts [old-checksum] [checksum] [migrate-msg]",
+		Short:   "Creates a proposal to migrate all contracts using a checksum to a new byte code",
+		Long:    "Creates a proposal to migrate the contracts of all light clients using the old checksum to the byte code corresponding to checksum, passing the JSON-encoded migrate message to each contract",
+		Example: fmt.Sprintf("%s tx %s-wasm migrate-contracts b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab 3c5f7a3d36e5b6a9b4e2b5ee1d5b0b2d6a5f6a9a3b3c1d0e4f7a8b9c0d1e2f3a {}", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, err := getAuthority(cmd)
+			if err != nil {
+				return err
+			}
+
+			oldChecksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid old checksum format: %w", err)
+			}
+
+			checksum, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid checksum format: %w", err)
+			}
+
+			msg := types.NewMsgMigrateContracts(authority, oldChecksum, checksum, []byte(args[2]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create a migrate contracts proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// getAuthority returns the authority set with the authority flag, defaulting to the gov module account.
+func getAuthority(cmd *cobra.Command) (string, error) {
+	authority, _ := cmd.Flags().GetString(FlagAuthority)
+	if authority == "" {
+		return sdk.AccAddress(address.Module(govtypes.ModuleName)).String(), nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return "", fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	return authority, nil
+}
+
+// addProposalFlags adds the authority, tx and gov proposal flags to the command.
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAuthority, "", "The address of the wasm client module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+}
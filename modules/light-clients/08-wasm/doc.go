@@ -9,6 +9,7 @@ By default the 08-wasm module requires cgo and libwasmvm dependencies available
 However, users of this module may want to depend only on types, without incurring the dependency on cgo or libwasmvm.
 In this case, it is possible to build the code with either cgo disabled or a custom build directive: nolink_libwasmvm.
 This allows disabling linking of libwasmvm and not forcing users to have specific libraries available on their systems.
+Binaries built this way can still run Wasm light clients using the pure Go Wasm VM provided by the wazerovm package.
 
 Please refer to the 08-wasm module documentation for more information.
 */
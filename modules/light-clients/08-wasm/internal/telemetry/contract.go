@@ -0,0 +1,25 @@
+package telemetry
+
+import (
+	metrics "github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	ibcmetrics "github.com/cosmos/ibc-go/v9/modules/core/metrics"
+)
+
+// ReportContractCall reports the number of calls, the amount of gas used and the number
+// of failed calls for the given contract checksum and entry point.
+func ReportContractCall(checksum, entryPoint string, gasUsed uint64, failed bool) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(ibcmetrics.LabelChecksum, checksum),
+		telemetry.NewLabel(ibcmetrics.LabelEntryPoint, entryPoint),
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"ibc", "wasm", "contract", "calls"}, 1, labels)
+	telemetry.IncrCounterWithLabels([]string{"ibc", "wasm", "contract", "gas_used"}, float32(gasUsed), labels)
+
+	if failed {
+		telemetry.IncrCounterWithLabels([]string{"ibc", "wasm", "contract", "failures"}, 1, labels)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/telemetry"
 	internaltypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/types"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
@@ -22,6 +23,11 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
+const (
+	entryPointSudo  = "sudo"
+	entryPointQuery = "query"
+)
+
 var (
 	VMGasRegister = types.NewDefaultWasmGasRegister()
 	// wasmvmAPI is a wasmvm.GoAPI implementation that is passed to the wasmvm, it
@@ -56,13 +62,21 @@ func (k Keeper) instantiateContract(ctx sdk.Context, clientID string, clientStor
 func (k Keeper) callContract(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum, msg []byte) (*wasmvmtypes.ContractResult, error) {
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := types.NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
-	gasLimit := VMGasRegister.RuntimeGasForContract(ctx)
+	maxGas := k.contractGasLimit(checksum).MaxSudoGas
+	gasLimit, capped := runtimeGasLimit(ctx, maxGas)
 
 	env := getEnv(ctx, clientID)
 
 	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: sudo")
 	resp, gasUsed, err := k.GetVM().Sudo(checksum, env, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
+
+	telemetry.ReportContractCall(hex.EncodeToString(checksum), entryPointSudo, VMGasRegister.FromWasmVMGas(gasUsed), err != nil || resp.Err != "")
+
+	if capped && errors.Is(err, wasmvmtypes.OutOfGasError{}) {
+		return nil, errorsmod.Wrapf(types.ErrWasmGasLimitExceeded, "sudo call to contract with checksum %s exceeded maximum of %d gas", hex.EncodeToString(checksum), maxGas)
+	}
+
 	return resp, err
 }
 
@@ -70,7 +84,8 @@ func (k Keeper) callContract(ctx sdk.Context, clientID string, clientStore store
 func (k Keeper) queryContract(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum, msg []byte) (*wasmvmtypes.QueryResult, error) {
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := types.NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
-	gasLimit := VMGasRegister.RuntimeGasForContract(ctx)
+	maxGas := k.contractGasLimit(checksum).MaxQueryGas
+	gasLimit, capped := runtimeGasLimit(ctx, maxGas)
 
 	env := getEnv(ctx, clientID)
 
@@ -78,9 +93,38 @@ func (k Keeper) queryContract(ctx sdk.Context, clientID string, clientStore stor
 	resp, gasUsed, err := k.GetVM().Query(checksum, env, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
 
+	telemetry.ReportContractCall(hex.EncodeToString(checksum), entryPointQuery, VMGasRegister.FromWasmVMGas(gasUsed), err != nil || resp.Err != "")
+
+	if capped && errors.Is(err, wasmvmtypes.OutOfGasError{}) {
+		return nil, errorsmod.Wrapf(types.ErrWasmGasLimitExceeded, "query to contract with checksum %s exceeded maximum of %d gas", hex.EncodeToString(checksum), maxGas)
+	}
+
 	return resp, err
 }
 
+// contractGasLimit returns the gas limits configured for the contract with the given checksum.
+// The zero value is returned if no limits are configured.
+func (k Keeper) contractGasLimit(checksum types.Checksum) types.ContractGasLimit {
+	return k.contractGasLimits[hex.EncodeToString(checksum)]
+}
+
+// runtimeGasLimit returns the gas limit in wasmvm gas units for a contract call given the maximum
+// amount of SDK gas configured for the call, where zero means no maximum. The returned boolean is true
+// if the configured maximum is lower than the gas remaining in the transaction and is therefore used as the limit.
+func runtimeGasLimit(ctx sdk.Context, maxGas uint64) (uint64, bool) {
+	gasLimit := VMGasRegister.RuntimeGasForContract(ctx)
+	if maxGas == 0 {
+		return gasLimit, false
+	}
+
+	maxWasmVMGas := VMGasRegister.ToWasmVMGas(maxGas)
+	if maxWasmVMGas < gasLimit {
+		return maxWasmVMGas, true
+	}
+
+	return gasLimit, false
+}
+
 // migrateContract calls vm.Migrate with internally constructed gas meter and environment.
 func (k Keeper) migrateContract(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum, msg []byte) (*wasmvmtypes.ContractResult, error) {
 	sdkGasMeter := ctx.GasMeter()
@@ -126,11 +170,16 @@ func (k Keeper) WasmInstantiate(ctx sdk.Context, clientID string, clientStore st
 		return errorsmod.Wrapf(types.ErrWasmInvalidContractModification, "expected checksum %s, got %s", hex.EncodeToString(checksum), hex.EncodeToString(newClientState.Checksum))
 	}
 
-	return nil
+	if err := k.setChecksumClient(ctx, checksum, clientID); err != nil {
+		return err
+	}
+
+	return k.updateLifecycleHooks(ctx, clientID, res.Ok.Data)
 }
 
 // WasmSudo calls the contract with the given payload and returns the result.
 // WasmSudo returns an error if:
+// - the contract call exceeds the gas limit configured for the checksum
 // - the contract call returns an error
 // - the response of the contract call contains non-empty messages
 // - the response of the contract call contains non-empty events
@@ -144,6 +193,9 @@ func (k Keeper) WasmSudo(ctx sdk.Context, clientID string, clientStore storetype
 
 	checksum := cs.Checksum
 	res, err := k.callContract(ctx, clientID, clientStore, checksum, encodedData)
+	if errors.Is(err, types.ErrWasmGasLimitExceeded) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -171,6 +223,7 @@ func (k Keeper) WasmSudo(ctx sdk.Context, clientID string, clientStore storetype
 // WasmMigrate migrate calls the migrate entry point of the contract with the given payload and returns the result.
 // WasmMigrate returns an error if:
 // - the contract migration returns an error
+// - the data of the response is not empty and cannot be unmarshaled into an InstantiateResult
 func (k Keeper) WasmMigrate(ctx sdk.Context, clientStore storetypes.KVStore, cs *types.ClientState, clientID string, payload []byte) error {
 	res, err := k.migrateContract(ctx, clientID, clientStore, cs.Checksum, payload)
 	if err != nil {
@@ -184,12 +237,16 @@ func (k Keeper) WasmMigrate(ctx sdk.Context, clientStore storetypes.KVStore, cs
 		return errorsmod.Wrapf(err, "checksum (%s)", hex.EncodeToString(cs.Checksum))
 	}
 
-	_, err = validatePostExecutionClientState(clientStore, k.cdc)
-	return err
+	if _, err = validatePostExecutionClientState(clientStore, k.cdc); err != nil {
+		return err
+	}
+
+	return k.updateLifecycleHooks(ctx, clientID, res.Ok.Data)
 }
 
 // WasmQuery queries the contract with the given payload and returns the result.
 // WasmQuery returns an error if:
+// - the contract query exceeds the gas limit configured for the checksum
 // - the contract query returns an error
 // - the data bytes of the response cannot be unmarshal into the result type
 func (k Keeper) WasmQuery(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, cs *types.ClientState, payload types.QueryMsg) ([]byte, error) {
@@ -199,6 +256,9 @@ func (k Keeper) WasmQuery(ctx sdk.Context, clientID string, clientStore storetyp
 	}
 
 	res, err := k.queryContract(ctx, clientID, clientStore, cs.Checksum, encodedData)
+	if errors.Is(err, types.ErrWasmGasLimitExceeded) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -1,11 +1,13 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
 	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
@@ -329,7 +331,10 @@ func (suite *KeeperTestSuite) TestWasmMigrate() {
 }
 
 func (suite *KeeperTestSuite) TestWasmQuery() {
-	var payload types.QueryMsg
+	var (
+		payload           types.QueryMsg
+		contractGasLimits map[string]types.ContractGasLimit
+	)
 
 	testCases := []struct {
 		name     string
@@ -371,11 +376,36 @@ func (suite *KeeperTestSuite) TestWasmQuery() {
 			},
 			types.ErrWasmContractCallFailed,
 		},
+		{
+			"failure: contract exceeds configured gas limit",
+			func() {
+				contractGasLimits = map[string]types.ContractGasLimit{checksumHex(wasmtesting.Code): {MaxQueryGas: 1_000}}
+				suite.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, gasLimit uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+					suite.Require().Equal(keeper.VMGasRegister.ToWasmVMGas(1_000), gasLimit)
+
+					return nil, gasLimit, wasmvmtypes.OutOfGasError{}
+				})
+			},
+			types.ErrWasmGasLimitExceeded,
+		},
+		{
+			"failure: vm runs out of gas with configured gas limit for different checksum",
+			func() {
+				contractGasLimits = map[string]types.ContractGasLimit{checksumHex(wasmtesting.CreateMockContract([]byte("other"))): {MaxQueryGas: 1_000}}
+				suite.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, gasLimit uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+					suite.Require().Greater(gasLimit, keeper.VMGasRegister.ToWasmVMGas(1_000))
+
+					return nil, wasmtesting.DefaultGasUsed, wasmvmtypes.OutOfGasError{}
+				})
+			},
+			types.ErrVMError,
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
+			contractGasLimits = nil
 			suite.SetupWasmWithMockVM()
 			_ = suite.storeWasmCode(wasmtesting.Code)
 
@@ -394,6 +424,8 @@ func (suite *KeeperTestSuite) TestWasmQuery() {
 			tc.malleate()
 
 			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			wasmClientKeeper.SetContractGasLimits(contractGasLimits)
+
 			res, err := wasmClientKeeper.WasmQuery(suite.chainA.GetContext(), endpoint.ClientID, clientStore, wasmClientState, payload)
 
 			expPass := tc.expError == nil
@@ -408,7 +440,10 @@ func (suite *KeeperTestSuite) TestWasmQuery() {
 }
 
 func (suite *KeeperTestSuite) TestWasmSudo() {
-	var payload types.SudoMsg
+	var (
+		payload           types.SudoMsg
+		contractGasLimits map[string]types.ContractGasLimit
+	)
 
 	testCases := []struct {
 		name     string
@@ -527,11 +562,36 @@ func (suite *KeeperTestSuite) TestWasmSudo() {
 			},
 			types.ErrWasmInvalidContractModification,
 		},
+		{
+			"failure: contract exceeds configured gas limit",
+			func() {
+				contractGasLimits = map[string]types.ContractGasLimit{checksumHex(wasmtesting.Code): {MaxSudoGas: 1_000}}
+				suite.mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, gasLimit uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					suite.Require().Equal(keeper.VMGasRegister.ToWasmVMGas(1_000), gasLimit)
+
+					return nil, gasLimit, wasmvmtypes.OutOfGasError{}
+				})
+			},
+			types.ErrWasmGasLimitExceeded,
+		},
+		{
+			"failure: vm runs out of gas with configured gas limit for different checksum",
+			func() {
+				contractGasLimits = map[string]types.ContractGasLimit{checksumHex(wasmtesting.CreateMockContract([]byte("other"))): {MaxSudoGas: 1_000}}
+				suite.mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, gasLimit uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					suite.Require().Greater(gasLimit, keeper.VMGasRegister.ToWasmVMGas(1_000))
+
+					return nil, wasmtesting.DefaultGasUsed, wasmvmtypes.OutOfGasError{}
+				})
+			},
+			types.ErrVMError,
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
+			contractGasLimits = nil
 			suite.SetupWasmWithMockVM()
 			_ = suite.storeWasmCode(wasmtesting.Code)
 
@@ -550,6 +610,8 @@ func (suite *KeeperTestSuite) TestWasmSudo() {
 			tc.malleate()
 
 			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			wasmClientKeeper.SetContractGasLimits(contractGasLimits)
+
 			res, err := wasmClientKeeper.WasmSudo(suite.chainA.GetContext(), endpoint.ClientID, clientStore, wasmClientState, payload)
 
 			expPass := tc.expError == nil
@@ -562,3 +624,13 @@ func (suite *KeeperTestSuite) TestWasmSudo() {
 		})
 	}
 }
+
+// checksumHex returns the hex encoded checksum of the given wasm code.
+func checksumHex(code []byte) string {
+	checksum, err := types.CreateChecksum(code)
+	if err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(checksum)
+}
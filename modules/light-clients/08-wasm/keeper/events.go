@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/hex"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -37,3 +38,46 @@ func emitMigrateContractEvent(ctx sdk.Context, clientID string, checksum, newChe
 		),
 	})
 }
+
+// emitRemoveChecksumEvent emits a remove checksum event
+func emitRemoveChecksumEvent(ctx sdk.Context, checksum types.Checksum) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveChecksum,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitSetChecksumDeprecatedEvent emits a set checksum deprecated event
+func emitSetChecksumDeprecatedEvent(ctx sdk.Context, checksum types.Checksum, deprecated bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetChecksumDeprecated,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+			sdk.NewAttribute(types.AttributeKeyDeprecated, strconv.FormatBool(deprecated)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitMarkClientExpiredEvent emits a mark client expired event
+func emitMarkClientExpiredEvent(ctx sdk.Context, clientID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMarkClientExpired,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -1,6 +1,10 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
 
 // MigrateContractCode is a wrapper around k.migrateContractCode to allow the method to be directly called in tests.
 func (k Keeper) MigrateContractCode(ctx sdk.Context, clientID string, newChecksum, migrateMsg []byte) error {
@@ -16,3 +20,8 @@ func (k Keeper) GetQueryPlugins() QueryPlugins {
 func (k *Keeper) SetQueryPlugins(plugins QueryPlugins) {
 	k.setQueryPlugins(plugins)
 }
+
+// SetContractGasLimits applies the WithContractGasLimits option to the keeper to allow the limits to be set in tests.
+func (k *Keeper) SetContractGasLimits(limits map[string]types.ContractGasLimit) {
+	WithContractGasLimits(limits).apply(k)
+}
@@ -17,16 +17,44 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
 	}
 
 	for _, contract := range gs.Contracts {
-		_, err := k.storeWasmCode(ctx, contract.CodeBytes, storeFn)
+		checksum, err := k.storeWasmCode(ctx, contract.CodeBytes, storeFn)
 		if err != nil {
 			return err
 		}
+
+		if contract.Deprecated {
+			if err := k.GetDeprecatedChecksums().Set(ctx, checksum); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, clientID := range gs.LifecycleHooksClientIds {
+		if err := k.GetLifecycleHooksClients().Set(ctx, clientID); err != nil {
+			return err
+		}
+	}
+
+	for _, clientID := range gs.ExpiredClientIds {
+		if err := k.GetExpiredClients().Set(ctx, clientID); err != nil {
+			return err
+		}
+	}
+
+	for _, checksumClients := range gs.ChecksumClients {
+		for _, clientID := range checksumClients.ClientIds {
+			if err := k.setChecksumClient(ctx, checksumClients.Checksum, clientID); err != nil {
+				return err
+			}
+		}
 	}
+
 	return nil
 }
 
 // ExportGenesis returns the 08-wasm module's exported genesis. This includes the code
-// for all contracts previously stored.
+// for all contracts previously stored and whether they are deprecated, as well as the
+// clients supporting lifecycle hooks, the clients marked as expired and the clients referencing each checksum.
 func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	checksums, err := k.GetAllChecksums(ctx)
 	if err != nil {
@@ -41,9 +69,35 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 			panic(err)
 		}
 		genesisState.Contracts = append(genesisState.Contracts, types.Contract{
-			CodeBytes: code,
+			CodeBytes:  code,
+			Deprecated: k.IsChecksumDeprecated(ctx, checksum),
 		})
 	}
 
+	err = k.GetLifecycleHooksClients().Walk(ctx, nil, func(clientID string) (bool, error) {
+		genesisState.LifecycleHooksClientIds = append(genesisState.LifecycleHooksClientIds, clientID)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.GetExpiredClients().Walk(ctx, nil, func(clientID string) (bool, error) {
+		genesisState.ExpiredClientIds = append(genesisState.ExpiredClientIds, clientID)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, checksum := range checksums {
+		if clientIDs := k.GetChecksumClients(ctx, checksum); len(clientIDs) > 0 {
+			genesisState.ChecksumClients = append(genesisState.ChecksumClients, types.ChecksumClients{
+				Checksum:  checksum,
+				ClientIds: clientIDs,
+			})
+		}
+	}
+
 	return genesisState
 }
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"encoding/hex"
 
+	"cosmossdk.io/collections"
+
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -12,8 +14,9 @@ import (
 
 func (suite *KeeperTestSuite) TestInitGenesis() {
 	var (
-		genesisState types.GenesisState
-		expChecksums []string
+		genesisState  types.GenesisState
+		expChecksums  []string
+		expDeprecated bool
 	)
 
 	testCases := []struct {
@@ -36,6 +39,51 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				expChecksums = []string{checksum}
 			},
 		},
+		{
+			"success with deprecated contract",
+			func() {
+				checksum := "b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab" //nolint:gosec // these are not hard-coded credentials
+
+				genesisState = *types.NewGenesisState(
+					[]types.Contract{
+						{
+							CodeBytes:  wasmtesting.Code,
+							Deprecated: true,
+						},
+					},
+				)
+
+				expChecksums = []string{checksum}
+				expDeprecated = true
+			},
+		},
+		{
+			"success with lifecycle hooks and expired clients",
+			func() {
+				checksum := "b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab" //nolint:gosec // these are not hard-coded credentials
+
+				genesisState = *types.NewGenesisState(
+					[]types.Contract{
+						{
+							CodeBytes: wasmtesting.Code,
+						},
+					},
+				)
+				genesisState.LifecycleHooksClientIds = []string{defaultWasmClientID}
+				genesisState.ExpiredClientIds = []string{defaultWasmClientID}
+				checksumBz, err := hex.DecodeString(checksum)
+				suite.Require().NoError(err)
+
+				genesisState.ChecksumClients = []types.ChecksumClients{
+					{
+						Checksum:  checksumBz,
+						ClientIds: []string{defaultWasmClientID},
+					},
+				}
+
+				expChecksums = []string{checksum}
+			},
+		},
 		{
 			"success with empty genesis contract",
 			func() {
@@ -50,6 +98,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			suite.SetupWasmWithMockVM()
 
 			ctx := suite.chainA.GetContext()
+			expDeprecated = false
 			tc.malleate()
 
 			err := GetSimApp(suite.chainA).WasmClientKeeper.InitGenesis(ctx, genesisState)
@@ -61,10 +110,23 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 
 			for _, hash := range checksums {
 				storedHashes = append(storedHashes, hex.EncodeToString(hash))
+				suite.Require().Equal(expDeprecated, GetSimApp(suite.chainA).WasmClientKeeper.IsChecksumDeprecated(suite.chainA.GetContext(), hash))
 			}
 
 			suite.Require().Equal(len(expChecksums), len(storedHashes))
 			suite.Require().ElementsMatch(expChecksums, storedHashes)
+
+			for _, clientID := range genesisState.LifecycleHooksClientIds {
+				suite.Require().True(GetSimApp(suite.chainA).WasmClientKeeper.HasLifecycleHooks(ctx, clientID))
+			}
+
+			for _, clientID := range genesisState.ExpiredClientIds {
+				suite.Require().True(GetSimApp(suite.chainA).WasmClientKeeper.IsClientMarkedExpired(ctx, clientID))
+			}
+
+			for _, checksumClients := range genesisState.ChecksumClients {
+				suite.Require().Equal(checksumClients.ClientIds, GetSimApp(suite.chainA).WasmClientKeeper.GetChecksumClients(ctx, checksumClients.Checksum))
+			}
 		})
 	}
 }
@@ -86,4 +148,31 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	genesisState := GetSimApp(suite.chainA).WasmClientKeeper.ExportGenesis(ctx)
 	suite.Require().Len(genesisState.Contracts, 1)
 	suite.Require().NotEmpty(genesisState.Contracts[0].CodeBytes)
+	suite.Require().False(genesisState.Contracts[0].Deprecated)
+
+	err = GetSimApp(suite.chainA).WasmClientKeeper.GetDeprecatedChecksums().Set(ctx, res.Checksum)
+	suite.Require().NoError(err)
+
+	genesisState = GetSimApp(suite.chainA).WasmClientKeeper.ExportGenesis(ctx)
+	suite.Require().Len(genesisState.Contracts, 1)
+	suite.Require().True(genesisState.Contracts[0].Deprecated)
+	suite.Require().Empty(genesisState.LifecycleHooksClientIds)
+	suite.Require().Empty(genesisState.ExpiredClientIds)
+	suite.Require().Empty(genesisState.ChecksumClients)
+
+	err = GetSimApp(suite.chainA).WasmClientKeeper.GetLifecycleHooksClients().Set(ctx, defaultWasmClientID)
+	suite.Require().NoError(err)
+
+	err = GetSimApp(suite.chainA).WasmClientKeeper.GetExpiredClients().Set(ctx, defaultWasmClientID)
+	suite.Require().NoError(err)
+
+	genesisState = GetSimApp(suite.chainA).WasmClientKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]string{defaultWasmClientID}, genesisState.LifecycleHooksClientIds)
+	suite.Require().Equal([]string{defaultWasmClientID}, genesisState.ExpiredClientIds)
+
+	err = GetSimApp(suite.chainA).WasmClientKeeper.GetChecksumClientsIndex().Set(ctx, collections.Join([]byte(res.Checksum), defaultWasmClientID))
+	suite.Require().NoError(err)
+
+	genesisState = GetSimApp(suite.chainA).WasmClientKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.ChecksumClients{{Checksum: res.Checksum, ClientIds: []string{defaultWasmClientID}}}, genesisState.ChecksumClients)
 }
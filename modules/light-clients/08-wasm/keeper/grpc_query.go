@@ -44,21 +44,38 @@ func (k Keeper) Code(goCtx context.Context, req *types.QueryCodeRequest) (*types
 	}, nil
 }
 
-// Checksums implements the Query/Checksums gRPC method. It returns a list of hex encoded checksums stored.
+// Checksums implements the Query/Checksums gRPC method. It returns a list of hex encoded checksums stored,
+// together with their deprecation state and the identifiers of the clients referencing them.
 func (k Keeper) Checksums(goCtx context.Context, req *types.QueryChecksumsRequest) (*types.QueryChecksumsResponse, error) {
-	checksums, pageRes, err := sdkquery.CollectionPaginate(
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	checksumInfos, pageRes, err := sdkquery.CollectionPaginate(
 		goCtx,
 		k.GetChecksums(),
 		req.Pagination,
-		func(key []byte, value collections.NoValue) (string, error) {
-			return hex.EncodeToString(key), nil
+		func(key []byte, value collections.NoValue) (types.ChecksumInfo, error) {
+			return types.ChecksumInfo{
+				Checksum:   hex.EncodeToString(key),
+				Deprecated: k.IsChecksumDeprecated(ctx, key),
+				ClientIds:  k.GetChecksumClients(ctx, key),
+			}, nil
 		})
 	if err != nil {
 		return nil, err
 	}
 
+	checksums := make([]string, 0, len(checksumInfos))
+	for _, checksumInfo := range checksumInfos {
+		checksums = append(checksums, checksumInfo.Checksum)
+	}
+
 	return &types.QueryChecksumsResponse{
-		Checksums:  checksums,
-		Pagination: pageRes,
+		Checksums:     checksums,
+		Pagination:    pageRes,
+		ChecksumInfos: checksumInfos,
 	}, nil
 }
@@ -67,7 +67,10 @@ func (suite *KeeperTestSuite) TestQueryCode() {
 }
 
 func (suite *KeeperTestSuite) TestQueryChecksums() {
-	var expChecksums []string
+	var (
+		expChecksums     []string
+		expChecksumInfos []types.ChecksumInfo
+	)
 
 	testCases := []struct {
 		name     string
@@ -78,6 +81,7 @@ func (suite *KeeperTestSuite) TestQueryChecksums() {
 			"success with no checksums",
 			func() {
 				expChecksums = []string{}
+				expChecksumInfos = []types.ChecksumInfo{}
 			},
 			true,
 		},
@@ -91,9 +95,47 @@ func (suite *KeeperTestSuite) TestQueryChecksums() {
 				suite.Require().NoError(err)
 
 				expChecksums = append(expChecksums, hex.EncodeToString(res.Checksum))
+				expChecksumInfos = append(expChecksumInfos, types.ChecksumInfo{Checksum: hex.EncodeToString(res.Checksum)})
 			},
 			true,
 		},
+		{
+			"success with checksum referenced by clients",
+			func() {
+				checksum := suite.storeWasmCode(wasmtesting.Code)
+
+				var clientIDs []string
+				for i := 0; i < 2; i++ {
+					endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+					err := endpoint.CreateClient()
+					suite.Require().NoError(err)
+
+					clientIDs = append(clientIDs, endpoint.ClientID)
+				}
+
+				expChecksums = []string{hex.EncodeToString(checksum)}
+				expChecksumInfos = []types.ChecksumInfo{{Checksum: hex.EncodeToString(checksum), ClientIds: clientIDs}}
+			},
+			true,
+		},
+		{
+			"success with deprecated checksum",
+			func() {
+				checksum := suite.storeWasmCode(wasmtesting.Code)
+
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetDeprecatedChecksums().Set(suite.chainA.GetContext(), checksum)
+				suite.Require().NoError(err)
+
+				expChecksums = []string{hex.EncodeToString(checksum)}
+				expChecksumInfos = []types.ChecksumInfo{{Checksum: hex.EncodeToString(checksum), Deprecated: true}}
+			},
+			true,
+		},
+		{
+			"failure: nil request",
+			func() {},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -102,7 +144,10 @@ func (suite *KeeperTestSuite) TestQueryChecksums() {
 
 			tc.malleate()
 
-			req := &types.QueryChecksumsRequest{}
+			var req *types.QueryChecksumsRequest
+			if tc.expPass {
+				req = &types.QueryChecksumsRequest{}
+			}
 			res, err := GetSimApp(suite.chainA).WasmClientKeeper.Checksums(suite.chainA.GetContext(), req)
 
 			if tc.expPass {
@@ -110,6 +155,7 @@ func (suite *KeeperTestSuite) TestQueryChecksums() {
 				suite.Require().NotNil(res)
 				suite.Require().Equal(len(expChecksums), len(res.Checksums))
 				suite.Require().ElementsMatch(expChecksums, res.Checksums)
+				suite.Require().ElementsMatch(expChecksumInfos, res.ChecksumInfos)
 			} else {
 				suite.Require().Error(err)
 			}
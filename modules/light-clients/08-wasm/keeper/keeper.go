@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
+	"strings"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 
@@ -30,14 +32,85 @@ type Keeper struct {
 
 	vm types.WasmEngine
 
-	checksums    collections.KeySet[[]byte]
-	storeService store.KVStoreService
+	checksums             collections.KeySet[[]byte]
+	deprecatedChecksums   collections.KeySet[[]byte]
+	lifecycleHooksClients collections.KeySet[string]
+	expiredClients        collections.KeySet[string]
+	checksumClients       collections.KeySet[collections.Pair[[]byte, string]]
+	storeService          store.KVStoreService
 
 	queryPlugins QueryPlugins
 
+	// contractGasLimits maps hex encoded checksums to the gas limits of their contracts.
+	contractGasLimits map[string]types.ContractGasLimit
+
 	authority string
 }
 
+// NewKeeperWithVM creates a new Keeper instance with the provided Wasm VM.
+// This constructor function is meant to be used when the chain uses x/wasm
+// and the same Wasm VM instance should be shared with it, or when the binary is
+// compiled without libwasmvm and a pure Go Wasm VM (see the wazerovm package) is used.
+func NewKeeperWithVM(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	clientKeeper types.ClientKeeper,
+	authority string,
+	vm types.WasmEngine,
+	queryRouter types.QueryRouter,
+	opts ...Option,
+) Keeper {
+	if clientKeeper == nil {
+		panic(errors.New("client keeper must not be nil"))
+	}
+
+	if queryRouter == nil {
+		panic(errors.New("query router must not be nil"))
+	}
+
+	if vm == nil {
+		panic(errors.New("wasm VM must not be nil"))
+	}
+
+	if storeService == nil {
+		panic(errors.New("store service must not be nil"))
+	}
+
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	keeper := &Keeper{
+		cdc:                   cdc,
+		vm:                    vm,
+		checksums:             collections.NewKeySet(sb, types.ChecksumsKey, "checksums", collections.BytesKey),
+		deprecatedChecksums:   collections.NewKeySet(sb, types.DeprecatedChecksumsKey, "deprecated_checksums", collections.BytesKey),
+		lifecycleHooksClients: collections.NewKeySet(sb, types.LifecycleHooksClientsKey, "lifecycle_hooks_clients", collections.StringKey),
+		expiredClients:        collections.NewKeySet(sb, types.ExpiredClientsKey, "expired_clients", collections.StringKey),
+		checksumClients:       collections.NewKeySet(sb, types.ChecksumClientsKey, "checksum_clients", collections.PairKeyCodec(collections.BytesKey, collections.StringKey)),
+		storeService:          storeService,
+		clientKeeper:          clientKeeper,
+		authority:             authority,
+	}
+
+	_, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	// set query plugins to ensure there is a non-nil query plugin
+	// regardless of what options the user provides
+	keeper.setQueryPlugins(NewDefaultQueryPlugins(queryRouter))
+
+	for _, opt := range opts {
+		opt.apply(keeper)
+	}
+
+	return *keeper
+}
+
 // Codec returns the 08-wasm module's codec.
 func (k Keeper) Codec() codec.BinaryCodec {
 	return k.cdc
@@ -67,6 +140,26 @@ func (k Keeper) GetChecksums() collections.KeySet[[]byte] {
 	return k.checksums
 }
 
+// GetDeprecatedChecksums returns the checksums which may not be used to create new clients.
+func (k Keeper) GetDeprecatedChecksums() collections.KeySet[[]byte] {
+	return k.deprecatedChecksums
+}
+
+// GetLifecycleHooksClients returns the identifiers of the clients whose contracts support lifecycle hooks.
+func (k Keeper) GetLifecycleHooksClients() collections.KeySet[string] {
+	return k.lifecycleHooksClients
+}
+
+// GetExpiredClients returns the identifiers of the clients which have been marked as expired.
+func (k Keeper) GetExpiredClients() collections.KeySet[string] {
+	return k.expiredClients
+}
+
+// GetChecksumClientsIndex returns the index of the identifiers of the clients referencing each checksum.
+func (k Keeper) GetChecksumClientsIndex() collections.KeySet[collections.Pair[[]byte, string]] {
+	return k.checksumClients
+}
+
 // getQueryPlugins returns the set query plugins.
 func (k Keeper) getQueryPlugins() QueryPlugins {
 	return k.queryPlugins
@@ -152,6 +245,10 @@ func (k Keeper) migrateContractCode(ctx sdk.Context, clientID string, newChecksu
 		return types.ErrWasmChecksumNotFound
 	}
 
+	if k.IsChecksumDeprecated(ctx, newChecksum) {
+		return errorsmod.Wrapf(types.ErrWasmChecksumDeprecated, "cannot migrate to checksum (%s)", hex.EncodeToString(newChecksum))
+	}
+
 	if bytes.Equal(wasmClientState.Checksum, newChecksum) {
 		return errorsmod.Wrapf(types.ErrWasmCodeExists, "new checksum (%s) is the same as current checksum (%s)", hex.EncodeToString(newChecksum), hex.EncodeToString(wasmClientState.Checksum))
 	}
@@ -179,6 +276,14 @@ func (k Keeper) migrateContractCode(ctx sdk.Context, clientID string, newChecksu
 
 	k.clientKeeper.SetClientState(ctx, clientID, wasmClientState)
 
+	if err := k.removeChecksumClient(ctx, oldChecksum, clientID); err != nil {
+		return err
+	}
+
+	if err := k.setChecksumClient(ctx, newChecksum, clientID); err != nil {
+		return err
+	}
+
 	emitMigrateContractEvent(ctx, clientID, oldChecksum, newChecksum)
 
 	return nil
@@ -231,6 +336,55 @@ func (k Keeper) HasChecksum(ctx context.Context, checksum types.Checksum) bool {
 	return found
 }
 
+// IsChecksumDeprecated returns true if the given checksum has been deprecated
+// and false otherwise.
+func (k Keeper) IsChecksumDeprecated(ctx context.Context, checksum types.Checksum) bool {
+	found, err := k.GetDeprecatedChecksums().Has(ctx, checksum)
+	if err != nil {
+		return false
+	}
+
+	return found
+}
+
+// GetChecksumClients returns the identifiers of all 08-wasm clients whose client state
+// references the given checksum.
+func (k Keeper) GetChecksumClients(ctx sdk.Context, checksum types.Checksum) []string {
+	var clientIDs []string
+	err := k.GetChecksumClientsIndex().Walk(ctx, collections.NewPrefixedPairRange[[]byte, string](checksum), func(key collections.Pair[[]byte, string]) (bool, error) {
+		clientIDs = append(clientIDs, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return clientIDs
+}
+
+// setChecksumClient records that the client state of the client with the given identifier references the given checksum.
+func (k Keeper) setChecksumClient(ctx sdk.Context, checksum types.Checksum, clientID string) error {
+	return k.GetChecksumClientsIndex().Set(ctx, collections.Join([]byte(checksum), clientID))
+}
+
+// removeChecksumClient removes the record that the client state of the client with the given identifier references the given checksum.
+func (k Keeper) removeChecksumClient(ctx sdk.Context, checksum types.Checksum, clientID string) error {
+	return k.GetChecksumClientsIndex().Remove(ctx, collections.Join([]byte(checksum), clientID))
+}
+
+// iterateWasmClientStates iterates over the client states of all 08-wasm clients
+// and performs a callback function. Iteration stops if the callback returns true.
+func (k Keeper) iterateWasmClientStates(ctx sdk.Context, cb func(clientID string, clientState *types.ClientState) bool) {
+	k.clientKeeper.IterateClientStates(ctx, []byte(types.Wasm), func(clientID string, cs exported.ClientState) bool {
+		clientState, ok := cs.(*types.ClientState)
+		if !ok {
+			return false
+		}
+
+		return cb(clientID, clientState)
+	})
+}
+
 // InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned
 func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 	checksums, err := k.GetAllChecksums(ctx)
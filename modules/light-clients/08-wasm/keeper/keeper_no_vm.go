@@ -10,26 +10,12 @@ import (
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 )
 
-// NewKeeperWithVM creates a new Keeper instance with the provided Wasm VM.
-// This constructor function is used when binaries are compiled with cgo disabled or the
-// custom build directive: nolink_libwasmvm.
-// This function is intended to panic and notify users that 08-wasm keeper functionality is not available.
-func NewKeeperWithVM(
-	_ codec.BinaryCodec,
-	_ storetypes.KVStoreService,
-	_ types.ClientKeeper,
-	_ string,
-	_ types.WasmEngine,
-	_ types.QueryRouter,
-	_ ...Option,
-) Keeper {
-	panic("not implemented, please build with cgo enabled or nolink_libwasmvm disabled")
-}
-
 // NewKeeperWithConfig creates a new Keeper instance with the provided Wasm configuration.
 // This constructor function is used when binaries are compiled with cgo disabled or the
 // custom build directive: nolink_libwasmvm.
-// This function is intended to panic and notify users that 08-wasm keeper functionality is not available.
+// This function is intended to panic and notify users that libwasmvm is not available. Binaries compiled
+// without libwasmvm may use NewKeeperWithVM together with a pure Go Wasm VM, such as the one provided by the
+// wazerovm package.
 func NewKeeperWithConfig(
 	_ codec.BinaryCodec,
 	_ storetypes.KVStoreService,
@@ -39,5 +25,5 @@ func NewKeeperWithConfig(
 	_ types.QueryRouter,
 	_ ...Option,
 ) Keeper {
-	panic("not implemented, please build with cgo enabled or nolink_libwasmvm disabled")
+	panic("not implemented, please build with cgo enabled or nolink_libwasmvm disabled, or use NewKeeperWithVM with a pure Go Wasm VM")
 }
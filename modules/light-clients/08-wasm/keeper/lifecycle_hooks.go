@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// HasLifecycleHooks returns true if the contract of the client with the given identifier supports lifecycle hooks.
+func (k Keeper) HasLifecycleHooks(ctx sdk.Context, clientID string) bool {
+	found, err := k.GetLifecycleHooksClients().Has(ctx, clientID)
+	if err != nil {
+		return false
+	}
+
+	return found
+}
+
+// IsClientMarkedExpired returns true if the client with the given identifier has been marked as expired.
+func (k Keeper) IsClientMarkedExpired(ctx sdk.Context, clientID string) bool {
+	found, err := k.GetExpiredClients().Has(ctx, clientID)
+	if err != nil {
+		return false
+	}
+
+	return found
+}
+
+// updateLifecycleHooks updates the lifecycle hooks support of the client with the given identifier according
+// to the data returned by the instantiate or migrate entry point of its contract. The support is left unchanged
+// if the contract does not return any data.
+func (k Keeper) updateLifecycleHooks(ctx sdk.Context, clientID string, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var result types.InstantiateResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return errorsmod.Wrap(types.ErrWasmInvalidResponseData, err.Error())
+	}
+
+	if result.LifecycleHooks {
+		return k.GetLifecycleHooksClients().Set(ctx, clientID)
+	}
+
+	return k.GetLifecycleHooksClients().Remove(ctx, clientID)
+}
+
+// markClientExpired notifies the contract of the client with the given identifier that the client has expired
+// and records the client as expired. An error is returned if the client is not expired, if its contract does not
+// support lifecycle hooks, if the client has already been marked as expired or if the contract call fails.
+func (k Keeper) markClientExpired(ctx sdk.Context, clientID string) error {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Expired {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "client %s is not expired (status %s)", clientID, status)
+	}
+
+	if !k.HasLifecycleHooks(ctx, clientID) {
+		return errorsmod.Wrapf(types.ErrWasmLifecycleHooksNotSupported, "client %s", clientID)
+	}
+
+	if k.IsClientMarkedExpired(ctx, clientID) {
+		return errorsmod.Wrapf(types.ErrWasmClientAlreadyExpired, "client %s", clientID)
+	}
+
+	payload := types.SudoMsg{
+		ClientExpired: &types.ClientExpiredMsg{},
+	}
+
+	if err := k.callLifecycleHook(ctx, clientID, payload); err != nil {
+		return err
+	}
+
+	return k.GetExpiredClients().Set(ctx, clientID)
+}
+
+// NotifyClientRecovered notifies the contract of the client with the given identifier that the client has been
+// recovered using the state of the substitute client, if the contract supports lifecycle hooks. A client which
+// has been marked as expired is no longer considered expired after its recovery.
+func (k Keeper) NotifyClientRecovered(ctx sdk.Context, clientID, substituteClientID string) error {
+	if err := k.GetExpiredClients().Remove(ctx, clientID); err != nil {
+		return err
+	}
+
+	if !k.HasLifecycleHooks(ctx, clientID) {
+		return nil
+	}
+
+	payload := types.SudoMsg{
+		ClientRecovered: &types.ClientRecoveredMsg{SubstituteClientID: substituteClientID},
+	}
+
+	k.notifyLifecycleHook(ctx, clientID, payload)
+
+	return nil
+}
+
+// NotifyUpgradePlanScheduled notifies the contracts of all clients supporting lifecycle hooks of the scheduled
+// IBC software upgrade plan.
+func (k Keeper) NotifyUpgradePlanScheduled(ctx sdk.Context, planName string, planHeight int64, upgradedClientState []byte) error {
+	var clientIDs []string
+	err := k.GetLifecycleHooksClients().Walk(ctx, nil, func(clientID string) (bool, error) {
+		clientIDs = append(clientIDs, clientID)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	payload := types.SudoMsg{
+		UpgradePlanScheduled: &types.UpgradePlanScheduledMsg{
+			PlanName:            planName,
+			PlanHeight:          planHeight,
+			UpgradedClientState: upgradedClientState,
+		},
+	}
+
+	for _, clientID := range clientIDs {
+		k.notifyLifecycleHook(ctx, clientID, payload)
+	}
+
+	return nil
+}
+
+// notifyLifecycleHook calls the contract of the client with the given identifier with the provided payload
+// in a cached context. The state changes of the contract are only committed if the call succeeds, failures
+// are logged and do not affect the caller.
+func (k Keeper) notifyLifecycleHook(ctx sdk.Context, clientID string, payload types.SudoMsg) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.callLifecycleHook(cacheCtx, clientID, payload); err != nil {
+		k.Logger(ctx).Error("failed to call lifecycle hook of wasm contract", "client-id", clientID, "error", err)
+		return
+	}
+
+	writeFn()
+}
+
+// callLifecycleHook calls the contract of the client with the given identifier with the provided payload.
+func (k Keeper) callLifecycleHook(ctx sdk.Context, clientID string, payload types.SudoMsg) error {
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientState, found := types.GetClientState(clientStore, k.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	_, err := k.WasmSudo(ctx, clientID, clientStore, clientState, payload)
+	return err
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+func (suite *KeeperTestSuite) TestInstantiateLifecycleHooks() {
+	var data []byte
+
+	testCases := []struct {
+		name              string
+		malleate          func()
+		expLifecycleHooks bool
+		expError          error
+	}{
+		{
+			"success: no data returned",
+			func() {
+				data = nil
+			},
+			false,
+			nil,
+		},
+		{
+			"success: lifecycle hooks supported",
+			func() {
+				var err error
+				data, err = json.Marshal(types.InstantiateResult{LifecycleHooks: true})
+				suite.Require().NoError(err)
+			},
+			true,
+			nil,
+		},
+		{
+			"success: lifecycle hooks not supported",
+			func() {
+				var err error
+				data, err = json.Marshal(types.InstantiateResult{LifecycleHooks: false})
+				suite.Require().NoError(err)
+			},
+			false,
+			nil,
+		},
+		{
+			"failure: invalid data returned",
+			func() {
+				data = []byte("invalid")
+			},
+			false,
+			types.ErrWasmInvalidResponseData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			checksum := suite.storeWasmCode(wasmtesting.Code)
+
+			tc.malleate()
+
+			suite.mockVM.InstantiateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ wasmvmtypes.MessageInfo, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				store.Set(host.ClientStateKey(), wasmtesting.CreateMockClientStateBz(suite.chainA.App.AppCodec(), checksum))
+
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: data}}, 0, nil
+			}
+
+			initMsg := types.InstantiateMessage{
+				ClientState:    clienttypes.MustMarshalClientState(suite.chainA.App.AppCodec(), wasmtesting.MockTendermitClientState),
+				ConsensusState: clienttypes.MustMarshalConsensusState(suite.chainA.App.AppCodec(), wasmtesting.MockTendermintClientConsensusState),
+				Checksum:       checksum,
+			}
+
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), defaultWasmClientID)
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			err := wasmClientKeeper.WasmInstantiate(suite.chainA.GetContext(), defaultWasmClientID, clientStore, &types.ClientState{Checksum: checksum}, initMsg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+
+			suite.Require().Equal(tc.expLifecycleHooks, wasmClientKeeper.HasLifecycleHooks(suite.chainA.GetContext(), defaultWasmClientID))
+		})
+	}
+}
@@ -45,6 +45,22 @@ func (m Migrator) MigrateChecksums(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateChecksumClients populates the index of the identifiers of the clients referencing each checksum
+// from the client states of all existing 08-wasm clients.
+func (m Migrator) MigrateChecksumClients(ctx sdk.Context) error {
+	var err error
+	m.keeper.iterateWasmClientStates(ctx, func(clientID string, clientState *types.ClientState) bool {
+		err = m.keeper.setChecksumClient(ctx, clientState.Checksum, clientID)
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated checksum clients index")
+	return nil
+}
+
 // getStoredChecksums returns the checksums stored under the KeyChecksums key.
 func (m Migrator) getStoredChecksums(ctx sdk.Context) ([][]byte, error) {
 	store := m.keeper.storeService.OpenKVStore(ctx)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 )
 
@@ -46,6 +47,30 @@ func (suite *KeeperTestSuite) TestMigrateWasmStore() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigrateChecksumClients() {
+	suite.SetupWasmWithMockVM()
+
+	checksum := suite.storeWasmCode(wasmtesting.Code)
+
+	endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+	err := endpoint.CreateClient()
+	suite.Require().NoError(err)
+
+	wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+	ctx := suite.chainA.GetContext()
+
+	// clear the index to simulate a client created before the index was introduced
+	err = wasmClientKeeper.GetChecksumClientsIndex().Clear(ctx, nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(wasmClientKeeper.GetChecksumClients(ctx, checksum))
+
+	m := keeper.NewMigrator(wasmClientKeeper)
+	err = m.MigrateChecksumClients(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]string{endpoint.ClientID}, wasmClientKeeper.GetChecksumClients(ctx, checksum))
+}
+
 // storeChecksums stores the given checksums under the KeyChecksums key, it runs
 // each time on an empty store so we don't need to read the previous checksums.
 func (suite *KeeperTestSuite) storeChecksums(checksums [][]byte) {
@@ -43,16 +43,30 @@ func (k Keeper) RemoveChecksum(goCtx context.Context, msg *types.MsgRemoveChecks
 		return nil, types.ErrWasmChecksumNotFound
 	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// refuse to remove checksums which are still in use, unless explicitly requested
+	if clientIDs := k.GetChecksumClients(ctx, msg.Checksum); len(clientIDs) > 0 && !msg.Force {
+		return nil, errorsmod.Wrapf(types.ErrWasmChecksumInUse, "checksum (%s) is referenced by clients %v", hex.EncodeToString(msg.Checksum), clientIDs)
+	}
+
 	err := k.GetChecksums().Remove(goCtx, msg.Checksum)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to remove checksum")
 	}
 
+	err = k.GetDeprecatedChecksums().Remove(goCtx, msg.Checksum)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove deprecated checksum")
+	}
+
 	// unpin the code from the vm in-memory cache
 	if err := k.GetVM().Unpin(msg.Checksum); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to unpin contract with checksum (%s) from vm cache", hex.EncodeToString(msg.Checksum))
 	}
 
+	emitRemoveChecksumEvent(ctx, msg.Checksum)
+
 	return &types.MsgRemoveChecksumResponse{}, nil
 }
 
@@ -73,3 +87,63 @@ func (k Keeper) MigrateContract(goCtx context.Context, msg *types.MsgMigrateCont
 
 	return &types.MsgMigrateContractResponse{}, nil
 }
+
+// SetChecksumDeprecated defines a rpc handler method for MsgSetChecksumDeprecated
+func (k Keeper) SetChecksumDeprecated(goCtx context.Context, msg *types.MsgSetChecksumDeprecated) (*types.MsgSetChecksumDeprecatedResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if !k.HasChecksum(goCtx, msg.Checksum) {
+		return nil, types.ErrWasmChecksumNotFound
+	}
+
+	var err error
+	if msg.Deprecated {
+		err = k.GetDeprecatedChecksums().Set(goCtx, msg.Checksum)
+	} else {
+		err = k.GetDeprecatedChecksums().Remove(goCtx, msg.Checksum)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update checksum deprecation")
+	}
+
+	emitSetChecksumDeprecatedEvent(sdk.UnwrapSDKContext(goCtx), msg.Checksum, msg.Deprecated)
+
+	return &types.MsgSetChecksumDeprecatedResponse{}, nil
+}
+
+// MigrateContracts defines a rpc handler method for MsgMigrateContracts
+func (k Keeper) MigrateContracts(goCtx context.Context, msg *types.MsgMigrateContracts) (*types.MsgMigrateContractsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientIDs := k.GetChecksumClients(ctx, msg.OldChecksum)
+	for _, clientID := range clientIDs {
+		if err := k.migrateContractCode(ctx, clientID, msg.Checksum, msg.Msg); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to migrate contract for client %s", clientID)
+		}
+	}
+
+	// event emission is handled in migrateContractCode
+
+	return &types.MsgMigrateContractsResponse{
+		ClientIds: clientIDs,
+	}, nil
+}
+
+// MarkClientExpired defines a rpc handler method for MsgMarkClientExpired
+func (k Keeper) MarkClientExpired(goCtx context.Context, msg *types.MsgMarkClientExpired) (*types.MsgMarkClientExpiredResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.markClientExpired(ctx, msg.ClientId); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to mark client %s as expired", msg.ClientId)
+	}
+
+	emitMarkClientExpiredEvent(ctx, msg.ClientId)
+
+	return &types.MsgMarkClientExpiredResponse{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"strconv"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
@@ -17,6 +18,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -323,6 +325,10 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 	checksum, err := types.CreateChecksum(wasmtesting.Code)
 	suite.Require().NoError(err)
 
+	unusedByteCode := wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgRemoveChecksum"))
+	unusedChecksum, err := types.CreateChecksum(unusedByteCode)
+	suite.Require().NoError(err)
+
 	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 	var (
@@ -338,18 +344,18 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 		{
 			"success",
 			func() {
-				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
+				msg = types.NewMsgRemoveChecksum(govAcc, unusedChecksum)
 
-				expChecksums = []types.Checksum{}
+				expChecksums = []types.Checksum{checksum}
 			},
 			nil,
 		},
 		{
 			"success: many checksums",
 			func() {
-				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
+				msg = types.NewMsgRemoveChecksum(govAcc, unusedChecksum)
 
-				expChecksums = []types.Checksum{}
+				expChecksums = []types.Checksum{checksum}
 
 				for i := 0; i < 20; i++ {
 					mockCode := wasmtesting.CreateMockContract([]byte{byte(i)})
@@ -365,6 +371,34 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 			},
 			nil,
 		},
+		{
+			"success: deprecated checksum",
+			func() {
+				msg = types.NewMsgRemoveChecksum(govAcc, unusedChecksum)
+
+				expChecksums = []types.Checksum{checksum}
+
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetDeprecatedChecksums().Set(suite.chainA.GetContext(), unusedChecksum)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"success: forced removal of checksum referenced by clients",
+			func() {
+				msg = types.NewMsgForceRemoveChecksum(govAcc, checksum)
+
+				expChecksums = []types.Checksum{unusedChecksum}
+			},
+			nil,
+		},
+		{
+			"failure: checksum is referenced by clients",
+			func() {
+				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
+			},
+			types.ErrWasmChecksumInUse,
+		},
 		{
 			"failure: checksum is missing",
 			func() {
@@ -375,14 +409,14 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 		{
 			"failure: unauthorized signer",
 			func() {
-				msg = types.NewMsgRemoveChecksum(suite.chainA.SenderAccount.GetAddress().String(), checksum)
+				msg = types.NewMsgRemoveChecksum(suite.chainA.SenderAccount.GetAddress().String(), unusedChecksum)
 			},
 			ibcerrors.ErrUnauthorized,
 		},
 		{
 			"failure: code has could not be unpinned",
 			func() {
-				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
+				msg = types.NewMsgRemoveChecksum(govAcc, unusedChecksum)
 
 				suite.mockVM.UnpinFn = func(_ wasmvm.Checksum) error {
 					return wasmtesting.ErrMockVM
@@ -397,6 +431,7 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 			suite.SetupWasmWithMockVM()
 
 			_ = suite.storeWasmCode(wasmtesting.Code)
+			_ = suite.storeWasmCode(unusedByteCode)
 
 			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
 			err := endpoint.CreateClient()
@@ -418,8 +453,340 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 				// Check equality of checksums up to order
 				suite.Require().ElementsMatch(expChecksums, checksums)
 
+				suite.Require().False(GetSimApp(suite.chainA).WasmClientKeeper.IsChecksumDeprecated(suite.chainA.GetContext(), msg.Checksum))
+
 				// Verify events
-				suite.Require().Len(events, 0)
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						"remove_checksum",
+						sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(msg.Checksum)),
+					),
+					sdk.NewEvent(
+						sdk.EventTypeMessage,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					),
+				}.ToABCIEvents()
+
+				for _, evt := range expectedEvents {
+					suite.Require().Contains(events, evt)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetChecksumDeprecated() {
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	suite.Require().NoError(err)
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	var msg *types.MsgSetChecksumDeprecated
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: deprecate checksum",
+			func() {
+				msg = types.NewMsgSetChecksumDeprecated(govAcc, checksum, true)
+			},
+			nil,
+		},
+		{
+			"success: undeprecate checksum",
+			func() {
+				msg = types.NewMsgSetChecksumDeprecated(govAcc, checksum, false)
+
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetDeprecatedChecksums().Set(suite.chainA.GetContext(), checksum)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: checksum is missing",
+			func() {
+				msg = types.NewMsgSetChecksumDeprecated(govAcc, []byte{1}, true)
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgSetChecksumDeprecated(suite.chainA.SenderAccount.GetAddress().String(), checksum, true)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			_ = suite.storeWasmCode(wasmtesting.Code)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.SetChecksumDeprecated(ctx, msg)
+			events := ctx.EventManager().Events().ToABCIEvents()
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().Equal(msg.Deprecated, GetSimApp(suite.chainA).WasmClientKeeper.IsChecksumDeprecated(suite.chainA.GetContext(), checksum))
+
+				// Verify events
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						"set_checksum_deprecated",
+						sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+						sdk.NewAttribute(types.AttributeKeyDeprecated, strconv.FormatBool(msg.Deprecated)),
+					),
+					sdk.NewEvent(
+						sdk.EventTypeMessage,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					),
+				}.ToABCIEvents()
+
+				for _, evt := range expectedEvents {
+					suite.Require().Contains(events, evt)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMigrateContracts() {
+	oldChecksum, err := types.CreateChecksum(wasmtesting.Code)
+	suite.Require().NoError(err)
+
+	newByteCode := wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgMigrateContracts"))
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	var (
+		newChecksum  []byte
+		msg          *types.MsgMigrateContracts
+		expClientIDs []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: all clients are migrated",
+			func() {
+				msg = types.NewMsgMigrateContracts(govAcc, oldChecksum, newChecksum, []byte("{}"))
+			},
+			nil,
+		},
+		{
+			"success: no clients reference the checksum",
+			func() {
+				msg = types.NewMsgMigrateContracts(govAcc, newChecksum, oldChecksum, []byte("{}"))
+
+				expClientIDs = nil
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgMigrateContracts(suite.chainA.SenderAccount.GetAddress().String(), oldChecksum, newChecksum, []byte("{}"))
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: new checksum is deprecated",
+			func() {
+				msg = types.NewMsgMigrateContracts(govAcc, oldChecksum, newChecksum, []byte("{}"))
+
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetDeprecatedChecksums().Set(suite.chainA.GetContext(), newChecksum)
+				suite.Require().NoError(err)
+			},
+			types.ErrWasmChecksumDeprecated,
+		},
+		{
+			"failure: contract returns error",
+			func() {
+				msg = types.NewMsgMigrateContracts(govAcc, oldChecksum, newChecksum, []byte("{}"))
+
+				suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: wasmtesting.ErrMockContract.Error()}, wasmtesting.DefaultGasUsed, nil
+				}
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			_ = suite.storeWasmCode(wasmtesting.Code)
+			newChecksum = suite.storeWasmCode(newByteCode)
+
+			expClientIDs = nil
+			for i := 0; i < 3; i++ {
+				endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+				err := endpoint.CreateClient()
+				suite.Require().NoError(err)
+
+				expClientIDs = append(expClientIDs, endpoint.ClientID)
+			}
+
+			suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				data, err := json.Marshal(types.EmptyResult{})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: data}}, wasmtesting.DefaultGasUsed, nil
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.MigrateContracts(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expClientIDs, res.ClientIds)
+
+				for _, clientID := range expClientIDs {
+					clientState, err := GetSimApp(suite.chainA).WasmClientKeeper.GetWasmClientState(suite.chainA.GetContext(), clientID)
+					suite.Require().NoError(err)
+					suite.Require().Equal(msg.Checksum, clientState.Checksum)
+				}
+
+				suite.Require().Empty(GetSimApp(suite.chainA).WasmClientKeeper.GetChecksumClients(suite.chainA.GetContext(), msg.OldChecksum))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMarkClientExpired() {
+	var (
+		msg        *types.MsgMarkClientExpired
+		status     exported.Status
+		hookCalled bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client is not expired",
+			func() {
+				status = exported.Active
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: contract does not support lifecycle hooks",
+			func() {
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetLifecycleHooksClients().Remove(suite.chainA.GetContext(), msg.ClientId)
+				suite.Require().NoError(err)
+			},
+			types.ErrWasmLifecycleHooksNotSupported,
+		},
+		{
+			"failure: client has already been marked as expired",
+			func() {
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetExpiredClients().Set(suite.chainA.GetContext(), msg.ClientId)
+				suite.Require().NoError(err)
+			},
+			types.ErrWasmClientAlreadyExpired,
+		},
+		{
+			"failure: contract returns error",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.ClientExpiredMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: wasmtesting.ErrMockContract.Error()}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			_ = suite.storeWasmCode(wasmtesting.Code)
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgMarkClientExpired(suite.chainA.SenderAccount.GetAddress().String(), endpoint.ClientID)
+			status = exported.Expired
+			hookCalled = false
+
+			err = GetSimApp(suite.chainA).WasmClientKeeper.GetLifecycleHooksClients().Set(suite.chainA.GetContext(), endpoint.ClientID)
+			suite.Require().NoError(err)
+
+			suite.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+				resp, err := json.Marshal(types.StatusResult{Status: status.String()})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.QueryResult{Ok: resp}, wasmtesting.DefaultGasUsed, nil
+			})
+
+			suite.mockVM.RegisterSudoCallback(types.ClientExpiredMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				hookCalled = true
+
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, wasmtesting.DefaultGasUsed, nil
+			})
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.MarkClientExpired(ctx, msg)
+			events := ctx.EventManager().Events().ToABCIEvents()
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().True(hookCalled)
+				suite.Require().True(GetSimApp(suite.chainA).WasmClientKeeper.IsClientMarkedExpired(suite.chainA.GetContext(), msg.ClientId))
+
+				// Verify events
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						"mark_client_expired",
+						sdk.NewAttribute(types.AttributeKeyClientID, msg.ClientId),
+					),
+					sdk.NewEvent(
+						sdk.EventTypeMessage,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					),
+				}.ToABCIEvents()
+
+				for _, evt := range expectedEvents {
+					suite.Require().Contains(events, evt)
+				}
 			} else {
 				suite.Require().ErrorIs(err, tc.expError)
 				suite.Require().Nil(res)
@@ -1,5 +1,12 @@
 package keeper
 
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
 // Option is an extension point to instantiate keeper with non default values
 type Option interface {
 	apply(*Keeper)
@@ -21,3 +28,39 @@ func WithQueryPlugins(plugins *QueryPlugins) Option {
 		k.setQueryPlugins(newPlugins)
 	})
 }
+
+// WithClientQueryAllowList is an optional constructor parameter which allows contracts to query the
+// state of the light clients with the provided identifiers. See AllowListClientQuerier for details.
+func WithClientQueryAllowList(allowedClientIDs []string) Option {
+	return optsFn(func(k *Keeper) {
+		currentPlugins := k.getQueryPlugins()
+		newPlugins := currentPlugins.Merge(&QueryPlugins{
+			Client: AllowListClientQuerier(allowedClientIDs, k.clientKeeper),
+		})
+
+		k.setQueryPlugins(newPlugins)
+	})
+}
+
+// WithContractGasLimits is an optional constructor parameter to limit the amount of gas that a single
+// sudo or query call to the contracts with the given hex encoded checksums may consume.
+// Calls exceeding the limit fail with ErrWasmGasLimitExceeded.
+func WithContractGasLimits(limits map[string]types.ContractGasLimit) Option {
+	return optsFn(func(k *Keeper) {
+		contractGasLimits := make(map[string]types.ContractGasLimit, len(limits))
+		for checksumHex, limit := range limits {
+			checksum, err := hex.DecodeString(checksumHex)
+			if err != nil {
+				panic(fmt.Errorf("invalid checksum %s in contract gas limits: %w", checksumHex, err))
+			}
+
+			if err := types.ValidateWasmChecksum(checksum); err != nil {
+				panic(fmt.Errorf("invalid checksum %s in contract gas limits: %w", checksumHex, err))
+			}
+
+			contractGasLimits[hex.EncodeToString(checksum)] = limit
+		}
+
+		k.contractGasLimits = contractGasLimits
+	})
+}
@@ -133,6 +133,30 @@ func (suite *KeeperTestSuite) TestNewKeeperWithOptions() {
 				suite.Require().ErrorContains(err, "stargate querier error for TestNewKeeperWithOptions")
 			},
 		},
+		{
+			"success: client query accept list",
+			func() {
+				k = keeper.NewKeeperWithVM(
+					GetSimApp(suite.chainA).AppCodec(),
+					runtime.NewKVStoreService(GetSimApp(suite.chainA).GetKey(types.StoreKey)),
+					GetSimApp(suite.chainA).IBCKeeper.ClientKeeper,
+					GetSimApp(suite.chainA).WasmClientKeeper.GetAuthority(),
+					GetSimApp(suite.chainA).WasmClientKeeper.GetVM(),
+					GetSimApp(suite.chainA).GRPCQueryRouter(),
+					keeper.WithClientQueryAllowList([]string{"07-tendermint-0"}),
+				)
+			},
+			func(k keeper.Keeper) {
+				plugins := k.GetQueryPlugins()
+
+				_, err := plugins.Custom(sdk.Context{}, nil)
+				suite.Require().ErrorIs(err, wasmvmtypes.UnsupportedRequest{Kind: "Custom queries are not allowed"})
+
+				suite.Require().NotNil(plugins.Client)
+				_, err = plugins.Client(sdk.Context{}, &types.ClientQuery{Status: &types.ClientStatusQuery{ClientID: "07-tendermint-1"}})
+				suite.Require().ErrorIs(err, wasmvmtypes.UnsupportedRequest{Kind: "client '07-tendermint-1' is not allowed to be queried from the contract"})
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -15,6 +15,8 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 /*
@@ -26,6 +28,9 @@ can be replaced by the user through the options api in the keeper.
 In addition, the `types.StargateQuerier` references a global `types.QueryRouter` which points
 to `baseapp.GRPCQueryRouter`.
 
+An optional `ClientQuerier` serves custom queries wrapped in the `ibc_client` key, giving
+contracts read-only access to the state of other light clients (see `AllowListClientQuerier`).
+
 This design is based on wasmd's (v0.50.0) querier plugin design.
 */
 
@@ -82,11 +87,14 @@ func (q *queryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64)
 type (
 	CustomQuerier   func(ctx sdk.Context, request json.RawMessage) ([]byte, error)
 	StargateQuerier func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error)
+	ClientQuerier   func(ctx sdk.Context, request *types.ClientQuery) ([]byte, error)
 
 	// QueryPlugins is a list of queriers that can be used to extend the default querier.
 	QueryPlugins struct {
 		Custom   CustomQuerier
 		Stargate StargateQuerier
+		// Client is optional. If set, it handles the custom queries setting the ibc_client field.
+		Client ClientQuerier
 	}
 )
 
@@ -105,6 +113,10 @@ func (e QueryPlugins) Merge(x *QueryPlugins) QueryPlugins {
 		e.Stargate = x.Stargate
 	}
 
+	if x.Client != nil {
+		e.Client = x.Client
+	}
+
 	return e
 }
 
@@ -115,6 +127,13 @@ func (e QueryPlugins) HandleQuery(ctx sdk.Context, caller string, request wasmvm
 	}
 
 	if request.Custom != nil {
+		if e.Client != nil {
+			var customQuery types.CustomQuery
+			if err := json.Unmarshal(request.Custom, &customQuery); err == nil && customQuery.IBCClient != nil {
+				return e.Client(ctx, customQuery.IBCClient)
+			}
+		}
+
 		return e.Custom(ctx, request.Custom)
 	}
 
@@ -168,6 +187,95 @@ func RejectCustomQuerier() func(sdk.Context, json.RawMessage) ([]byte, error) {
 	}
 }
 
+// AllowListClientQuerier allows contracts to query the status, latest height, timestamp at height and
+// consensus state at height of the light clients in the provided accept list. The gas consumed by each
+// query is charged according to types.VMGasRegister, in addition to the gas consumed by store reads.
+// This function returns JSON encoded responses in bytes.
+func AllowListClientQuerier(allowedClientIDs []string, clientKeeper types.ClientKeeper) func(sdk.Context, *types.ClientQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.ClientQuery) ([]byte, error) {
+		var (
+			res any
+			err error
+		)
+
+		switch {
+		case request.Status != nil:
+			res, err = queryClientStatus(ctx, clientKeeper, allowedClientIDs, request.Status)
+		case request.LatestHeight != nil:
+			res, err = queryClientLatestHeight(ctx, clientKeeper, allowedClientIDs, request.LatestHeight)
+		case request.TimestampAtHeight != nil:
+			res, err = queryClientTimestampAtHeight(ctx, clientKeeper, allowedClientIDs, request.TimestampAtHeight)
+		case request.ConsensusState != nil:
+			res, err = queryClientConsensusState(ctx, clientKeeper, allowedClientIDs, request.ConsensusState)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "Unsupported client query"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, wasmvmtypes.InvalidResponse{Err: err.Error()}
+		}
+
+		ctx.GasMeter().ConsumeGas(types.VMGasRegister.ClientQueryCosts(len(bz)), "contract client query")
+
+		return bz, nil
+	}
+}
+
+func queryClientStatus(ctx sdk.Context, clientKeeper types.ClientKeeper, allowedClientIDs []string, query *types.ClientStatusQuery) (types.StatusResult, error) {
+	if err := checkClientQueryAllowed(allowedClientIDs, query.ClientID); err != nil {
+		return types.StatusResult{}, err
+	}
+
+	return types.StatusResult{Status: clientKeeper.GetClientStatus(ctx, query.ClientID).String()}, nil
+}
+
+func queryClientLatestHeight(ctx sdk.Context, clientKeeper types.ClientKeeper, allowedClientIDs []string, query *types.ClientLatestHeightQuery) (types.LatestHeightResult, error) {
+	if err := checkClientQueryAllowed(allowedClientIDs, query.ClientID); err != nil {
+		return types.LatestHeightResult{}, err
+	}
+
+	return types.LatestHeightResult{Height: clientKeeper.GetClientLatestHeight(ctx, query.ClientID)}, nil
+}
+
+func queryClientTimestampAtHeight(ctx sdk.Context, clientKeeper types.ClientKeeper, allowedClientIDs []string, query *types.ClientTimestampAtHeightQuery) (types.TimestampAtHeightResult, error) {
+	if err := checkClientQueryAllowed(allowedClientIDs, query.ClientID); err != nil {
+		return types.TimestampAtHeightResult{}, err
+	}
+
+	timestamp, err := clientKeeper.GetClientTimestampAtHeight(ctx, query.ClientID, query.Height)
+	if err != nil {
+		return types.TimestampAtHeightResult{}, err
+	}
+
+	return types.TimestampAtHeightResult{Timestamp: timestamp}, nil
+}
+
+func queryClientConsensusState(ctx sdk.Context, clientKeeper types.ClientKeeper, allowedClientIDs []string, query *types.ClientConsensusStateQuery) (types.ConsensusStateResult, error) {
+	if err := checkClientQueryAllowed(allowedClientIDs, query.ClientID); err != nil {
+		return types.ConsensusStateResult{}, err
+	}
+
+	bz := clientKeeper.ClientStore(ctx, query.ClientID).Get(host.ConsensusStateKey(query.Height))
+	if len(bz) == 0 {
+		return types.ConsensusStateResult{}, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "client (%s) at height (%s)", query.ClientID, query.Height)
+	}
+
+	return types.ConsensusStateResult{ConsensusState: bz}, nil
+}
+
+// checkClientQueryAllowed returns an error if the given client identifier is not in the accept list.
+func checkClientQueryAllowed(allowedClientIDs []string, clientID string) error {
+	if !slices.Contains(allowedClientIDs, clientID) {
+		return wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("client '%s' is not allowed to be queried from the contract", clientID)}
+	}
+
+	return nil
+}
+
 // Wasmd Issue [#759](https://github.com/CosmWasm/wasmd/issues/759)
 // Don't return error string for worries of non-determinism
 func redactError(err error) error {
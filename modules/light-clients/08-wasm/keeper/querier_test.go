@@ -352,3 +352,174 @@ func (suite *KeeperTestSuite) TestStargateQuery() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestClientQuery() {
+	var (
+		targetEndpoint *wasmtesting.WasmEndpoint
+		clientQuery    types.ClientQuery
+		querierPlugin  keeper.QueryPlugins
+		expResult      any
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: status query",
+			func() {
+				clientQuery = types.ClientQuery{Status: &types.ClientStatusQuery{ClientID: targetEndpoint.ClientID}}
+				expResult = types.StatusResult{Status: exported.Active.String()}
+			},
+			nil,
+		},
+		{
+			"success: latest height query",
+			func() {
+				clientQuery = types.ClientQuery{LatestHeight: &types.ClientLatestHeightQuery{ClientID: targetEndpoint.ClientID}}
+				expResult = types.LatestHeightResult{Height: suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientLatestHeight(suite.chainA.GetContext(), targetEndpoint.ClientID)}
+			},
+			nil,
+		},
+		{
+			"success: timestamp at height query",
+			func() {
+				height := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientLatestHeight(suite.chainA.GetContext(), targetEndpoint.ClientID)
+				clientQuery = types.ClientQuery{TimestampAtHeight: &types.ClientTimestampAtHeightQuery{ClientID: targetEndpoint.ClientID, Height: height}}
+				expResult = types.TimestampAtHeightResult{Timestamp: 42}
+
+				suite.mockVM.RegisterQueryCallback(types.TimestampAtHeightMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+					resp, err := json.Marshal(types.TimestampAtHeightResult{Timestamp: 42})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.QueryResult{Ok: resp}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
+		{
+			"success: consensus state query",
+			func() {
+				height := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientLatestHeight(suite.chainA.GetContext(), targetEndpoint.ClientID)
+				clientQuery = types.ClientQuery{ConsensusState: &types.ClientConsensusStateQuery{ClientID: targetEndpoint.ClientID, Height: height}}
+
+				consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), targetEndpoint.ClientID, height)
+				suite.Require().True(found)
+				expResult = types.ConsensusStateResult{ConsensusState: clienttypes.MustMarshalConsensusState(suite.chainA.App.AppCodec(), consensusState)}
+			},
+			nil,
+		},
+		{
+			"success: custom query without ibc_client field is handled by the custom querier",
+			func() {
+				querierPlugin.Custom = mockCustomQuerier()
+				expResult = "hello world"
+			},
+			nil,
+		},
+		{
+			"failure: client is not in the accept list",
+			func() {
+				querierPlugin.Client = keeper.AllowListClientQuerier([]string{}, suite.chainA.App.GetIBCKeeper().ClientKeeper)
+				clientQuery = types.ClientQuery{Status: &types.ClientStatusQuery{ClientID: targetEndpoint.ClientID}}
+			},
+			wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("client '%s' is not allowed to be queried from the contract", "08-wasm-1")},
+		},
+		{
+			"failure: consensus state not found",
+			func() {
+				clientQuery = types.ClientQuery{ConsensusState: &types.ClientConsensusStateQuery{ClientID: targetEndpoint.ClientID, Height: clienttypes.NewHeight(100, 100)}}
+			},
+			fmt.Errorf("codespace: %s, code: %d", clienttypes.ErrConsensusStateNotFound.Codespace(), clienttypes.ErrConsensusStateNotFound.ABCICode()),
+		},
+		{
+			"failure: empty client query",
+			func() {
+				clientQuery = types.ClientQuery{}
+			},
+			wasmvmtypes.UnsupportedRequest{Kind: "Unsupported client query"},
+		},
+		{
+			"failure: default querier",
+			func() {
+				querierPlugin = keeper.NewDefaultQueryPlugins(GetSimApp(suite.chainA).GRPCQueryRouter())
+				clientQuery = types.ClientQuery{Status: &types.ClientStatusQuery{ClientID: targetEndpoint.ClientID}}
+			},
+			wasmvmtypes.UnsupportedRequest{Kind: "Custom queries are not allowed"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			_ = suite.storeWasmCode(wasmtesting.Code)
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			targetEndpoint = wasmtesting.NewWasmEndpoint(suite.chainA)
+			err = targetEndpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			querierPlugin = keeper.NewDefaultQueryPlugins(GetSimApp(suite.chainA).GRPCQueryRouter())
+			querierPlugin.Client = keeper.AllowListClientQuerier([]string{targetEndpoint.ClientID}, suite.chainA.App.GetIBCKeeper().ClientKeeper)
+			clientQuery = types.ClientQuery{}
+			expResult = nil
+
+			tc.malleate()
+
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			wasmClientKeeper.SetQueryPlugins(querierPlugin)
+
+			var (
+				resp     []byte
+				queryErr error
+			)
+			suite.mockVM.RegisterQueryCallback(types.CheckForMisbehaviourMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, querier wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+				request, err := json.Marshal(types.CustomQuery{IBCClient: &clientQuery})
+				suite.Require().NoError(err)
+
+				if expResult == "hello world" {
+					request, err = json.Marshal(CustomQuery{Echo: &QueryEcho{Data: "hello world"}})
+					suite.Require().NoError(err)
+				}
+
+				resp, queryErr = querier.Query(wasmvmtypes.QueryRequest{Custom: json.RawMessage(request)}, math.MaxUint64)
+
+				result, err := json.Marshal(types.CheckForMisbehaviourResult{})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.QueryResult{Ok: result}, wasmtesting.DefaultGasUsed, nil
+			})
+
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), endpoint.ClientID)
+			clientState, ok := endpoint.GetClientState().(*types.ClientState)
+			suite.Require().True(ok)
+
+			ctx := suite.chainA.GetContext()
+			payload := types.QueryMsg{CheckForMisbehaviour: &types.CheckForMisbehaviourMsg{}}
+			_, err = wasmClientKeeper.WasmQuery(ctx, endpoint.ClientID, clientStore, clientState, payload)
+			suite.Require().NoError(err)
+
+			if tc.expError == nil {
+				suite.Require().NoError(queryErr)
+
+				expResp, err := json.Marshal(expResult)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResp, resp)
+
+				if clientQuery != (types.ClientQuery{}) {
+					suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), types.VMGasRegister.ClientQueryCosts(len(resp)))
+				}
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorContains(queryErr, tc.expError.Error())
+			}
+
+			// reset query plugins after each test
+			wasmClientKeeper.SetQueryPlugins(keeper.NewDefaultQueryPlugins(GetSimApp(suite.chainA).GRPCQueryRouter()))
+		})
+	}
+}
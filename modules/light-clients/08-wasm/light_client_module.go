@@ -19,7 +19,10 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule  = (*LightClientModule)(nil)
+	_ exported.UpgradePlanHandler = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -66,6 +69,11 @@ func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientSt
 		return errorsmod.Wrapf(types.ErrInvalidChecksum, "checksum (%s) has not been previously stored", hex.EncodeToString(clientState.Checksum))
 	}
 
+	// Do not allow new clients to use a deprecated checksum. Existing clients are not affected.
+	if l.keeper.IsChecksumDeprecated(ctx, clientState.Checksum) {
+		return errorsmod.Wrapf(types.ErrWasmChecksumDeprecated, "cannot create client with checksum (%s)", hex.EncodeToString(clientState.Checksum))
+	}
+
 	payload := types.InstantiateMessage{
 		ClientState:    clientState.Data,
 		ConsensusState: consensusState.Data,
@@ -414,6 +422,7 @@ func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, h
 // subject client and calls into the appropriate contract endpoint.
 // It will verify that a substitute client state is valid and update the subject client state.
 // Note that this method is used only for recovery and will not allow changes to the checksum.
+// After a successful recovery, contracts supporting lifecycle hooks are notified with the client_recovered sudo message.
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 08-wasm-{n}.
 func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error {
@@ -452,8 +461,20 @@ func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteCl
 		MigrateClientStore: &types.MigrateClientStoreMsg{},
 	}
 
-	_, err = l.keeper.WasmSudo(ctx, clientID, store, subjectClientState, payload)
-	return err
+	if _, err = l.keeper.WasmSudo(ctx, clientID, store, subjectClientState, payload); err != nil {
+		return err
+	}
+
+	return l.keeper.NotifyClientRecovered(ctx, clientID, substituteClientID)
+}
+
+// OnUpgradePlanScheduled implements the exported.UpgradePlanHandler interface. It notifies the contracts of all
+// clients supporting lifecycle hooks of the scheduled IBC software upgrade plan. Failures of individual contracts
+// are logged and otherwise ignored.
+func (l LightClientModule) OnUpgradePlanScheduled(ctx sdk.Context, planName string, planHeight int64, upgradedClientState []byte) {
+	if err := l.keeper.NotifyUpgradePlanScheduled(ctx, planName, planHeight, upgradedClientState); err != nil {
+		l.keeper.Logger(ctx).Error("failed to notify wasm clients of scheduled upgrade plan", "plan", planName, "error", err)
+	}
 }
 
 // VerifyUpgradeAndUpdateState obtains the client state associated with the client identifier and calls into the appropriate contract endpoint.
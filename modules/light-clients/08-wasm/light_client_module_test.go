@@ -10,6 +10,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
 	errorsmod "cosmossdk.io/errors"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	internaltypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/types"
 	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
@@ -309,6 +310,14 @@ func (suite *WasmTestSuite) TestInitialize() {
 			},
 			types.ErrInvalidChecksum,
 		},
+		{
+			"failure: checksum is deprecated",
+			func() {
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetDeprecatedChecksums().Set(suite.chainA.GetContext(), suite.checksum)
+				suite.Require().NoError(err)
+			},
+			types.ErrWasmChecksumDeprecated,
+		},
 		{
 			"failure: vm returns error",
 			func() {
@@ -1587,3 +1596,220 @@ func (suite *WasmTestSuite) TestLatestHeight() {
 		})
 	}
 }
+
+func (suite *WasmTestSuite) TestRecoverClientLifecycleHooks() {
+	var (
+		subjectClientID, substituteClientID string
+		hookCalled                          bool
+	)
+
+	hookKey := []byte("recovered")
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expHookCalled bool
+		expHookState  bool
+	}{
+		{
+			"success: contract supporting lifecycle hooks is notified",
+			func() {
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetLifecycleHooksClients().Set(suite.chainA.GetContext(), subjectClientID)
+				suite.Require().NoError(err)
+			},
+			true,
+			true,
+		},
+		{
+			"success: contract not supporting lifecycle hooks is not notified",
+			func() {},
+			false,
+			false,
+		},
+		{
+			"success: failing lifecycle hook does not prevent recovery",
+			func() {
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetLifecycleHooksClients().Set(suite.chainA.GetContext(), subjectClientID)
+				suite.Require().NoError(err)
+
+				suite.mockVM.RegisterSudoCallback(
+					types.ClientRecoveredMsg{},
+					func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+						hookCalled = true
+						store.Set(hookKey, []byte{1})
+
+						return &wasmvmtypes.ContractResult{Err: wasmtesting.ErrMockContract.Error()}, wasmtesting.DefaultGasUsed, nil
+					},
+				)
+			},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			subjectEndpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := subjectEndpoint.CreateClient()
+			suite.Require().NoError(err)
+			subjectClientID = subjectEndpoint.ClientID
+
+			substituteEndpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err = substituteEndpoint.CreateClient()
+			suite.Require().NoError(err)
+			substituteClientID = substituteEndpoint.ClientID
+
+			hookCalled = false
+
+			err = GetSimApp(suite.chainA).WasmClientKeeper.GetExpiredClients().Set(suite.chainA.GetContext(), subjectClientID)
+			suite.Require().NoError(err)
+
+			suite.mockVM.RegisterSudoCallback(
+				types.MigrateClientStoreMsg{},
+				func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					prefixedKey := internaltypes.SubjectPrefix
+					prefixedKey = append(prefixedKey, host.ClientStateKey()...)
+					store.Set(prefixedKey, wasmtesting.CreateMockClientStateBz(suite.chainA.Codec, suite.checksum))
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, wasmtesting.DefaultGasUsed, nil
+				},
+			)
+
+			suite.mockVM.RegisterSudoCallback(
+				types.ClientRecoveredMsg{},
+				func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					hookCalled = true
+
+					var payload types.SudoMsg
+					err := json.Unmarshal(sudoMsg, &payload)
+					suite.Require().NoError(err)
+					suite.Require().Equal(substituteClientID, payload.ClientRecovered.SubstituteClientID)
+
+					store.Set(hookKey, []byte{1})
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, wasmtesting.DefaultGasUsed, nil
+				},
+			)
+
+			tc.malleate()
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), subjectClientID)
+			suite.Require().NoError(err)
+
+			err = lightClientModule.RecoverClient(suite.chainA.GetContext(), subjectClientID, substituteClientID)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expHookCalled, hookCalled)
+
+			subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectClientID)
+			suite.Require().Equal(tc.expHookState, subjectClientStore.Has(hookKey))
+
+			suite.Require().False(GetSimApp(suite.chainA).WasmClientKeeper.IsClientMarkedExpired(suite.chainA.GetContext(), subjectClientID))
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestOnUpgradePlanScheduled() {
+	var (
+		clientID      string
+		notifiedPlans []types.UpgradePlanScheduledMsg
+	)
+
+	hookKey := []byte("upgrade_plan")
+	plan := upgradetypes.Plan{
+		Name:   "upgrade IBC clients",
+		Height: 1000,
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expNotified  bool
+		expHookState bool
+	}{
+		{
+			"success: contract supporting lifecycle hooks is notified",
+			func() {
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetLifecycleHooksClients().Set(suite.chainA.GetContext(), clientID)
+				suite.Require().NoError(err)
+			},
+			true,
+			true,
+		},
+		{
+			"success: contract not supporting lifecycle hooks is not notified",
+			func() {},
+			false,
+			false,
+		},
+		{
+			"success: failing lifecycle hook does not prevent scheduling the plan",
+			func() {
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetLifecycleHooksClients().Set(suite.chainA.GetContext(), clientID)
+				suite.Require().NoError(err)
+
+				suite.mockVM.RegisterSudoCallback(
+					types.UpgradePlanScheduledMsg{},
+					func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+						store.Set(hookKey, []byte{1})
+
+						return nil, wasmtesting.DefaultGasUsed, wasmtesting.ErrMockVM
+					},
+				)
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+			clientID = endpoint.ClientID
+
+			notifiedPlans = nil
+
+			suite.mockVM.RegisterSudoCallback(
+				types.UpgradePlanScheduledMsg{},
+				func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					var payload types.SudoMsg
+					err := json.Unmarshal(sudoMsg, &payload)
+					suite.Require().NoError(err)
+
+					notifiedPlans = append(notifiedPlans, *payload.UpgradePlanScheduled)
+					store.Set(hookKey, []byte{1})
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, wasmtesting.DefaultGasUsed, nil
+				},
+			)
+
+			tc.malleate()
+
+			err = GetSimApp(suite.chainA).IBCKeeper.ClientKeeper.ScheduleIBCSoftwareUpgrade(suite.chainA.GetContext(), plan, wasmtesting.MockTendermitClientState)
+			suite.Require().NoError(err)
+
+			_, err = GetSimApp(suite.chainA).UpgradeKeeper.GetUpgradePlan(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+
+			if tc.expNotified {
+				suite.Require().Len(notifiedPlans, 1)
+				suite.Require().Equal(plan.Name, notifiedPlans[0].PlanName)
+				suite.Require().Equal(plan.Height, notifiedPlans[0].PlanHeight)
+				suite.Require().NotEmpty(notifiedPlans[0].UpgradedClientState)
+			} else {
+				suite.Require().Empty(notifiedPlans)
+			}
+
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+			suite.Require().Equal(tc.expHookState, clientStore.Has(hookKey))
+		})
+	}
+}
@@ -22,7 +22,7 @@ var (
 	queryTypes = [...]any{types.StatusMsg{}, types.TimestampAtHeightMsg{}, types.VerifyClientMessageMsg{}, types.CheckForMisbehaviourMsg{}}
 
 	// sudoTypes contains all the possible sudo message types.
-	sudoTypes = [...]any{types.UpdateStateMsg{}, types.UpdateStateOnMisbehaviourMsg{}, types.VerifyUpgradeAndUpdateStateMsg{}, types.VerifyMembershipMsg{}, types.VerifyNonMembershipMsg{}, types.MigrateClientStoreMsg{}}
+	sudoTypes = [...]any{types.UpdateStateMsg{}, types.UpdateStateOnMisbehaviourMsg{}, types.VerifyUpgradeAndUpdateStateMsg{}, types.VerifyMembershipMsg{}, types.VerifyNonMembershipMsg{}, types.MigrateClientStoreMsg{}, types.ClientRecoveredMsg{}, types.UpgradePlanScheduledMsg{}, types.ClientExpiredMsg{}}
 )
 
 type (
@@ -276,6 +276,18 @@ func getSudoMsgPayloadTypeName(sudoMsgBz []byte) string {
 		payloadField = *payload.MigrateClientStore
 	}
 
+	if payload.ClientRecovered != nil {
+		payloadField = *payload.ClientRecovered
+	}
+
+	if payload.UpgradePlanScheduled != nil {
+		payloadField = *payload.UpgradePlanScheduled
+	}
+
+	if payload.ClientExpired != nil {
+		payloadField = *payload.ClientExpired
+	}
+
 	if payloadField == nil {
 		panic(fmt.Errorf("failed to extract valid sudo message from bytes: %s", string(sudoMsgBz)))
 	}
@@ -28,6 +28,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgStoreCode{},
 		&MsgMigrateContract{},
 		&MsgRemoveChecksum{},
+		&MsgSetChecksumDeprecated{},
+		&MsgMigrateContracts{},
+		&MsgMarkClientExpired{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -48,6 +48,21 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgRemoveChecksum{}),
 			true,
 		},
+		{
+			"success: MsgSetChecksumDeprecated",
+			sdk.MsgTypeURL(&types.MsgSetChecksumDeprecated{}),
+			true,
+		},
+		{
+			"success: MsgMigrateContracts",
+			sdk.MsgTypeURL(&types.MsgMigrateContracts{}),
+			true,
+		},
+		{
+			"success: MsgMarkClientExpired",
+			sdk.MsgTypeURL(&types.MsgMarkClientExpired{}),
+			true,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -31,6 +31,20 @@ type WasmConfig struct {
 	// ContractDebugMode is a flag to log what contracts print. It must be false on all
 	// production nodes, and only enabled in test environments or debug non-validating nodes.
 	ContractDebugMode bool
+	// ContractGasLimits maps hex encoded checksums to the maximum amount of gas that a single
+	// sudo or query call to the contract may consume. Calls to contracts without an entry are
+	// only bounded by the gas meter of the transaction. These limits affect the outcome of
+	// transactions and must therefore be the same on all nodes of the network.
+	ContractGasLimits map[string]ContractGasLimit
+}
+
+// ContractGasLimit defines the maximum amount of SDK gas that may be consumed by a single
+// call to the sudo and query entry points of a contract. A value of zero means no limit.
+type ContractGasLimit struct {
+	// MaxSudoGas is the maximum amount of gas consumed by a single sudo call.
+	MaxSudoGas uint64
+	// MaxQueryGas is the maximum amount of gas consumed by a single query call.
+	MaxQueryGas uint64
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig.
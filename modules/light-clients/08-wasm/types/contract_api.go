@@ -52,6 +52,9 @@ type SudoMsg struct {
 	VerifyMembership            *VerifyMembershipMsg            `json:"verify_membership,omitempty"`
 	VerifyNonMembership         *VerifyNonMembershipMsg         `json:"verify_non_membership,omitempty"`
 	MigrateClientStore          *MigrateClientStoreMsg          `json:"migrate_client_store,omitempty"`
+	ClientRecovered             *ClientRecoveredMsg             `json:"client_recovered,omitempty"`
+	UpgradePlanScheduled        *UpgradePlanScheduledMsg        `json:"upgrade_plan_scheduled,omitempty"`
+	ClientExpired               *ClientExpiredMsg               `json:"client_expired,omitempty"`
 }
 
 // UpdateStateMsg is a sudoMsg sent to the contract to update the client state.
@@ -94,11 +97,38 @@ type VerifyUpgradeAndUpdateStateMsg struct {
 // MigrateClientStoreMsg is a sudoMsg sent to the contract to verify a given substitute client and update to its state.
 type MigrateClientStoreMsg struct{}
 
+// ClientRecoveredMsg is a sudoMsg sent to contracts supporting lifecycle hooks after the client
+// has been recovered using the state of the substitute client.
+type ClientRecoveredMsg struct {
+	SubstituteClientID string `json:"substitute_client_id"`
+}
+
+// UpgradePlanScheduledMsg is a sudoMsg sent to contracts supporting lifecycle hooks when an IBC
+// software upgrade plan is scheduled on the chain.
+type UpgradePlanScheduledMsg struct {
+	PlanName            string `json:"plan_name"`
+	PlanHeight          int64  `json:"plan_height"`
+	UpgradedClientState []byte `json:"upgraded_client_state"`
+}
+
+// ClientExpiredMsg is a sudoMsg sent to contracts supporting lifecycle hooks when the client
+// has been marked as expired.
+type ClientExpiredMsg struct{}
+
 // ContractResult is a type constraint that defines the expected results that can be returned by a contract call/query.
 type ContractResult interface {
 	EmptyResult | StatusResult | TimestampAtHeightResult | CheckForMisbehaviourResult | UpdateStateResult
 }
 
+// InstantiateResult is the optional data returned by the instantiate and migrate entry points of a contract.
+// It allows contracts to declare the capabilities they support. Contracts that do not return any data
+// do not support any optional capability.
+type InstantiateResult struct {
+	// LifecycleHooks is true if the contract supports the client_recovered, upgrade_plan_scheduled
+	// and client_expired sudo messages.
+	LifecycleHooks bool `json:"lifecycle_hooks"`
+}
+
 // EmptyResult is the default return type of any contract call that does not require a custom return type.
 type EmptyResult struct{}
 
@@ -123,3 +153,55 @@ type CheckForMisbehaviourResult struct {
 type UpdateStateResult struct {
 	Heights []clienttypes.Height `json:"heights"`
 }
+
+// CustomQuery is used to decode the custom queries made by a contract to the chain. Custom queries
+// which do not set the IBCClient field are handled by the custom querier of the query plugins.
+type CustomQuery struct {
+	IBCClient *ClientQuery `json:"ibc_client,omitempty"`
+}
+
+// ClientQuery is used to decode the queries made by a contract to read the state of other light clients.
+// The json omitempty tag is mandatory since it omits any empty (default initialized) fields from the encoded JSON,
+// this is required in order to be compatible with Rust's enum matching as used in the contract.
+// Only one field should be set at a time.
+type ClientQuery struct {
+	Status            *ClientStatusQuery            `json:"status,omitempty"`
+	LatestHeight      *ClientLatestHeightQuery      `json:"latest_height,omitempty"`
+	TimestampAtHeight *ClientTimestampAtHeightQuery `json:"timestamp_at_height,omitempty"`
+	ConsensusState    *ClientConsensusStateQuery    `json:"consensus_state,omitempty"`
+}
+
+// ClientStatusQuery is a clientQuery made by a contract to query the status of a light client.
+type ClientStatusQuery struct {
+	ClientID string `json:"client_id"`
+}
+
+// ClientLatestHeightQuery is a clientQuery made by a contract to query the latest height of a light client.
+type ClientLatestHeightQuery struct {
+	ClientID string `json:"client_id"`
+}
+
+// ClientTimestampAtHeightQuery is a clientQuery made by a contract to query the timestamp of a light client
+// at a given height.
+type ClientTimestampAtHeightQuery struct {
+	ClientID string             `json:"client_id"`
+	Height   clienttypes.Height `json:"height"`
+}
+
+// ClientConsensusStateQuery is a clientQuery made by a contract to query the consensus state of a light client
+// at a given height.
+type ClientConsensusStateQuery struct {
+	ClientID string             `json:"client_id"`
+	Height   clienttypes.Height `json:"height"`
+}
+
+// LatestHeightResult is the return type of the clientLatestHeightQuery. It returns the latest height of a light client.
+type LatestHeightResult struct {
+	Height clienttypes.Height `json:"height"`
+}
+
+// ConsensusStateResult is the return type of the clientConsensusStateQuery. It returns the protobuf
+// encoded consensus state of a light client, wrapped in an Any.
+type ConsensusStateResult struct {
+	ConsensusState []byte `json:"consensus_state"`
+}
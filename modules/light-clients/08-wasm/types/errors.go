@@ -20,4 +20,9 @@ var (
 	ErrWasmInvalidResponseData         = errorsmod.Register(ModuleName, 15, "wasm contract returned invalid response data")
 	ErrWasmInvalidContractModification = errorsmod.Register(ModuleName, 16, "wasm contract made invalid state modifications")
 	ErrVMError                         = errorsmod.Register(ModuleName, 17, "wasm VM error")
+	ErrWasmChecksumInUse               = errorsmod.Register(ModuleName, 18, "wasm checksum is referenced by light clients")
+	ErrWasmChecksumDeprecated          = errorsmod.Register(ModuleName, 19, "wasm checksum is deprecated")
+	ErrWasmGasLimitExceeded            = errorsmod.Register(ModuleName, 20, "wasm contract call exceeded its gas limit")
+	ErrWasmLifecycleHooksNotSupported  = errorsmod.Register(ModuleName, 21, "wasm contract does not support lifecycle hooks")
+	ErrWasmClientAlreadyExpired        = errorsmod.Register(ModuleName, 22, "wasm client has already been marked as expired")
 )
@@ -6,6 +6,12 @@ const (
 	EventTypeStoreWasmCode = "store_wasm_code"
 	// EventTypeMigrateContract defines the event type for a contract migration
 	EventTypeMigrateContract = "migrate_contract"
+	// EventTypeRemoveChecksum defines the event type for the removal of a checksum
+	EventTypeRemoveChecksum = "remove_checksum"
+	// EventTypeSetChecksumDeprecated defines the event type for a change of the deprecation state of a checksum
+	EventTypeSetChecksumDeprecated = "set_checksum_deprecated"
+	// EventTypeMarkClientExpired defines the event type for marking a client as expired
+	EventTypeMarkClientExpired = "mark_client_expired"
 
 	// AttributeKeyWasmChecksum denotes the checksum of the wasm code that was stored or migrated
 	AttributeKeyWasmChecksum = "wasm_checksum"
@@ -13,6 +19,8 @@ const (
 	AttributeKeyClientID = "client_id"
 	// AttributeKeyNewChecksum denotes the checksum of the new wasm code.
 	AttributeKeyNewChecksum = "new_checksum"
+	// AttributeKeyDeprecated denotes whether the checksum is deprecated
+	AttributeKeyDeprecated = "deprecated"
 
 	AttributeValueCategory = ModuleName
 )
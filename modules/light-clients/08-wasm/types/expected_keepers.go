@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
@@ -13,4 +14,8 @@ type ClientKeeper interface {
 	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	SetClientState(ctx sdk.Context, clientID string, clientState exported.ClientState)
+	IterateClientStates(ctx sdk.Context, storePrefix []byte, cb func(clientID string, cs exported.ClientState) bool)
+	GetClientStatus(ctx sdk.Context, clientID string) exported.Status
+	GetClientLatestHeight(ctx sdk.Context, clientID string) clienttypes.Height
+	GetClientTimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error)
 }
@@ -6,6 +6,7 @@ import (
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,11 @@ import (
 const (
 	// DefaultDeserializationCostPerByte The formula should be `len(data) * deserializationCostPerByte`
 	DefaultDeserializationCostPerByte = 1
+	// DefaultClientQueryCost is how much SDK gas is charged for each light client query made by a contract,
+	// in addition to the gas consumed by the store reads required to serve it.
+	DefaultClientQueryCost uint64 = 1_000
+	// DefaultClientQueryDataCost is how much SDK gas is charged *per byte* of the response to a light client query.
+	DefaultClientQueryDataCost uint64 = 3
 )
 
 var CostJSONDeserialization = wasmvmtypes.UFraction{
@@ -24,6 +30,14 @@ var CostJSONDeserialization = wasmvmtypes.UFraction{
 	Denominator: 1,
 }
 
+// ClientQueryCosts costs to serve a light client query made by a contract, returning a response of the given length.
+func (WasmGasRegister) ClientQueryCosts(responseLen int) storetypes.Gas {
+	if responseLen < 0 {
+		panic(errorsmod.Wrap(ErrInvalid, "negative length"))
+	}
+	return DefaultClientQueryCost + DefaultClientQueryDataCost*uint64(responseLen)
+}
+
 func (g WasmGasRegister) RuntimeGasForContract(ctx sdk.Context) uint64 {
 	meter := ctx.GasMeter()
 	if meter.IsOutOfGas() {
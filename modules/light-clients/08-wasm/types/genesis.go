@@ -18,5 +18,29 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, clientID := range gs.LifecycleHooksClientIds {
+		if err := ValidateClientID(clientID); err != nil {
+			return errorsmod.Wrap(err, "invalid lifecycle hooks client identifier")
+		}
+	}
+
+	for _, clientID := range gs.ExpiredClientIds {
+		if err := ValidateClientID(clientID); err != nil {
+			return errorsmod.Wrap(err, "invalid expired client identifier")
+		}
+	}
+
+	for _, checksumClients := range gs.ChecksumClients {
+		if err := ValidateWasmChecksum(checksumClients.Checksum); err != nil {
+			return errorsmod.Wrap(err, "invalid checksum of checksum clients")
+		}
+
+		for _, clientID := range checksumClients.ClientIds {
+			if err := ValidateClientID(clientID); err != nil {
+				return errorsmod.Wrap(err, "invalid checksum client identifier")
+			}
+		}
+	}
+
 	return nil
 }
@@ -27,6 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// uploaded light client wasm contracts
 	Contracts []Contract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	// identifiers of the clients whose contracts support lifecycle hooks
+	LifecycleHooksClientIds []string `protobuf:"bytes,2,rep,name=lifecycle_hooks_client_ids,json=lifecycleHooksClientIds,proto3" json:"lifecycle_hooks_client_ids,omitempty"`
+	// identifiers of the clients whose contracts have been notified of their expiry
+	ExpiredClientIds []string `protobuf:"bytes,3,rep,name=expired_client_ids,json=expiredClientIds,proto3" json:"expired_client_ids,omitempty"`
+	// identifiers of the clients referencing each checksum
+	ChecksumClients []ChecksumClients `protobuf:"bytes,4,rep,name=checksum_clients,json=checksumClients,proto3" json:"checksum_clients"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,10 +75,33 @@ func (m *GenesisState) GetContracts() []Contract {
 	return nil
 }
 
+func (m *GenesisState) GetLifecycleHooksClientIds() []string {
+	if m != nil {
+		return m.LifecycleHooksClientIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpiredClientIds() []string {
+	if m != nil {
+		return m.ExpiredClientIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChecksumClients() []ChecksumClients {
+	if m != nil {
+		return m.ChecksumClients
+	}
+	return nil
+}
+
 // Contract stores contract code
 type Contract struct {
 	// contract byte code
 	CodeBytes []byte `protobuf:"bytes,1,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	// deprecated is true if new clients may not be created using the contract
+	Deprecated bool `protobuf:"varint,2,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -108,9 +137,65 @@ func (m *Contract) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Contract proto.InternalMessageInfo
 
+// ChecksumClients stores the identifiers of the clients whose client state references a checksum
+type ChecksumClients struct {
+	// checksum of the contract
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// identifiers of the clients referencing the checksum
+	ClientIds []string `protobuf:"bytes,2,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+}
+
+func (m *ChecksumClients) Reset()         { *m = ChecksumClients{} }
+func (m *ChecksumClients) String() string { return proto.CompactTextString(m) }
+func (*ChecksumClients) ProtoMessage()    {}
+func (*ChecksumClients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05e250654f164e20, []int{2}
+}
+func (m *ChecksumClients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChecksumClients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChecksumClients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChecksumClients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChecksumClients.Merge(m, src)
+}
+func (m *ChecksumClients) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChecksumClients) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChecksumClients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChecksumClients proto.InternalMessageInfo
+
+func (m *ChecksumClients) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *ChecksumClients) GetClientIds() []string {
+	if m != nil {
+		return m.ClientIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.lightclients.wasm.v1.GenesisState")
 	proto.RegisterType((*Contract)(nil), "ibc.lightclients.wasm.v1.Contract")
+	proto.RegisterType((*ChecksumClients)(nil), "ibc.lightclients.wasm.v1.ChecksumClients")
 }
 
 func init() {
@@ -118,24 +203,32 @@ func init() {
 }
 
 var fileDescriptor_05e250654f164e20 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x4c, 0x4a, 0xd6,
-	0xcf, 0xc9, 0x4c, 0xcf, 0x28, 0x49, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x4f, 0x2c,
-	0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0xc8, 0x4c, 0x4a, 0xd6, 0x43, 0x56, 0xa7, 0x07, 0x52, 0xa7, 0x57, 0x66,
-	0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x85, 0x71,
-	0xf1, 0xb8, 0x43, 0x0c, 0x08, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x72, 0xe3, 0xe2, 0x4c, 0xce, 0xcf,
-	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd2, 0xc3,
-	0x65, 0xa6, 0x9e, 0x33, 0x54, 0xa9, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x08, 0xad, 0x4a,
-	0xfa, 0x5c, 0x1c, 0x30, 0x49, 0x21, 0x59, 0x2e, 0xae, 0xe4, 0xfc, 0x94, 0xd4, 0xf8, 0xa4, 0xca,
-	0x92, 0x54, 0x90, 0xa1, 0x8c, 0x1a, 0x3c, 0x20, 0xa5, 0x29, 0xa9, 0x4e, 0x20, 0x01, 0x2b, 0x96,
-	0x8e, 0x05, 0xf2, 0x0c, 0x4e, 0x61, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9c, 0x5f, 0x9c,
-	0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93,
-	0x5a, 0x0c, 0x09, 0x17, 0x5d, 0x58, 0xc0, 0x18, 0x58, 0xe8, 0x82, 0xc3, 0xa6, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0xec, 0x4f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0xb3, 0x17,
-	0x15, 0x41, 0x01, 0x00, 0x00,
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x93, 0xb6, 0x48, 0x3b, 0x5e, 0xb8, 0x97, 0x41, 0x30, 0x14, 0x4c, 0x4b, 0x16, 0x12,
+	0xc1, 0x66, 0xac, 0x6e, 0x44, 0x5d, 0xb5, 0xe0, 0x1f, 0x10, 0x84, 0x0a, 0x2e, 0xba, 0x09, 0xc9,
+	0x99, 0x63, 0x32, 0x34, 0xe9, 0x84, 0xcc, 0xb4, 0xda, 0x37, 0x70, 0xe9, 0x0b, 0x08, 0x3e, 0x4e,
+	0x97, 0x5d, 0xba, 0x12, 0x69, 0x5f, 0x44, 0xf2, 0xaf, 0xad, 0x05, 0xef, 0x2e, 0x39, 0xf3, 0xfb,
+	0xbe, 0x33, 0xdf, 0xf0, 0x91, 0x87, 0x22, 0x04, 0x96, 0x88, 0x28, 0xd6, 0x90, 0x08, 0x5c, 0x6a,
+	0xc5, 0xbe, 0x04, 0x2a, 0x65, 0xeb, 0x31, 0x8b, 0x70, 0x89, 0x4a, 0x28, 0x2f, 0xcb, 0xa5, 0x96,
+	0xd4, 0x12, 0x21, 0x78, 0xe7, 0x9c, 0x57, 0x70, 0xde, 0x7a, 0xdc, 0xbf, 0x17, 0xc9, 0x48, 0x96,
+	0x10, 0x2b, 0xbe, 0x2a, 0xde, 0xf9, 0xd1, 0x22, 0x57, 0x6f, 0x2a, 0x87, 0x8f, 0x3a, 0xd0, 0x48,
+	0x5f, 0x93, 0x1e, 0xc8, 0xa5, 0xce, 0x03, 0xd0, 0xca, 0x32, 0x87, 0x6d, 0xf7, 0xee, 0x53, 0xc7,
+	0xfb, 0x9f, 0xa9, 0x37, 0xad, 0xd1, 0x49, 0x67, 0xfb, 0x7b, 0x60, 0xcc, 0x4e, 0x52, 0xfa, 0x92,
+	0xf4, 0x13, 0xf1, 0x19, 0x61, 0x03, 0x09, 0xfa, 0xb1, 0x94, 0x0b, 0xe5, 0x57, 0x62, 0x5f, 0x70,
+	0x65, 0xb5, 0x86, 0x6d, 0xb7, 0x37, 0xbb, 0x7f, 0x24, 0xde, 0x16, 0xc0, 0xb4, 0x3c, 0x7f, 0xc7,
+	0x15, 0x7d, 0x4c, 0x28, 0x7e, 0xcd, 0x44, 0x8e, 0xfc, 0x5c, 0xd4, 0x2e, 0x45, 0x37, 0xf5, 0xc9,
+	0x89, 0x9e, 0x93, 0x1b, 0x88, 0x11, 0x16, 0x6a, 0x95, 0xd6, 0xb8, 0xb2, 0x3a, 0xe5, 0xcd, 0x1f,
+	0xdd, 0x72, 0xf3, 0x5a, 0x51, 0xd9, 0xa8, 0x3a, 0xc0, 0x35, 0xfc, 0x3b, 0x76, 0x3e, 0x90, 0x6e,
+	0x93, 0x91, 0x3e, 0x20, 0x04, 0x24, 0x47, 0x3f, 0xdc, 0x68, 0x2c, 0xde, 0xc6, 0x74, 0xaf, 0x8a,
+	0xc4, 0x1c, 0x27, 0xc5, 0x80, 0xda, 0x84, 0x70, 0xcc, 0x72, 0x84, 0x40, 0x23, 0xb7, 0x5a, 0x43,
+	0xd3, 0xed, 0xce, 0xce, 0x26, 0x2f, 0x3a, 0xdf, 0x7e, 0x0e, 0x0c, 0xe7, 0x3d, 0xb9, 0xbe, 0x58,
+	0x4d, 0xfb, 0xa4, 0xdb, 0xac, 0xad, 0x5d, 0x8f, 0xff, 0xe5, 0xce, 0xcb, 0x67, 0xeb, 0x41, 0x13,
+	0x7d, 0xf2, 0x69, 0xbb, 0xb7, 0xcd, 0xdd, 0xde, 0x36, 0xff, 0xec, 0x6d, 0xf3, 0xfb, 0xc1, 0x36,
+	0x76, 0x07, 0xdb, 0xf8, 0x75, 0xb0, 0x8d, 0xf9, 0xab, 0x48, 0xe8, 0x78, 0x15, 0x7a, 0x20, 0x53,
+	0x06, 0x52, 0xa5, 0x52, 0x31, 0x11, 0xc2, 0x28, 0x92, 0x2c, 0x95, 0x7c, 0x95, 0xa0, 0xaa, 0xda,
+	0x34, 0x6a, 0xea, 0xf4, 0xe4, 0xf9, 0xa8, 0x6c, 0x94, 0xde, 0x64, 0xa8, 0xc2, 0x3b, 0x65, 0x3b,
+	0x9e, 0xfd, 0x1d, 0x00, 0xce, 0xcb, 0xaf, 0x82, 0x77, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -158,6 +251,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChecksumClients) > 0 {
+		for iNdEx := len(m.ChecksumClients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChecksumClients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ExpiredClientIds) > 0 {
+		for iNdEx := len(m.ExpiredClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpiredClientIds[iNdEx])
+			copy(dAtA[i:], m.ExpiredClientIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExpiredClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LifecycleHooksClientIds) > 0 {
+		for iNdEx := len(m.LifecycleHooksClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LifecycleHooksClientIds[iNdEx])
+			copy(dAtA[i:], m.LifecycleHooksClientIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LifecycleHooksClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +320,16 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.CodeBytes) > 0 {
 		i -= len(m.CodeBytes)
 		copy(dAtA[i:], m.CodeBytes)
@@ -205,6 +340,45 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChecksumClients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChecksumClients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChecksumClients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIds[iNdEx])
+			copy(dAtA[i:], m.ClientIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -228,6 +402,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LifecycleHooksClientIds) > 0 {
+		for _, s := range m.LifecycleHooksClientIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpiredClientIds) > 0 {
+		for _, s := range m.ExpiredClientIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChecksumClients) > 0 {
+		for _, e := range m.ChecksumClients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -241,6 +433,28 @@ func (m *Contract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Deprecated {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChecksumClients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ClientIds) > 0 {
+		for _, s := range m.ClientIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -313,6 +527,104 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleHooksClientIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LifecycleHooksClientIds = append(m.LifecycleHooksClientIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredClientIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiredClientIds = append(m.ExpiredClientIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumClients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumClients = append(m.ChecksumClients, ChecksumClients{})
+			if err := m.ChecksumClients[len(m.ChecksumClients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -397,6 +709,142 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				m.CodeBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChecksumClients) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChecksumClients: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChecksumClients: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -17,6 +17,23 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			},
 			true,
 		},
+		{
+			"valid genesis with lifecycle hooks and expired clients",
+			&types.GenesisState{
+				Contracts:               []types.Contract{{CodeBytes: []byte{1}}},
+				LifecycleHooksClientIds: []string{"08-wasm-0"},
+				ExpiredClientIds:        []string{"08-wasm-0"},
+			},
+			true,
+		},
+		{
+			"valid genesis with checksum clients",
+			&types.GenesisState{
+				Contracts:       []types.Contract{{CodeBytes: []byte{1}}},
+				ChecksumClients: []types.ChecksumClients{{Checksum: make([]byte, 32), ClientIds: []string{"08-wasm-0"}}},
+			},
+			true,
+		},
 		{
 			"invalid genesis",
 			&types.GenesisState{
@@ -24,6 +41,34 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"invalid lifecycle hooks client identifier",
+			&types.GenesisState{
+				LifecycleHooksClientIds: []string{"07-tendermint-0"},
+			},
+			false,
+		},
+		{
+			"invalid expired client identifier",
+			&types.GenesisState{
+				ExpiredClientIds: []string{""},
+			},
+			false,
+		},
+		{
+			"invalid checksum of checksum clients",
+			&types.GenesisState{
+				ChecksumClients: []types.ChecksumClients{{Checksum: []byte{1}, ClientIds: []string{"08-wasm-0"}}},
+			},
+			false,
+		},
+		{
+			"invalid checksum client identifier",
+			&types.GenesisState{
+				ChecksumClients: []types.ChecksumClients{{Checksum: make([]byte, 32), ClientIds: []string{"07-tendermint-0"}}},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
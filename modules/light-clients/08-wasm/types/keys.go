@@ -17,5 +17,15 @@ const (
 	KeyChecksums = "checksums"
 )
 
-// ChecksumsKey is the key under which all checksums are stored
-var ChecksumsKey = collections.NewPrefix(0)
+var (
+	// ChecksumsKey is the key under which all checksums are stored
+	ChecksumsKey = collections.NewPrefix(0)
+	// DeprecatedChecksumsKey is the key under which all deprecated checksums are stored
+	DeprecatedChecksumsKey = collections.NewPrefix(1)
+	// LifecycleHooksClientsKey is the key under which the identifiers of the clients whose contracts support lifecycle hooks are stored
+	LifecycleHooksClientsKey = collections.NewPrefix(2)
+	// ExpiredClientsKey is the key under which the identifiers of the clients marked as expired are stored
+	ExpiredClientsKey = collections.NewPrefix(3)
+	// ChecksumClientsKey is the key under which the identifiers of the clients referencing each checksum are stored
+	ChecksumClientsKey = collections.NewPrefix(4)
+)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,9 +14,15 @@ var (
 	_ sdk.Msg              = (*MsgStoreCode)(nil)
 	_ sdk.Msg              = (*MsgMigrateContract)(nil)
 	_ sdk.Msg              = (*MsgRemoveChecksum)(nil)
+	_ sdk.Msg              = (*MsgSetChecksumDeprecated)(nil)
+	_ sdk.Msg              = (*MsgMigrateContracts)(nil)
+	_ sdk.Msg              = (*MsgMarkClientExpired)(nil)
 	_ sdk.HasValidateBasic = (*MsgStoreCode)(nil)
 	_ sdk.HasValidateBasic = (*MsgMigrateContract)(nil)
 	_ sdk.HasValidateBasic = (*MsgRemoveChecksum)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetChecksumDeprecated)(nil)
+	_ sdk.HasValidateBasic = (*MsgMigrateContracts)(nil)
+	_ sdk.HasValidateBasic = (*MsgMarkClientExpired)(nil)
 )
 
 // NewMsgStoreCode creates a new MsgStoreCode instance
@@ -47,6 +55,16 @@ func NewMsgRemoveChecksum(signer string, checksum []byte) *MsgRemoveChecksum {
 	}
 }
 
+// NewMsgForceRemoveChecksum creates a new MsgRemoveChecksum instance which removes
+// the checksum even if light clients still reference it.
+func NewMsgForceRemoveChecksum(signer string, checksum []byte) *MsgRemoveChecksum {
+	return &MsgRemoveChecksum{
+		Signer:   signer,
+		Checksum: checksum,
+		Force:    true,
+	}
+}
+
 // ValidateBasic implements sdk.HasValidateBasic
 func (m MsgRemoveChecksum) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Signer)
@@ -92,3 +110,76 @@ func (m MsgMigrateContract) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetChecksumDeprecated creates a new MsgSetChecksumDeprecated instance
+func NewMsgSetChecksumDeprecated(signer string, checksum []byte, deprecated bool) *MsgSetChecksumDeprecated {
+	return &MsgSetChecksumDeprecated{
+		Signer:     signer,
+		Checksum:   checksum,
+		Deprecated: deprecated,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgSetChecksumDeprecated) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateWasmChecksum(m.Checksum)
+}
+
+// NewMsgMigrateContracts creates a new MsgMigrateContracts instance
+func NewMsgMigrateContracts(signer string, oldChecksum, checksum, migrateMsg []byte) *MsgMigrateContracts {
+	return &MsgMigrateContracts{
+		Signer:      signer,
+		OldChecksum: oldChecksum,
+		Checksum:    checksum,
+		Msg:         migrateMsg,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgMigrateContracts) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := ValidateWasmChecksum(m.OldChecksum); err != nil {
+		return errorsmod.Wrap(err, "invalid old checksum")
+	}
+
+	if err := ValidateWasmChecksum(m.Checksum); err != nil {
+		return err
+	}
+
+	if bytes.Equal(m.OldChecksum, m.Checksum) {
+		return errorsmod.Wrap(ErrWasmCodeExists, "new checksum must differ from the old checksum")
+	}
+
+	if len(m.Msg) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "migrate message cannot be empty")
+	}
+
+	return nil
+}
+
+// NewMsgMarkClientExpired creates a new MsgMarkClientExpired instance
+func NewMsgMarkClientExpired(signer, clientID string) *MsgMarkClientExpired {
+	return &MsgMarkClientExpired{
+		Signer:   signer,
+		ClientId: clientID,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgMarkClientExpired) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateClientID(m.ClientId)
+}
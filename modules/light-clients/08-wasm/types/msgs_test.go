@@ -235,6 +235,146 @@ func TestMsgRemoveChecksumValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSetChecksumDeprecatedValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetChecksumDeprecated
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid length checksum",
+			types.NewMsgSetChecksumDeprecated(signer, checksum, true),
+			nil,
+		},
+		{
+			"failure: checksum is empty",
+			types.NewMsgSetChecksumDeprecated(signer, []byte(""), true),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgSetChecksumDeprecated(ibctesting.InvalidID, checksum, true),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgMigrateContractsValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	oldChecksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+	checksum, err := types.CreateChecksum(wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgMigrateContractsValidateBasic")))
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgMigrateContracts
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid checksums, valid migrate msg",
+			types.NewMsgMigrateContracts(signer, oldChecksum, checksum, []byte("{}")),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgMigrateContracts(ibctesting.InvalidID, oldChecksum, checksum, []byte("{}")),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: old checksum is empty",
+			types.NewMsgMigrateContracts(signer, []byte(""), checksum, []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: checksum is nil",
+			types.NewMsgMigrateContracts(signer, oldChecksum, nil, []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: checksums are equal",
+			types.NewMsgMigrateContracts(signer, checksum, checksum, []byte("{}")),
+			types.ErrWasmCodeExists,
+		},
+		{
+			"failure: empty migrate msg",
+			types.NewMsgMigrateContracts(signer, oldChecksum, checksum, nil),
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgMarkClientExpiredValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgMarkClientExpired
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid client ID",
+			types.NewMsgMarkClientExpired(signer, defaultWasmClientID),
+			nil,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgMarkClientExpired(ibctesting.InvalidID, defaultWasmClientID),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: client ID is empty",
+			types.NewMsgMarkClientExpired(signer, ""),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: client ID does not contain 08-wasm prefix",
+			types.NewMsgMarkClientExpired(signer, "07-tendermint-1"),
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgRemoveChecksumGetSigners() {
 	checksum, err := types.CreateChecksum(wasmtesting.Code)
 	suite.Require().NoError(err)
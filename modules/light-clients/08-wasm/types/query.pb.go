@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -81,6 +82,8 @@ type QueryChecksumsResponse struct {
 	Checksums []string `protobuf:"bytes,1,rep,name=checksums,proto3" json:"checksums,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// checksum_infos contains the usage information of each of the returned checksums, in the same order.
+	ChecksumInfos []ChecksumInfo `protobuf:"bytes,3,rep,name=checksum_infos,json=checksumInfos,proto3" json:"checksum_infos"`
 }
 
 func (m *QueryChecksumsResponse) Reset()         { *m = QueryChecksumsResponse{} }
@@ -130,6 +133,77 @@ func (m *QueryChecksumsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryChecksumsResponse) GetChecksumInfos() []ChecksumInfo {
+	if m != nil {
+		return m.ChecksumInfos
+	}
+	return nil
+}
+
+// ChecksumInfo describes the usage of a stored checksum.
+type ChecksumInfo struct {
+	// checksum is the hex encoded checksum of the wasm code.
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// deprecated is true if new clients may not be created using the checksum.
+	Deprecated bool `protobuf:"varint,2,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// client_ids are the identifiers of the light clients whose client state references the checksum.
+	ClientIds []string `protobuf:"bytes,3,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+}
+
+func (m *ChecksumInfo) Reset()         { *m = ChecksumInfo{} }
+func (m *ChecksumInfo) String() string { return proto.CompactTextString(m) }
+func (*ChecksumInfo) ProtoMessage()    {}
+func (*ChecksumInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{2}
+}
+func (m *ChecksumInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChecksumInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChecksumInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChecksumInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChecksumInfo.Merge(m, src)
+}
+func (m *ChecksumInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChecksumInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChecksumInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChecksumInfo proto.InternalMessageInfo
+
+func (m *ChecksumInfo) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *ChecksumInfo) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *ChecksumInfo) GetClientIds() []string {
+	if m != nil {
+		return m.ClientIds
+	}
+	return nil
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	// checksum is a hex encoded string of the code stored.
@@ -140,7 +214,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e3718a8cb915777, []int{2}
+	return fileDescriptor_9e3718a8cb915777, []int{3}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,7 +259,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e3718a8cb915777, []int{3}
+	return fileDescriptor_9e3718a8cb915777, []int{4}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,6 +298,7 @@ func (m *QueryCodeResponse) GetData() []byte {
 func init() {
 	proto.RegisterType((*QueryChecksumsRequest)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsRequest")
 	proto.RegisterType((*QueryChecksumsResponse)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsResponse")
+	proto.RegisterType((*ChecksumInfo)(nil), "ibc.lightclients.wasm.v1.ChecksumInfo")
 	proto.RegisterType((*QueryCodeRequest)(nil), "ibc.lightclients.wasm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "ibc.lightclients.wasm.v1.QueryCodeResponse")
 }
@@ -233,34 +308,40 @@ func init() {
 }
 
 var fileDescriptor_9e3718a8cb915777 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x3b, 0xf5, 0x2a, 0x66, 0x74, 0xa1, 0x03, 0x4a, 0x09, 0x97, 0x70, 0x89, 0x7f, 0xee,
-	0xe5, 0x5e, 0x3a, 0xd3, 0xb4, 0x08, 0x82, 0xae, 0x14, 0x74, 0xab, 0x59, 0xb8, 0x70, 0x23, 0x93,
-	0xc9, 0x90, 0x0e, 0x26, 0x99, 0xb4, 0x33, 0xa9, 0x14, 0x11, 0xc1, 0x27, 0x10, 0x5c, 0xea, 0xe3,
-	0xb8, 0x70, 0x59, 0x70, 0xe3, 0x52, 0x5a, 0x1f, 0x44, 0x32, 0x93, 0x34, 0xad, 0x58, 0xda, 0xdd,
-	0xe4, 0xf0, 0x3b, 0xdf, 0xf7, 0x9d, 0x93, 0x03, 0xef, 0x8a, 0x88, 0x91, 0x54, 0x24, 0x63, 0xcd,
-	0x52, 0xc1, 0x73, 0xad, 0xc8, 0x3b, 0xaa, 0x32, 0x32, 0x0b, 0xc8, 0xa4, 0xe4, 0xd3, 0x39, 0x2e,
-	0xa6, 0x52, 0x4b, 0xd4, 0x13, 0x11, 0xc3, 0x9b, 0x14, 0xae, 0x28, 0x3c, 0x0b, 0xdc, 0xe3, 0x44,
-	0xca, 0x24, 0xe5, 0x84, 0x16, 0x82, 0xd0, 0x3c, 0x97, 0x9a, 0x6a, 0x21, 0x73, 0x65, 0xfb, 0xdc,
-	0x73, 0x26, 0x55, 0x26, 0x15, 0x89, 0xa8, 0xe2, 0x56, 0x90, 0xcc, 0x82, 0x88, 0x6b, 0x1a, 0x90,
-	0x82, 0x26, 0x22, 0x37, 0xb0, 0x65, 0xfd, 0x37, 0xf0, 0xd6, 0xcb, 0x8a, 0x78, 0x3a, 0xe6, 0xec,
-	0xad, 0x2a, 0x33, 0x15, 0xf2, 0x49, 0xc9, 0x95, 0x46, 0xcf, 0x20, 0x6c, 0xe1, 0x1e, 0x38, 0x01,
-	0x67, 0xd7, 0x86, 0xf7, 0xb1, 0x55, 0xc6, 0x95, 0x32, 0xb6, 0x51, 0x6b, 0x65, 0xfc, 0x82, 0x26,
-	0xbc, 0xee, 0x0d, 0x37, 0x3a, 0xfd, 0x8f, 0xf0, 0xf6, 0xbf, 0x06, 0xaa, 0x90, 0xb9, 0xe2, 0xe8,
-	0x18, 0x3a, 0xac, 0x29, 0xf6, 0xc0, 0xc9, 0xa5, 0x33, 0x27, 0x6c, 0x0b, 0xe8, 0xf9, 0x96, 0x7f,
-	0xd7, 0xf8, 0x9f, 0xee, 0xf5, 0xb7, 0xd2, 0x5b, 0x01, 0x30, 0xbc, 0x61, 0x03, 0xc8, 0xb8, 0x09,
-	0x88, 0x5c, 0x78, 0xb5, 0x71, 0x32, 0xa3, 0x39, 0xe1, 0xfa, 0xdb, 0x3f, 0x85, 0x37, 0x37, 0xf8,
-	0x3a, 0x2b, 0x82, 0x47, 0x31, 0xd5, 0xd4, 0xc0, 0xd7, 0x43, 0xf3, 0x1e, 0x7e, 0xef, 0xc2, 0xcb,
-	0x86, 0x44, 0x5f, 0x01, 0x74, 0xd6, 0xf3, 0x21, 0x82, 0x77, 0xfd, 0x37, 0xfc, 0xdf, 0x55, 0xbb,
-	0x83, 0xc3, 0x1b, 0x6c, 0x1c, 0xff, 0xe2, 0xd3, 0xcf, 0x3f, 0x5f, 0xba, 0xf7, 0xd0, 0x1d, 0xb2,
-	0xf3, 0x90, 0xda, 0x4d, 0x7e, 0x03, 0xf0, 0xa8, 0x1a, 0x06, 0x9d, 0xef, 0xf3, 0x69, 0x37, 0xe4,
-	0x5e, 0x1c, 0xc4, 0xd6, 0x71, 0x1e, 0x99, 0x38, 0x0f, 0xd0, 0xe8, 0x80, 0x38, 0xe4, 0x7d, 0xf3,
-	0xfc, 0x40, 0x98, 0x8c, 0xf9, 0x93, 0x57, 0x3f, 0x96, 0x1e, 0x58, 0x2c, 0x3d, 0xf0, 0x7b, 0xe9,
-	0x81, 0xcf, 0x2b, 0xaf, 0xb3, 0x58, 0x79, 0x9d, 0x5f, 0x2b, 0xaf, 0xf3, 0xfa, 0x71, 0x22, 0xf4,
-	0xb8, 0x8c, 0x30, 0x93, 0x19, 0xa9, 0x4f, 0x5a, 0x44, 0xac, 0x9f, 0x48, 0x92, 0xc9, 0xb8, 0x4c,
-	0xb9, 0xb2, 0x56, 0xfd, 0xc6, 0x6b, 0xf0, 0xb0, 0x6f, 0xec, 0xf4, 0xbc, 0xe0, 0x2a, 0xba, 0x62,
-	0x0e, 0x7c, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x82, 0x4a, 0xb3, 0x6c, 0x03, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0x49, 0x94, 0xe6, 0xb5, 0x8a, 0x0e, 0x2a, 0x21, 0xd4, 0x35, 0xac, 0x7f, 0x5a,
+	0x5a, 0x32, 0xd3, 0xb4, 0x08, 0x82, 0x9e, 0x2a, 0x28, 0xbd, 0xe9, 0x0a, 0x1e, 0xbc, 0x84, 0xd9,
+	0xd9, 0xe9, 0x66, 0x30, 0xbb, 0xb3, 0xcd, 0xcc, 0x46, 0x8a, 0x78, 0xf1, 0x13, 0x08, 0x1e, 0xf5,
+	0xe3, 0x78, 0xe8, 0xb1, 0xa0, 0x07, 0x4f, 0x22, 0x89, 0x1f, 0x44, 0x76, 0x66, 0xb7, 0x59, 0xc5,
+	0xb4, 0xb9, 0x4d, 0xde, 0xfc, 0xe6, 0x7d, 0x9e, 0xf7, 0xd9, 0x79, 0xe1, 0xae, 0x08, 0x18, 0x19,
+	0x89, 0x68, 0xa8, 0xd9, 0x48, 0xf0, 0x44, 0x2b, 0xf2, 0x96, 0xaa, 0x98, 0x4c, 0xfa, 0xe4, 0x30,
+	0xe3, 0xe3, 0x23, 0x9c, 0x8e, 0xa5, 0x96, 0xa8, 0x2d, 0x02, 0x86, 0xab, 0x14, 0xce, 0x29, 0x3c,
+	0xe9, 0x77, 0xae, 0x47, 0x32, 0x92, 0x06, 0x22, 0xf9, 0xc9, 0xf2, 0x9d, 0xb5, 0x48, 0xca, 0x68,
+	0xc4, 0x09, 0x4d, 0x05, 0xa1, 0x49, 0x22, 0x35, 0xd5, 0x42, 0x26, 0xaa, 0xf8, 0x77, 0x93, 0x49,
+	0x15, 0x4b, 0x45, 0x02, 0xaa, 0xb8, 0x95, 0x21, 0x93, 0x7e, 0xc0, 0x35, 0xed, 0x93, 0x94, 0x46,
+	0x22, 0x31, 0xb0, 0x65, 0xbd, 0x01, 0xdc, 0x78, 0x91, 0x13, 0x4f, 0x86, 0x9c, 0xbd, 0x51, 0x59,
+	0xac, 0x7c, 0x7e, 0x98, 0x71, 0xa5, 0xd1, 0x53, 0x80, 0x39, 0xdc, 0x76, 0xba, 0xce, 0xc6, 0xa5,
+	0x9d, 0xfb, 0xd8, 0x76, 0xc6, 0x79, 0x67, 0x6c, 0x07, 0x28, 0x3a, 0xe3, 0xe7, 0x34, 0xe2, 0xc5,
+	0x5d, 0xbf, 0x72, 0xd3, 0xfb, 0xee, 0xc0, 0xcd, 0x7f, 0x15, 0x54, 0x2a, 0x13, 0xc5, 0xd1, 0x1a,
+	0xb4, 0x58, 0x59, 0x6c, 0x3b, 0xdd, 0xc6, 0x46, 0xcb, 0x9f, 0x17, 0xd0, 0xb3, 0xbf, 0x0c, 0xd4,
+	0x8d, 0x81, 0xf5, 0x73, 0x0d, 0xd8, 0xd6, 0x55, 0x07, 0xe8, 0x25, 0x5c, 0x29, 0xbb, 0x0e, 0x44,
+	0x72, 0x20, 0x55, 0xbb, 0xd1, 0x6d, 0x98, 0x69, 0x16, 0xa5, 0x8e, 0x4b, 0xaf, 0xfb, 0xc9, 0x81,
+	0xdc, 0x6b, 0x1e, 0xff, 0xbc, 0x5d, 0xf3, 0x2f, 0xb3, 0x4a, 0x4d, 0x79, 0x02, 0x56, 0xab, 0x10,
+	0xea, 0xc0, 0x4a, 0x09, 0x98, 0xb0, 0x5a, 0xfe, 0xe9, 0x6f, 0xe4, 0x02, 0x84, 0x3c, 0x1d, 0x73,
+	0x46, 0x35, 0x0f, 0xcd, 0x24, 0x2b, 0x7e, 0xa5, 0x82, 0x6e, 0x01, 0x58, 0x03, 0x03, 0x11, 0x5a,
+	0x73, 0x79, 0x10, 0xa6, 0xb2, 0x1f, 0x2a, 0x0f, 0xc3, 0x55, 0x1b, 0xa0, 0x0c, 0xcb, 0x84, 0xcf,
+	0x92, 0xf3, 0xd6, 0xe1, 0x5a, 0x85, 0x2f, 0xb2, 0x46, 0xd0, 0x0c, 0xa9, 0xa6, 0x06, 0x5e, 0xf5,
+	0xcd, 0x79, 0xe7, 0x6b, 0x1d, 0x2e, 0x18, 0x12, 0x7d, 0x76, 0xa0, 0x75, 0xfa, 0x7d, 0x10, 0x59,
+	0x1c, 0xcc, 0x7f, 0xdf, 0x4a, 0x67, 0x7b, 0xf9, 0x0b, 0xd6, 0x8e, 0xb7, 0xf5, 0xe1, 0xdb, 0xef,
+	0x4f, 0xf5, 0x7b, 0xe8, 0x0e, 0x59, 0xb8, 0x1f, 0xf3, 0x97, 0xf0, 0xc5, 0x81, 0x66, 0x3e, 0x0c,
+	0xda, 0x3c, 0x4f, 0x67, 0x9e, 0x50, 0x67, 0x6b, 0x29, 0xb6, 0xb0, 0xf3, 0xc8, 0xd8, 0x79, 0x80,
+	0x76, 0x97, 0xb0, 0x43, 0xde, 0x95, 0xc7, 0xf7, 0x84, 0xc9, 0x90, 0xef, 0xbd, 0x3a, 0x9e, 0xba,
+	0xce, 0xc9, 0xd4, 0x75, 0x7e, 0x4d, 0x5d, 0xe7, 0xe3, 0xcc, 0xad, 0x9d, 0xcc, 0xdc, 0xda, 0x8f,
+	0x99, 0x5b, 0x7b, 0xfd, 0x38, 0x12, 0x7a, 0x98, 0x05, 0x98, 0xc9, 0x98, 0x14, 0x3b, 0x29, 0x02,
+	0xd6, 0x8b, 0x24, 0x89, 0x65, 0x98, 0x8d, 0xb8, 0xb2, 0x52, 0xbd, 0x52, 0x6b, 0xfb, 0x61, 0xcf,
+	0xc8, 0xe9, 0xa3, 0x94, 0xab, 0xe0, 0xa2, 0xd9, 0xd0, 0xdd, 0x3f, 0x03, 0x00, 0x19, 0x8f, 0x38,
+	0x19, 0x43, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -438,6 +519,20 @@ func (m *QueryChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChecksumInfos) > 0 {
+		for iNdEx := len(m.ChecksumInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChecksumInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -462,6 +557,55 @@ func (m *QueryChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ChecksumInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChecksumInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChecksumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIds[iNdEx])
+			copy(dAtA[i:], m.ClientIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -562,6 +706,34 @@ func (m *QueryChecksumsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.ChecksumInfos) > 0 {
+		for _, e := range m.ChecksumInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChecksumInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	if len(m.ClientIds) > 0 {
+		for _, s := range m.ClientIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -780,6 +952,174 @@ func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumInfos = append(m.ChecksumInfos, ChecksumInfo{})
+			if err := m.ChecksumInfos[len(m.ChecksumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChecksumInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChecksumInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChecksumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
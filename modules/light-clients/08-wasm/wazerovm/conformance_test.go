@@ -0,0 +1,241 @@
+package wazerovm_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+)
+
+// newMockLightClientEngine returns a mock engine with callbacks implementing the same
+// behaviour as the light client test contract returned by lightClientContract.
+func newMockLightClientEngine() *wasmtesting.MockWasmEngine {
+	mockVM := wasmtesting.NewMockWasmEngine()
+
+	mockVM.InstantiateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		store.Set([]byte(keyInit), initMsg)
+		return mustContractResult(responseOk), wasmtesting.DefaultGasUsed, nil
+	}
+
+	mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		store.Delete([]byte(keyFrozen))
+		return mustContractResult(responseOk), wasmtesting.DefaultGasUsed, nil
+	}
+
+	mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		if store.Get([]byte(keyFrozen)) != nil {
+			return mustQueryResult(statusFrozen), wasmtesting.DefaultGasUsed, nil
+		}
+
+		return mustQueryResult(statusActive), wasmtesting.DefaultGasUsed, nil
+	})
+
+	mockVM.RegisterQueryCallback(types.TimestampAtHeightMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		return nil, wasmtesting.DefaultGasUsed, errors.New("Aborted: " + abortMsg)
+	})
+
+	mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return mustContractResult(responseOk), wasmtesting.DefaultGasUsed, nil
+	})
+
+	mockVM.RegisterSudoCallback(types.UpdateStateOnMisbehaviourMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		store.Set([]byte(keyFrozen), []byte("1"))
+		return mustContractResult(responseOk), wasmtesting.DefaultGasUsed, nil
+	})
+
+	verifyFn := func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		if store.Get([]byte(keyFrozen)) != nil {
+			return mustContractResult(responseFrozen), wasmtesting.DefaultGasUsed, nil
+		}
+
+		return mustContractResult(responseOk), wasmtesting.DefaultGasUsed, nil
+	}
+	mockVM.RegisterSudoCallback(types.VerifyMembershipMsg{}, verifyFn)
+	mockVM.RegisterSudoCallback(types.VerifyNonMembershipMsg{}, verifyFn)
+
+	return mockVM
+}
+
+// TestConformance executes the same sequence of contract calls using the wazero VM with the light client
+// test contract and the mock engine with callbacks implementing the same behaviour, and checks that both
+// engines return the same results and leave the contract store in the same state.
+func (suite *WazeroVMTestSuite) TestConformance() {
+	type engine struct {
+		vm       types.WasmEngine
+		store    wasmvm.KVStore
+		checksum wasmvm.Checksum
+	}
+
+	instantiateMsg := mustMarshalJSON(types.InstantiateMessage{ClientState: []byte("client state"), ConsensusState: []byte("consensus state")})
+	queryMsg := func(msg types.QueryMsg) []byte { return mustMarshalJSON(msg) }
+	sudoMsg := func(msg types.SudoMsg) []byte { return mustMarshalJSON(msg) }
+
+	testCases := []struct {
+		name   string
+		call   func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error)
+		expErr error
+	}{
+		{
+			"instantiate",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				return e.vm.Instantiate(e.checksum, wasmvmtypes.Env{}, wasmvmtypes.MessageInfo{}, instantiateMsg, e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"query status: active",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				return e.vm.Query(e.checksum, wasmvmtypes.Env{}, queryMsg(types.QueryMsg{Status: &types.StatusMsg{}}), e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"sudo verify membership: success",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				msg := sudoMsg(types.SudoMsg{VerifyMembership: &types.VerifyMembershipMsg{Height: clienttypes.NewHeight(1, 1), Proof: []byte("proof"), Value: []byte("value")}})
+				return e.vm.Sudo(e.checksum, wasmvmtypes.Env{}, msg, e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"sudo update state",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				msg := sudoMsg(types.SudoMsg{UpdateState: &types.UpdateStateMsg{ClientMessage: []byte("header")}})
+				return e.vm.Sudo(e.checksum, wasmvmtypes.Env{}, msg, e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"sudo update state on misbehaviour",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				msg := sudoMsg(types.SudoMsg{UpdateStateOnMisbehaviour: &types.UpdateStateOnMisbehaviourMsg{ClientMessage: []byte("misbehaviour")}})
+				return e.vm.Sudo(e.checksum, wasmvmtypes.Env{}, msg, e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"query status: frozen",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				return e.vm.Query(e.checksum, wasmvmtypes.Env{}, queryMsg(types.QueryMsg{Status: &types.StatusMsg{}}), e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"sudo verify membership: client frozen",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				msg := sudoMsg(types.SudoMsg{VerifyMembership: &types.VerifyMembershipMsg{Height: clienttypes.NewHeight(1, 1), Proof: []byte("proof"), Value: []byte("value")}})
+				return e.vm.Sudo(e.checksum, wasmvmtypes.Env{}, msg, e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"sudo verify non-membership: client frozen",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				msg := sudoMsg(types.SudoMsg{VerifyNonMembership: &types.VerifyNonMembershipMsg{Height: clienttypes.NewHeight(1, 1), Proof: []byte("proof")}})
+				return e.vm.Sudo(e.checksum, wasmvmtypes.Env{}, msg, e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"migrate",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				return e.vm.Migrate(e.checksum, wasmvmtypes.Env{}, []byte("{}"), e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"query status: active after migration",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				return e.vm.Query(e.checksum, wasmvmtypes.Env{}, queryMsg(types.QueryMsg{Status: &types.StatusMsg{}}), e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			nil,
+		},
+		{
+			"query timestamp at height: unsupported",
+			func(e engine, gasMeter wasmvm.GasMeter) (any, uint64, error) {
+				msg := queryMsg(types.QueryMsg{TimestampAtHeight: &types.TimestampAtHeightMsg{Height: clienttypes.NewHeight(1, 1)}})
+				return e.vm.Query(e.checksum, wasmvmtypes.Env{}, msg, e.store, wasmvm.GoAPI{}, nil, gasMeter, defaultGasLimit, types.CostJSONDeserialization)
+			},
+			errors.New("Aborted: " + abortMsg),
+		},
+	}
+
+	code := lightClientContract()
+
+	wazeroEngine := engine{vm: suite.vm, store: newStore(storetypes.NewInfiniteGasMeter())}
+	mockEngine := engine{vm: newMockLightClientEngine(), store: newStore(storetypes.NewInfiniteGasMeter())}
+
+	var err error
+	wazeroEngine.checksum, _, err = wazeroEngine.vm.StoreCode(code, defaultGasLimit)
+	suite.Require().NoError(err)
+	suite.Require().NoError(wazeroEngine.vm.Pin(wazeroEngine.checksum))
+
+	mockEngine.checksum, _, err = mockEngine.vm.StoreCode(code, defaultGasLimit)
+	suite.Require().NoError(err)
+	suite.Require().Equal(mockEngine.checksum, wazeroEngine.checksum)
+
+	// the test cases are executed in order against the same contract state
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			gasMeter := types.NewMultipliedGasMeter(storetypes.NewInfiniteGasMeter(), types.VMGasRegister)
+
+			expRes, _, expErr := tc.call(mockEngine, gasMeter)
+			res, gasUsed, err := tc.call(wazeroEngine, gasMeter)
+
+			suite.Require().LessOrEqual(gasUsed, uint64(defaultGasLimit))
+
+			if tc.expErr != nil {
+				suite.Require().ErrorContains(expErr, tc.expErr.Error())
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			} else {
+				suite.Require().NoError(expErr)
+				suite.Require().NoError(err)
+				suite.Require().Positive(gasUsed)
+				suite.Require().JSONEq(string(mustMarshalJSON(expRes)), string(mustMarshalJSON(res)))
+			}
+
+			suite.Require().Equal(storeContents(mockEngine.store), storeContents(wazeroEngine.store))
+		})
+	}
+}
+
+// storeContents returns all key value pairs of the store.
+func storeContents(store wasmvm.KVStore) map[string]string {
+	contents := make(map[string]string)
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		contents[string(it.Key())] = string(it.Value())
+	}
+
+	return contents
+}
+
+func mustContractResult(bz string) *wasmvmtypes.ContractResult {
+	var result wasmvmtypes.ContractResult
+	if err := json.Unmarshal([]byte(bz), &result); err != nil {
+		panic(err)
+	}
+
+	return &result
+}
+
+func mustQueryResult(bz string) *wasmvmtypes.QueryResult {
+	var result wasmvmtypes.QueryResult
+	if err := json.Unmarshal([]byte(bz), &result); err != nil {
+		panic(err)
+	}
+
+	return &result
+}
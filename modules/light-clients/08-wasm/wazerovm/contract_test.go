@@ -0,0 +1,405 @@
+package wazerovm_test
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+)
+
+// Operations used by the test contracts.
+const (
+	opLoop      = 0x03
+	opIf        = 0x04
+	opElse      = 0x05
+	opEnd       = 0x0b
+	opBr        = 0x0c
+	opReturn    = 0x0f
+	opCall      = 0x10
+	opLocalGet  = 0x20
+	opLocalSet  = 0x21
+	opGlobalGet = 0x23
+	opGlobalSet = 0x24
+	opI32Load   = 0x28
+	opI32Load8U = 0x2d
+	opI32Store  = 0x36
+	opI32Const  = 0x41
+	opF32Const  = 0x43
+	opI32Eq     = 0x46
+	opI32Ne     = 0x47
+	opI32Add    = 0x6a
+	opI32And    = 0x71
+	opDrop      = 0x1a
+
+	valTypeI32     = 0x7f
+	blockTypeEmpty = 0x40
+)
+
+// funcType is a Wasm function signature.
+type funcType struct {
+	params, results []byte
+}
+
+// wasmFunction is a function defined by a Wasm module.
+type wasmFunction struct {
+	typeIdx uint32
+	locals  []byte
+	body    []byte
+}
+
+// wasmExport is an export of a Wasm module.
+type wasmExport struct {
+	name  string
+	kind  byte
+	index uint32
+}
+
+// wasmModule is a minimal assembler for Wasm modules used to build test contracts.
+type wasmModule struct {
+	types       []funcType
+	imports     []wasmImport
+	functions   []wasmFunction
+	memoryPages uint32
+	noMemory    bool
+	globals     [][]byte
+	exports     []wasmExport
+	data        []dataSegment
+	start       *uint32
+}
+
+type wasmImport struct {
+	module, name string
+	typeIdx      uint32
+}
+
+type dataSegment struct {
+	offset uint32
+	data   []byte
+}
+
+func (m wasmModule) encode() []byte {
+	out := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+	out = appendSection(out, 1, appendVec(len(m.types), func(dst []byte, i int) []byte {
+		dst = append(dst, 0x60)
+		dst = appendBytes(dst, m.types[i].params)
+		return appendBytes(dst, m.types[i].results)
+	}))
+
+	if len(m.imports) > 0 {
+		out = appendSection(out, 2, appendVec(len(m.imports), func(dst []byte, i int) []byte {
+			dst = appendBytes(dst, []byte(m.imports[i].module))
+			dst = appendBytes(dst, []byte(m.imports[i].name))
+			return appendU32(append(dst, 0x00), m.imports[i].typeIdx)
+		}))
+	}
+
+	out = appendSection(out, 3, appendVec(len(m.functions), func(dst []byte, i int) []byte {
+		return appendU32(dst, m.functions[i].typeIdx)
+	}))
+
+	if !m.noMemory {
+		out = appendSection(out, 5, appendU32(appendU32([]byte{0x01}, 0x00), m.memoryPages))
+	}
+
+	if len(m.globals) > 0 {
+		out = appendSection(out, 6, appendVec(len(m.globals), func(dst []byte, i int) []byte {
+			return append(dst, m.globals[i]...)
+		}))
+	}
+
+	out = appendSection(out, 7, appendVec(len(m.exports), func(dst []byte, i int) []byte {
+		dst = appendBytes(dst, []byte(m.exports[i].name))
+		return appendU32(append(dst, m.exports[i].kind), m.exports[i].index)
+	}))
+
+	if m.start != nil {
+		out = appendSection(out, 8, appendU32(nil, *m.start))
+	}
+
+	out = appendSection(out, 10, appendVec(len(m.functions), func(dst []byte, i int) []byte {
+		fn := m.functions[i]
+		body := appendU32(nil, uint32(len(fn.locals)))
+		for _, local := range fn.locals {
+			body = append(appendU32(body, 1), local)
+		}
+		body = append(body, fn.body...)
+
+		return appendBytes(dst, body)
+	}))
+
+	if len(m.data) > 0 {
+		out = appendSection(out, 11, appendVec(len(m.data), func(dst []byte, i int) []byte {
+			dst = append(dst, 0x00, opI32Const)
+			dst = appendS32(dst, int32(m.data[i].offset))
+			return appendBytes(append(dst, opEnd), m.data[i].data)
+		}))
+	}
+
+	return out
+}
+
+func appendSection(dst []byte, id byte, payload []byte) []byte {
+	return appendBytes(append(dst, id), payload)
+}
+
+func appendVec(n int, fn func(dst []byte, i int) []byte) []byte {
+	out := appendU32(nil, uint32(n))
+	for i := 0; i < n; i++ {
+		out = fn(out, i)
+	}
+
+	return out
+}
+
+func appendBytes(dst, b []byte) []byte {
+	return append(appendU32(dst, uint32(len(b))), b...)
+}
+
+func appendU32(dst []byte, v uint32) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(dst, b)
+		}
+
+		dst = append(dst, b|0x80)
+	}
+}
+
+func appendS32(dst []byte, v int32) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(dst, b)
+		}
+
+		dst = append(dst, b|0x80)
+	}
+}
+
+// i32Const returns the instruction pushing the provided constant.
+func i32Const(v int32) []byte {
+	return appendS32([]byte{opI32Const}, v)
+}
+
+// call returns the instruction calling the function with the provided index.
+func call(idx uint32) []byte {
+	return appendU32([]byte{opCall}, idx)
+}
+
+// concat concatenates instructions.
+func concat(instrs ...[]byte) []byte {
+	var out []byte
+	for _, instr := range instrs {
+		out = append(out, instr...)
+	}
+
+	return out
+}
+
+// Pointers to the static regions of the light client test contract.
+const (
+	regionKeyInit int32 = 12 * iota
+	regionKeyFrozen
+	regionValueFrozen
+	regionResponseOk
+	regionResponseFrozen
+	regionStatusActive
+	regionStatusFrozen
+	regionAbortMsg
+)
+
+const (
+	keyInit   = "init"
+	keyFrozen = "frozen"
+
+	responseOk     = `{"ok":{"messages":[],"attributes":[],"events":[],"data":null}}`
+	responseFrozen = `{"error":"client is frozen"}`
+	abortMsg       = "unsupported query"
+)
+
+var (
+	statusActive = `{"ok":"` + base64.StdEncoding.EncodeToString([]byte(`{"status":"Active"}`)) + `"}`
+	statusFrozen = `{"ok":"` + base64.StdEncoding.EncodeToString([]byte(`{"status":"Frozen"}`)) + `"}`
+)
+
+// Function indices of the light client test contract.
+const (
+	fnDBRead uint32 = iota
+	fnDBWrite
+	fnDBRemove
+	fnAbort
+	fnAllocate
+	fnDeallocate
+	fnInterfaceVersion
+	fnInstantiate
+	fnQuery
+	fnSudo
+	fnMigrate
+)
+
+// msgByte returns the instructions loading the byte at the provided index of the message
+// passed to an entry point in the region pointed to by the local with the provided index.
+func msgByte(local byte, idx uint32) []byte {
+	return concat([]byte{opLocalGet, local, opI32Load, 0x02, 0x00, opI32Load8U, 0x00}, appendU32(nil, idx))
+}
+
+// lightClientContract returns a minimal Wasm light client contract, which is modelled after the
+// behaviour of a light client that can be frozen:
+//   - instantiate stores the instantiate message under the key "init".
+//   - query returns the status of the client, which is Frozen if the key "frozen" is set and Active otherwise.
+//     Query messages with any other payload abort the execution, except for payloads starting with "l", which
+//     loop forever, and payloads starting with "w", which attempt to write to storage.
+//   - sudo freezes the client on update_state_on_misbehaviour messages and fails verify_* messages if the
+//     client is frozen. All other sudo messages succeed without any effect.
+//   - migrate unfreezes the client.
+func lightClientContract() []byte {
+	var (
+		staticData []byte
+		regions    []byte
+	)
+
+	// static data is placed after the regions pointing to it
+	const dataOffset = 256
+	for _, s := range []string{keyInit, keyFrozen, "1", responseOk, responseFrozen, statusActive, statusFrozen, abortMsg} {
+		offset := uint32(dataOffset + len(staticData))
+		regions = binary.LittleEndian.AppendUint32(regions, offset)
+		regions = binary.LittleEndian.AppendUint32(regions, uint32(len(s)))
+		regions = binary.LittleEndian.AppendUint32(regions, uint32(len(s)))
+		staticData = append(staticData, s...)
+	}
+
+	const (
+		typeI32ToI32 uint32 = iota
+		typeI32I32ToNone
+		typeI32ToNone
+		typeNoneToNone
+		typeI32I32I32ToI32
+		typeI32I32ToI32
+	)
+
+	// allocate uses a bump allocator, with the heap pointer stored in global 0
+	allocate := concat(
+		[]byte{opGlobalGet, 0x00, opLocalSet, 0x01},
+		// region.offset = ptr + 12
+		[]byte{opLocalGet, 0x01, opLocalGet, 0x01}, i32Const(12), []byte{opI32Add, opI32Store, 0x02, 0x00},
+		// region.capacity = size
+		[]byte{opLocalGet, 0x01, opLocalGet, 0x00, opI32Store, 0x02, 0x04},
+		// region.length = 0
+		[]byte{opLocalGet, 0x01}, i32Const(0), []byte{opI32Store, 0x02, 0x08},
+		// heap = ptr + 12 + size
+		[]byte{opLocalGet, 0x01}, i32Const(12), []byte{opI32Add, opLocalGet, 0x00, opI32Add, opGlobalSet, 0x00},
+		[]byte{opLocalGet, 0x01, opEnd},
+	)
+
+	instantiate := concat(
+		i32Const(regionKeyInit), []byte{opLocalGet, 0x02}, call(fnDBWrite),
+		i32Const(regionResponseOk), []byte{opEnd},
+	)
+
+	query := concat(
+		// loop forever on payloads starting with "l"
+		msgByte(0x01, 2), i32Const('l'), []byte{opI32Eq, opIf, blockTypeEmpty, opLoop, blockTypeEmpty, opBr, 0x00, opEnd, opEnd},
+		// write to storage on payloads starting with "w"
+		msgByte(0x01, 2), i32Const('w'), []byte{opI32Eq, opIf, blockTypeEmpty}, i32Const(regionKeyFrozen), i32Const(regionValueFrozen), call(fnDBWrite), []byte{opEnd},
+		// abort on payloads other than status
+		msgByte(0x01, 2), i32Const('s'), []byte{opI32Ne, opIf, blockTypeEmpty}, i32Const(regionAbortMsg), call(fnAbort), []byte{opEnd},
+		i32Const(regionKeyFrozen), call(fnDBRead),
+		[]byte{opIf, valTypeI32}, i32Const(regionStatusFrozen), []byte{opElse}, i32Const(regionStatusActive), []byte{opEnd},
+		[]byte{opEnd},
+	)
+
+	sudo := concat(
+		// freeze on update_state_on_misbehaviour
+		msgByte(0x01, 2), i32Const('u'), []byte{opI32Eq},
+		msgByte(0x01, 14), i32Const('_'), []byte{opI32Eq},
+		[]byte{opI32And, opIf, blockTypeEmpty},
+		i32Const(regionKeyFrozen), i32Const(regionValueFrozen), call(fnDBWrite),
+		i32Const(regionResponseOk), []byte{opReturn, opEnd},
+		// fail verification if frozen
+		msgByte(0x01, 2), i32Const('v'), []byte{opI32Eq, opIf, blockTypeEmpty},
+		i32Const(regionKeyFrozen), call(fnDBRead), []byte{opIf, blockTypeEmpty}, i32Const(regionResponseFrozen), []byte{opReturn, opEnd},
+		[]byte{opEnd},
+		i32Const(regionResponseOk), []byte{opEnd},
+	)
+
+	migrate := concat(
+		i32Const(regionKeyFrozen), call(fnDBRemove),
+		i32Const(regionResponseOk), []byte{opEnd},
+	)
+
+	return wasmModule{
+		types: []funcType{
+			typeI32ToI32:       {params: []byte{valTypeI32}, results: []byte{valTypeI32}},
+			typeI32I32ToNone:   {params: []byte{valTypeI32, valTypeI32}},
+			typeI32ToNone:      {params: []byte{valTypeI32}},
+			typeNoneToNone:     {},
+			typeI32I32I32ToI32: {params: []byte{valTypeI32, valTypeI32, valTypeI32}, results: []byte{valTypeI32}},
+			typeI32I32ToI32:    {params: []byte{valTypeI32, valTypeI32}, results: []byte{valTypeI32}},
+		},
+		imports: []wasmImport{
+			{"env", "db_read", typeI32ToI32},
+			{"env", "db_write", typeI32I32ToNone},
+			{"env", "db_remove", typeI32ToNone},
+			{"env", "abort", typeI32ToNone},
+		},
+		functions: []wasmFunction{
+			{typeIdx: typeI32ToI32, locals: []byte{valTypeI32}, body: allocate},
+			{typeIdx: typeI32ToNone, body: []byte{opEnd}},
+			{typeIdx: typeNoneToNone, body: []byte{opEnd}},
+			{typeIdx: typeI32I32I32ToI32, body: instantiate},
+			{typeIdx: typeI32I32ToI32, body: query},
+			{typeIdx: typeI32I32ToI32, body: sudo},
+			{typeIdx: typeI32I32ToI32, body: migrate},
+		},
+		memoryPages: 16,
+		// mutable i32 heap pointer
+		globals: [][]byte{concat([]byte{valTypeI32, 0x01}, i32Const(4096), []byte{opEnd})},
+		exports: []wasmExport{
+			{"memory", 0x02, 0},
+			{"allocate", 0x00, fnAllocate},
+			{"deallocate", 0x00, fnDeallocate},
+			{"interface_version_8", 0x00, fnInterfaceVersion},
+			{"instantiate", 0x00, fnInstantiate},
+			{"query", 0x00, fnQuery},
+			{"sudo", 0x00, fnSudo},
+			{"migrate", 0x00, fnMigrate},
+		},
+		data: []dataSegment{
+			{offset: 0, data: regions},
+			{offset: dataOffset, data: staticData},
+		},
+	}.encode()
+}
+
+// minimalContract returns a contract with the minimal set of exports required by CosmWasm,
+// where the provided function is added as an export with the provided name if name is not empty.
+func minimalContract(name string, body []byte) wasmModule {
+	m := wasmModule{
+		types: []funcType{
+			{params: []byte{valTypeI32}, results: []byte{valTypeI32}},
+			{params: []byte{valTypeI32}},
+			{},
+		},
+		functions: []wasmFunction{
+			{typeIdx: 0, body: concat([]byte{opLocalGet, 0x00}, []byte{opEnd})},
+			{typeIdx: 1, body: []byte{opEnd}},
+			{typeIdx: 2, body: []byte{opEnd}},
+		},
+		memoryPages: 1,
+		exports: []wasmExport{
+			{"memory", 0x02, 0},
+			{"allocate", 0x00, 0},
+			{"deallocate", 0x00, 1},
+			{"interface_version_8", 0x00, 2},
+		},
+	}
+
+	if name != "" {
+		m.functions = append(m.functions, wasmFunction{typeIdx: 2, body: body})
+		m.exports = append(m.exports, wasmExport{name, 0x00, uint32(len(m.functions) - 1)})
+	}
+
+	return m
+}
@@ -0,0 +1,16 @@
+/*
+Package wazerovm implements the 08-wasm WasmEngine interface using wazero, a WebAssembly
+runtime written in pure Go. It allows binaries built without cgo (or with the nolink_libwasmvm
+build tag) to execute Wasm light client contracts, without linking against libwasmvm.
+
+The engine supports the CosmWasm entry points used by the 08-wasm module (instantiate, sudo,
+query and migrate) together with the CosmWasm host functions for storage access, address
+handling, signature verification and querying the chain.
+
+Gas is metered in CosmWasm gas units by instrumenting the contract code at the start of every
+basic block, and converted to Cosmos SDK gas by the 08-wasm keeper using the WasmGasRegister,
+in the same way as for libwasmvm. Gas consumption is deterministic for a given contract and
+input, but is not identical to the gas consumption of libwasmvm. Therefore all validators of a
+chain must use the same WasmEngine implementation.
+*/
+package wazerovm
@@ -0,0 +1,90 @@
+package wazerovm
+
+import (
+	"math"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// The gas costs below are expressed in CosmWasm gas and are modelled after the default
+// gas configuration of CosmWasm. CosmWasm gas is converted to Cosmos SDK gas by the
+// WasmGasRegister of the 08-wasm module.
+const (
+	// GasPerOperation is the flat gas cost charged for every executed Wasm operation.
+	GasPerOperation uint64 = 115
+	// CompileCostPerByte is the gas cost charged per byte of Wasm code for storing code.
+	CompileCostPerByte uint64 = 3 * 140_000
+
+	// gasPerMicrosecond is the gas cost of a microsecond of execution time of host functions.
+	gasPerMicrosecond uint64 = 1_000
+
+	secp256k1VerifyCost        = 96 * gasPerMicrosecond
+	secp256k1RecoverPubkeyCost = 194 * gasPerMicrosecond
+	ed25519VerifyCost          = 35 * gasPerMicrosecond
+	ed25519BatchVerifyCost     = 24 * gasPerMicrosecond
+)
+
+// compileCost returns the gas cost of storing the provided Wasm code.
+func compileCost(code []byte) uint64 {
+	return CompileCostPerByte * uint64(len(code))
+}
+
+// gasState tracks the gas consumption of a single contract execution. The gas left for execution
+// is stored in a global of the instrumented contract, which is decremented by the contract at the
+// start of every basic block and by the host functions for the gas they consume.
+type gasState struct {
+	limit uint64
+	// meter is the gas meter used to account for gas consumed outside of the VM,
+	// e.g. by storage access, which counts towards the gas limit of the execution.
+	meter wasmvmtypes.GasMeter
+	// lastExternal is the gas consumed by the gas meter at the last synchronisation.
+	lastExternal uint64
+	// external is the total amount of gas consumed outside of the VM.
+	external uint64
+}
+
+func newGasState(limit uint64, meter wasmvmtypes.GasMeter) *gasState {
+	return &gasState{
+		limit:        limit,
+		meter:        meter,
+		lastExternal: meter.GasConsumed(),
+	}
+}
+
+// initialGasLeft returns the initial value of the gas global of the instrumented contract.
+func (g *gasState) initialGasLeft() int64 {
+	if g.limit > math.MaxInt64 {
+		return math.MaxInt64
+	}
+
+	return int64(g.limit)
+}
+
+// externalDelta returns the gas consumed outside of the VM since the last call.
+func (g *gasState) externalDelta() uint64 {
+	consumed := g.meter.GasConsumed()
+	if consumed < g.lastExternal {
+		return 0
+	}
+
+	delta := consumed - g.lastExternal
+	g.lastExternal = consumed
+	g.external += delta
+
+	return delta
+}
+
+// usedInternally returns the gas consumed by the VM, excluding the gas consumed outside of the VM,
+// given the value of the gas global after execution.
+func (g *gasState) usedInternally(gasLeft int64) uint64 {
+	if gasLeft < 0 {
+		gasLeft = 0
+	}
+
+	used := uint64(g.initialGasLeft() - gasLeft)
+	if used < g.external {
+		return 0
+	}
+
+	return used - g.external
+}
@@ -0,0 +1,626 @@
+package wazerovm
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/oasisprotocol/curve25519-voi/primitives/ed25519"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// hostModuleName is the name of the module from which contracts import host functions.
+const hostModuleName = "env"
+
+// The maximum lengths of the data passed from contracts to host functions.
+const (
+	maxLengthDBKey            = 64 * 1024
+	maxLengthDBValue          = 128 * 1024
+	maxLengthCanonicalAddress = 64
+	maxLengthHumanAddress     = 256
+	maxLengthQueryRequest     = 64 * 1024
+	maxLengthDebug            = 2 * 1024 * 1024
+	maxLengthAbort            = 2 * 1024
+	maxLengthHash             = 32
+	maxLengthSignature        = 64
+	maxLengthPubkey           = 65
+	maxLengthEd25519Message   = 128 * 1024
+	maxCountEd25519Batch      = 256
+)
+
+// The result codes of the signature verification host functions, as defined by CosmWasm.
+const (
+	cryptoVerified               uint32 = 0
+	cryptoNotVerified            uint32 = 1
+	cryptoInvalidHashFormat      uint32 = 3
+	cryptoInvalidSignatureFormat uint32 = 4
+	cryptoInvalidPubkeyFormat    uint32 = 5
+	cryptoInvalidRecoveryParam   uint32 = 6
+	cryptoBatchErr               uint32 = 7
+)
+
+const (
+	iteratorOrderAscending  = 1
+	iteratorOrderDescending = 2
+)
+
+var (
+	i32 = api.ValueTypeI32
+	i64 = api.ValueTypeI64
+)
+
+// hostFunction defines a host function provided to contracts.
+type hostFunction struct {
+	fn      func(ctx context.Context, env *callEnv, mod api.Module, stack []uint64)
+	params  []api.ValueType
+	results []api.ValueType
+}
+
+// hostFunctions are the CosmWasm host functions provided to contracts, keyed by import name.
+var hostFunctions = map[string]hostFunction{
+	"db_read":                  {dbRead, []api.ValueType{i32}, []api.ValueType{i32}},
+	"db_write":                 {dbWrite, []api.ValueType{i32, i32}, nil},
+	"db_remove":                {dbRemove, []api.ValueType{i32}, nil},
+	"db_scan":                  {dbScan, []api.ValueType{i32, i32, i32}, []api.ValueType{i32}},
+	"db_next":                  {dbNext, []api.ValueType{i32}, []api.ValueType{i32}},
+	"db_next_key":              {dbNextKey, []api.ValueType{i32}, []api.ValueType{i32}},
+	"db_next_value":            {dbNextValue, []api.ValueType{i32}, []api.ValueType{i32}},
+	"addr_validate":            {addrValidate, []api.ValueType{i32}, []api.ValueType{i32}},
+	"addr_canonicalize":        {addrCanonicalize, []api.ValueType{i32, i32}, []api.ValueType{i32}},
+	"addr_humanize":            {addrHumanize, []api.ValueType{i32, i32}, []api.ValueType{i32}},
+	"secp256k1_verify":         {secp256k1Verify, []api.ValueType{i32, i32, i32}, []api.ValueType{i32}},
+	"secp256k1_recover_pubkey": {secp256k1RecoverPubkey, []api.ValueType{i32, i32, i32}, []api.ValueType{i64}},
+	"ed25519_verify":           {ed25519Verify, []api.ValueType{i32, i32, i32}, []api.ValueType{i32}},
+	"ed25519_batch_verify":     {ed25519BatchVerify, []api.ValueType{i32, i32, i32}, []api.ValueType{i32}},
+	"debug":                    {debug, []api.ValueType{i32}, nil},
+	"abort":                    {abort, []api.ValueType{i32}, nil},
+	"query_chain":              {queryChain, []api.ValueType{i32}, []api.ValueType{i32}},
+}
+
+// isSupportedImport returns true if a host function with the provided name is provided to contracts.
+func isSupportedImport(name string) bool {
+	_, ok := hostFunctions[name]
+	return ok
+}
+
+// instantiateHostModule instantiates the module providing the host functions in the runtime.
+func instantiateHostModule(ctx context.Context, runtime wazero.Runtime) error {
+	builder := runtime.NewHostModuleBuilder(hostModuleName)
+	for name, hf := range hostFunctions {
+		builder.NewFunctionBuilder().
+			WithGoModuleFunction(wrapHostFunction(hf.fn), hf.params, hf.results).
+			Export(name)
+	}
+
+	_, err := builder.Instantiate(ctx)
+	return err
+}
+
+// callEnvKey is the context key under which the environment of a contract execution is stored.
+type callEnvKey struct{}
+
+// callEnv is the environment of a single contract execution, available to the host functions.
+type callEnv struct {
+	store      wasmvmtypes.KVStore
+	goapi      wasmvmtypes.GoAPI
+	querier    wasmvmtypes.Querier
+	gas        *gasState
+	readOnly   bool
+	printDebug bool
+
+	iterators []wasmvmtypes.Iterator
+}
+
+// close closes all iterators opened during the contract execution.
+func (e *callEnv) close() {
+	for _, it := range e.iterators {
+		it.Close()
+	}
+
+	e.iterators = nil
+}
+
+// wrapHostFunction wraps a host function, retrieving the environment of the contract execution
+// from the context and converting panics into errors aborting the contract execution.
+// Out of gas panics of the Cosmos SDK gas meter are converted into wasmvm OutOfGasErrors.
+func wrapHostFunction(fn func(ctx context.Context, env *callEnv, mod api.Module, stack []uint64)) api.GoModuleFunction {
+	return api.GoModuleFunc(func(ctx context.Context, mod api.Module, stack []uint64) {
+		env, ok := ctx.Value(callEnvKey{}).(*callEnv)
+		if !ok {
+			panic(errors.New("host function called outside of contract execution"))
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				panic(recoveredError(r))
+			}
+		}()
+
+		fn(ctx, env, mod, stack)
+	})
+}
+
+// recoveredError converts a recovered panic into an error. The Cosmos SDK signals that the gas
+// limit is exceeded using panics of type ErrorOutOfGas, which are detected by their type name to
+// avoid depending on the Cosmos SDK, in the same way as wasmvm.
+func recoveredError(r any) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+
+	if t := reflect.TypeOf(r); t != nil && t.Name() == "ErrorOutOfGas" {
+		return wasmvmtypes.OutOfGasError{}
+	}
+
+	return fmt.Errorf("panic in host function: %v", r)
+}
+
+// consumeGas subtracts the provided amount from the gas left of the contract execution
+// and aborts the execution if the gas left becomes negative.
+func consumeGas(mod api.Module, amount uint64) {
+	global, ok := mod.ExportedGlobal(GasGlobalExport).(api.MutableGlobal)
+	if !ok {
+		panic(errors.New("gas global not found"))
+	}
+
+	left := int64(global.Get())
+	if amount > uint64(left) || left < 0 {
+		global.Set(api.EncodeI64(-1))
+		panic(wasmvmtypes.OutOfGasError{})
+	}
+
+	global.Set(uint64(left - int64(amount)))
+}
+
+// gasLeft returns the gas left of the contract execution.
+func gasLeft(mod api.Module) uint64 {
+	global, ok := mod.ExportedGlobal(GasGlobalExport).(api.MutableGlobal)
+	if !ok {
+		panic(errors.New("gas global not found"))
+	}
+
+	left := int64(global.Get())
+	if left < 0 {
+		return 0
+	}
+
+	return uint64(left)
+}
+
+// consumeExternalGas charges the gas consumed outside of the VM, e.g. by storage access,
+// since the last synchronisation against the gas left of the contract execution.
+func consumeExternalGas(env *callEnv, mod api.Module) {
+	consumeGas(mod, env.gas.externalDelta())
+}
+
+// mustRead reads the data of the region at the provided pointer and aborts the contract execution on failure.
+func mustRead(mod api.Module, ptr uint32, maxLength uint32) []byte {
+	data, err := readRegionData(mod.Memory(), ptr, maxLength)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+// mustWrite writes data into the region at the provided pointer and aborts the contract execution on failure.
+func mustWrite(mod api.Module, ptr uint32, data []byte) {
+	if err := writeRegionData(mod.Memory(), ptr, data); err != nil {
+		panic(err)
+	}
+}
+
+// mustAllocate allocates a region in contract memory using the allocate export of the
+// contract, writes the data into it and returns the pointer to the region.
+func mustAllocate(ctx context.Context, mod api.Module, data []byte) uint32 {
+	ptr, err := allocate(ctx, mod, data)
+	if err != nil {
+		panic(err)
+	}
+
+	return ptr
+}
+
+// mustRequireWritable aborts the contract execution if storage is accessed read-only.
+func mustRequireWritable(env *callEnv) {
+	if env.readOnly {
+		panic(errors.New("Write access to storage not allowed in this context"))
+	}
+}
+
+func dbRead(ctx context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	key := mustRead(mod, api.DecodeU32(stack[0]), maxLengthDBKey)
+
+	value := env.store.Get(key)
+	consumeExternalGas(env, mod)
+
+	if value == nil {
+		stack[0] = 0
+		return
+	}
+
+	stack[0] = api.EncodeU32(mustAllocate(ctx, mod, value))
+}
+
+func dbWrite(_ context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	mustRequireWritable(env)
+
+	key := mustRead(mod, api.DecodeU32(stack[0]), maxLengthDBKey)
+	value := mustRead(mod, api.DecodeU32(stack[1]), maxLengthDBValue)
+
+	env.store.Set(key, value)
+	consumeExternalGas(env, mod)
+}
+
+func dbRemove(_ context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	mustRequireWritable(env)
+
+	key := mustRead(mod, api.DecodeU32(stack[0]), maxLengthDBKey)
+
+	env.store.Delete(key)
+	consumeExternalGas(env, mod)
+}
+
+func dbScan(_ context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	start := optionalBytes(mustRead(mod, api.DecodeU32(stack[0]), maxLengthDBKey))
+	end := optionalBytes(mustRead(mod, api.DecodeU32(stack[1]), maxLengthDBKey))
+
+	var it wasmvmtypes.Iterator
+	switch api.DecodeI32(stack[2]) {
+	case iteratorOrderAscending:
+		it = env.store.Iterator(start, end)
+	case iteratorOrderDescending:
+		it = env.store.ReverseIterator(start, end)
+	default:
+		panic(fmt.Errorf("invalid iterator order %d", api.DecodeI32(stack[2])))
+	}
+
+	consumeExternalGas(env, mod)
+
+	env.iterators = append(env.iterators, it)
+
+	// iterator ids start at 1
+	stack[0] = api.EncodeU32(uint32(len(env.iterators)))
+}
+
+// nextRecord advances the iterator with the provided id and returns the current key and value.
+// A nil key is returned if the iterator is exhausted.
+func nextRecord(env *callEnv, mod api.Module, id uint32) ([]byte, []byte) {
+	if id == 0 || id > uint32(len(env.iterators)) {
+		panic(fmt.Errorf("iterator %d does not exist", id))
+	}
+
+	it := env.iterators[id-1]
+	if !it.Valid() {
+		return nil, nil
+	}
+
+	key, value := it.Key(), it.Value()
+	it.Next()
+	consumeExternalGas(env, mod)
+
+	return key, value
+}
+
+func dbNext(ctx context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	key, value := nextRecord(env, mod, api.DecodeU32(stack[0]))
+
+	// an exhausted iterator is signalled by an empty key and value
+	stack[0] = api.EncodeU32(mustAllocate(ctx, mod, encodeSections(key, value)))
+}
+
+func dbNextKey(ctx context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	key, _ := nextRecord(env, mod, api.DecodeU32(stack[0]))
+	if key == nil {
+		stack[0] = 0
+		return
+	}
+
+	stack[0] = api.EncodeU32(mustAllocate(ctx, mod, key))
+}
+
+func dbNextValue(ctx context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	key, value := nextRecord(env, mod, api.DecodeU32(stack[0]))
+	if key == nil {
+		stack[0] = 0
+		return
+	}
+
+	stack[0] = api.EncodeU32(mustAllocate(ctx, mod, value))
+}
+
+// addrError returns the pointer to a newly allocated region containing the error message
+// of an address operation, which is returned to the contract.
+func addrError(ctx context.Context, mod api.Module, msg string) uint64 {
+	return api.EncodeU32(mustAllocate(ctx, mod, []byte(msg)))
+}
+
+func addrValidate(ctx context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	source := mustRead(mod, api.DecodeU32(stack[0]), maxLengthHumanAddress)
+	if len(source) == 0 {
+		stack[0] = addrError(ctx, mod, "Input is empty")
+		return
+	}
+
+	gasCost, err := env.goapi.ValidateAddress(string(source))
+	consumeGas(mod, gasCost)
+	if err != nil {
+		stack[0] = addrError(ctx, mod, err.Error())
+		return
+	}
+
+	stack[0] = 0
+}
+
+func addrCanonicalize(ctx context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	source := mustRead(mod, api.DecodeU32(stack[0]), maxLengthHumanAddress)
+	if len(source) == 0 {
+		stack[0] = addrError(ctx, mod, "Input is empty")
+		return
+	}
+
+	canonical, gasCost, err := env.goapi.CanonicalizeAddress(string(source))
+	consumeGas(mod, gasCost)
+	if err != nil {
+		stack[0] = addrError(ctx, mod, err.Error())
+		return
+	}
+
+	mustWrite(mod, api.DecodeU32(stack[1]), canonical)
+	stack[0] = 0
+}
+
+func addrHumanize(ctx context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	canonical := mustRead(mod, api.DecodeU32(stack[0]), maxLengthCanonicalAddress)
+	if len(canonical) == 0 {
+		stack[0] = addrError(ctx, mod, "Input is empty")
+		return
+	}
+
+	human, gasCost, err := env.goapi.HumanizeAddress(canonical)
+	consumeGas(mod, gasCost)
+	if err != nil {
+		stack[0] = addrError(ctx, mod, err.Error())
+		return
+	}
+
+	mustWrite(mod, api.DecodeU32(stack[1]), []byte(human))
+	stack[0] = 0
+}
+
+// parseSecp256k1Signature parses a signature in the fixed size r || s format.
+// Signatures with a high s value are accepted.
+func parseSecp256k1Signature(sig []byte) (*ecdsa.Signature, bool) {
+	if len(sig) != 64 {
+		return nil, false
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(sig[:32]); overflow || r.IsZero() {
+		return nil, false
+	}
+
+	if overflow := s.SetByteSlice(sig[32:]); overflow || s.IsZero() {
+		return nil, false
+	}
+
+	return ecdsa.NewSignature(&r, &s), true
+}
+
+func secp256k1Verify(_ context.Context, _ *callEnv, mod api.Module, stack []uint64) {
+	hash := mustRead(mod, api.DecodeU32(stack[0]), maxLengthHash)
+	sig := mustRead(mod, api.DecodeU32(stack[1]), maxLengthSignature)
+	pubkey := mustRead(mod, api.DecodeU32(stack[2]), maxLengthPubkey)
+
+	consumeGas(mod, secp256k1VerifyCost)
+
+	stack[0] = api.EncodeU32(func() uint32 {
+		if len(hash) != 32 {
+			return cryptoInvalidHashFormat
+		}
+
+		signature, ok := parseSecp256k1Signature(sig)
+		if !ok {
+			return cryptoInvalidSignatureFormat
+		}
+
+		key, err := secp256k1.ParsePubKey(pubkey)
+		if err != nil {
+			return cryptoInvalidPubkeyFormat
+		}
+
+		if !signature.Verify(hash, key) {
+			return cryptoNotVerified
+		}
+
+		return cryptoVerified
+	}())
+}
+
+func secp256k1RecoverPubkey(ctx context.Context, _ *callEnv, mod api.Module, stack []uint64) {
+	hash := mustRead(mod, api.DecodeU32(stack[0]), maxLengthHash)
+	sig := mustRead(mod, api.DecodeU32(stack[1]), maxLengthSignature)
+	recoveryParam := api.DecodeU32(stack[2])
+
+	consumeGas(mod, secp256k1RecoverPubkeyCost)
+
+	// errors are returned to the contract in the high half of the result
+	fail := func(code uint32) {
+		stack[0] = uint64(code) << 32
+	}
+
+	if len(hash) != 32 {
+		fail(cryptoInvalidHashFormat)
+		return
+	}
+
+	if _, ok := parseSecp256k1Signature(sig); !ok {
+		fail(cryptoInvalidSignatureFormat)
+		return
+	}
+
+	if recoveryParam > 1 {
+		fail(cryptoInvalidRecoveryParam)
+		return
+	}
+
+	// the compact signature format is prefixed with 27 + recovery id
+	compact := append([]byte{27 + byte(recoveryParam)}, sig...)
+	key, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		fail(cryptoInvalidSignatureFormat)
+		return
+	}
+
+	// the pointer to the recovered public key is returned to the contract in the low half of the result
+	stack[0] = uint64(mustAllocate(ctx, mod, key.SerializeUncompressed()))
+}
+
+// ed25519VerifyOptions are the verification options used for ed25519 signatures, which follow the
+// ZIP-215 validation rules of the ed25519-zebra implementation used by CosmWasm.
+var ed25519VerifyOptions = &ed25519.Options{Verify: ed25519.VerifyOptionsZIP_215}
+
+func ed25519Verify(_ context.Context, _ *callEnv, mod api.Module, stack []uint64) {
+	msg := mustRead(mod, api.DecodeU32(stack[0]), maxLengthEd25519Message)
+	sig := mustRead(mod, api.DecodeU32(stack[1]), maxLengthSignature)
+	pubkey := mustRead(mod, api.DecodeU32(stack[2]), maxLengthPubkey)
+
+	consumeGas(mod, ed25519VerifyCost)
+
+	stack[0] = api.EncodeU32(verifyEd25519(msg, sig, pubkey))
+}
+
+func verifyEd25519(msg, sig, pubkey []byte) uint32 {
+	if len(sig) != ed25519.SignatureSize {
+		return cryptoInvalidSignatureFormat
+	}
+
+	if len(pubkey) != ed25519.PublicKeySize {
+		return cryptoInvalidPubkeyFormat
+	}
+
+	if !ed25519.VerifyWithOptions(pubkey, msg, sig, ed25519VerifyOptions) {
+		return cryptoNotVerified
+	}
+
+	return cryptoVerified
+}
+
+func ed25519BatchVerify(_ context.Context, _ *callEnv, mod api.Module, stack []uint64) {
+	const (
+		maxLengthMessages   = maxCountEd25519Batch * (maxLengthEd25519Message + 4)
+		maxLengthSignatures = maxCountEd25519Batch * (maxLengthSignature + 4)
+		maxLengthPubkeys    = maxCountEd25519Batch * (maxLengthPubkey + 4)
+	)
+
+	msgs := mustDecodeSections(mustRead(mod, api.DecodeU32(stack[0]), maxLengthMessages))
+	sigs := mustDecodeSections(mustRead(mod, api.DecodeU32(stack[1]), maxLengthSignatures))
+	pubkeys := mustDecodeSections(mustRead(mod, api.DecodeU32(stack[2]), maxLengthPubkeys))
+
+	consumeGas(mod, ed25519BatchVerifyCost*uint64(len(sigs)))
+
+	stack[0] = api.EncodeU32(func() uint32 {
+		switch {
+		case len(msgs) == len(sigs) && len(sigs) == len(pubkeys):
+		case len(msgs) == 1 && len(sigs) == len(pubkeys):
+			// a single message signed by multiple keys
+		case len(pubkeys) == 1 && len(msgs) == len(sigs):
+			// multiple messages signed by a single key
+		default:
+			return cryptoBatchErr
+		}
+
+		for i := range sigs {
+			msg, pubkey := msgs[0], pubkeys[0]
+			if len(msgs) > 1 {
+				msg = msgs[i]
+			}
+
+			if len(pubkeys) > 1 {
+				pubkey = pubkeys[i]
+			}
+
+			if code := verifyEd25519(msg, sigs[i], pubkey); code != cryptoVerified {
+				return code
+			}
+		}
+
+		return cryptoVerified
+	}())
+}
+
+func debug(_ context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	msg := mustRead(mod, api.DecodeU32(stack[0]), maxLengthDebug)
+	if env.printDebug {
+		fmt.Println(string(msg))
+	}
+}
+
+func abort(_ context.Context, _ *callEnv, mod api.Module, stack []uint64) {
+	msg := mustRead(mod, api.DecodeU32(stack[0]), maxLengthAbort)
+	panic(fmt.Errorf("Aborted: %s", msg))
+}
+
+func queryChain(ctx context.Context, env *callEnv, mod api.Module, stack []uint64) {
+	request := mustRead(mod, api.DecodeU32(stack[0]), maxLengthQueryRequest)
+
+	result := wasmvmtypes.RustQuery(env.querier, request, gasLeft(mod))
+	consumeExternalGas(env, mod)
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		panic(err)
+	}
+
+	stack[0] = api.EncodeU32(mustAllocate(ctx, mod, bz))
+}
+
+// optionalBytes returns nil for empty data, which CosmWasm uses to encode an absent value.
+func optionalBytes(data []byte) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+
+	return data
+}
+
+// encodeSections encodes multiple byte slices into a single byte slice, where every
+// slice is followed by its length as a big endian encoded u32, as defined by CosmWasm.
+func encodeSections(sections ...[]byte) []byte {
+	var out []byte
+	for _, s := range sections {
+		out = append(out, s...)
+		out = binary.BigEndian.AppendUint32(out, uint32(len(s)))
+	}
+
+	return out
+}
+
+// mustDecodeSections decodes data encoded by encodeSections and aborts the contract execution on failure.
+func mustDecodeSections(data []byte) [][]byte {
+	var sections [][]byte
+	for len(data) > 0 {
+		if len(data) < 4 {
+			panic(errors.New("invalid section encoding"))
+		}
+
+		length := binary.BigEndian.Uint32(data[len(data)-4:])
+		data = data[:len(data)-4]
+		if uint64(length) > uint64(len(data)) {
+			panic(errors.New("invalid section encoding"))
+		}
+
+		sections = append([][]byte{data[uint32(len(data))-length:]}, sections...)
+		data = data[:uint32(len(data))-length]
+	}
+
+	return sections
+}
@@ -0,0 +1,495 @@
+package wazerovm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// GasGlobalExport is the name under which the gas global injected into contracts is exported.
+// Contracts must not export any other item under this name.
+const GasGlobalExport = "__gas_left"
+
+// MaxFunctionBodies is the maximum number of functions a contract may define.
+const MaxFunctionBodies = 20_000
+
+const (
+	wasmPageSize = 65536
+
+	sectionCustom    byte = 0
+	sectionImport    byte = 2
+	sectionMemory    byte = 5
+	sectionGlobal    byte = 6
+	sectionExport    byte = 7
+	sectionStart     byte = 8
+	sectionCode      byte = 10
+	sectionDataCount byte = 12
+
+	externFunc   byte = 0x00
+	externGlobal byte = 0x03
+
+	valTypeI64 byte = 0x7e
+)
+
+var wasmHeader = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// requiredExports are the exports every CosmWasm contract must provide.
+var requiredExports = []string{"memory", "allocate", "deallocate", "interface_version_8"}
+
+// sectionOrder returns the position of a known section in a Wasm module. The data count
+// section (id 12) is placed before the code section (id 10).
+func sectionOrder(id byte) int {
+	switch id {
+	case sectionDataCount:
+		return 10
+	case sectionCode:
+		return 11
+	case 11:
+		return 12
+	default:
+		return int(id)
+	}
+}
+
+// section is a section of a Wasm module.
+type section struct {
+	id      byte
+	payload []byte
+}
+
+// moduleInfo contains the information gathered during the static analysis of a contract.
+type moduleInfo struct {
+	exports          []string
+	numGlobals       uint32
+	hasGlobalSection bool
+}
+
+// instrument performs the static analysis of the provided contract code and returns
+// the instrumented code, in which the execution of every basic block is metered using an
+// exported mutable i64 global holding the gas left. The analysis rejects contracts that use
+// non-deterministic or unsupported Wasm features, that import unsupported host functions,
+// that do not define exactly one memory within the memory limit or that lack the exports
+// required by CosmWasm.
+func instrument(code []byte, supportedCapabilities []string, memoryLimitPages uint32) ([]byte, error) {
+	sections, err := parseSections(code)
+	if err != nil {
+		return nil, err
+	}
+
+	info := moduleInfo{}
+	for _, s := range sections {
+		switch s.id {
+		case sectionImport:
+			if err := validateImports(s.payload); err != nil {
+				return nil, err
+			}
+		case sectionMemory:
+			if err := validateMemory(s.payload, memoryLimitPages); err != nil {
+				return nil, err
+			}
+		case sectionGlobal:
+			r := newReader(s.payload)
+			count, err := r.u32()
+			if err != nil {
+				return nil, fmt.Errorf("invalid global section: %w", err)
+			}
+			info.numGlobals = count
+			info.hasGlobalSection = true
+		case sectionExport:
+			if info.exports, err = parseExports(s.payload); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// the gas global is initialized after instantiation, therefore no code may run during instantiation
+	if hasSection(sections, sectionStart) {
+		return nil, errors.New("wasm contract must not define a start function")
+	}
+
+	if !hasSection(sections, sectionMemory) {
+		return nil, errors.New("wasm contract must contain exactly one memory")
+	}
+
+	if err := validateExports(info.exports, supportedCapabilities); err != nil {
+		return nil, err
+	}
+
+	gasGlobal := info.numGlobals
+
+	var out []section
+	for _, s := range sections {
+		if !info.hasGlobalSection && s.id != sectionCustom && sectionOrder(s.id) > sectionOrder(sectionGlobal) {
+			out = append(out, section{id: sectionGlobal, payload: appendGasGlobal(0, nil)})
+			info.hasGlobalSection = true
+		}
+
+		switch s.id {
+		case sectionGlobal:
+			r := newReader(s.payload)
+			count, _ := r.u32()
+			s.payload = appendGasGlobal(count, r.rest())
+		case sectionExport:
+			r := newReader(s.payload)
+			count, _ := r.u32()
+			s.payload = appendGasExport(count, r.rest(), gasGlobal)
+		case sectionCode:
+			if s.payload, err = instrumentCode(s.payload, gasGlobal); err != nil {
+				return nil, err
+			}
+		}
+
+		out = append(out, s)
+	}
+
+	return encodeModule(out), nil
+}
+
+// parseSections splits the Wasm module into its sections.
+func parseSections(code []byte) ([]section, error) {
+	if !bytes.HasPrefix(code, wasmHeader) {
+		return nil, errors.New("invalid wasm header")
+	}
+
+	var (
+		sections []section
+		last     int
+	)
+
+	r := newReader(code[len(wasmHeader):])
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := r.u32()
+		if err != nil {
+			return nil, fmt.Errorf("invalid size of section %d: %w", id, err)
+		}
+
+		payload, err := r.bytes(size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid section %d: %w", id, err)
+		}
+
+		if id > sectionDataCount {
+			return nil, fmt.Errorf("unknown section id %d", id)
+		}
+
+		if id != sectionCustom {
+			if sectionOrder(id) <= last {
+				return nil, fmt.Errorf("section %d out of order or duplicated", id)
+			}
+			last = sectionOrder(id)
+		}
+
+		sections = append(sections, section{id: id, payload: payload})
+	}
+
+	return sections, nil
+}
+
+func hasSection(sections []section, id byte) bool {
+	for _, s := range sections {
+		if s.id == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// encodeModule encodes the sections into a Wasm module.
+func encodeModule(sections []section) []byte {
+	out := append([]byte(nil), wasmHeader...)
+	for _, s := range sections {
+		out = append(out, s.id)
+		out = appendU32(out, uint32(len(s.payload)))
+		out = append(out, s.payload...)
+	}
+
+	return out
+}
+
+// validateImports checks that the contract only imports supported host functions from the env module.
+func validateImports(payload []byte) error {
+	r := newReader(payload)
+	count, err := r.u32()
+	if err != nil {
+		return fmt.Errorf("invalid import section: %w", err)
+	}
+
+	for i := uint32(0); i < count; i++ {
+		module, err := r.name()
+		if err != nil {
+			return fmt.Errorf("invalid import section: %w", err)
+		}
+
+		name, err := r.name()
+		if err != nil {
+			return fmt.Errorf("invalid import section: %w", err)
+		}
+
+		kind, err := r.byte()
+		if err != nil {
+			return fmt.Errorf("invalid import section: %w", err)
+		}
+
+		if kind != externFunc {
+			return fmt.Errorf("import %s.%s: only function imports are supported", module, name)
+		}
+
+		if _, err := r.u32(); err != nil {
+			return fmt.Errorf("invalid import section: %w", err)
+		}
+
+		if module != hostModuleName || !isSupportedImport(name) {
+			return fmt.Errorf("wasm contract requires unsupported import: \"%s.%s\"", module, name)
+		}
+	}
+
+	return nil
+}
+
+// validateMemory checks that the contract defines exactly one non-shared 32-bit memory
+// with an initial size within the memory limit.
+func validateMemory(payload []byte, memoryLimitPages uint32) error {
+	r := newReader(payload)
+	count, err := r.u32()
+	if err != nil {
+		return fmt.Errorf("invalid memory section: %w", err)
+	}
+
+	if count != 1 {
+		return errors.New("wasm contract must contain exactly one memory")
+	}
+
+	flags, err := r.byte()
+	if err != nil {
+		return fmt.Errorf("invalid memory section: %w", err)
+	}
+
+	if flags > 0x01 {
+		return errors.New("wasm contract memory must not be shared or 64-bit")
+	}
+
+	initial, err := r.u32()
+	if err != nil {
+		return fmt.Errorf("invalid memory section: %w", err)
+	}
+
+	if initial > memoryLimitPages {
+		return fmt.Errorf("wasm contract memory's minimum must not exceed %d pages", memoryLimitPages)
+	}
+
+	return nil
+}
+
+// parseExports returns the names of all exports of the contract.
+func parseExports(payload []byte) ([]string, error) {
+	r := newReader(payload)
+	count, err := r.u32()
+	if err != nil {
+		return nil, fmt.Errorf("invalid export section: %w", err)
+	}
+
+	exports := make([]string, 0, count)
+	for i := uint32(0); i < count; i++ {
+		name, err := r.name()
+		if err != nil {
+			return nil, fmt.Errorf("invalid export section: %w", err)
+		}
+
+		if _, err := r.byte(); err != nil {
+			return nil, fmt.Errorf("invalid export section: %w", err)
+		}
+
+		if _, err := r.u32(); err != nil {
+			return nil, fmt.Errorf("invalid export section: %w", err)
+		}
+
+		exports = append(exports, name)
+	}
+
+	return exports, nil
+}
+
+// validateExports checks that the contract provides the exports required by CosmWasm, that all
+// capabilities required by the contract are supported and that the gas global export is not used.
+func validateExports(exports []string, supportedCapabilities []string) error {
+	exported := make(map[string]bool, len(exports))
+	for _, name := range exports {
+		if name == GasGlobalExport {
+			return fmt.Errorf("wasm contract must not export reserved name %s", GasGlobalExport)
+		}
+
+		if capability, found := strings.CutPrefix(name, "requires_"); found && !contains(supportedCapabilities, capability) {
+			return fmt.Errorf("wasm contract requires unavailable capabilities: %s", capability)
+		}
+
+		exported[name] = true
+	}
+
+	for _, name := range requiredExports {
+		if !exported[name] {
+			return fmt.Errorf("wasm contract doesn't have required export: \"%s\"", name)
+		}
+	}
+
+	return nil
+}
+
+// appendGasGlobal returns the payload of a global section containing the count provided global
+// definitions followed by the gas global, which is a mutable i64 global initialized to zero.
+func appendGasGlobal(count uint32, globals []byte) []byte {
+	out := appendU32(nil, count+1)
+	out = append(out, globals...)
+
+	return append(out, valTypeI64, 0x01, opI64Const, 0x00, opEnd)
+}
+
+// appendGasExport returns the payload of an export section containing the count provided export
+// definitions followed by the export of the gas global.
+func appendGasExport(count uint32, exports []byte, gasGlobal uint32) []byte {
+	out := appendU32(nil, count+1)
+	out = append(out, exports...)
+	out = appendU32(out, uint32(len(GasGlobalExport)))
+	out = append(out, GasGlobalExport...)
+	out = append(out, externGlobal)
+
+	return appendU32(out, gasGlobal)
+}
+
+// instrumentCode instruments all function bodies of the code section.
+func instrumentCode(payload []byte, gasGlobal uint32) ([]byte, error) {
+	r := newReader(payload)
+	count, err := r.u32()
+	if err != nil {
+		return nil, fmt.Errorf("invalid code section: %w", err)
+	}
+
+	if count > MaxFunctionBodies {
+		return nil, fmt.Errorf("wasm contract defines more than %d functions", MaxFunctionBodies)
+	}
+
+	out := appendU32(nil, count)
+	for i := uint32(0); i < count; i++ {
+		size, err := r.u32()
+		if err != nil {
+			return nil, fmt.Errorf("invalid code section: %w", err)
+		}
+
+		body, err := r.bytes(size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code section: %w", err)
+		}
+
+		instrumented, err := instrumentFunction(body, gasGlobal)
+		if err != nil {
+			return nil, fmt.Errorf("function %d: %w", i, err)
+		}
+
+		out = appendU32(out, uint32(len(instrumented)))
+		out = append(out, instrumented...)
+	}
+
+	if !r.done() {
+		return nil, errors.New("invalid code section: trailing bytes")
+	}
+
+	return out, nil
+}
+
+// instrumentFunction injects a gas charge at the start of every basic block of the function body.
+// A basic block ends with any instruction that may change the control flow and the charged amount
+// is the number of instructions of the block multiplied by GasPerOperation.
+func instrumentFunction(body []byte, gasGlobal uint32) ([]byte, error) {
+	r := newReader(body)
+
+	numLocalDecls, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numLocalDecls; i++ {
+		if _, err := r.u32(); err != nil {
+			return nil, err
+		}
+
+		valType, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+
+		if isFloatOrVectorType(valType) {
+			return nil, errors.New("float and vector locals are not supported")
+		}
+	}
+
+	out := append([]byte(nil), body[:r.pos]...)
+
+	var (
+		blockStart = r.pos
+		numOps     uint64
+	)
+
+	for !r.done() {
+		op, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := r.skipImmediates(op); err != nil {
+			return nil, err
+		}
+
+		numOps++
+
+		if endsBasicBlock(op) || r.done() {
+			out = appendGasCharge(out, gasGlobal, numOps*GasPerOperation)
+			out = append(out, body[blockStart:r.pos]...)
+
+			blockStart = r.pos
+			numOps = 0
+		}
+	}
+
+	return out, nil
+}
+
+// endsBasicBlock returns true if the operation ends a basic block.
+func endsBasicBlock(op byte) bool {
+	switch op {
+	case opUnreachable, opBlock, opLoop, opIf, opElse, opEnd, opBr, opBrIf, opBrTable, opReturn:
+		return true
+	default:
+		return false
+	}
+}
+
+// appendGasCharge appends the instructions which subtract cost from the gas global
+// and trap if the gas left becomes negative.
+func appendGasCharge(dst []byte, gasGlobal uint32, cost uint64) []byte {
+	dst = append(dst, opGlobalGet)
+	dst = appendU32(dst, gasGlobal)
+	dst = append(dst, opI64Const)
+	dst = appendS64(dst, int64(cost))
+	dst = append(dst, opI64Sub, opGlobalSet)
+	dst = appendU32(dst, gasGlobal)
+	dst = append(dst, opGlobalGet)
+	dst = appendU32(dst, gasGlobal)
+
+	return append(dst, opI64Const, 0x00, opI64LtS, opIf, blockTypeEmpty, opUnreachable, opEnd)
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package wazerovm
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tetratelabs/wazero/api"
+)
+
+// regionSize is the size in bytes of a CosmWasm Region in contract memory.
+const regionSize = 12
+
+// region describes a chunk of contract memory. It is the representation of the
+// CosmWasm Region struct, which consists of three little endian encoded u32 values.
+type region struct {
+	Offset   uint32
+	Capacity uint32
+	Length   uint32
+}
+
+// readRegion reads the region located at the provided pointer from contract memory.
+func readRegion(mem api.Memory, ptr uint32) (region, error) {
+	data, ok := mem.Read(ptr, regionSize)
+	if !ok {
+		return region{}, fmt.Errorf("region pointer %d out of bounds", ptr)
+	}
+
+	r := region{
+		Offset:   binary.LittleEndian.Uint32(data[0:4]),
+		Capacity: binary.LittleEndian.Uint32(data[4:8]),
+		Length:   binary.LittleEndian.Uint32(data[8:12]),
+	}
+
+	if r.Length > r.Capacity {
+		return region{}, fmt.Errorf("region length %d exceeds capacity %d", r.Length, r.Capacity)
+	}
+
+	if uint64(r.Offset)+uint64(r.Capacity) > uint64(mem.Size()) {
+		return region{}, fmt.Errorf("region at offset %d with capacity %d out of bounds", r.Offset, r.Capacity)
+	}
+
+	return r, nil
+}
+
+// writeRegion writes the region to contract memory at the provided pointer.
+func writeRegion(mem api.Memory, ptr uint32, r region) error {
+	data := make([]byte, regionSize)
+	binary.LittleEndian.PutUint32(data[0:4], r.Offset)
+	binary.LittleEndian.PutUint32(data[4:8], r.Capacity)
+	binary.LittleEndian.PutUint32(data[8:12], r.Length)
+
+	if !mem.Write(ptr, data) {
+		return fmt.Errorf("region pointer %d out of bounds", ptr)
+	}
+
+	return nil
+}
+
+// readRegionData returns a copy of the data of the region located at the provided pointer.
+// An error is returned if the data is larger than maxLength.
+func readRegionData(mem api.Memory, ptr uint32, maxLength uint32) ([]byte, error) {
+	r, err := readRegion(mem, ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Length > maxLength {
+		return nil, fmt.Errorf("region length %d exceeds maximum length %d", r.Length, maxLength)
+	}
+
+	data, ok := mem.Read(r.Offset, r.Length)
+	if !ok {
+		return nil, fmt.Errorf("region data at offset %d out of bounds", r.Offset)
+	}
+
+	return append([]byte(nil), data...), nil
+}
+
+// writeRegionData writes data into the region located at the provided pointer and updates its length.
+// An error is returned if the capacity of the region is too small to hold the data.
+func writeRegionData(mem api.Memory, ptr uint32, data []byte) error {
+	r, err := readRegion(mem, ptr)
+	if err != nil {
+		return err
+	}
+
+	if uint64(len(data)) > uint64(r.Capacity) {
+		return fmt.Errorf("region capacity %d too small for data of length %d", r.Capacity, len(data))
+	}
+
+	if !mem.Write(r.Offset, data) {
+		return fmt.Errorf("region data at offset %d out of bounds", r.Offset)
+	}
+
+	r.Length = uint32(len(data))
+
+	return writeRegion(mem, ptr, r)
+}
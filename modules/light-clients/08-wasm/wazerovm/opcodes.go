@@ -0,0 +1,316 @@
+package wazerovm
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	opUnreachable byte = 0x00
+	opBlock       byte = 0x02
+	opLoop        byte = 0x03
+	opIf          byte = 0x04
+	opElse        byte = 0x05
+	opEnd         byte = 0x0b
+	opBr          byte = 0x0c
+	opBrIf        byte = 0x0d
+	opBrTable     byte = 0x0e
+	opReturn      byte = 0x0f
+	opCall        byte = 0x10
+	opCallInd     byte = 0x11
+	opSelectT     byte = 0x1c
+	opGlobalGet   byte = 0x23
+	opGlobalSet   byte = 0x24
+	opI32Const    byte = 0x41
+	opI64Const    byte = 0x42
+	opI64LtS      byte = 0x53
+	opI64Sub      byte = 0x7d
+	opRefNull     byte = 0xd0
+	opRefFunc     byte = 0xd2
+	opMiscPrefix  byte = 0xfc
+
+	blockTypeEmpty byte = 0x40
+)
+
+var errUnexpectedEnd = errors.New("unexpected end of data")
+
+// reader decodes the binary encoding of Wasm modules.
+type reader struct {
+	data []byte
+	pos  int
+}
+
+func newReader(data []byte) *reader {
+	return &reader{data: data}
+}
+
+func (r *reader) done() bool {
+	return r.pos >= len(r.data)
+}
+
+func (r *reader) rest() []byte {
+	return r.data[r.pos:]
+}
+
+func (r *reader) byte() (byte, error) {
+	if r.done() {
+		return 0, errUnexpectedEnd
+	}
+
+	b := r.data[r.pos]
+	r.pos++
+
+	return b, nil
+}
+
+func (r *reader) bytes(n uint32) ([]byte, error) {
+	if uint64(len(r.data)-r.pos) < uint64(n) {
+		return nil, errUnexpectedEnd
+	}
+
+	b := r.data[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+
+	return b, nil
+}
+
+// u32 decodes an unsigned LEB128 encoded 32-bit integer.
+func (r *reader) u32() (uint32, error) {
+	var result uint32
+	for shift := uint(0); shift < 35; shift += 7 {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+
+		if shift == 28 && b > 0x0f {
+			return 0, errors.New("invalid u32 encoding")
+		}
+
+		result |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, nil
+		}
+	}
+
+	return 0, errors.New("invalid u32 encoding")
+}
+
+// skipLEB skips a LEB128 encoded integer consisting of at most maxBytes bytes.
+func (r *reader) skipLEB(maxBytes int) error {
+	for i := 0; i < maxBytes; i++ {
+		b, err := r.byte()
+		if err != nil {
+			return err
+		}
+
+		if b&0x80 == 0 {
+			return nil
+		}
+	}
+
+	return errors.New("invalid integer encoding")
+}
+
+// name decodes a UTF-8 encoded name.
+func (r *reader) name() (string, error) {
+	length, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+
+	b, err := r.bytes(length)
+	if err != nil {
+		return "", err
+	}
+
+	if !utf8.Valid(b) {
+		return "", errors.New("invalid utf-8 name")
+	}
+
+	return string(b), nil
+}
+
+// skipImmediates skips the immediate arguments of the operation and returns an error if the
+// operation is not supported. Floating point operations are not supported as their results
+// are not guaranteed to be deterministic across platforms, as are vector operations, threads,
+// exception handling, tail calls and typed function references.
+func (r *reader) skipImmediates(op byte) error {
+	switch {
+	case op == opUnreachable, op == 0x01, op == opElse, op == opEnd, op == opReturn:
+		return nil
+	case op == opBlock, op == opLoop, op == opIf:
+		// block type encoded as s33
+		return r.skipLEB(5)
+	case op == opBr, op == opBrIf, op == opCall, op == opRefFunc:
+		_, err := r.u32()
+		return err
+	case op == opBrTable:
+		count, err := r.u32()
+		if err != nil {
+			return err
+		}
+
+		for i := uint64(0); i <= uint64(count); i++ {
+			if _, err := r.u32(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case op == opCallInd:
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+
+		_, err := r.u32()
+		return err
+	case op == 0x1a, op == 0x1b:
+		// drop, select
+		return nil
+	case op == opSelectT:
+		count, err := r.u32()
+		if err != nil {
+			return err
+		}
+
+		for i := uint32(0); i < count; i++ {
+			valType, err := r.byte()
+			if err != nil {
+				return err
+			}
+
+			if isFloatOrVectorType(valType) {
+				return errors.New("float and vector operations are not supported")
+			}
+		}
+
+		return nil
+	case op >= 0x20 && op <= 0x26:
+		// local.get, local.set, local.tee, global.get, global.set, table.get, table.set
+		_, err := r.u32()
+		return err
+	case op >= 0x28 && op <= 0x3e:
+		// loads and stores
+		if op == 0x2a || op == 0x2b || op == 0x38 || op == 0x39 {
+			return errors.New("float operations are not supported")
+		}
+
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+
+		_, err := r.u32()
+		return err
+	case op == 0x3f, op == 0x40:
+		// memory.size, memory.grow
+		return r.expectZero()
+	case op == opI32Const:
+		return r.skipLEB(5)
+	case op == opI64Const:
+		return r.skipLEB(10)
+	case op >= 0x45 && op <= 0x5a, op >= 0x67 && op <= 0x8a, op == 0xa7, op == 0xac, op == 0xad, op >= 0xc0 && op <= 0xc4:
+		// integer comparison, arithmetic, conversion and sign extension operations
+		return nil
+	case op == opRefNull:
+		_, err := r.byte()
+		return err
+	case op == 0xd1:
+		// ref.is_null
+		return nil
+	case op == opMiscPrefix:
+		return r.skipMiscImmediates()
+	case op == 0x43, op == 0x44, op >= 0x5b && op <= 0x66, op >= 0x8b && op <= 0xbf:
+		return errors.New("float operations are not supported")
+	default:
+		return fmt.Errorf("unsupported operation 0x%02x", op)
+	}
+}
+
+// skipMiscImmediates skips the immediate arguments of operations with the 0xfc prefix.
+func (r *reader) skipMiscImmediates() error {
+	subOp, err := r.u32()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case subOp <= 7:
+		return errors.New("float operations are not supported")
+	case subOp == 8:
+		// memory.init
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+
+		return r.expectZero()
+	case subOp == 9, subOp == 13, subOp >= 15 && subOp <= 17:
+		// data.drop, elem.drop, table.grow, table.size, table.fill
+		_, err := r.u32()
+		return err
+	case subOp == 10:
+		// memory.copy
+		if err := r.expectZero(); err != nil {
+			return err
+		}
+
+		return r.expectZero()
+	case subOp == 11:
+		// memory.fill
+		return r.expectZero()
+	case subOp == 12, subOp == 14:
+		// table.init, table.copy
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+
+		_, err := r.u32()
+		return err
+	default:
+		return fmt.Errorf("unsupported operation 0xfc %d", subOp)
+	}
+}
+
+func (r *reader) expectZero() error {
+	b, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	if b != 0x00 {
+		return errors.New("multiple memories are not supported")
+	}
+
+	return nil
+}
+
+func isFloatOrVectorType(valType byte) bool {
+	return valType == 0x7d || valType == 0x7c || valType == 0x7b
+}
+
+// appendU32 appends the unsigned LEB128 encoding of v to dst.
+func appendU32(dst []byte, v uint32) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(dst, b)
+		}
+
+		dst = append(dst, b|0x80)
+	}
+}
+
+// appendS64 appends the signed LEB128 encoding of v to dst.
+func appendS64(dst []byte, v int64) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(dst, b)
+		}
+
+		dst = append(dst, b|0x80)
+	}
+}
@@ -0,0 +1,452 @@
+package wazerovm
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+const (
+	// maxLengthResult is the maximum length of the result of a contract execution.
+	maxLengthResult = 64 * 1024 * 1024
+	// maxLengthReplyPayload is the maximum length of the payload of a submessage.
+	maxLengthReplyPayload = 128 * 1024
+)
+
+var _ types.WasmEngine = (*VM)(nil)
+
+// VM is a WasmEngine executing CosmWasm contracts using the wazero runtime.
+// The original code of stored contracts is persisted in the data directory of the VM,
+// while the compiled code of pinned contracts is kept in memory.
+type VM struct {
+	runtime wazero.Runtime
+	codeDir string
+
+	supportedCapabilities []string
+	memoryLimitPages      uint32
+	printDebug            bool
+
+	mtx    sync.Mutex
+	pinned map[string]wazero.CompiledModule
+}
+
+// NewVM creates a new VM storing contract code in the provided data directory. The memory limit
+// of contract instances is provided in MiB. If printDebug is true, messages emitted by contracts
+// using the debug host function are printed to stdout.
+func NewVM(dataDir string, supportedCapabilities []string, memoryLimit uint32, printDebug bool) (*VM, error) {
+	codeDir := filepath.Join(dataDir, "wazero", "code")
+	if err := os.MkdirAll(codeDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create code directory: %w", err)
+	}
+
+	memoryLimitPages := memoryLimit * (1024 * 1024 / wasmPageSize)
+
+	ctx := context.Background()
+	config := wazero.NewRuntimeConfig().
+		WithCoreFeatures(api.CoreFeaturesV2).
+		WithMemoryLimitPages(memoryLimitPages)
+
+	runtime := wazero.NewRuntimeWithConfig(ctx, config)
+	if err := instantiateHostModule(ctx, runtime); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to instantiate host module: %w", err), runtime.Close(ctx))
+	}
+
+	return &VM{
+		runtime:               runtime,
+		codeDir:               codeDir,
+		supportedCapabilities: supportedCapabilities,
+		memoryLimitPages:      memoryLimitPages,
+		printDebug:            printDebug,
+		pinned:                make(map[string]wazero.CompiledModule),
+	}, nil
+}
+
+// Cleanup releases all resources held by the VM. The VM must not be used afterwards.
+func (vm *VM) Cleanup() {
+	vm.mtx.Lock()
+	defer vm.mtx.Unlock()
+
+	vm.pinned = make(map[string]wazero.CompiledModule)
+	_ = vm.runtime.Close(context.Background())
+}
+
+// StoreCode validates and compiles the Wasm code and persists it, returning its checksum and the
+// gas cost of storing the code. An OutOfGasError is returned if the gas limit is below the gas cost.
+func (vm *VM) StoreCode(code wasmvm.WasmCode, gasLimit uint64) (wasmvmtypes.Checksum, uint64, error) {
+	gasCost := compileCost(code)
+	if gasLimit < gasCost {
+		return nil, gasCost, wasmvmtypes.OutOfGasError{}
+	}
+
+	checksum, err := vm.StoreCodeUnchecked(code)
+	return checksum, gasCost, err
+}
+
+// StoreCodeUnchecked is the same as StoreCode but does not charge gas. Static validation of the
+// code cannot be skipped, since it is performed as part of the instrumentation of the code.
+func (vm *VM) StoreCodeUnchecked(code wasmvm.WasmCode) (wasmvm.Checksum, error) {
+	checksum, err := wasmvm.CreateChecksum(code)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := vm.compile(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := compiled.Close(context.Background()); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(vm.codePath(checksum), code, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write wasm code: %w", err)
+	}
+
+	return checksum, nil
+}
+
+// GetCode returns the original Wasm code stored for the provided checksum.
+func (vm *VM) GetCode(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+	code, err := os.ReadFile(vm.codePath(checksum))
+	if err != nil {
+		return nil, fmt.Errorf("error opening wasm file for reading: %w", err)
+	}
+
+	return code, nil
+}
+
+// Pin compiles the code stored for the provided checksum and keeps it in memory,
+// such that it does not need to be compiled for every execution. Pin is idempotent.
+func (vm *VM) Pin(checksum wasmvm.Checksum) error {
+	vm.mtx.Lock()
+	defer vm.mtx.Unlock()
+
+	if _, ok := vm.pinned[string(checksum)]; ok {
+		return nil
+	}
+
+	code, err := vm.GetCode(checksum)
+	if err != nil {
+		return err
+	}
+
+	compiled, err := vm.compile(code)
+	if err != nil {
+		return err
+	}
+
+	vm.pinned[string(checksum)] = compiled
+
+	return nil
+}
+
+// Unpin removes the compiled code of the provided checksum from memory. Unpin is idempotent.
+func (vm *VM) Unpin(checksum wasmvm.Checksum) error {
+	vm.mtx.Lock()
+	defer vm.mtx.Unlock()
+
+	compiled, ok := vm.pinned[string(checksum)]
+	if !ok {
+		return nil
+	}
+
+	delete(vm.pinned, string(checksum))
+
+	return compiled.Close(context.Background())
+}
+
+// Instantiate calls the instantiate entry point of the contract.
+func (vm *VM) Instantiate(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	info wasmvmtypes.MessageInfo,
+	initMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.ContractResult, uint64, error) {
+	envBz, err := json.Marshal(env)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	infoBz, err := json.Marshal(info)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var result wasmvmtypes.ContractResult
+	gasUsed, err := vm.execute(checksum, "instantiate", [][]byte{envBz, infoBz, initMsg}, store, goapi, querier, gasMeter, gasLimit, deserCost, false, &result)
+	if err != nil {
+		return nil, gasUsed, err
+	}
+
+	return &result, gasUsed, nil
+}
+
+// Query calls the query entry point of the contract. The contract is provided read-only access to storage.
+func (vm *VM) Query(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	queryMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.QueryResult, uint64, error) {
+	envBz, err := json.Marshal(env)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var result wasmvmtypes.QueryResult
+	gasUsed, err := vm.execute(checksum, "query", [][]byte{envBz, queryMsg}, store, goapi, querier, gasMeter, gasLimit, deserCost, true, &result)
+	if err != nil {
+		return nil, gasUsed, err
+	}
+
+	return &result, gasUsed, nil
+}
+
+// Migrate calls the migrate entry point of the contract.
+func (vm *VM) Migrate(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	migrateMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.ContractResult, uint64, error) {
+	return vm.call(checksum, "migrate", env, migrateMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+}
+
+// Sudo calls the sudo entry point of the contract.
+func (vm *VM) Sudo(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	sudoMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.ContractResult, uint64, error) {
+	return vm.call(checksum, "sudo", env, sudoMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+}
+
+// call calls an entry point of the contract taking the env and a message and returning a ContractResult.
+func (vm *VM) call(
+	checksum wasmvm.Checksum,
+	entryPoint string,
+	env wasmvmtypes.Env,
+	msg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.ContractResult, uint64, error) {
+	envBz, err := json.Marshal(env)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var result wasmvmtypes.ContractResult
+	gasUsed, err := vm.execute(checksum, entryPoint, [][]byte{envBz, msg}, store, goapi, querier, gasMeter, gasLimit, deserCost, false, &result)
+	if err != nil {
+		return nil, gasUsed, err
+	}
+
+	for i, m := range result.SubMessages() {
+		if len(m.Payload) > maxLengthReplyPayload {
+			return nil, gasUsed, fmt.Errorf("reply contains submessage at index %d with payload larger than %d bytes: %d bytes", i, maxLengthReplyPayload, len(m.Payload))
+		}
+	}
+
+	return &result, gasUsed, nil
+}
+
+// execute instantiates the contract, calls the entry point with the provided arguments and decodes
+// the result into the provided response. It returns the gas consumed by the VM, which includes the
+// gas for deserializing the result, but excludes the gas consumed outside of the VM.
+func (vm *VM) execute(
+	checksum wasmvm.Checksum,
+	entryPoint string,
+	args [][]byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+	readOnly bool,
+	response any,
+) (uint64, error) {
+	compiled, release, err := vm.load(checksum)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	env := &callEnv{
+		store:      store,
+		goapi:      goapi,
+		querier:    querier,
+		gas:        newGasState(gasLimit, gasMeter),
+		readOnly:   readOnly,
+		printDebug: vm.printDebug,
+	}
+	defer env.close()
+
+	ctx := context.WithValue(context.Background(), callEnvKey{}, env)
+
+	mod, err := vm.runtime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().WithName("").WithStartFunctions())
+	if err != nil {
+		return 0, fmt.Errorf("failed to instantiate contract: %w", err)
+	}
+	defer mod.Close(ctx)
+
+	gasGlobal, ok := mod.ExportedGlobal(GasGlobalExport).(api.MutableGlobal)
+	if !ok {
+		return 0, errors.New("gas global not found")
+	}
+	gasGlobal.Set(uint64(env.gas.initialGasLeft()))
+
+	data, err := vm.run(ctx, mod, entryPoint, args)
+	gasUsed := env.gas.usedInternally(int64(gasGlobal.Get()))
+	if err != nil {
+		if int64(gasGlobal.Get()) < 0 || errors.Is(err, wasmvmtypes.OutOfGasError{}) {
+			return gasUsed, wasmvmtypes.OutOfGasError{}
+		}
+
+		return gasUsed, err
+	}
+
+	gasForDeserialization := deserCost.Mul(uint64(len(data))).Floor()
+	if gasLimit < gasForDeserialization+gasUsed {
+		return gasUsed, fmt.Errorf("Insufficient gas left to deserialize contract execution result (%d bytes)", len(data))
+	}
+	gasUsed += gasForDeserialization
+
+	if err := json.Unmarshal(data, response); err != nil {
+		return gasUsed, err
+	}
+
+	return gasUsed, nil
+}
+
+// run writes the arguments into contract memory, calls the entry point and returns the result data.
+func (*VM) run(ctx context.Context, mod api.Module, entryPoint string, args [][]byte) ([]byte, error) {
+	fn := mod.ExportedFunction(entryPoint)
+	if fn == nil {
+		return nil, fmt.Errorf("wasm contract doesn't have required export: \"%s\"", entryPoint)
+	}
+
+	ptrs := make([]uint64, len(args))
+	for i, arg := range args {
+		ptr, err := allocate(ctx, mod, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		ptrs[i] = api.EncodeU32(ptr)
+	}
+
+	results, err := fn.Call(ctx, ptrs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) != 1 {
+		return nil, fmt.Errorf("entry point %s must return a single value", entryPoint)
+	}
+
+	return readRegionData(mod.Memory(), api.DecodeU32(results[0]), maxLengthResult)
+}
+
+// load returns the compiled code for the provided checksum, together with a function that must be
+// called once the compiled code is no longer used. Code that is not pinned is compiled for every call.
+func (vm *VM) load(checksum wasmvm.Checksum) (wazero.CompiledModule, func(), error) {
+	vm.mtx.Lock()
+	compiled, ok := vm.pinned[string(checksum)]
+	vm.mtx.Unlock()
+
+	if ok {
+		return compiled, func() {}, nil
+	}
+
+	code, err := vm.GetCode(checksum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compiled, err = vm.compile(code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return compiled, func() { _ = compiled.Close(context.Background()) }, nil
+}
+
+// compile validates and instruments the Wasm code and compiles it.
+func (vm *VM) compile(code []byte) (wazero.CompiledModule, error) {
+	instrumented, err := instrument(code, vm.supportedCapabilities, vm.memoryLimitPages)
+	if err != nil {
+		return nil, fmt.Errorf("wasm contract failed static validation: %w", err)
+	}
+
+	compiled, err := vm.runtime.CompileModule(context.Background(), instrumented)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile wasm contract: %w", err)
+	}
+
+	return compiled, nil
+}
+
+func (vm *VM) codePath(checksum wasmvm.Checksum) string {
+	return filepath.Join(vm.codeDir, hex.EncodeToString(checksum))
+}
+
+// allocate allocates a region in contract memory using the allocate export of the
+// contract, writes the data into it and returns the pointer to the region.
+func allocate(ctx context.Context, mod api.Module, data []byte) (uint32, error) {
+	fn := mod.ExportedFunction("allocate")
+	if fn == nil {
+		return 0, errors.New("wasm contract doesn't have required export: \"allocate\"")
+	}
+
+	results, err := fn.Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, err
+	}
+
+	ptr := api.DecodeU32(results[0])
+	if err := writeRegionData(mod.Memory(), ptr, data); err != nil {
+		return 0, err
+	}
+
+	return ptr, nil
+}
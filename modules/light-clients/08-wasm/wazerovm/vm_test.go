@@ -0,0 +1,300 @@
+package wazerovm_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	dbm "github.com/cosmos/cosmos-db"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/gaskv"
+	storetypes "cosmossdk.io/store/types"
+
+	internaltypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/types"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/wazerovm"
+)
+
+const defaultGasLimit = 10_000_000_000
+
+type WazeroVMTestSuite struct {
+	testifysuite.Suite
+
+	vm *wazerovm.VM
+
+	sdkGasMeter storetypes.GasMeter
+	gasMeter    types.MultipliedGasMeter
+	store       wasmvm.KVStore
+}
+
+func TestWazeroVMTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(WazeroVMTestSuite))
+}
+
+func (suite *WazeroVMTestSuite) SetupTest() {
+	suite.vm = suite.newVM()
+
+	suite.sdkGasMeter = storetypes.NewInfiniteGasMeter()
+	suite.gasMeter = types.NewMultipliedGasMeter(suite.sdkGasMeter, types.VMGasRegister)
+	suite.store = newStore(suite.sdkGasMeter)
+}
+
+func (suite *WazeroVMTestSuite) newVM() *wazerovm.VM {
+	vm, err := wazerovm.NewVM(suite.T().TempDir(), []string{"iterator"}, types.ContractMemoryLimit, false)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(vm.Cleanup)
+
+	return vm
+}
+
+// newStore returns an in-memory store charging gas on the provided gas meter.
+func newStore(gasMeter storetypes.GasMeter) wasmvm.KVStore {
+	return internaltypes.NewStoreAdapter(gaskv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, gasMeter, storetypes.KVGasConfig()))
+}
+
+// query sends the query message to the contract using the suite's store and gas meter.
+func (suite *WazeroVMTestSuite) query(checksum wasmvm.Checksum, msg []byte, gasLimit uint64) (*wasmvmtypes.QueryResult, uint64, error) {
+	return suite.vm.Query(checksum, wasmvmtypes.Env{}, msg, suite.store, wasmvm.GoAPI{}, nil, suite.gasMeter, gasLimit, types.CostJSONDeserialization)
+}
+
+func (suite *WazeroVMTestSuite) TestStoreCode() {
+	var (
+		wasmCode []byte
+		gasLimit uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"failure: gas limit below compile cost",
+			func() {
+				gasLimit = wazerovm.CompileCostPerByte*uint64(len(wasmCode)) - 1
+			},
+			wasmvmtypes.OutOfGasError{}.Error(),
+		},
+		{
+			"failure: invalid wasm header",
+			func() {
+				wasmCode = []byte("\x00asm\x02\x00\x00\x00")
+			},
+			"invalid wasm header",
+		},
+		{
+			"failure: float operation",
+			func() {
+				m := minimalContract("float", concat(i32Const(0), []byte{opDrop, opF32Const, 0, 0, 0, 0, opDrop, opEnd}))
+				wasmCode = m.encode()
+			},
+			"float operations are not supported",
+		},
+		{
+			"failure: missing required export",
+			func() {
+				m := minimalContract("", nil)
+				m.exports = m.exports[:3]
+				wasmCode = m.encode()
+			},
+			"wasm contract doesn't have required export: \"interface_version_8\"",
+		},
+		{
+			"failure: unsupported import",
+			func() {
+				m := minimalContract("", nil)
+				m.types = append(m.types, funcType{})
+				m.imports = []wasmImport{{"env", "unsupported", uint32(len(m.types) - 1)}}
+				for i := range m.exports[1:] {
+					m.exports[i+1].index++
+				}
+				wasmCode = m.encode()
+			},
+			"wasm contract requires unsupported import: \"env.unsupported\"",
+		},
+		{
+			"failure: unavailable capability",
+			func() {
+				wasmCode = minimalContract("requires_staking", []byte{opEnd}).encode()
+			},
+			"wasm contract requires unavailable capabilities: staking",
+		},
+		{
+			"failure: reserved gas global export",
+			func() {
+				wasmCode = minimalContract(wazerovm.GasGlobalExport, []byte{opEnd}).encode()
+			},
+			"wasm contract must not export reserved name __gas_left",
+		},
+		{
+			"failure: start function",
+			func() {
+				m := minimalContract("", nil)
+				start := uint32(2)
+				m.start = &start
+				wasmCode = m.encode()
+			},
+			"wasm contract must not define a start function",
+		},
+		{
+			"failure: no memory",
+			func() {
+				m := minimalContract("", nil)
+				m.noMemory = true
+				m.exports = m.exports[1:]
+				wasmCode = m.encode()
+			},
+			"wasm contract must contain exactly one memory",
+		},
+		{
+			"failure: memory exceeds limit",
+			func() {
+				m := minimalContract("", nil)
+				m.memoryPages = types.ContractMemoryLimit*16 + 1
+				wasmCode = m.encode()
+			},
+			"wasm contract memory's minimum must not exceed 512 pages",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			wasmCode = lightClientContract()
+			gasLimit = defaultGasLimit
+
+			tc.malleate()
+
+			checksum, gasCost, err := suite.vm.StoreCode(wasmCode, gasLimit)
+			suite.Require().Equal(wazerovm.CompileCostPerByte*uint64(len(wasmCode)), gasCost)
+
+			if tc.expErr == "" {
+				suite.Require().NoError(err)
+
+				expChecksum, err := wasmvm.CreateChecksum(wasmCode)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expChecksum, checksum)
+
+				storedCode, err := suite.vm.GetCode(checksum)
+				suite.Require().NoError(err)
+				suite.Require().Equal(wasmCode, []byte(storedCode))
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *WazeroVMTestSuite) TestPinUnpin() {
+	checksum, err := suite.vm.StoreCodeUnchecked(lightClientContract())
+	suite.Require().NoError(err)
+
+	// pinning is idempotent
+	suite.Require().NoError(suite.vm.Pin(checksum))
+	suite.Require().NoError(suite.vm.Pin(checksum))
+
+	res, _, err := suite.query(checksum, []byte(`{"status":{}}`), defaultGasLimit)
+	suite.Require().NoError(err)
+	suite.Require().JSONEq(statusActive, string(mustMarshalJSON(res)))
+
+	// unpinning is idempotent and pinned code can still be executed after unpinning
+	suite.Require().NoError(suite.vm.Unpin(checksum))
+	suite.Require().NoError(suite.vm.Unpin(checksum))
+
+	res, _, err = suite.query(checksum, []byte(`{"status":{}}`), defaultGasLimit)
+	suite.Require().NoError(err)
+	suite.Require().JSONEq(statusActive, string(mustMarshalJSON(res)))
+
+	err = suite.vm.Pin(wasmvm.Checksum(make([]byte, 32)))
+	suite.Require().Error(err)
+}
+
+func (suite *WazeroVMTestSuite) TestCodePersistence() {
+	dir := suite.T().TempDir()
+
+	vm, err := wazerovm.NewVM(dir, nil, types.ContractMemoryLimit, false)
+	suite.Require().NoError(err)
+
+	checksum, err := vm.StoreCodeUnchecked(lightClientContract())
+	suite.Require().NoError(err)
+	vm.Cleanup()
+
+	// code stored by a previous VM instance is available
+	vm, err = wazerovm.NewVM(dir, nil, types.ContractMemoryLimit, false)
+	suite.Require().NoError(err)
+	defer vm.Cleanup()
+
+	code, err := vm.GetCode(checksum)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lightClientContract(), []byte(code))
+}
+
+func (suite *WazeroVMTestSuite) TestGasMetering() {
+	checksum, err := suite.vm.StoreCodeUnchecked(lightClientContract())
+	suite.Require().NoError(err)
+
+	_, gasUsed, err := suite.query(checksum, []byte(`{"status":{}}`), defaultGasLimit)
+	suite.Require().NoError(err)
+	suite.Require().Positive(gasUsed)
+
+	// the gas consumed is deterministic
+	_, gasUsedAgain, err := suite.query(checksum, []byte(`{"status":{}}`), defaultGasLimit)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gasUsed, gasUsedAgain)
+
+	// gas consumed by storage access counts towards the gas limit, but is not included in the gas used
+	externalGas := types.VMGasRegister.ToWasmVMGas(suite.sdkGasMeter.GasConsumed()) / 2
+	suite.Require().Positive(externalGas)
+
+	gasForDeserialization := types.CostJSONDeserialization.Mul(uint64(len(statusActive))).Floor()
+
+	_, _, err = suite.query(checksum, []byte(`{"status":{}}`), gasUsed+externalGas)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.query(checksum, []byte(`{"status":{}}`), gasUsed+externalGas-gasForDeserialization-1)
+	suite.Require().ErrorIs(err, wasmvmtypes.OutOfGasError{})
+
+	// execution runs out of gas
+	_, gasUsed, err = suite.query(checksum, []byte(`{"loop":{}}`), 1_000_000)
+	suite.Require().ErrorIs(err, wasmvmtypes.OutOfGasError{})
+	suite.Require().LessOrEqual(gasUsed, uint64(1_000_000))
+}
+
+func (suite *WazeroVMTestSuite) TestQueryReadOnly() {
+	checksum, err := suite.vm.StoreCodeUnchecked(lightClientContract())
+	suite.Require().NoError(err)
+
+	_, _, err = suite.query(checksum, []byte(`{"write":{}}`), defaultGasLimit)
+	suite.Require().ErrorContains(err, "Write access to storage not allowed in this context")
+	suite.Require().Nil(suite.store.Get([]byte(keyFrozen)))
+}
+
+func (suite *WazeroVMTestSuite) TestAbort() {
+	checksum, err := suite.vm.StoreCodeUnchecked(lightClientContract())
+	suite.Require().NoError(err)
+
+	_, _, err = suite.query(checksum, []byte(`{"timestamp_at_height":{}}`), defaultGasLimit)
+	suite.Require().ErrorContains(err, "Aborted: "+abortMsg)
+	suite.Require().False(errors.Is(err, wasmvmtypes.OutOfGasError{}))
+}
+
+func mustMarshalJSON(v any) []byte {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
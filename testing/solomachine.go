@@ -11,9 +11,7 @@ import (
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
-	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
@@ -24,6 +22,7 @@ import (
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v9/modules/light-clients/06-solomachine"
+	smsigner "github.com/cosmos/ibc-go/v9/modules/light-clients/06-solomachine/signer"
 	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
 )
 
@@ -156,39 +155,12 @@ func (solo *Solomachine) CreateHeader(newDiversifier string) *solomachine.Header
 	// generate new private keys and signature for header
 	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
 
-	publicKey, err := codectypes.NewAnyWithValue(newPubKey)
+	sm := solo.soloMachine()
+	header, err := sm.CreateHeader(newPubKey, newDiversifier, newSigner(newPrivKeys))
 	require.NoError(solo.t, err)
 
-	data := &solomachine.HeaderData{
-		NewPubKey:      publicKey,
-		NewDiversifier: newDiversifier,
-	}
-
-	dataBz, err := solo.cdc.Marshal(data)
-	require.NoError(solo.t, err)
-
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        []byte(solomachine.SentinelHeaderPath),
-		Data:        dataBz,
-	}
-
-	bz, err := solo.cdc.Marshal(signBytes)
-	require.NoError(solo.t, err)
-
-	sig := solo.GenerateSignature(bz)
-
-	header := &solomachine.Header{
-		Timestamp:      solo.Time,
-		Signature:      sig,
-		NewPublicKey:   publicKey,
-		NewDiversifier: newDiversifier,
-	}
-
 	// assumes successful header update
-	solo.Sequence++
+	solo.Sequence = sm.Sequence
 	solo.Time++
 	solo.PrivateKeys = newPrivKeys
 	solo.PublicKeys = newPubKeys
@@ -463,32 +435,7 @@ func (solo *Solomachine) TimeoutPacketOnClose(chain *TestChain, packet channelty
 // over the sign bytes with each key. If the amount of keys is greater than
 // 1 then a multisig data type is returned.
 func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
-	sigs := make([]signing.SignatureData, len(solo.PrivateKeys))
-	for i, key := range solo.PrivateKeys {
-		sig, err := key.Sign(signBytes)
-		require.NoError(solo.t, err)
-
-		sigs[i] = &signing.SingleSignatureData{
-			Signature: sig,
-		}
-	}
-
-	var sigData signing.SignatureData
-	if len(sigs) == 1 {
-		// single public key
-		sigData = sigs[0]
-	} else {
-		// generate multi signature data
-		multiSigData := multisig.NewMultisig(len(sigs))
-		for i, sig := range sigs {
-			multisig.AddSignature(multiSigData, sig, i)
-		}
-
-		sigData = multiSigData
-	}
-
-	protoSigData := signing.SignatureDataToProto(sigData)
-	bz, err := solo.cdc.Marshal(protoSigData)
+	bz, err := smsigner.GenerateSignature(solo.cdc, newSigner(solo.PrivateKeys), signBytes)
 	require.NoError(solo.t, err)
 
 	return bz
@@ -516,37 +463,17 @@ func (solo *Solomachine) GenerateProof(signBytes *solomachine.SignBytes) []byte
 // GenerateClientStateProof generates the proof of the client state required for the connection open try and ack handshake steps.
 // The client state should be the self client states of the tendermint chain.
 func (solo *Solomachine) GenerateClientStateProof(clientState exported.ClientState) []byte {
-	data, err := clienttypes.MarshalClientState(solo.cdc, clientState)
-	require.NoError(solo.t, err)
-
-	path := host.FullClientStateKey(clientIDSolomachine)
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        path,
-		Data:        data,
-	}
-
-	return solo.GenerateProof(signBytes)
+	return solo.generateProof(func(sm *smsigner.SoloMachine) ([]byte, error) {
+		return sm.ClientStateProof(clientIDSolomachine, clientState)
+	})
 }
 
 // GenerateConsensusStateProof generates the proof of the consensus state required for the connection open try and ack handshake steps.
 // The consensus state should be the self consensus states of the tendermint chain.
 func (solo *Solomachine) GenerateConsensusStateProof(consensusState exported.ConsensusState, consensusHeight exported.Height) []byte {
-	data, err := clienttypes.MarshalConsensusState(solo.cdc, consensusState)
-	require.NoError(solo.t, err)
-
-	path := host.FullConsensusStateKey(clientIDSolomachine, consensusHeight)
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        path,
-		Data:        data,
-	}
-
-	return solo.GenerateProof(signBytes)
+	return solo.generateProof(func(sm *smsigner.SoloMachine) ([]byte, error) {
+		return sm.ConsensusStateProof(clientIDSolomachine, consensusHeight, consensusState)
+	})
 }
 
 // GenerateConnOpenTryProof generates the proofTry required for the connection open ack handshake step.
@@ -555,19 +482,9 @@ func (solo *Solomachine) GenerateConnOpenTryProof(counterpartyClientID, counterp
 	counterparty := connectiontypes.NewCounterparty(counterpartyClientID, counterpartyConnectionID, prefix)
 	connection := connectiontypes.NewConnectionEnd(connectiontypes.TRYOPEN, clientIDSolomachine, counterparty, []*connectiontypes.Version{ConnectionVersion}, DefaultDelayPeriod)
 
-	data, err := solo.cdc.Marshal(&connection)
-	require.NoError(solo.t, err)
-
-	path := host.ConnectionKey(connectionIDSolomachine)
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        path,
-		Data:        data,
-	}
-
-	return solo.GenerateProof(signBytes)
+	return solo.generateProof(func(sm *smsigner.SoloMachine) ([]byte, error) {
+		return sm.ConnectionStateProof(connectionIDSolomachine, connection)
+	})
 }
 
 // GenerateChanOpenTryProof generates the proofTry required for the channel open ack handshake step.
@@ -576,19 +493,9 @@ func (solo *Solomachine) GenerateChanOpenTryProof(portID, version, counterpartyC
 	counterparty := channeltypes.NewCounterparty(portID, counterpartyChannelID)
 	channel := channeltypes.NewChannel(channeltypes.TRYOPEN, channeltypes.UNORDERED, counterparty, []string{connectionIDSolomachine}, version)
 
-	data, err := solo.cdc.Marshal(&channel)
-	require.NoError(solo.t, err)
-
-	path := host.ChannelKey(portID, channelIDSolomachine)
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        path,
-		Data:        data,
-	}
-
-	return solo.GenerateProof(signBytes)
+	return solo.generateProof(func(sm *smsigner.SoloMachine) ([]byte, error) {
+		return sm.ChannelStateProof(portID, channelIDSolomachine, channel)
+	})
 }
 
 // GenerateChanClosedProof generates a channel closed proof.
@@ -597,64 +504,67 @@ func (solo *Solomachine) GenerateChanClosedProof(portID, version, counterpartyCh
 	counterparty := channeltypes.NewCounterparty(portID, counterpartyChannelID)
 	channel := channeltypes.NewChannel(channeltypes.CLOSED, channeltypes.UNORDERED, counterparty, []string{connectionIDSolomachine}, version)
 
-	data, err := solo.cdc.Marshal(&channel)
-	require.NoError(solo.t, err)
-
-	path := host.ChannelKey(portID, channelIDSolomachine)
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        path,
-		Data:        data,
-	}
-
-	return solo.GenerateProof(signBytes)
+	return solo.generateProof(func(sm *smsigner.SoloMachine) ([]byte, error) {
+		return sm.ChannelStateProof(portID, channelIDSolomachine, channel)
+	})
 }
 
 // GenerateCommitmentProof generates a commitment proof for the provided packet.
 func (solo *Solomachine) GenerateCommitmentProof(packet channeltypes.Packet) []byte {
-	commitment := channeltypes.CommitPacket(solo.cdc, packet)
-
-	path := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        path,
-		Data:        commitment,
-	}
-
-	return solo.GenerateProof(signBytes)
+	return solo.generateProof(func(sm *smsigner.SoloMachine) ([]byte, error) {
+		return sm.PacketCommitmentProof(packet)
+	})
 }
 
 // GenerateAcknowledgementProof generates an acknowledgement proof.
 func (solo *Solomachine) GenerateAcknowledgementProof(packet channeltypes.Packet) []byte {
 	transferAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
 
-	path := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        path,
-		Data:        channeltypes.CommitAcknowledgement(transferAck),
-	}
-
-	return solo.GenerateProof(signBytes)
+	return solo.generateProof(func(sm *smsigner.SoloMachine) ([]byte, error) {
+		return sm.PacketAcknowledgementProof(packet, transferAck)
+	})
 }
 
 // GenerateReceiptAbsenceProof generates a receipt absence proof for the provided packet.
 func (solo *Solomachine) GenerateReceiptAbsenceProof(packet channeltypes.Packet) []byte {
-	path := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-	signBytes := &solomachine.SignBytes{
-		Sequence:    solo.Sequence,
-		Timestamp:   solo.Time,
-		Diversifier: solo.Diversifier,
-		Path:        path,
-		Data:        nil,
+	return solo.generateProof(func(sm *smsigner.SoloMachine) ([]byte, error) {
+		return sm.PacketReceiptAbsenceProof(packet)
+	})
+}
+
+// generateProof calls the provided proof generation function with a signer.SoloMachine
+// reflecting the current state of the testing solo machine. The sequence is updated to
+// the sequence of the signer.SoloMachine after proof generation.
+func (solo *Solomachine) generateProof(fn func(sm *smsigner.SoloMachine) ([]byte, error)) []byte {
+	sm := solo.soloMachine()
+
+	proof, err := fn(sm)
+	require.NoError(solo.t, err)
+
+	solo.Sequence = sm.Sequence
+
+	return proof
+}
+
+// soloMachine returns a signer.SoloMachine using the current private keys, sequence,
+// time and diversifier of the testing solo machine.
+func (solo *Solomachine) soloMachine() *smsigner.SoloMachine {
+	return smsigner.NewSoloMachine(solo.cdc, newSigner(solo.PrivateKeys), solo.Sequence, solo.Time, solo.Diversifier)
+}
+
+// newSigner returns a signer for the provided private keys. If the amount of keys is
+// greater than 1 then a multi signer is returned.
+func newSigner(privKeys []cryptotypes.PrivKey) smsigner.Signer {
+	if len(privKeys) == 1 {
+		return smsigner.NewKeySigner(privKeys[0])
 	}
-	return solo.GenerateProof(signBytes)
+
+	signers := make([]smsigner.Signer, len(privKeys))
+	for i, privKey := range privKeys {
+		signers[i] = smsigner.NewKeySigner(privKey)
+	}
+
+	return smsigner.NewMultiSigner(signers...)
 }
 
 // GetClientStatePath returns the commitment path for the client state.